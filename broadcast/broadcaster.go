@@ -35,22 +35,26 @@ func Insert(ctx context.Context, dbId string, table string, pk interface{}) {
 	if Broadcaster != nil {
 		Broadcaster.Insert(ctx, dbId, table, pk)
 	}
+	Notify(ctx, dbId, table)
 }
 
 func Update(ctx context.Context, dbId string, table string, pk interface{}, fieldnames ...string) {
 	if Broadcaster != nil {
 		Broadcaster.Update(ctx, dbId, table, pk, fieldnames...)
 	}
+	Notify(ctx, dbId, table)
 }
 
 func Delete(ctx context.Context, dbId string, table string, pk interface{}) {
 	if Broadcaster != nil {
 		Broadcaster.Delete(ctx, dbId, table, pk)
 	}
+	Notify(ctx, dbId, table)
 }
 
 func BulkChange(ctx context.Context, dbId string, table string) {
 	if Broadcaster != nil {
 		Broadcaster.BulkChange(ctx, dbId, table)
 	}
+	Notify(ctx, dbId, table)
 }
@@ -0,0 +1,98 @@
+package broadcast
+
+import (
+	"context"
+	"sync"
+
+	"github.com/goradd/gro/db"
+)
+
+// Listener is a function that is called when a table in a database changes.
+type Listener func(ctx context.Context, dbId string, table string)
+
+var listeners = struct {
+	sync.RWMutex
+	m map[string][]Listener
+}{m: make(map[string][]Listener)}
+
+// pending records the tables whose listeners are running. The value is true if another change was
+// notified while they were running, and they need to run again.
+var pending = struct {
+	sync.Mutex
+	m  map[string]bool
+	wg sync.WaitGroup
+}{m: make(map[string]bool)}
+
+// Listen registers f to be called when a change to the given table is broadcast.
+// The generated registries of lookup tables use it to reload themselves.
+func Listen(dbId string, table string, f Listener) {
+	listeners.Lock()
+	defer listeners.Unlock()
+	k := dbId + "." + table
+	listeners.m[k] = append(listeners.m[k], f)
+}
+
+// Notify calls the listeners of the given table.
+//
+// Insert, Update, Delete and BulkChange call it for the changes made by this process.
+// A Broadcaster that receives the changes made by other processes should call it as well,
+// so that the listeners in this process see those changes.
+//
+// If ctx is in a transaction, the listeners are called after the transaction is committed, and not at all
+// if it is rolled back. The listeners run in their own goroutine with a new context, so that they do not
+// hold up the caller. Notifications that arrive while the listeners of a table are running are combined
+// into one more call of the listeners.
+func Notify(ctx context.Context, dbId string, table string) {
+	db.AfterCommit(ctx, dbId, func() {
+		schedule(dbId, table)
+	})
+}
+
+// Wait waits until the listeners of all the notified changes have finished.
+func Wait() {
+	pending.wg.Wait()
+}
+
+func schedule(dbId string, table string) {
+	k := dbId + "." + table
+	listeners.RLock()
+	n := len(listeners.m[k])
+	listeners.RUnlock()
+	if n == 0 {
+		return
+	}
+
+	pending.Lock()
+	if _, ok := pending.m[k]; ok {
+		pending.m[k] = true
+		pending.Unlock()
+		return
+	}
+	pending.m[k] = false
+	pending.wg.Add(1)
+	pending.Unlock()
+
+	go func() {
+		defer pending.wg.Done()
+		for {
+			callListeners(context.Background(), dbId, table)
+			pending.Lock()
+			if !pending.m[k] {
+				delete(pending.m, k)
+				pending.Unlock()
+				return
+			}
+			pending.m[k] = false
+			pending.Unlock()
+		}
+	}()
+}
+
+func callListeners(ctx context.Context, dbId string, table string) {
+	listeners.RLock()
+	l := listeners.m[dbId+"."+table]
+	listeners.RUnlock()
+	for _, f := range l {
+		f(ctx, dbId, table)
+	}
+}
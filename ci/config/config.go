@@ -116,7 +116,7 @@ func initSQLite() {
 	path := testDir()
 	fmt.Println(path)
 
-	s, err := schema.ReadJsonFile(filepath.Join(path, "schema", "goradd_schema.json"))
+	s, err := schema.ReadFile(filepath.Join(path, "schema", "goradd_schema.json"))
 	if err != nil {
 		panic(err)
 	}
@@ -136,7 +136,7 @@ func initSQLite() {
 	}
 	db.AddDatabase(database, goraddUnitKey)
 
-	s, err = schema.ReadJsonFile(filepath.Join(path, "schema", "goraddunit_schema.json"))
+	s, err = schema.ReadFile(filepath.Join(path, "schema", "goraddunit_schema.json"))
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,82 @@
+package crud
+
+import (
+	"context"
+	"testing"
+
+	goradd_unit2 "github.com/goradd/gro/ci/tests/gen/goradd_unit"
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumSet(t *testing.T) {
+	ctx := context.Background()
+
+	tk := goradd_unit2.NewTicket()
+	tk.SetTitle("enumSetTicket")
+	tk.SetPriority(goradd_unit2.PriorityLow)
+	tk.SetPlatforms([]goradd_unit2.Platform{goradd_unit2.PlatformLinux, goradd_unit2.PlatformWindows, goradd_unit2.PlatformLinux})
+	assert.Equal(t, []goradd_unit2.Platform{goradd_unit2.PlatformWindows, goradd_unit2.PlatformLinux}, tk.Platforms())
+	tk.AddPlatforms(goradd_unit2.PlatformAndroid)
+	tk.RemovePlatforms(goradd_unit2.PlatformWindows)
+	assert.True(t, tk.HasPlatforms(goradd_unit2.PlatformAndroid))
+	assert.False(t, tk.HasPlatforms(goradd_unit2.PlatformWindows))
+	require.NoError(t, tk.Save(ctx))
+	defer func() {
+		_ = tk.Delete(ctx)
+	}()
+
+	// The database holds the bit flags
+	cursor, err := goradd_unit2.Database().Query(ctx, "ticket",
+		map[string]query.ReceiverType{"platforms": query.ColTypeInteger64},
+		map[string]any{"id": tk.ID()},
+		nil)
+	require.NoError(t, err)
+	row, err := cursor.Next()
+	require.NoError(t, err)
+	require.NoError(t, cursor.Close())
+	assert.Equal(t, int64(1<<goradd_unit2.PlatformLinux|1<<goradd_unit2.PlatformAndroid), row["platforms"])
+
+	tk2, err := goradd_unit2.LoadTicket(ctx, tk.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []goradd_unit2.Platform{goradd_unit2.PlatformLinux, goradd_unit2.PlatformAndroid}, tk2.Platforms())
+
+	count, err := goradd_unit2.QueryTickets(ctx).
+		Where(op.And(
+			op.Equal(node.Ticket().Title(), "enumSetTicket"),
+			op.HasAny(node.Ticket().Platforms(), goradd_unit2.NewPlatformSet(goradd_unit2.PlatformMac, goradd_unit2.PlatformAndroid)),
+		)).
+		Count()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = goradd_unit2.QueryTickets(ctx).
+		Where(op.And(
+			op.Equal(node.Ticket().Title(), "enumSetTicket"),
+			op.HasAny(node.Ticket().Platforms(), goradd_unit2.NewPlatformSet(goradd_unit2.PlatformMac, goradd_unit2.PlatformIos)),
+		)).
+		Count()
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	count, err = goradd_unit2.QueryTickets(ctx).
+		Where(op.And(
+			op.Equal(node.Ticket().Title(), "enumSetTicket"),
+			op.HasAll(node.Ticket().Platforms(), goradd_unit2.NewPlatformSet(goradd_unit2.PlatformLinux, goradd_unit2.PlatformAndroid)),
+		)).
+		Count()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = goradd_unit2.QueryTickets(ctx).
+		Where(op.And(
+			op.Equal(node.Ticket().Title(), "enumSetTicket"),
+			op.HasAll(node.Ticket().Platforms(), goradd_unit2.NewPlatformSet(goradd_unit2.PlatformLinux, goradd_unit2.PlatformMac)),
+		)).
+		Count()
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,59 @@
+package crud
+
+import (
+	"context"
+	"testing"
+
+	goradd_unit2 "github.com/goradd/gro/ci/tests/gen/goradd_unit"
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumStorage(t *testing.T) {
+	ctx := context.Background()
+
+	tk := goradd_unit2.NewTicket()
+	tk.SetTitle("enumStorageTicket")
+	tk.SetPriority(goradd_unit2.PriorityHigh)
+	tk.SetSeverity(goradd_unit2.SeverityMajor)
+	tk.SetPlatforms(nil)
+	require.NoError(t, tk.Save(ctx))
+	defer func() {
+		_ = tk.Delete(ctx)
+	}()
+
+	// The database holds the keys
+	cursor, err := goradd_unit2.Database().Query(ctx, "ticket",
+		map[string]query.ReceiverType{"priority": query.ColTypeString, "severity": query.ColTypeString},
+		map[string]any{"id": tk.ID()},
+		nil)
+	require.NoError(t, err)
+	row, err := cursor.Next()
+	require.NoError(t, err)
+	require.NoError(t, cursor.Close())
+	assert.Equal(t, "urgent", row["priority"])
+	assert.Equal(t, "major", row["severity"])
+
+	// Values are converted back when loaded and when used in a query
+	tk2, err := goradd_unit2.QueryTickets(ctx).
+		Where(op.And(
+			op.Equal(node.Ticket().Priority(), goradd_unit2.PriorityHigh),
+			op.Equal(node.Ticket().Title(), "enumStorageTicket"),
+		)).
+		Get()
+	require.NoError(t, err)
+	require.NotNil(t, tk2)
+	assert.Equal(t, goradd_unit2.PriorityHigh, tk2.Priority())
+	assert.Equal(t, goradd_unit2.SeverityMajor, tk2.Severity())
+
+	tk2.SetSeverityToNull()
+	tk2.SetPriority(goradd_unit2.PriorityLow)
+	require.NoError(t, tk2.Save(ctx))
+	tk3, err := goradd_unit2.LoadTicket(ctx, tk.ID())
+	require.NoError(t, err)
+	assert.Equal(t, goradd_unit2.PriorityLow, tk3.Priority())
+	assert.True(t, tk3.SeverityIsNull())
+}
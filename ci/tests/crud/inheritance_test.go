@@ -0,0 +1,93 @@
+package crud
+
+import (
+	"context"
+	"testing"
+
+	goradd_unit2 "github.com/goradd/gro/ci/tests/gen/goradd_unit"
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInheritance(t *testing.T) {
+	ctx := context.Background()
+
+	c := goradd_unit2.NewCar()
+	c.SetName("inheritanceCar")
+	c.SetDoorCount(4)
+	require.NoError(t, c.Save(ctx))
+	defer func() {
+		_ = c.Delete(ctx)
+	}()
+	tr := goradd_unit2.NewTruck()
+	tr.SetName("inheritanceTruck")
+	tr.SetPayload(2000)
+	require.NoError(t, tr.Save(ctx))
+	defer func() {
+		_ = tr.Delete(ctx)
+	}()
+	v := goradd_unit2.NewVehicle()
+	v.SetName("inheritanceVehicle")
+	require.NoError(t, v.Save(ctx))
+	defer func() {
+		_ = v.Delete(ctx)
+	}()
+
+	// Both records share the primary key
+	assert.Equal(t, c.Vehicle.ID(), c.ID())
+	v2, err := goradd_unit2.LoadVehicle(ctx, c.ID())
+	require.NoError(t, err)
+	require.NotNil(t, v2)
+	assert.Equal(t, "inheritanceCar", v2.Name())
+
+	// The base record is loaded with the subtype
+	c2, err := goradd_unit2.LoadCar(ctx, c.ID())
+	require.NoError(t, err)
+	require.NotNil(t, c2)
+	assert.Equal(t, "inheritanceCar", c2.Name())
+	assert.Equal(t, 4, c2.DoorCount())
+	assert.Equal(t, "inheritanceCar", c2.Get(goradd_unit2.VehicleNameField))
+
+	// Changes to either record are saved together
+	c2.SetName("inheritanceCar2")
+	c2.SetDoorCount(2)
+	assert.True(t, c2.IsDirty())
+	require.NoError(t, c2.Save(ctx))
+	c3, err := goradd_unit2.QueryCars(ctx).
+		Where(op.Equal(node.Car().Vehicle().Name(), "inheritanceCar2")).
+		Get()
+	require.NoError(t, err)
+	require.NotNil(t, c3)
+	assert.Equal(t, 2, c3.DoorCount())
+
+	// Querying the base table returns the concrete types
+	objs, err := goradd_unit2.QueryVehicles(ctx).
+		Where(op.In(node.Vehicle().ID(), c.ID(), tr.ID(), v.ID())).
+		OrderBy(node.Vehicle().Name()).
+		LoadTyped()
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+	if c4, ok := objs[0].(*goradd_unit2.Car); assert.True(t, ok) {
+		assert.Equal(t, 2, c4.DoorCount())
+		assert.Equal(t, "inheritanceCar2", c4.Name())
+	}
+	if tr2, ok := objs[1].(*goradd_unit2.Truck); assert.True(t, ok) {
+		assert.Equal(t, 2000, tr2.Payload())
+		assert.Equal(t, "inheritanceTruck", tr2.Name())
+	}
+	if v3, ok := objs[2].(*goradd_unit2.Vehicle); assert.True(t, ok) {
+		assert.Equal(t, "inheritanceVehicle", v3.Name())
+	}
+
+	// Deleting the subtype deletes the base record, and deleting the base record deletes the subtype
+	require.NoError(t, c.Delete(ctx))
+	has, err := goradd_unit2.HasVehicle(ctx, c.ID())
+	require.NoError(t, err)
+	assert.False(t, has)
+	require.NoError(t, goradd_unit2.DeleteVehicle(ctx, tr.ID()))
+	has, err = goradd_unit2.HasTruck(ctx, tr.ID())
+	require.NoError(t, err)
+	assert.False(t, has)
+}
@@ -0,0 +1,98 @@
+package crud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goradd/gro/broadcast"
+	goradd_unit2 "github.com/goradd/gro/ci/tests/gen/goradd_unit"
+	"github.com/goradd/gro/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupRegistry(t *testing.T) {
+	ctx := context.Background()
+
+	c := goradd_unit2.NewCategory()
+	c.SetCode("lookupBooks")
+	c.SetName("Books")
+	require.NoError(t, c.Save(ctx))
+	defer func() {
+		_ = c.Delete(ctx)
+	}()
+
+	require.NoError(t, goradd_unit2.LoadCategoryRegistry(ctx))
+	c2 := goradd_unit2.CategoryFromRegistryByCode("lookupBooks")
+	require.NotNil(t, c2)
+	assert.Equal(t, "Books", c2.Name())
+	c3 := goradd_unit2.CategoryFromRegistry(c2.ID())
+	assert.Same(t, c2, c3)
+	assert.Nil(t, goradd_unit2.CategoryFromRegistryByCode("lookupMusic"))
+
+	// Changes to the table reload the registry
+	c4 := goradd_unit2.NewCategory()
+	c4.SetCode("lookupMusic")
+	c4.SetName("Music")
+	require.NoError(t, c4.Save(ctx))
+	defer func() {
+		_ = c4.Delete(ctx)
+	}()
+	broadcast.Wait()
+	c5 := goradd_unit2.CategoryFromRegistryByCode("lookupMusic")
+	require.NotNil(t, c5)
+	assert.Equal(t, "Music", c5.Name())
+	assert.Same(t, c5, goradd_unit2.CategoryFromRegistry(c4.ID()))
+
+	c.SetName("Printed Books")
+	require.NoError(t, c.Save(ctx))
+	broadcast.Wait()
+	assert.Equal(t, "Printed Books", goradd_unit2.CategoryFromRegistryByCode("lookupBooks").Name())
+
+	require.NoError(t, c4.Delete(ctx))
+	broadcast.Wait()
+	assert.Nil(t, goradd_unit2.CategoryFromRegistryByCode("lookupMusic"))
+	for _, o := range goradd_unit2.CategoriesFromRegistry() {
+		assert.NotEqual(t, "lookupMusic", o.Code())
+	}
+}
+
+func TestLookupRegistryTransaction(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, goradd_unit2.LoadCategoryRegistry(ctx))
+	d := db.GetDatabase("goradd_unit")
+
+	// The registry is not reloaded until the transaction is committed
+	c := goradd_unit2.NewCategory()
+	c.SetCode("lookupFilms")
+	c.SetName("Films")
+	err := db.WithTransaction(ctx, d, func(ctx context.Context) error {
+		if err := c.Save(ctx); err != nil {
+			return err
+		}
+		broadcast.Wait()
+		assert.Nil(t, goradd_unit2.CategoryFromRegistryByCode("lookupFilms"))
+		return nil
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = c.Delete(ctx)
+	}()
+	broadcast.Wait()
+	assert.NotNil(t, goradd_unit2.CategoryFromRegistryByCode("lookupFilms"))
+
+	// A rolled back change does not reload the registry
+	c2 := goradd_unit2.NewCategory()
+	c2.SetCode("lookupGames")
+	c2.SetName("Games")
+	err = db.WithTransaction(ctx, d, func(ctx context.Context) error {
+		if err := c2.Save(ctx); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+	require.Error(t, err)
+	broadcast.Wait()
+	assert.Nil(t, goradd_unit2.CategoryFromRegistryByCode("lookupGames"))
+}
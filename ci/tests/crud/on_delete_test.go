@@ -0,0 +1,43 @@
+package crud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goradd/anyutil"
+	goradd_unit2 "github.com/goradd/gro/ci/tests/gen/goradd_unit"
+	"github.com/goradd/gro/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnDeleteRestrict(t *testing.T) {
+	ctx := context.Background()
+
+	s := goradd_unit2.NewSong()
+	s.SetTitle("restricted")
+	n := goradd_unit2.NewSongNote()
+	n.SetText("note")
+	n.SetSong(s)
+	require.NoError(t, n.Save(ctx))
+	defer func() {
+		_ = n.Delete(ctx)
+		_ = s.Delete(ctx)
+	}()
+
+	err := s.Delete(ctx)
+	e, ok := anyutil.As[*db.ReferenceRestrictedError](err)
+	require.True(t, ok)
+	assert.Equal(t, "song", e.Table)
+	assert.Equal(t, map[string][]any{"song_note": {n.PrimaryKey()}}, e.Children)
+
+	s2, err := goradd_unit2.LoadSong(ctx, s.ID())
+	require.NoError(t, err)
+	assert.NotNil(t, s2)
+
+	require.NoError(t, n.Delete(ctx))
+	require.NoError(t, s.Delete(ctx))
+	s2, err = goradd_unit2.LoadSong(ctx, s.ID())
+	require.NoError(t, err)
+	assert.Nil(t, s2)
+}
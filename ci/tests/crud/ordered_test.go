@@ -0,0 +1,128 @@
+package crud
+
+import (
+	"context"
+	"testing"
+
+	goradd_unit2 "github.com/goradd/gro/ci/tests/gen/goradd_unit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func songTitles(songs []*goradd_unit2.Song) (titles []string) {
+	for _, s := range songs {
+		titles = append(titles, s.Title())
+	}
+	return
+}
+
+func TestOrderedAssociation(t *testing.T) {
+	ctx := context.Background()
+
+	s1 := goradd_unit2.NewSong()
+	s1.SetTitle("One")
+	s2 := goradd_unit2.NewSong()
+	s2.SetTitle("Two")
+	s3 := goradd_unit2.NewSong()
+	s3.SetTitle("Three")
+
+	p := goradd_unit2.NewPlaylist()
+	p.SetName("orderedAssociation")
+	p.SetSongs(s3, s1, s2)
+	require.NoError(t, p.Save(ctx))
+	defer func() {
+		_ = p.Delete(ctx)
+		_ = s1.Delete(ctx)
+		_ = s2.Delete(ctx)
+		_ = s3.Delete(ctx)
+	}()
+
+	p2, err := goradd_unit2.LoadPlaylist(ctx, p.ID())
+	require.NoError(t, err)
+	songs, err := p2.LoadSongs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Three", "One", "Two"}, songTitles(songs))
+
+	p2.SetSongs(s2, s3, s1)
+	require.NoError(t, p2.Save(ctx))
+	songs, err = p2.LoadSongs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Two", "Three", "One"}, songTitles(songs))
+
+	require.NoError(t, p2.MoveSong(ctx, 0, 2))
+	assert.Equal(t, []string{"Three", "One", "Two"}, songTitles(p2.Songs()))
+	songs, err = p2.LoadSongs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Three", "One", "Two"}, songTitles(songs))
+	assert.Error(t, p2.MoveSong(ctx, 0, 3))
+
+	// Unsaved changes cannot be moved
+	p4, err := goradd_unit2.LoadPlaylist(ctx, p.ID())
+	require.NoError(t, err)
+	p4.SetSongs(s1, s2)
+	assert.Error(t, p4.MoveSong(ctx, 0, 1))
+
+	// Changing the playlists of a song keeps its position in the playlists it stays in,
+	// and adds it to the end of new ones.
+	p3 := goradd_unit2.NewPlaylist()
+	p3.SetName("orderedAssociation2")
+	p3.SetSongs(s2)
+	require.NoError(t, p3.Save(ctx))
+	defer func() {
+		_ = p3.Delete(ctx)
+	}()
+
+	s3.SetPlaylists(p2, p3)
+	require.NoError(t, s3.Save(ctx))
+	songs, err = p2.LoadSongs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Three", "One", "Two"}, songTitles(songs))
+	songs, err = p3.LoadSongs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Two", "Three"}, songTitles(songs))
+}
+
+func TestOrderedReverse(t *testing.T) {
+	ctx := context.Background()
+
+	c := goradd_unit2.NewChecklist()
+	c.SetName("orderedReverse")
+	var items []*goradd_unit2.ChecklistItem
+	for _, name := range []string{"c", "a", "b"} {
+		item := goradd_unit2.NewChecklistItem()
+		item.SetName(name)
+		items = append(items, item)
+	}
+	c.SetChecklistItems(items...)
+	require.NoError(t, c.Save(ctx))
+	defer func() {
+		_ = c.Delete(ctx)
+	}()
+
+	names := func(items []*goradd_unit2.ChecklistItem) (n []string) {
+		for _, item := range items {
+			n = append(n, item.Name())
+		}
+		return
+	}
+
+	c2, err := goradd_unit2.LoadChecklist(ctx, c.ID())
+	require.NoError(t, err)
+	items, err = c2.LoadChecklistItems(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "a", "b"}, names(items))
+
+	require.NoError(t, c2.MoveChecklistItem(ctx, 2, 0))
+	assert.Equal(t, []string{"b", "c", "a"}, names(c2.ChecklistItems()))
+	items, err = c2.LoadChecklistItems(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c", "a"}, names(items))
+	assert.Equal(t, 0, items[0].Position())
+	assert.Equal(t, 2, items[2].Position())
+
+	// Unsaved changes cannot be moved
+	c3, err := goradd_unit2.LoadChecklist(ctx, c.ID())
+	require.NoError(t, err)
+	c3.SetChecklistItems(items[0])
+	assert.Error(t, c3.MoveChecklistItem(ctx, 0, 1))
+}
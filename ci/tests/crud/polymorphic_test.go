@@ -0,0 +1,97 @@
+package crud
+
+import (
+	"context"
+	"testing"
+
+	goradd_unit2 "github.com/goradd/gro/ci/tests/gen/goradd_unit"
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolymorphicReference(t *testing.T) {
+	ctx := context.Background()
+
+	p := goradd_unit2.NewPlaylist()
+	p.SetName("polymorphicPlaylist")
+	c := goradd_unit2.NewChecklist()
+	c.SetName("polymorphicChecklist")
+
+	c1 := goradd_unit2.NewComment()
+	c1.SetBody("on playlist")
+	c1.SetCommentable(p)
+	c2 := goradd_unit2.NewComment()
+	c2.SetBody("on checklist")
+	c2.SetCommentable(c)
+	require.NoError(t, c1.Save(ctx))
+	require.NoError(t, c2.Save(ctx))
+	defer func() {
+		_ = p.Delete(ctx)
+		_ = c.Delete(ctx)
+	}()
+	assert.Equal(t, "playlist", c1.CommentableType())
+	assert.Equal(t, p.ID(), c1.CommentableID())
+	assert.Equal(t, "checklist", c2.CommentableType())
+
+	c3, err := goradd_unit2.LoadComment(ctx, c1.ID())
+	require.NoError(t, err)
+	obj, err := c3.LoadCommentable(ctx)
+	require.NoError(t, err)
+	if p2, ok := obj.(*goradd_unit2.Playlist); assert.True(t, ok) {
+		assert.Equal(t, "polymorphicPlaylist", p2.Name())
+	}
+	c4, err := goradd_unit2.LoadComment(ctx, c2.ID())
+	require.NoError(t, err)
+	obj, err = c4.LoadCommentable(ctx)
+	require.NoError(t, err)
+	if c5, ok := obj.(*goradd_unit2.Checklist); assert.True(t, ok) {
+		assert.Equal(t, "polymorphicChecklist", c5.Name())
+	}
+
+	comments, err := p.LoadComments(ctx)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, "on playlist", comments[0].Body())
+	n, err := c.CountComments(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	// Joins only match rows whose type column names the joined table
+	comments, err = goradd_unit2.QueryComments(ctx).
+		Where(op.In(node.Comment().ID(), c1.ID(), c2.ID())).
+		Select(node.Comment().CommentablePlaylist()).
+		OrderBy(node.Comment().ID()).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	if p3, ok := comments[0].Commentable().(*goradd_unit2.Playlist); assert.True(t, ok) {
+		assert.Equal(t, "polymorphicPlaylist", p3.Name())
+	}
+	assert.Nil(t, comments[1].Commentable())
+
+	comments, err = goradd_unit2.QueryComments(ctx).
+		Where(op.Equal(node.Comment().CommentableChecklist().Name(), "polymorphicChecklist")).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, "on checklist", comments[0].Body())
+
+	p4, err := goradd_unit2.QueryPlaylists(ctx).
+		Where(op.Equal(node.Playlist().ID(), p.ID())).
+		Select(node.Playlist().Comments()).
+		Get()
+	require.NoError(t, err)
+	require.Len(t, p4.Comments(), 1)
+	assert.Equal(t, "on playlist", p4.Comments()[0].Body())
+
+	// Deleting the referenced object deletes the comments that refer to it, but not others
+	require.NoError(t, p.Delete(ctx))
+	c6, err := goradd_unit2.LoadComment(ctx, c1.ID())
+	require.NoError(t, err)
+	assert.Nil(t, c6)
+	n, err = c.CountComments(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
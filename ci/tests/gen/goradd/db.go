@@ -482,6 +482,8 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			map[string]query.ReceiverType{
 				"project_id":     query.ColTypeString,
 				"team_member_id": query.ColTypeString,
+				"role":           query.ColTypeString,
+				"hours_per_week": query.ColTypeInteger,
 			},
 			nil,
 			[]string{"project_id", "team_member_id"})
@@ -894,14 +896,23 @@ func jsonDecodeTeamMemberProjectAssn(ctx context.Context, decoder *json.Decoder)
 	database := Database()
 	for decoder.More() {
 		var imp struct {
-			Src  string `json:"project_id"`
-			Dest string `json:"team_member_id"`
+			Src          string  `json:"project_id"`
+			Dest         string  `json:"team_member_id"`
+			Role         *string `json:"role"`
+			HoursPerWeek *int    `json:"hours_per_week"`
 		}
 
 		if err = decoder.Decode(&imp); err != nil {
 			return err
 		}
-		db.Associate(ctx, database, "team_member_project_assn", "project_id", imp.Src, "team_member_id", imp.Dest)
+		values := make(map[string]any)
+		if imp.Role != nil {
+			values["role"] = *imp.Role
+		}
+		if imp.HoursPerWeek != nil {
+			values["hours_per_week"] = *imp.HoursPerWeek
+		}
+		db.Associate(ctx, database, "team_member_project_assn", "project_id", imp.Src, "team_member_id", imp.Dest, values)
 	}
 
 	// Check if the last token is the end of the array
@@ -413,6 +413,26 @@ func HasLogin(ctx context.Context, pk string) (bool, error) {
 	return v > 0, err
 }
 
+// LoadLoginByUsernameIgnoreCase queries for a single Login object by the given unique index values.
+// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
+// See [LoginsBuilder.Select].
+// If you need a more elaborate query, use QueryLogins() to start a query builder.
+func LoadLoginByUsernameIgnoreCase(ctx context.Context, username string, selectNodes ...query.Node) (*Login, error) {
+	q := queryLogins(ctx)
+	q = q.Where(op.Equal(op.Function("lower", node.Login().Username()), op.Function("lower", username)))
+	return q.Select(selectNodes...).Get()
+}
+
+// HasLoginByUsernameIgnoreCase returns true if the
+// given unique index values exist in the database.
+// doc: type=Login
+func HasLoginByUsernameIgnoreCase(ctx context.Context, username string) (bool, error) {
+	q := queryLogins(ctx)
+	q = q.Where(op.Equal(op.Function("lower", node.Login().Username()), op.Function("lower", username)))
+	v, err := q.Count()
+	return v > 0, err
+}
+
 // LoadLoginByUsername queries for a single Login object by the given unique index values.
 // selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
 // See [LoginsBuilder.Select].
@@ -598,7 +618,8 @@ func (b *LoginBuilder) OrderBy(nodes ...query.Sorter) *LoginBuilder {
 // Limit will return a subset of the data, limited to the offset and number of rows specified.
 // For large data sets and specific types of queries, this can be slow, because it will perform
 // the entire query before computing the limit.
-// You cannot limit a query that has embedded arrays.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the Login objects. You cannot otherwise limit a query that has embedded arrays.
 func (b *LoginBuilder) Limit(maxRowCount int, offset int) *LoginBuilder {
 	b.builder.Limit(maxRowCount, offset)
 	return b
@@ -611,11 +632,22 @@ func (b *LoginBuilder) Limit(maxRowCount int, offset int) *LoginBuilder {
 // If columns in related tables are specified, then only those columns will be queried and loaded.
 // Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
 // will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
 func (b *LoginBuilder) Select(nodes ...query.Node) *LoginBuilder {
 	b.builder.Select(nodes...)
 	return b
 }
 
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded Login objects at once, rather than joining them to the query.
+// This avoids repeating the data of each Login object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *LoginBuilder) Preload(nodes ...query.Node) *LoginBuilder {
+	b.builder.Preload(nodes...)
+	return b
+}
+
 // Calculation adds operation as an aliased value onto base.
 // After the query, you can read the data by passing alias to GetAlias on the returned object.
 func (b *LoginBuilder) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *LoginBuilder {
@@ -642,6 +674,35 @@ func (b *LoginBuilder) Having(node query.Node) *LoginBuilder {
 	return b
 }
 
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *LoginBuilder) ForUpdate() *LoginBuilder {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *LoginBuilder) ForShare() *LoginBuilder {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *LoginBuilder) SkipLocked() *LoginBuilder {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *LoginBuilder) NoWait() *LoginBuilder {
+	b.builder.NoWait()
+	return b
+}
+
 // Count terminates a query and returns just the number of items in the result.
 // If you have Select or Calculation columns in the query, it will count NULL results as well.
 // To not count NULL values, use Where in the builder with a NotNull operation.
@@ -663,6 +724,16 @@ func CountLogins(ctx context.Context) (int, error) {
 	return QueryLogins(ctx).Count()
 }
 
+// CountLoginsByUsernameIgnoreCase queries the database and returns the number of Login objects that
+// have username.
+// doc: type=Login
+func CountLoginsByUsernameIgnoreCase(ctx context.Context, username string) (int, error) {
+	v_username := username
+	return QueryLogins(ctx).
+		Where(op.Equal(op.Function("lower", node.Login().Username()), op.Function("lower", v_username))).
+		Count()
+}
+
 // CountLoginsByUsername queries the database and returns the number of Login objects that
 // have username.
 // doc: type=Login
@@ -460,6 +460,12 @@ func TestLogin_Count(t *testing.T) {
 
 	// reread in case there are data limitations imposed by the database
 	obj2, _ := LoadLogin(ctx, obj.PrimaryKey())
+	assert.Positive(t,
+		func() int {
+			i, _ := CountLoginsByUsernameIgnoreCase(ctx,
+				obj2.Username())
+			return i
+		}())
 	assert.Positive(t,
 		func() int {
 			i, _ := CountLoginsByUsername(ctx,
@@ -554,6 +560,10 @@ func TestLogin_Indexes(t *testing.T) {
 	defer deleteSampleLogin(ctx, obj)
 
 	var obj2 *Login
+	obj2, _ = LoadLoginByUsernameIgnoreCase(ctx, obj.Username())
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	assert.True(t, func() bool { h, _ := HasLoginByUsernameIgnoreCase(ctx, obj.Username()); return h }())
+
 	obj2, _ = LoadLoginByUsername(ctx, obj.Username())
 	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
 	assert.True(t, func() bool { h, _ := HasLoginByUsername(ctx, obj.Username()); return h }())
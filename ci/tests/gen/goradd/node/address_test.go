@@ -46,7 +46,9 @@ func TestSerializeReferencesAddressTable(t *testing.T) {
 		assert.True(t, query.NodesMatch(Address().Person().ID(), n2.(PersonNode).ID()))
 		assert.True(t, query.NodesMatch(Address().Person().FirstName(), n2.(PersonNode).FirstName()))
 		assert.True(t, query.NodesMatch(Address().Person().LastName(), n2.(PersonNode).LastName()))
+		assert.True(t, query.NodesMatch(Address().Person().FullName(), n2.(PersonNode).FullName()))
 		assert.True(t, query.NodesMatch(Address().Person().PersonType(), n2.(PersonNode).PersonType()))
+		assert.True(t, query.NodesMatch(Address().Person().PersonTypes(), n2.(PersonNode).PersonTypes()))
 		assert.True(t, query.NodesMatch(Address().Person().Created(), n2.(PersonNode).Created()))
 		assert.True(t, query.NodesMatch(Address().Person().Modified(), n2.(PersonNode).Modified()))
 		assert.True(t, query.NodesMatch(Address().Person().ManagerProjects(), n2.(PersonNode).ManagerProjects()))
@@ -46,7 +46,9 @@ func TestSerializeReferencesLoginTable(t *testing.T) {
 		assert.True(t, query.NodesMatch(Login().Person().ID(), n2.(PersonNode).ID()))
 		assert.True(t, query.NodesMatch(Login().Person().FirstName(), n2.(PersonNode).FirstName()))
 		assert.True(t, query.NodesMatch(Login().Person().LastName(), n2.(PersonNode).LastName()))
+		assert.True(t, query.NodesMatch(Login().Person().FullName(), n2.(PersonNode).FullName()))
 		assert.True(t, query.NodesMatch(Login().Person().PersonType(), n2.(PersonNode).PersonType()))
+		assert.True(t, query.NodesMatch(Login().Person().PersonTypes(), n2.(PersonNode).PersonTypes()))
 		assert.True(t, query.NodesMatch(Login().Person().Created(), n2.(PersonNode).Created()))
 		assert.True(t, query.NodesMatch(Login().Person().Modified(), n2.(PersonNode).Modified()))
 		assert.True(t, query.NodesMatch(Login().Person().ManagerProjects(), n2.(PersonNode).ManagerProjects()))
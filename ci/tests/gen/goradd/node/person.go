@@ -21,14 +21,18 @@ type PersonNode interface {
 	FirstName() *query.ColumnNode
 	// LastName represents the last_name column in the database.
 	LastName() *query.ColumnNode
+	// FullName represents the full_name column in the database.
+	FullName() *query.ColumnNode
 	// PersonType represents the person_type column in the database.
 	PersonType() *query.ColumnNode
+	// PersonTypes represents the person_types column in the database.
+	PersonTypes() *query.ColumnNode
 	// Created represents the created column in the database.
 	Created() *query.ColumnNode
 	// Modified represents the modified column in the database.
 	Modified() *query.ColumnNode
 	// Projects represents the many-many reference to Project objects.
-	Projects() ProjectNode
+	Projects() PersonProjectsNode
 	// ManagerProject represents the ManagerProject reverse reference to Project objects
 	// through the ManagerID foreign key there.
 	ManagerProjects() ProjectNode
@@ -60,6 +64,21 @@ type personAssociation struct {
 	query.ManyManyNode
 }
 
+// PersonProjectsNode is the builder interface to the Projects many-many relationship.
+// Besides the nodes of the Project objects, it gives access to the extra columns
+// of the team_member_project_assn association table.
+type PersonProjectsNode interface {
+	ProjectNode
+	// Role represents the role column in the team_member_project_assn association table.
+	Role() *query.ColumnNode
+	// HoursPerWeek represents the hours_per_week column in the team_member_project_assn association table.
+	HoursPerWeek() *query.ColumnNode
+}
+
+type personProjectsAssociation struct {
+	projectAssociation
+}
+
 // Person returns a table node that starts a node chain that begins with the person table.
 func Person() PersonNode {
 	return personTable{}
@@ -85,7 +104,9 @@ func (n personTable) ColumnNodes_() (nodes []query.Node) {
 	nodes = append(nodes, n.ID())
 	nodes = append(nodes, n.FirstName())
 	nodes = append(nodes, n.LastName())
+	nodes = append(nodes, n.FullName())
 	nodes = append(nodes, n.PersonType())
+	nodes = append(nodes, n.PersonTypes())
 	nodes = append(nodes, n.Created())
 	nodes = append(nodes, n.Modified())
 	return nodes
@@ -226,6 +247,31 @@ func (n *personAssociation) LastName() *query.ColumnNode {
 	return cn
 }
 
+func (n personTable) FullName() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"full_name",
+		"fullName",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *personReference) FullName() *query.ColumnNode {
+	cn := n.personTable.FullName()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *personAssociation) FullName() *query.ColumnNode {
+	cn := n.personTable.FullName()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
 func (n personTable) PersonType() *query.ColumnNode {
 	cn := query.NewColumnNode(
 		"person_type",
@@ -251,6 +297,31 @@ func (n *personAssociation) PersonType() *query.ColumnNode {
 	return cn
 }
 
+func (n personTable) PersonTypes() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"person_types",
+		"personTypes",
+		query.ColTypeIntArray,
+		schema.ColTypeEnumArray,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *personReference) PersonTypes() *query.ColumnNode {
+	cn := n.personTable.PersonTypes()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *personAssociation) PersonTypes() *query.ColumnNode {
+	cn := n.personTable.PersonTypes()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
 func (n personTable) Created() *query.ColumnNode {
 	cn := query.NewColumnNode(
 		"created",
@@ -302,29 +373,66 @@ func (n *personAssociation) Modified() *query.ColumnNode {
 }
 
 // Projects represents the many-to-many relationship formed by the team_member_project_assn table.
-func (n personTable) Projects() ProjectNode {
-	cn := &projectAssociation{
-		ManyManyNode: query.ManyManyNode{
-			AssnTableQueryName: "team_member_project_assn",
-			ParentForeignKey:   "team_member_id",
-			ParentPrimaryKey:   "id",
-			Field:              "projects",
-			RefForeignKey:      "project_id",
-			RefPrimaryKey:      "id",
+func (n personTable) Projects() PersonProjectsNode {
+	cn := &personProjectsAssociation{
+		projectAssociation: projectAssociation{
+			ManyManyNode: query.ManyManyNode{
+				AssnTableQueryName: "team_member_project_assn",
+				ParentForeignKey:   "team_member_id",
+				ParentPrimaryKey:   "id",
+				Field:              "projects",
+				RefForeignKey:      "project_id",
+				RefPrimaryKey:      "id",
+			},
 		},
 	}
 	query.NodeSetParent(cn, n)
 	return cn
 }
 
-func (n *personReference) Projects() ProjectNode {
-	cn := n.personTable.Projects().(*projectAssociation)
+// ColumnNodes_ returns the column nodes of the Project table, followed by the extra columns of the association table.
+func (n *personProjectsAssociation) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.projectAssociation.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	nodes = append(nodes, n.Role())
+	nodes = append(nodes, n.HoursPerWeek())
+	return
+}
+
+// Role represents the role column in the team_member_project_assn association table.
+func (n *personProjectsAssociation) Role() *query.ColumnNode {
+	return query.NewAssociationColumnNode(
+		"role",
+		"_linkRole",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		n,
+	)
+}
+
+// HoursPerWeek represents the hours_per_week column in the team_member_project_assn association table.
+func (n *personProjectsAssociation) HoursPerWeek() *query.ColumnNode {
+	return query.NewAssociationColumnNode(
+		"hours_per_week",
+		"_linkHoursPerWeek",
+		query.ColTypeInteger,
+		schema.ColTypeInt,
+		schema.ColSubTypeNone,
+		n,
+	)
+}
+
+func (n *personReference) Projects() PersonProjectsNode {
+	cn := n.personTable.Projects().(*personProjectsAssociation)
 	query.NodeSetParent(cn, n)
 	return cn
 }
 
-func (n *personAssociation) Projects() ProjectNode {
-	cn := n.personTable.Projects().(*projectAssociation)
+func (n *personAssociation) Projects() PersonProjectsNode {
+	cn := n.personTable.Projects().(*personProjectsAssociation)
 	query.NodeSetParent(cn, n)
 	return cn
 }
@@ -491,4 +599,5 @@ func init() {
 	gob.Register(new(personTable))
 	gob.Register(new(personReference))
 	gob.Register(new(personAssociation))
+	gob.Register(new(personProjectsAssociation))
 }
@@ -44,7 +44,7 @@ type ProjectNode interface {
 	// Parent references the Project object whose primary key is ParentID.
 	Parent() ProjectNode
 	// TeamMembers represents the many-many reference to Person objects.
-	TeamMembers() PersonNode
+	TeamMembers() ProjectTeamMembersNode
 	// Child represents the Child reverse reference to Project objects
 	// through the ParentID foreign key there.
 	Children() ProjectNode
@@ -75,6 +75,21 @@ type projectAssociation struct {
 	query.ManyManyNode
 }
 
+// ProjectTeamMembersNode is the builder interface to the TeamMembers many-many relationship.
+// Besides the nodes of the Person objects, it gives access to the extra columns
+// of the team_member_project_assn association table.
+type ProjectTeamMembersNode interface {
+	PersonNode
+	// Role represents the role column in the team_member_project_assn association table.
+	Role() *query.ColumnNode
+	// HoursPerWeek represents the hours_per_week column in the team_member_project_assn association table.
+	HoursPerWeek() *query.ColumnNode
+}
+
+type projectTeamMembersAssociation struct {
+	personAssociation
+}
+
 // Project returns a table node that starts a node chain that begins with the project table.
 func Project() ProjectNode {
 	return projectTable{}
@@ -599,35 +614,72 @@ func (n *projectAssociation) Parent() ProjectNode {
 }
 
 // TeamMembers represents the many-to-many relationship formed by the team_member_project_assn table.
-func (n projectTable) TeamMembers() PersonNode {
-	cn := &personAssociation{
-		ManyManyNode: query.ManyManyNode{
-			AssnTableQueryName: "team_member_project_assn",
-			ParentForeignKey:   "project_id",
-			ParentPrimaryKey:   "id",
-			Field:              "teamMembers",
-			RefForeignKey:      "team_member_id",
-			RefPrimaryKey:      "id",
+func (n projectTable) TeamMembers() ProjectTeamMembersNode {
+	cn := &projectTeamMembersAssociation{
+		personAssociation: personAssociation{
+			ManyManyNode: query.ManyManyNode{
+				AssnTableQueryName: "team_member_project_assn",
+				ParentForeignKey:   "project_id",
+				ParentPrimaryKey:   "id",
+				Field:              "teamMembers",
+				RefForeignKey:      "team_member_id",
+				RefPrimaryKey:      "id",
+			},
 		},
 	}
 	query.NodeSetParent(cn, n)
 	return cn
 }
 
-func (n *projectReference) TeamMembers() PersonNode {
-	cn := n.projectTable.TeamMembers().(*personAssociation)
+// ColumnNodes_ returns the column nodes of the Person table, followed by the extra columns of the association table.
+func (n *projectTeamMembersAssociation) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.personAssociation.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	nodes = append(nodes, n.Role())
+	nodes = append(nodes, n.HoursPerWeek())
+	return
+}
+
+// Role represents the role column in the team_member_project_assn association table.
+func (n *projectTeamMembersAssociation) Role() *query.ColumnNode {
+	return query.NewAssociationColumnNode(
+		"role",
+		"_linkRole",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		n,
+	)
+}
+
+// HoursPerWeek represents the hours_per_week column in the team_member_project_assn association table.
+func (n *projectTeamMembersAssociation) HoursPerWeek() *query.ColumnNode {
+	return query.NewAssociationColumnNode(
+		"hours_per_week",
+		"_linkHoursPerWeek",
+		query.ColTypeInteger,
+		schema.ColTypeInt,
+		schema.ColSubTypeNone,
+		n,
+	)
+}
+
+func (n *projectReference) TeamMembers() ProjectTeamMembersNode {
+	cn := n.projectTable.TeamMembers().(*projectTeamMembersAssociation)
 	query.NodeSetParent(cn, n)
 	return cn
 }
 
-func (n *projectReverse) TeamMembers() PersonNode {
-	cn := n.projectTable.TeamMembers().(*personAssociation)
+func (n *projectReverse) TeamMembers() ProjectTeamMembersNode {
+	cn := n.projectTable.TeamMembers().(*projectTeamMembersAssociation)
 	query.NodeSetParent(cn, n)
 	return cn
 }
 
-func (n *projectAssociation) TeamMembers() PersonNode {
-	cn := n.projectTable.TeamMembers().(*personAssociation)
+func (n *projectAssociation) TeamMembers() ProjectTeamMembersNode {
+	cn := n.projectTable.TeamMembers().(*projectTeamMembersAssociation)
 	query.NodeSetParent(cn, n)
 	return cn
 }
@@ -774,4 +826,5 @@ func init() {
 	gob.Register(new(projectReference))
 	gob.Register(new(projectReverse))
 	gob.Register(new(projectAssociation))
+	gob.Register(new(projectTeamMembersAssociation))
 }
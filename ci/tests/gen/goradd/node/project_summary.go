@@ -0,0 +1,235 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package node
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
+)
+
+// ProjectSummaryNode is the builder interface to the ProjectSummary nodes.
+type ProjectSummaryNode interface {
+	query.TableNodeI
+	// PrimaryKey returns the column node representing the primary key of the table
+	PrimaryKey() *query.ColumnNode
+	// ID represents the id column in the database.
+	ID() *query.ColumnNode
+	// Name represents the name column in the database.
+	Name() *query.ColumnNode
+	// MemberCount represents the member_count column in the database.
+	MemberCount() *query.ColumnNode
+	// ManagerID represents the manager_id foreign key column in the database
+	// that references the Manager object.
+	ManagerID() *query.ColumnNode
+	// Manager references the Person object whose primary key is ManagerID.
+	Manager() PersonNode
+}
+
+// projectSummaryTable represents the project_summary table in a query. It uses a builder pattern to chain
+// together other tables and columns to form a node chain in a query.
+//
+// To use the projectSummaryTable, call [ProjectSummary()] to start a reference chain when querying the project_summary table.
+type projectSummaryTable struct {
+}
+
+type projectSummaryReverse struct {
+	projectSummaryTable
+	query.ReverseNode
+}
+
+// ProjectSummary returns a table node that starts a node chain that begins with the project_summary table.
+func ProjectSummary() ProjectSummaryNode {
+	return projectSummaryTable{}
+}
+
+// TableName_ returns the query name of the table the node is associated with.
+func (n projectSummaryTable) TableName_() string {
+	return "project_summary"
+}
+
+// NodeType_ returns the query.NodeType of the node.
+func (n projectSummaryTable) NodeType_() query.NodeType {
+	return query.TableNodeType
+}
+
+// DatabaseKey_ returns the database key of the database the node is associated with.
+func (n projectSummaryTable) DatabaseKey_() string {
+	return "goradd"
+}
+
+// ColumnNodes_ returns a list of all the column nodes in this node.
+func (n projectSummaryTable) ColumnNodes_() (nodes []query.Node) {
+	nodes = append(nodes, n.ID())
+	nodes = append(nodes, n.Name())
+	nodes = append(nodes, n.MemberCount())
+	nodes = append(nodes, n.ManagerID())
+	return nodes
+}
+
+func (n *projectSummaryReverse) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.projectSummaryTable.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	return
+}
+
+func (n *projectSummaryReverse) NodeType_() query.NodeType {
+	return query.ReverseNodeType
+}
+
+// PrimaryKeys returns the primary key column nodes to satisfy the PrimaryKeyer interface.
+func (n projectSummaryTable) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n projectSummaryTable) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+// PrimaryKeys returns the primary key column nodes.
+func (n *projectSummaryReverse) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n projectSummaryReverse) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+func (n projectSummaryTable) ID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"id",
+		"id",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		true,
+		n,
+	)
+	return cn
+}
+
+func (n *projectSummaryReverse) ID() *query.ColumnNode {
+	cn := n.projectSummaryTable.ID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n projectSummaryTable) Name() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"name",
+		"name",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *projectSummaryReverse) Name() *query.ColumnNode {
+	cn := n.projectSummaryTable.Name()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n projectSummaryTable) MemberCount() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"member_count",
+		"memberCount",
+		query.ColTypeInteger64,
+		schema.ColTypeInt,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *projectSummaryReverse) MemberCount() *query.ColumnNode {
+	cn := n.projectSummaryTable.MemberCount()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n projectSummaryTable) ManagerID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"manager_id",
+		"managerID",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *projectSummaryReverse) ManagerID() *query.ColumnNode {
+	cn := n.projectSummaryTable.ManagerID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// Manager represents the link to a Person object.
+func (n projectSummaryTable) Manager() PersonNode {
+	cn := &personReference{
+		ReferenceNode: query.ReferenceNode{
+			ForeignKey: "manager_id",
+			PrimaryKey: "id",
+			Field:      "manager",
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *projectSummaryReverse) Manager() PersonNode {
+	cn := n.projectSummaryTable.Manager().(*personReference)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n projectSummaryTable) GobEncode() (data []byte, err error) {
+	return
+}
+
+func (n *projectSummaryTable) GobDecode(data []byte) (err error) {
+	return
+}
+
+func (n *projectSummaryReverse) GobEncode() (data []byte, err error) {
+	var buf bytes.Buffer
+	e := gob.NewEncoder(&buf)
+
+	if err = e.Encode(&n.ReverseNode); err != nil {
+		panic(err)
+	}
+	data = buf.Bytes()
+	return
+}
+
+func (n *projectSummaryReverse) GobDecode(data []byte) (err error) {
+	buf := bytes.NewBuffer(data)
+	dec := gob.NewDecoder(buf)
+
+	if err = dec.Decode(&n.ReverseNode); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func init() {
+	gob.Register(new(projectSummaryTable))
+	gob.Register(new(projectSummaryReverse))
+}
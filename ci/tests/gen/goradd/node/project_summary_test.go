@@ -0,0 +1,68 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeTableProjectSummaryTable(t *testing.T) {
+	var n query.Node = ProjectSummary()
+
+	assert.Equal(t, "project_summary", n.TableName_())
+	assert.Equal(t, query.TableNodeType, n.NodeType_())
+	assert.Equal(t, "goradd", n.DatabaseKey_())
+
+	n2 := serNode(t, n)
+
+	assert.Equal(t, "project_summary", n2.TableName_())
+	assert.Equal(t, query.TableNodeType, n2.NodeType_())
+	assert.Equal(t, "goradd", n2.DatabaseKey_())
+
+	nodes := projectSummaryTable{}.ColumnNodes_()
+	for _, cn := range nodes {
+		cn2 := serNode(t, cn)
+		assert.Equal(t, "project_summary", cn2.TableName_())
+		assert.Equal(t, query.TableNodeType, query.NodeParent(cn2).NodeType_())
+	}
+}
+
+func TestSerializeReferencesProjectSummaryTable(t *testing.T) {
+	{
+		n := ProjectSummary().Manager()
+		n2 := serNode(t, n)
+		parentNode := query.NodeParent(n2)
+		assert.Equal(t, query.TableNodeType, parentNode.NodeType_())
+		assert.Equal(t, "project_summary", parentNode.TableName_())
+
+		nodes := n.(query.TableNodeI).ColumnNodes_()
+		for _, cn := range nodes {
+			cn2 := serNode(t, cn)
+			assert.Equal(t, n.TableName_(), cn2.TableName_())
+			assert.Equal(t, query.ReferenceNodeType, query.NodeParent(cn2).NodeType_())
+		}
+
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().ID(), n2.(PersonNode).ID()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().FirstName(), n2.(PersonNode).FirstName()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().LastName(), n2.(PersonNode).LastName()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().FullName(), n2.(PersonNode).FullName()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().PersonType(), n2.(PersonNode).PersonType()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().PersonTypes(), n2.(PersonNode).PersonTypes()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().Created(), n2.(PersonNode).Created()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().Modified(), n2.(PersonNode).Modified()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().ManagerProjects(), n2.(PersonNode).ManagerProjects()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().Addresses(), n2.(PersonNode).Addresses()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().EmployeeInfo(), n2.(PersonNode).EmployeeInfo()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().Login(), n2.(PersonNode).Login()))
+		assert.True(t, query.NodesMatch(ProjectSummary().Manager().Projects(), n2.(PersonNode).Projects()))
+
+	}
+
+}
+
+func TestSerializeReverseReferencesProjectSummaryTable(t *testing.T) {
+}
+
+func TestSerializeAssociationsProjectSummaryTable(t *testing.T) {
+}
@@ -46,7 +46,9 @@ func TestSerializeReferencesProjectTable(t *testing.T) {
 		assert.True(t, query.NodesMatch(Project().Manager().ID(), n2.(PersonNode).ID()))
 		assert.True(t, query.NodesMatch(Project().Manager().FirstName(), n2.(PersonNode).FirstName()))
 		assert.True(t, query.NodesMatch(Project().Manager().LastName(), n2.(PersonNode).LastName()))
+		assert.True(t, query.NodesMatch(Project().Manager().FullName(), n2.(PersonNode).FullName()))
 		assert.True(t, query.NodesMatch(Project().Manager().PersonType(), n2.(PersonNode).PersonType()))
+		assert.True(t, query.NodesMatch(Project().Manager().PersonTypes(), n2.(PersonNode).PersonTypes()))
 		assert.True(t, query.NodesMatch(Project().Manager().Created(), n2.(PersonNode).Created()))
 		assert.True(t, query.NodesMatch(Project().Manager().Modified(), n2.(PersonNode).Modified()))
 		assert.True(t, query.NodesMatch(Project().Manager().ManagerProjects(), n2.(PersonNode).ManagerProjects()))
@@ -170,7 +172,9 @@ func TestSerializeAssociationsProjectTable(t *testing.T) {
 		assert.True(t, query.NodesMatch(Project().TeamMembers().ID(), n2.(PersonNode).ID()))
 		assert.True(t, query.NodesMatch(Project().TeamMembers().FirstName(), n2.(PersonNode).FirstName()))
 		assert.True(t, query.NodesMatch(Project().TeamMembers().LastName(), n2.(PersonNode).LastName()))
+		assert.True(t, query.NodesMatch(Project().TeamMembers().FullName(), n2.(PersonNode).FullName()))
 		assert.True(t, query.NodesMatch(Project().TeamMembers().PersonType(), n2.(PersonNode).PersonType()))
+		assert.True(t, query.NodesMatch(Project().TeamMembers().PersonTypes(), n2.(PersonNode).PersonTypes()))
 		assert.True(t, query.NodesMatch(Project().TeamMembers().Created(), n2.(PersonNode).Created()))
 		assert.True(t, query.NodesMatch(Project().TeamMembers().Modified(), n2.(PersonNode).Modified()))
 		assert.True(t, query.NodesMatch(Project().TeamMembers().ManagerProjects(), n2.(PersonNode).ManagerProjects()))
@@ -8,6 +8,7 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 	"unicode/utf8"
 
@@ -24,24 +25,30 @@ import (
 // The member variables of the structure are private and should not normally be accessed by the Person embedder.
 // Instead, use the accessor functions.
 type personBase struct {
-	id                 string
-	idIsLoaded         bool
-	idIsDirty          bool
-	firstName          string
-	firstNameIsLoaded  bool
-	firstNameIsDirty   bool
-	lastName           string
-	lastNameIsLoaded   bool
-	lastNameIsDirty    bool
-	personType         PersonType
-	personTypeIsNull   bool
-	personTypeIsLoaded bool
-	personTypeIsDirty  bool
-	created            time.Time
-	createdIsLoaded    bool
-	modified           time.Time
-	modifiedIsNull     bool
-	modifiedIsLoaded   bool
+	id                  string
+	idIsLoaded          bool
+	idIsDirty           bool
+	firstName           string
+	firstNameIsLoaded   bool
+	firstNameIsDirty    bool
+	lastName            string
+	lastNameIsLoaded    bool
+	lastNameIsDirty     bool
+	fullName            string
+	fullNameIsLoaded    bool
+	personType          PersonType
+	personTypeIsNull    bool
+	personTypeIsLoaded  bool
+	personTypeIsDirty   bool
+	personTypes         []PersonType
+	personTypesIsNull   bool
+	personTypesIsLoaded bool
+	personTypesIsDirty  bool
+	created             time.Time
+	createdIsLoaded     bool
+	modified            time.Time
+	modifiedIsNull      bool
+	modifiedIsLoaded    bool
 
 	// Reverse references
 	managerProjects        maps.SliceMap[string, *Project] // Objects in the order they were queried
@@ -57,6 +64,7 @@ type personBase struct {
 	projects        maps.SliceMap[string, *Project]
 	projectsPks     []string // Primary keys to associate at Save time
 	projectsIsDirty bool
+	projectLinks    map[string]*PersonProjectLink // Values of the association table, by primary key of the Project
 
 	// Custom aliases, if specified
 	_aliases map[string]any
@@ -73,7 +81,9 @@ const (
 	PersonIDField             = `id`
 	PersonFirstNameField      = `firstName`
 	PersonLastNameField       = `lastName`
+	PersonFullNameField       = `fullName`
 	PersonPersonTypeField     = `personType`
+	PersonPersonTypesField    = `personTypes`
 	PersonCreatedField        = `created`
 	PersonModifiedField       = `modified`
 	PersonManagerProjectField = `managerProjects`
@@ -86,6 +96,7 @@ const (
 const PersonIDMaxLength = 5         // The number of runes the column can hold
 const PersonFirstNameMaxLength = 50 // The number of runes the column can hold
 const PersonLastNameMaxLength = 50  // The number of runes the column can hold
+const PersonFullNameMaxLength = 101 // The number of runes the column can hold
 
 // Initialize or re-initialize a Person database object to default values.
 func (o *personBase) Initialize() {
@@ -101,11 +112,19 @@ func (o *personBase) Initialize() {
 	o.lastNameIsLoaded = false
 	o.lastNameIsDirty = false
 
+	o.fullName = ""
+	o.fullNameIsLoaded = false
+
 	o.personType = PersonType(0)
 	o.personTypeIsNull = true
 	o.personTypeIsLoaded = false
 	o.personTypeIsDirty = false
 
+	o.personTypes = []PersonType(nil)
+	o.personTypesIsNull = true
+	o.personTypesIsLoaded = false
+	o.personTypesIsDirty = false
+
 	o.created = time.Time{}
 	o.createdIsLoaded = true
 
@@ -131,6 +150,7 @@ func (o *personBase) Initialize() {
 	o.projects.Clear()
 	o.projectsPks = nil
 	o.projectsIsDirty = false
+	o.projectLinks = nil
 
 	o._aliases = nil
 	o._restored = false
@@ -158,6 +178,9 @@ func (o *personBase) Copy() (newObject *Person) {
 	if o.personTypeIsLoaded {
 		newObject.SetPersonType(o.personType)
 	}
+	if o.personTypesIsLoaded {
+		newObject.SetPersonTypes(o.personTypes)
+	}
 	return
 }
 
@@ -270,6 +293,19 @@ func (o *personBase) SetLastName(v string) {
 	o.lastNameIsDirty = true
 }
 
+// FullName returns the value of the loaded full_name field in the database.
+func (o *personBase) FullName() string {
+	if o._restored && !o.fullNameIsLoaded {
+		panic("FullName was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.fullName
+}
+
+// FullNameIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *personBase) FullNameIsLoaded() bool {
+	return o.fullNameIsLoaded
+}
+
 // PersonType returns the value of the loaded person_type field in the database.
 func (o *personBase) PersonType() PersonType {
 	if o._restored && !o.personTypeIsLoaded {
@@ -316,6 +352,52 @@ func (o *personBase) SetPersonTypeToNull() {
 	o.personType = PersonType(0)
 }
 
+// PersonTypes returns the value of the loaded person_types field in the database.
+func (o *personBase) PersonTypes() []PersonType {
+	if o._restored && !o.personTypesIsLoaded {
+		panic("PersonTypes was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.personTypes
+}
+
+// PersonTypesIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *personBase) PersonTypesIsLoaded() bool {
+	return o.personTypesIsLoaded
+}
+
+// PersonTypesIsNull returns true if the related database value is null.
+func (o *personBase) PersonTypesIsNull() bool {
+	return o.personTypesIsNull
+}
+
+// SetPersonTypes sets the value of PersonTypes in the object, to be saved later in the database using the Save() function.
+func (o *personBase) SetPersonTypes(v []PersonType) {
+	if o._restored &&
+		o.personTypesIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		!o.personTypesIsNull && // if the db value is null, force a set of value
+		slices.Equal(o.personTypes, v) {
+		// no change
+		return
+	}
+
+	o.personTypesIsLoaded = true
+	o.personTypes = slices.Clone(v)
+	o.personTypesIsDirty = true
+	o.personTypesIsNull = false
+}
+
+// SetPersonTypesToNull() will set the person_types value in the database to NULL.
+// PersonTypes() will return the column's default value after this.
+func (o *personBase) SetPersonTypesToNull() {
+	if !o.personTypesIsLoaded || !o.personTypesIsNull {
+		// If we know it is null in the database, don't save it
+		o.personTypesIsDirty = true
+	}
+	o.personTypesIsLoaded = true
+	o.personTypesIsNull = true
+	o.personTypes = []PersonType(nil)
+}
+
 // Created returns the value of the loaded created field in the database.
 func (o *personBase) Created() time.Time {
 	if o._restored && !o.createdIsLoaded {
@@ -377,6 +459,7 @@ func (o *personBase) Projects() []*Project {
 // SetProjects sets the associated objects to the given slice of Project objects
 // in preparation for saving. The associations will not be updated until Save() is called.
 // Objects that are modified or are new will be saved before completing the association.
+// Objects that were already associated keep the values of their links. Use SetProjectLinks to also set the values.
 func (o *personBase) SetProjects(objs ...*Project) {
 	o.projects.Clear()
 	o.projectsIsDirty = true
@@ -384,6 +467,11 @@ func (o *personBase) SetProjects(objs ...*Project) {
 	for _, obj := range objs {
 		o.projects.Set(obj.PrimaryKey(), obj)
 	}
+	for pk := range o.projectLinks {
+		if !o.projects.Has(pk) {
+			delete(o.projectLinks, pk)
+		}
+	}
 }
 
 // SetProjectsByID prepares to associate Project objects by
@@ -397,6 +485,11 @@ func (o *personBase) SetProjectsByID(ids ...string) {
 	o.projects.Clear()
 	o.projectsPks = ids
 	o.projectsIsDirty = true
+	for pk := range o.projectLinks {
+		if !slices.Contains(ids, pk) {
+			delete(o.projectLinks, pk)
+		}
+	}
 }
 
 // LoadProjects loads the Project objects associated through the Project-TeamMember relationship.
@@ -414,9 +507,22 @@ func (o *personBase) LoadProjects(ctx context.Context) ([]*Project, error) {
 			Where(op.In(node.Project().PrimaryKeys()[0], o.projectsPks...)).
 			Load()
 	} else {
-		objs, err = QueryProjects(ctx).
-			Where(op.Equal(node.Project().TeamMembers().PrimaryKey(), o.PrimaryKey())).
-			Load()
+		// Load through the Person so that the association table can be used
+		var obj *Person
+		obj, err = QueryPeople(ctx).
+			Where(op.Equal(node.Person().PrimaryKey(), o.PrimaryKey())).
+			Select(node.Person().Projects()).
+			Get()
+		if err != nil {
+			return nil, err
+		}
+		o.projects.Clear()
+		o.projectLinks = nil
+		if obj != nil {
+			o.projects = obj.projects
+			o.projectLinks = obj.projectLinks
+		}
+		return o.projects.Values(), nil
 	}
 	if err != nil {
 		return nil, err
@@ -439,6 +545,238 @@ func (o *personBase) CountProjects(ctx context.Context) (int, error) {
 
 }
 
+// projectsAssnValue returns the values to write to the extra columns of the team_member_project_assn table
+// when associating the Project with primary key pk at position i.
+func (o *personBase) projectsAssnValue(i int, pk string) map[string]any {
+	return o.projectLinks[pk].values()
+}
+
+// projectsAssnValues returns the values of projectsAssnValue for each of pks, in the form needed by db.AssociateOnly.
+func (o *personBase) projectsAssnValues(pks []string) []map[string]any {
+	values := make([]map[string]any, len(pks))
+	for i, pk := range pks {
+		values[i] = o.projectsAssnValue(i, pk)
+	}
+	return values
+}
+
+// PersonProjectLink is a link from a Person to a Project through the team_member_project_assn association table,
+// together with the values of the extra columns in that table.
+type PersonProjectLink struct {
+	projects     *Project
+	role         string
+	roleIsNull   bool
+	hoursPerWeek int
+	isDirty      bool
+}
+
+// NewPersonProjectLink returns a new link to obj with the extra columns set to their default values.
+// Pass the link to Person.SetProjectLinks to associate obj.
+func NewPersonProjectLink(obj *Project) *PersonProjectLink {
+	return &PersonProjectLink{
+		projects:     obj,
+		role:         "",
+		roleIsNull:   true,
+		hoursPerWeek: 40,
+	}
+}
+
+// Project returns the linked Project object.
+func (l *PersonProjectLink) Project() *Project {
+	return l.projects
+}
+
+// Role returns the value of the role column of the link.
+func (l *PersonProjectLink) Role() string {
+	return l.role
+}
+
+// RoleIsNull returns true if the role column of the link is null.
+func (l *PersonProjectLink) RoleIsNull() bool {
+	return l.roleIsNull
+}
+
+// SetRoleToNull sets the role column of the link to null.
+func (l *PersonProjectLink) SetRoleToNull() {
+	if !l.roleIsNull {
+		l.roleIsNull = true
+		l.role = ""
+		l.isDirty = true
+	}
+}
+
+// SetRole sets the value of the role column of the link, to be saved
+// when the Person is saved.
+func (l *PersonProjectLink) SetRole(v string) {
+	if utf8.RuneCountInString(v) > 50 {
+		panic("attempted to set PersonProjectLink.Role to a value larger than its maximum length in runes")
+	}
+	if !l.roleIsNull && l.role == v {
+		return
+	}
+	l.role = v
+	l.roleIsNull = false
+	l.isDirty = true
+}
+
+// HoursPerWeek returns the value of the hours_per_week column of the link.
+func (l *PersonProjectLink) HoursPerWeek() int {
+	return l.hoursPerWeek
+}
+
+// SetHoursPerWeek sets the value of the hours_per_week column of the link, to be saved
+// when the Person is saved.
+func (l *PersonProjectLink) SetHoursPerWeek(v int) {
+	if l.hoursPerWeek == v {
+		return
+	}
+	l.hoursPerWeek = v
+	l.isDirty = true
+}
+
+// IsDirty returns true if a value of the link has changed since it was loaded or saved.
+func (l *PersonProjectLink) IsDirty() bool {
+	return l.isDirty
+}
+
+// values returns the values of the extra columns keyed by column name, as needed by db.Associate.
+// A nil link returns nil, so that the database defaults are used.
+func (l *PersonProjectLink) values() map[string]any {
+	if l == nil {
+		return nil
+	}
+	m := make(map[string]any)
+	if l.roleIsNull {
+		m["role"] = nil
+	} else {
+		m["role"] = l.role
+	}
+	m["hours_per_week"] = l.hoursPerWeek
+	return m
+}
+
+// unpack sets the values of the link from the data of the linked object returned by a query.
+// It returns false if the query did not select the values of the link.
+func (l *PersonProjectLink) unpack(m map[string]any) (found bool) {
+	if v, ok := m["_linkRole"]; ok {
+		found = true
+		if v == nil {
+			l.role = ""
+			l.roleIsNull = true
+		} else if v2, ok2 := v.(string); ok2 {
+			l.role = v2
+			l.roleIsNull = false
+		} else {
+			panic("Wrong type found for PersonProjectLink.role.")
+		}
+	}
+	if v, ok := m["_linkHoursPerWeek"]; ok {
+		found = true
+		if v2, ok2 := v.(int); ok2 {
+			l.hoursPerWeek = v2
+		} else {
+			panic("Wrong type found for PersonProjectLink.hoursPerWeek.")
+		}
+	}
+	return
+}
+
+// encode writes the values of the link to enc. The linked object is encoded separately.
+func (l *PersonProjectLink) encode(enc db.Encoder) error {
+	if err := enc.Encode(l.role); err != nil {
+		return fmt.Errorf("error encoding PersonProjectLink.role: %w", err)
+	}
+	if err := enc.Encode(l.roleIsNull); err != nil {
+		return fmt.Errorf("error encoding PersonProjectLink.roleIsNull: %w", err)
+	}
+	if err := enc.Encode(l.hoursPerWeek); err != nil {
+		return fmt.Errorf("error encoding PersonProjectLink.hoursPerWeek: %w", err)
+	}
+	if err := enc.Encode(l.isDirty); err != nil {
+		return fmt.Errorf("error encoding PersonProjectLink.isDirty: %w", err)
+	}
+	return nil
+}
+
+// decode reads the values of the link written by encode.
+func (l *PersonProjectLink) decode(dec db.Decoder) error {
+	if err := dec.Decode(&l.role); err != nil {
+		return fmt.Errorf("error decoding PersonProjectLink.role: %w", err)
+	}
+	if err := dec.Decode(&l.roleIsNull); err != nil {
+		return fmt.Errorf("error decoding PersonProjectLink.roleIsNull: %w", err)
+	}
+	if err := dec.Decode(&l.hoursPerWeek); err != nil {
+		return fmt.Errorf("error decoding PersonProjectLink.hoursPerWeek: %w", err)
+	}
+	if err := dec.Decode(&l.isDirty); err != nil {
+		return fmt.Errorf("error decoding PersonProjectLink.isDirty: %w", err)
+	}
+	return nil
+}
+
+// ProjectLinks returns the links to the loaded Project objects, in the same order as Projects().
+// Changes to the values of the links will be written when Save() is called. If the Projects are not loaded, it will return nil.
+func (o *personBase) ProjectLinks() []*PersonProjectLink {
+	if o.projects.Len() == 0 {
+		return nil
+	}
+	links := make([]*PersonProjectLink, 0, o.projects.Len())
+	for obj := range o.projects.ValuesIter() {
+		links = append(links, o.ProjectLink(obj.PrimaryKey()))
+	}
+	return links
+}
+
+// ProjectLink returns the link to the loaded Project object with primary key pk, or nil
+// if that object is not loaded.
+func (o *personBase) ProjectLink(pk string) *PersonProjectLink {
+	obj := o.projects.Get(pk)
+	if obj == nil {
+		return nil
+	}
+	l := o.projectLinks[pk]
+	if l == nil {
+		l = NewPersonProjectLink(obj)
+		if o.projectLinks == nil {
+			o.projectLinks = make(map[string]*PersonProjectLink)
+		}
+		o.projectLinks[pk] = l
+	}
+	return l
+}
+
+// SetProjectLinks associates the objects in the given links, along with the values of the links,
+// in preparation for saving. The associations will not be updated until Save() is called.
+func (o *personBase) SetProjectLinks(links ...*PersonProjectLink) {
+	o.projects.Clear()
+	o.projectsIsDirty = true
+	o.projectsPks = nil
+	o.projectLinks = make(map[string]*PersonProjectLink, len(links))
+	for _, l := range links {
+		o.projects.Set(l.projects.PrimaryKey(), l.projects)
+		o.projectLinks[l.projects.PrimaryKey()] = l
+	}
+}
+
+// rekeyProjectLinks moves the link at oldPk to newPk after the linked object gets a new primary key when saved.
+func (o *personBase) rekeyProjectLinks(oldPk, newPk string) {
+	if l, ok := o.projectLinks[oldPk]; ok {
+		delete(o.projectLinks, oldPk)
+		o.projectLinks[newPk] = l
+	}
+}
+
+// projectLinksAreDirty returns true if the values of any of the links have changed.
+func (o *personBase) projectLinksAreDirty() bool {
+	for _, l := range o.projectLinks {
+		if l.isDirty {
+			return true
+		}
+	}
+	return false
+}
+
 // ManagerProject returns a single Project object by primary key, if one was loaded.
 // Otherwise, it will return nil. It will not return Project objects that are not saved.
 func (o *personBase) ManagerProject(pk string) *Project {
@@ -828,7 +1166,8 @@ func (b *PersonBuilder) OrderBy(nodes ...query.Sorter) *PersonBuilder {
 // Limit will return a subset of the data, limited to the offset and number of rows specified.
 // For large data sets and specific types of queries, this can be slow, because it will perform
 // the entire query before computing the limit.
-// You cannot limit a query that has embedded arrays.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the Person objects. You cannot otherwise limit a query that has embedded arrays.
 func (b *PersonBuilder) Limit(maxRowCount int, offset int) *PersonBuilder {
 	b.builder.Limit(maxRowCount, offset)
 	return b
@@ -841,11 +1180,22 @@ func (b *PersonBuilder) Limit(maxRowCount int, offset int) *PersonBuilder {
 // If columns in related tables are specified, then only those columns will be queried and loaded.
 // Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
 // will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
 func (b *PersonBuilder) Select(nodes ...query.Node) *PersonBuilder {
 	b.builder.Select(nodes...)
 	return b
 }
 
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded Person objects at once, rather than joining them to the query.
+// This avoids repeating the data of each Person object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *PersonBuilder) Preload(nodes ...query.Node) *PersonBuilder {
+	b.builder.Preload(nodes...)
+	return b
+}
+
 // Calculation adds operation as an aliased value onto base.
 // After the query, you can read the data by passing alias to GetAlias on the returned object.
 func (b *PersonBuilder) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *PersonBuilder {
@@ -872,6 +1222,35 @@ func (b *PersonBuilder) Having(node query.Node) *PersonBuilder {
 	return b
 }
 
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *PersonBuilder) ForUpdate() *PersonBuilder {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *PersonBuilder) ForShare() *PersonBuilder {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *PersonBuilder) SkipLocked() *PersonBuilder {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *PersonBuilder) NoWait() *PersonBuilder {
+	b.builder.NoWait()
+	return b
+}
+
 // Count terminates a query and returns just the number of items in the result.
 // If you have Select or Calculation columns in the query, it will count NULL results as well.
 // To not count NULL values, use Where in the builder with a NotNull operation.
@@ -948,6 +1327,17 @@ func (o *personBase) unpack(m map[string]interface{}, objThis *Person) {
 		o.lastNameIsDirty = false
 	}
 
+	if v, ok := m["fullName"]; ok && v != nil {
+		if o.fullName, ok = v.(string); ok {
+			o.fullNameIsLoaded = true
+		} else {
+			panic("Wrong type found for fullName.")
+		}
+	} else {
+		o.fullNameIsLoaded = false
+		o.fullName = ""
+	}
+
 	if v, ok := m["personType"]; ok {
 		if v == nil {
 			o.personType = PersonType(0)
@@ -969,6 +1359,30 @@ func (o *personBase) unpack(m map[string]interface{}, objThis *Person) {
 		o.personTypeIsDirty = false
 	}
 
+	if v, ok := m["personTypes"]; ok {
+		if v == nil {
+			o.personTypes = []PersonType(nil)
+			o.personTypesIsNull = true
+			o.personTypesIsLoaded = true
+			o.personTypesIsDirty = false
+		} else if a, ok2 := v.([]int); ok2 {
+			o.personTypes = make([]PersonType, len(a))
+			for i, i2 := range a {
+				o.personTypes[i] = PersonType(i2)
+			}
+			o.personTypesIsNull = false
+			o.personTypesIsLoaded = true
+			o.personTypesIsDirty = false
+		} else {
+			panic("Wrong type found for personTypes.")
+		}
+	} else {
+		o.personTypesIsLoaded = false
+		o.personTypesIsNull = true
+		o.personTypes = []PersonType(nil)
+		o.personTypesIsDirty = false
+	}
+
 	if v, ok := m["created"]; ok && v != nil {
 		if o.created, ok = v.(time.Time); ok {
 			o.createdIsLoaded = true
@@ -1002,11 +1416,18 @@ func (o *personBase) unpack(m map[string]interface{}, objThis *Person) {
 	if v, ok := m["projects"]; ok {
 		if v2, ok2 := v.([]map[string]any); ok2 {
 			o.projects.Clear()
+			o.projectLinks = nil
 
 			for _, v3 := range v2 {
 				obj := new(Project)
 				obj.unpack(v3, obj)
 				o.projects.Set(obj.PrimaryKey(), obj)
+				if l := NewPersonProjectLink(obj); l.unpack(v3) {
+					if o.projectLinks == nil {
+						o.projectLinks = make(map[string]*PersonProjectLink)
+					}
+					o.projectLinks[obj.PrimaryKey()] = l
+				}
 			}
 			o.projectsPks = nil
 		} else {
@@ -1015,6 +1436,7 @@ func (o *personBase) unpack(m map[string]interface{}, objThis *Person) {
 	} else {
 		o.projects.Clear()
 		o.projectsPks = nil
+		o.projectLinks = nil
 	}
 
 	// Reverse references
@@ -1129,6 +1551,9 @@ func (o *personBase) update(ctx context.Context) error {
 			if err2 != nil {
 				return err2
 			}
+			if err2 = o.loadGeneratedColumns(ctx); err2 != nil {
+				return err2
+			}
 		}
 
 		if o.managerProjectsIsDirty {
@@ -1304,29 +1729,23 @@ func (o *personBase) update(ctx context.Context) error {
 					// update key in the slice map without changing the order
 					o.projects.Delete(k)
 					o.projects.SetAt(i, obj.PrimaryKey(), obj)
+					o.rekeyProjectLinks(k, obj.PrimaryKey())
 				}
 			}
-			if o.projectsIsDirty {
-				if len(o.projectsPks) != 0 {
-					if err := db.AssociateOnly(ctx,
-						d,
-						"team_member_project_assn",
-						"team_member_id",
-						o.PrimaryKey(),
-						"project_id",
-						o.projectsPks); err != nil {
-						return err
-					}
-				} else {
-					if err := db.AssociateOnly(ctx,
-						d,
-						"team_member_project_assn",
-						"team_member_id",
-						o.PrimaryKey(),
-						"project_id",
-						o.projects.Keys()); err != nil {
-						return err
-					}
+			if o.projectsIsDirty || o.projectLinksAreDirty() {
+				pks := o.projectsPks
+				if len(pks) == 0 {
+					pks = o.projects.Keys()
+				}
+				if err := db.AssociateOnly(ctx,
+					d,
+					"team_member_project_assn",
+					"team_member_id",
+					o.PrimaryKey(),
+					"project_id",
+					pks,
+					o.projectsAssnValues(pks)...); err != nil {
+					return err
 				}
 			}
 		}
@@ -1375,6 +1794,9 @@ func (o *personBase) insert(ctx context.Context) (err error) {
 			return err
 		}
 		o._originalPK = o.PrimaryKey()
+		if err2 := o.loadGeneratedColumns(ctx); err2 != nil {
+			return err2
+		}
 
 		if o.managerProjects.Len() > 0 {
 			keys := o.managerProjects.Keys()
@@ -1430,6 +1852,7 @@ func (o *personBase) insert(ctx context.Context) (err error) {
 				if k != obj.PrimaryKey() {
 					o.projects.Delete(k)
 					o.projects.SetAt(i, obj.PrimaryKey(), obj)
+					o.rekeyProjectLinks(k, obj.PrimaryKey())
 				}
 				db.Associate(ctx,
 					d,
@@ -1438,10 +1861,11 @@ func (o *personBase) insert(ctx context.Context) (err error) {
 					o.PrimaryKey(),
 					"project_id",
 					obj.PrimaryKey(),
+					o.projectsAssnValue(i, obj.PrimaryKey()),
 				)
 			}
 		} else if len(o.projectsPks) > 0 {
-			for _, k := range o.projectsPks {
+			for i, k := range o.projectsPks {
 				obj, err2 := LoadProject(ctx, k)
 				if err2 != nil {
 					return err2
@@ -1454,6 +1878,7 @@ func (o *personBase) insert(ctx context.Context) (err error) {
 						o.PrimaryKey(),
 						"project_id",
 						k,
+						o.projectsAssnValue(i, k),
 					)
 				}
 			}
@@ -1501,6 +1926,13 @@ func (o *personBase) getUpdateFields() (fields map[string]interface{}) {
 			fields["person_type"] = o.personType
 		}
 	}
+	if o.personTypesIsDirty {
+		if o.personTypesIsNull {
+			fields["person_types"] = nil
+		} else {
+			fields["person_types"] = o.personTypes
+		}
+	}
 	if len(fields) > 0 {
 		fields["modified"] = time.Now().UTC()
 	}
@@ -1526,16 +1958,36 @@ func (o *personBase) getInsertFields() (fields map[string]interface{}) {
 	} else {
 		fields["person_type"] = o.personType
 	}
+	if o.personTypesIsNull {
+		fields["person_types"] = nil
+	} else {
+		fields["person_types"] = o.personTypes
+	}
 	fields["created"] = time.Now().UTC()
 	fields["modified"] = time.Now().UTC()
 	return
 }
 
+// loadGeneratedColumns reads the values that the database computed for the generated columns
+// after an insert or update.
+func (o *personBase) loadGeneratedColumns(ctx context.Context) error {
+	obj, err := LoadPerson(ctx, o.PrimaryKey(), node.Person().FullName())
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return db.NewRecordNotFoundError("person", o.PrimaryKey())
+	}
+	o.fullName = obj.fullName
+	o.fullNameIsLoaded = true
+	return nil
+}
+
 // Delete deletes the record from the database.
 //
-// Associated ManagerProject will have their Manager field set to NULL.
-// Associated Address will also be deleted since their Person fields are not nullable.
-// An associated {= rev.ReverseIdentifier  will also be deleted since its Person field is not nullable.
+// Associated ManagerProjects will have their Manager field set to NULL.
+// Associated Addresses will also be deleted.
+// An associated EmployeeInfo will also be deleted.
 // An associated Login will have its Person field set to NULL.
 func (o *personBase) Delete(ctx context.Context) (err error) {
 	if o == nil {
@@ -1676,12 +2128,16 @@ func (o *personBase) resetDirtyStatus() {
 	o.firstNameIsDirty = false
 	o.lastNameIsDirty = false
 	o.personTypeIsDirty = false
+	o.personTypesIsDirty = false
 	o.managerProjectsIsDirty = false
 	o.addressesIsDirty = false
 	o.employeeInfoIsDirty = false
 	o.loginIsDirty = false
 	o.projectsIsDirty = false
 	o.projectsPks = nil
+	for _, l := range o.projectLinks {
+		l.isDirty = false
+	}
 
 }
 
@@ -1690,7 +2146,8 @@ func (o *personBase) IsDirty() (dirty bool) {
 	dirty = o.idIsDirty ||
 		o.firstNameIsDirty ||
 		o.lastNameIsDirty ||
-		o.personTypeIsDirty
+		o.personTypeIsDirty ||
+		o.personTypesIsDirty
 
 	dirty = dirty ||
 		o.managerProjectsIsDirty ||
@@ -1713,6 +2170,7 @@ func (o *personBase) IsDirty() (dirty bool) {
 	for obj := range o.projects.ValuesIter() {
 		dirty = dirty || obj.IsDirty()
 	}
+	dirty = dirty || o.projectLinksAreDirty()
 
 	return
 }
@@ -1738,11 +2196,21 @@ func (o *personBase) Get(key string) interface{} {
 			return nil
 		}
 		return o.lastName
+	case PersonFullNameField:
+		if !o.fullNameIsLoaded {
+			return nil
+		}
+		return o.fullName
 	case PersonPersonTypeField:
 		if !o.personTypeIsLoaded {
 			return nil
 		}
 		return o.personType
+	case PersonPersonTypesField:
+		if !o.personTypesIsLoaded {
+			return nil
+		}
+		return o.personTypes
 	case PersonCreatedField:
 		if !o.createdIsLoaded {
 			return nil
@@ -1812,6 +2280,13 @@ func (o *personBase) encodeTo(enc db.Encoder) error {
 		return fmt.Errorf("error encoding Person.lastNameIsDirty: %w", err)
 	}
 
+	if err := enc.Encode(o.fullName); err != nil {
+		return fmt.Errorf("error encoding Person.fullName: %w", err)
+	}
+	if err := enc.Encode(o.fullNameIsLoaded); err != nil {
+		return fmt.Errorf("error encoding Person.fullNameIsLoaded: %w", err)
+	}
+
 	if err := enc.Encode(o.personType); err != nil {
 		return fmt.Errorf("error encoding Person.personType: %w", err)
 	}
@@ -1825,6 +2300,19 @@ func (o *personBase) encodeTo(enc db.Encoder) error {
 		return fmt.Errorf("error encoding Person.personTypeIsDirty: %w", err)
 	}
 
+	if err := enc.Encode(o.personTypes); err != nil {
+		return fmt.Errorf("error encoding Person.personTypes: %w", err)
+	}
+	if err := enc.Encode(o.personTypesIsNull); err != nil {
+		return fmt.Errorf("error encoding Person.personTypesIsNull: %w", err)
+	}
+	if err := enc.Encode(o.personTypesIsLoaded); err != nil {
+		return fmt.Errorf("error encoding Person.personTypesIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.personTypesIsDirty); err != nil {
+		return fmt.Errorf("error encoding Person.personTypesIsDirty: %w", err)
+	}
+
 	if err := enc.Encode(o.created); err != nil {
 		return fmt.Errorf("error encoding Person.created: %w", err)
 	}
@@ -1904,6 +2392,17 @@ func (o *personBase) encodeTo(enc db.Encoder) error {
 			return fmt.Errorf("error encoding Person.projectsPks: %w", err)
 		}
 	}
+	if err := enc.Encode(len(o.projectLinks)); err != nil {
+		return fmt.Errorf("error encoding Person.projectLinks length: %w", err)
+	}
+	for k, l := range o.projectLinks {
+		if err := enc.Encode(k); err != nil {
+			return fmt.Errorf("error encoding Person.projectLinks key: %w", err)
+		}
+		if err := l.encode(enc); err != nil {
+			return err
+		}
+	}
 
 	if o._aliases == nil {
 		if err := enc.Encode(false); err != nil {
@@ -1968,6 +2467,13 @@ func (o *personBase) decodeFrom(dec db.Decoder) (err error) {
 		return fmt.Errorf("error decoding Person.lastNameIsDirty: %w", err)
 	}
 
+	if err = dec.Decode(&o.fullName); err != nil {
+		return fmt.Errorf("error decoding Person.fullName: %w", err)
+	}
+	if err = dec.Decode(&o.fullNameIsLoaded); err != nil {
+		return fmt.Errorf("error decoding Person.fullNameIsLoaded: %w", err)
+	}
+
 	if err = dec.Decode(&o.personType); err != nil {
 		return fmt.Errorf("error decoding Person.personType: %w", err)
 	}
@@ -1981,6 +2487,19 @@ func (o *personBase) decodeFrom(dec db.Decoder) (err error) {
 		return fmt.Errorf("error decoding Person.personTypeIsDirty: %w", err)
 	}
 
+	if err = dec.Decode(&o.personTypes); err != nil {
+		return fmt.Errorf("error decoding Person.personTypes: %w", err)
+	}
+	if err = dec.Decode(&o.personTypesIsNull); err != nil {
+		return fmt.Errorf("error decoding Person.personTypesIsNull: %w", err)
+	}
+	if err = dec.Decode(&o.personTypesIsLoaded); err != nil {
+		return fmt.Errorf("error decoding Person.personTypesIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.personTypesIsDirty); err != nil {
+		return fmt.Errorf("error decoding Person.personTypesIsDirty: %w", err)
+	}
+
 	if err = dec.Decode(&o.created); err != nil {
 		return fmt.Errorf("error decoding Person.created: %w", err)
 	}
@@ -2053,6 +2572,27 @@ func (o *personBase) decodeFrom(dec db.Decoder) (err error) {
 			return fmt.Errorf("error decoding Person.projectsPks: %w", err)
 		}
 	}
+	{
+		var n int
+		if err = dec.Decode(&n); err != nil {
+			return fmt.Errorf("error decoding Person.projectLinks length: %w", err)
+		}
+		o.projectLinks = nil
+		for range n {
+			var k string
+			if err = dec.Decode(&k); err != nil {
+				return fmt.Errorf("error decoding Person.projectLinks key: %w", err)
+			}
+			l := &PersonProjectLink{projects: o.projects.Get(k)}
+			if err = l.decode(dec); err != nil {
+				return err
+			}
+			if o.projectLinks == nil {
+				o.projectLinks = make(map[string]*PersonProjectLink)
+			}
+			o.projectLinks[k] = l
+		}
+	}
 	if err = dec.Decode(&isPtr); err != nil {
 		return fmt.Errorf("error decoding Person._aliases isPtr: %w", err)
 	}
@@ -2098,6 +2638,10 @@ func (o *personBase) MarshalStringMap() map[string]interface{} {
 		v["lastName"] = o.lastName
 	}
 
+	if o.fullNameIsLoaded {
+		v["fullName"] = o.fullName
+	}
+
 	if o.personTypeIsLoaded {
 		if o.personTypeIsNull {
 			v["personType"] = nil
@@ -2106,6 +2650,14 @@ func (o *personBase) MarshalStringMap() map[string]interface{} {
 		}
 	}
 
+	if o.personTypesIsLoaded {
+		if o.personTypesIsNull {
+			v["personTypes"] = nil
+		} else {
+			v["personTypes"] = o.personTypes
+		}
+	}
+
 	if o.createdIsLoaded {
 		v["created"] = o.created
 	}
@@ -2164,7 +2716,9 @@ func (o *personBase) MarshalStringMap() map[string]interface{} {
 //	"id" - string
 //	"firstName" - string
 //	"lastName" - string
+//	"fullName" - string
 //	"personType" - PersonType, nullable
+//	"personTypes" - []PersonType, nullable
 //	"created" - time.Time
 //	"modified" - time.Time, nullable
 func (o *personBase) UnmarshalJSON(data []byte) (err error) {
@@ -2236,6 +2790,27 @@ func (o *personBase) UnmarshalStringMap(m map[string]interface{}) (err error) {
 				}
 				o.SetPersonType(v2)
 			}
+		case "personTypes":
+			{
+				if v == nil {
+					o.SetPersonTypesToNull()
+					continue
+				}
+
+				a, ok := v.([]any)
+				if !ok {
+					return fmt.Errorf("json field %s must be an array", k)
+				}
+				v2 := make([]PersonType, len(a))
+				for i, i2 := range a {
+					e, err := PersonTypeFromInterface(i2)
+					if err != nil {
+						return err
+					}
+					v2[i] = e
+				}
+				o.SetPersonTypes(v2)
+			}
 		case "managerProjects":
 			v2, ok := v.([]any)
 			if !ok {
@@ -37,6 +37,8 @@ func updateMinimalSamplePerson(obj *Person) {
 
 	obj.SetPersonType(test.RandomEnum(PersonTypes()))
 
+	obj.SetPersonTypes(test.RandomEnumSlice(PersonTypes()))
+
 }
 
 // createMaximalSamplePerson creates an unsaved version of a Person object
@@ -105,9 +107,15 @@ func assertEqualFieldsPerson(t *testing.T, obj1, obj2 *Person) {
 	if obj1.LastNameIsLoaded() && obj2.LastNameIsLoaded() { // only check loaded values
 		assert.EqualValues(t, obj1.LastName(), obj2.LastName())
 	}
+	if obj1.FullNameIsLoaded() && obj2.FullNameIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.FullName(), obj2.FullName())
+	}
 	if obj1.PersonTypeIsLoaded() && obj2.PersonTypeIsLoaded() { // only check loaded values
 		assert.EqualValues(t, obj1.PersonType(), obj2.PersonType())
 	}
+	if obj1.PersonTypesIsLoaded() && obj2.PersonTypesIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.PersonTypes(), obj2.PersonTypes())
+	}
 	if obj1.CreatedIsLoaded() && obj2.CreatedIsLoaded() { // only check loaded values
 		// ignore fractional seconds since some types truncate to the second.
 		assert.WithinDuration(t, obj1.Created(), obj2.Created(), time.Second)
@@ -199,6 +207,27 @@ func TestPerson_SetPersonType(t *testing.T) {
 	obj.SetPersonType(d)
 	assert.EqualValues(t, d, obj.PersonType(), "set default")
 
+}
+func TestPerson_SetPersonTypes(t *testing.T) {
+
+	obj := NewPerson()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomEnumSlice(PersonTypes())
+	obj.SetPersonTypes(val)
+	assert.Equal(t, val, obj.PersonTypes())
+	assert.False(t, obj.PersonTypesIsNull())
+
+	// Test NULL
+	obj.SetPersonTypesToNull()
+	assert.EqualValues(t, []PersonType(nil), obj.PersonTypes())
+	assert.True(t, obj.PersonTypesIsNull())
+
+	// test default
+	var d []PersonType = []PersonType(nil)
+	obj.SetPersonTypes(d)
+	assert.EqualValues(t, d, obj.PersonTypes(), "set default")
+
 }
 
 func TestPerson_Copy(t *testing.T) {
@@ -210,6 +239,7 @@ func TestPerson_Copy(t *testing.T) {
 	assert.Equal(t, obj.FirstName(), obj2.FirstName())
 	assert.Equal(t, obj.LastName(), obj2.LastName())
 	assert.Equal(t, obj.PersonType(), obj2.PersonType())
+	assert.Equal(t, obj.PersonTypes(), obj2.PersonTypes())
 
 }
 
@@ -243,6 +273,8 @@ func TestPerson_BasicInsert(t *testing.T) {
 	obj2.SetLastName(obj2.LastName())
 	assert.False(t, obj2.lastNameIsDirty)
 
+	assert.True(t, obj2.FullNameIsLoaded())
+
 	assert.True(t, obj2.PersonTypeIsLoaded())
 	assert.False(t, obj2.PersonTypeIsNull())
 	// test that setting it to the same value will not change the dirty bit
@@ -250,6 +282,13 @@ func TestPerson_BasicInsert(t *testing.T) {
 	obj2.SetPersonType(obj2.PersonType())
 	assert.False(t, obj2.personTypeIsDirty)
 
+	assert.True(t, obj2.PersonTypesIsLoaded())
+	assert.False(t, obj2.PersonTypesIsNull())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.personTypesIsDirty)
+	obj2.SetPersonTypes(obj2.PersonTypes())
+	assert.False(t, obj2.personTypesIsDirty)
+
 	assert.True(t, obj2.CreatedIsLoaded())
 
 	assert.True(t, obj2.ModifiedIsLoaded())
@@ -290,7 +329,9 @@ func TestPerson_BasicUpdate(t *testing.T) {
 	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
 	assert.Equal(t, obj2.FirstName(), obj.FirstName(), "FirstName did not update")
 	assert.Equal(t, obj2.LastName(), obj.LastName(), "LastName did not update")
+	assert.Equal(t, obj2.FullName(), obj.FullName(), "FullName did not update")
 	assert.Equal(t, obj2.PersonType(), obj.PersonType(), "PersonType did not update")
+	assert.Equal(t, obj2.PersonTypes(), obj.PersonTypes(), "PersonTypes did not update")
 
 	assert.WithinDuration(t, obj2.Created(), obj.Created(), time.Second, "Created not within one second")
 
@@ -443,9 +484,15 @@ func TestPerson_Getters(t *testing.T) {
 	assert.Equal(t, obj.LastName(), obj.Get(PersonLastNameField))
 	assert.Panics(t, func() { obj2.LastName() })
 	assert.Nil(t, obj2.Get(PersonLastNameField))
+	assert.Equal(t, obj.FullName(), obj.Get(PersonFullNameField))
+	assert.Panics(t, func() { obj2.FullName() })
+	assert.Nil(t, obj2.Get(PersonFullNameField))
 	assert.Equal(t, obj.PersonType(), obj.Get(PersonPersonTypeField))
 	assert.Panics(t, func() { obj2.PersonType() })
 	assert.Nil(t, obj2.Get(PersonPersonTypeField))
+	assert.Equal(t, obj.PersonTypes(), obj.Get(PersonPersonTypesField))
+	assert.Panics(t, func() { obj2.PersonTypes() })
+	assert.Nil(t, obj2.Get(PersonPersonTypesField))
 	assert.Equal(t, obj.Created(), obj.Get(PersonCreatedField))
 	assert.Panics(t, func() { obj2.Created() })
 	assert.Nil(t, obj2.Get(PersonCreatedField))
@@ -563,14 +610,14 @@ func TestPerson_FailingMarshalBinary(t *testing.T) {
 	obj := createMinimalSamplePerson()
 	var err error
 
-	for i := 0; i < 31; i++ {
+	for i := 0; i < 37; i++ {
 		enc := &test.GobEncoder{Count: i}
 		err = obj.encodeTo(enc)
 		assert.Error(t, err)
 	}
 	// do it again with aliases
 	obj._aliases = make(map[string]any)
-	for i := 0; i < 32; i++ {
+	for i := 0; i < 38; i++ {
 		enc := &test.GobEncoder{Count: i}
 		err = obj.encodeTo(enc)
 		assert.Error(t, err)
@@ -582,7 +629,7 @@ func TestPerson_FailingUnmarshalBinary(t *testing.T) {
 	b, err := obj.MarshalBinary()
 	assert.NoError(t, err)
 	obj2 := NewPerson()
-	for i := 0; i < 31; i++ {
+	for i := 0; i < 37; i++ {
 		buf := bytes.NewReader(b)
 		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
 		err = obj2.decodeFrom(dec)
@@ -596,7 +643,7 @@ func TestPerson_FailingUnmarshalBinary(t *testing.T) {
 	assert.NoError(t, err)
 
 	obj2 = NewPerson()
-	for i := 0; i < 32; i++ {
+	for i := 0; i < 38; i++ {
 		buf := bytes.NewReader(b)
 		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
 		err = obj2.decodeFrom(dec)
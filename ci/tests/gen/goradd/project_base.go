@@ -8,6 +8,7 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 	"unicode/utf8"
 
@@ -79,6 +80,7 @@ type projectBase struct {
 	teamMembers        maps.SliceMap[string, *Person]
 	teamMembersPks     []string // Primary keys to associate at Save time
 	teamMembersIsDirty bool
+	teamMemberLinks    map[string]*ProjectTeamMemberLink // Values of the association table, by primary key of the Person
 
 	// Custom aliases, if specified
 	_aliases map[string]any
@@ -184,6 +186,7 @@ func (o *projectBase) Initialize() {
 	o.teamMembers.Clear()
 	o.teamMembersPks = nil
 	o.teamMembersIsDirty = false
+	o.teamMemberLinks = nil
 
 	o._aliases = nil
 	o._restored = false
@@ -842,6 +845,7 @@ func (o *projectBase) TeamMembers() []*Person {
 // SetTeamMembers sets the associated objects to the given slice of Person objects
 // in preparation for saving. The associations will not be updated until Save() is called.
 // Objects that are modified or are new will be saved before completing the association.
+// Objects that were already associated keep the values of their links. Use SetTeamMemberLinks to also set the values.
 func (o *projectBase) SetTeamMembers(objs ...*Person) {
 	o.teamMembers.Clear()
 	o.teamMembersIsDirty = true
@@ -849,6 +853,11 @@ func (o *projectBase) SetTeamMembers(objs ...*Person) {
 	for _, obj := range objs {
 		o.teamMembers.Set(obj.PrimaryKey(), obj)
 	}
+	for pk := range o.teamMemberLinks {
+		if !o.teamMembers.Has(pk) {
+			delete(o.teamMemberLinks, pk)
+		}
+	}
 }
 
 // SetTeamMembersByID prepares to associate Person objects by
@@ -862,6 +871,11 @@ func (o *projectBase) SetTeamMembersByID(ids ...string) {
 	o.teamMembers.Clear()
 	o.teamMembersPks = ids
 	o.teamMembersIsDirty = true
+	for pk := range o.teamMemberLinks {
+		if !slices.Contains(ids, pk) {
+			delete(o.teamMemberLinks, pk)
+		}
+	}
 }
 
 // LoadTeamMembers loads the Person objects associated through the TeamMember-Project relationship.
@@ -879,9 +893,22 @@ func (o *projectBase) LoadTeamMembers(ctx context.Context) ([]*Person, error) {
 			Where(op.In(node.Person().PrimaryKeys()[0], o.teamMembersPks...)).
 			Load()
 	} else {
-		objs, err = QueryPeople(ctx).
-			Where(op.Equal(node.Person().Projects().PrimaryKey(), o.PrimaryKey())).
-			Load()
+		// Load through the Project so that the association table can be used
+		var obj *Project
+		obj, err = QueryProjects(ctx).
+			Where(op.Equal(node.Project().PrimaryKey(), o.PrimaryKey())).
+			Select(node.Project().TeamMembers()).
+			Get()
+		if err != nil {
+			return nil, err
+		}
+		o.teamMembers.Clear()
+		o.teamMemberLinks = nil
+		if obj != nil {
+			o.teamMembers = obj.teamMembers
+			o.teamMemberLinks = obj.teamMemberLinks
+		}
+		return o.teamMembers.Values(), nil
 	}
 	if err != nil {
 		return nil, err
@@ -904,6 +931,238 @@ func (o *projectBase) CountTeamMembers(ctx context.Context) (int, error) {
 
 }
 
+// teamMembersAssnValue returns the values to write to the extra columns of the team_member_project_assn table
+// when associating the Person with primary key pk at position i.
+func (o *projectBase) teamMembersAssnValue(i int, pk string) map[string]any {
+	return o.teamMemberLinks[pk].values()
+}
+
+// teamMembersAssnValues returns the values of teamMembersAssnValue for each of pks, in the form needed by db.AssociateOnly.
+func (o *projectBase) teamMembersAssnValues(pks []string) []map[string]any {
+	values := make([]map[string]any, len(pks))
+	for i, pk := range pks {
+		values[i] = o.teamMembersAssnValue(i, pk)
+	}
+	return values
+}
+
+// ProjectTeamMemberLink is a link from a Project to a Person through the team_member_project_assn association table,
+// together with the values of the extra columns in that table.
+type ProjectTeamMemberLink struct {
+	teamMembers  *Person
+	role         string
+	roleIsNull   bool
+	hoursPerWeek int
+	isDirty      bool
+}
+
+// NewProjectTeamMemberLink returns a new link to obj with the extra columns set to their default values.
+// Pass the link to Project.SetTeamMemberLinks to associate obj.
+func NewProjectTeamMemberLink(obj *Person) *ProjectTeamMemberLink {
+	return &ProjectTeamMemberLink{
+		teamMembers:  obj,
+		role:         "",
+		roleIsNull:   true,
+		hoursPerWeek: 40,
+	}
+}
+
+// TeamMember returns the linked Person object.
+func (l *ProjectTeamMemberLink) TeamMember() *Person {
+	return l.teamMembers
+}
+
+// Role returns the value of the role column of the link.
+func (l *ProjectTeamMemberLink) Role() string {
+	return l.role
+}
+
+// RoleIsNull returns true if the role column of the link is null.
+func (l *ProjectTeamMemberLink) RoleIsNull() bool {
+	return l.roleIsNull
+}
+
+// SetRoleToNull sets the role column of the link to null.
+func (l *ProjectTeamMemberLink) SetRoleToNull() {
+	if !l.roleIsNull {
+		l.roleIsNull = true
+		l.role = ""
+		l.isDirty = true
+	}
+}
+
+// SetRole sets the value of the role column of the link, to be saved
+// when the Project is saved.
+func (l *ProjectTeamMemberLink) SetRole(v string) {
+	if utf8.RuneCountInString(v) > 50 {
+		panic("attempted to set ProjectTeamMemberLink.Role to a value larger than its maximum length in runes")
+	}
+	if !l.roleIsNull && l.role == v {
+		return
+	}
+	l.role = v
+	l.roleIsNull = false
+	l.isDirty = true
+}
+
+// HoursPerWeek returns the value of the hours_per_week column of the link.
+func (l *ProjectTeamMemberLink) HoursPerWeek() int {
+	return l.hoursPerWeek
+}
+
+// SetHoursPerWeek sets the value of the hours_per_week column of the link, to be saved
+// when the Project is saved.
+func (l *ProjectTeamMemberLink) SetHoursPerWeek(v int) {
+	if l.hoursPerWeek == v {
+		return
+	}
+	l.hoursPerWeek = v
+	l.isDirty = true
+}
+
+// IsDirty returns true if a value of the link has changed since it was loaded or saved.
+func (l *ProjectTeamMemberLink) IsDirty() bool {
+	return l.isDirty
+}
+
+// values returns the values of the extra columns keyed by column name, as needed by db.Associate.
+// A nil link returns nil, so that the database defaults are used.
+func (l *ProjectTeamMemberLink) values() map[string]any {
+	if l == nil {
+		return nil
+	}
+	m := make(map[string]any)
+	if l.roleIsNull {
+		m["role"] = nil
+	} else {
+		m["role"] = l.role
+	}
+	m["hours_per_week"] = l.hoursPerWeek
+	return m
+}
+
+// unpack sets the values of the link from the data of the linked object returned by a query.
+// It returns false if the query did not select the values of the link.
+func (l *ProjectTeamMemberLink) unpack(m map[string]any) (found bool) {
+	if v, ok := m["_linkRole"]; ok {
+		found = true
+		if v == nil {
+			l.role = ""
+			l.roleIsNull = true
+		} else if v2, ok2 := v.(string); ok2 {
+			l.role = v2
+			l.roleIsNull = false
+		} else {
+			panic("Wrong type found for ProjectTeamMemberLink.role.")
+		}
+	}
+	if v, ok := m["_linkHoursPerWeek"]; ok {
+		found = true
+		if v2, ok2 := v.(int); ok2 {
+			l.hoursPerWeek = v2
+		} else {
+			panic("Wrong type found for ProjectTeamMemberLink.hoursPerWeek.")
+		}
+	}
+	return
+}
+
+// encode writes the values of the link to enc. The linked object is encoded separately.
+func (l *ProjectTeamMemberLink) encode(enc db.Encoder) error {
+	if err := enc.Encode(l.role); err != nil {
+		return fmt.Errorf("error encoding ProjectTeamMemberLink.role: %w", err)
+	}
+	if err := enc.Encode(l.roleIsNull); err != nil {
+		return fmt.Errorf("error encoding ProjectTeamMemberLink.roleIsNull: %w", err)
+	}
+	if err := enc.Encode(l.hoursPerWeek); err != nil {
+		return fmt.Errorf("error encoding ProjectTeamMemberLink.hoursPerWeek: %w", err)
+	}
+	if err := enc.Encode(l.isDirty); err != nil {
+		return fmt.Errorf("error encoding ProjectTeamMemberLink.isDirty: %w", err)
+	}
+	return nil
+}
+
+// decode reads the values of the link written by encode.
+func (l *ProjectTeamMemberLink) decode(dec db.Decoder) error {
+	if err := dec.Decode(&l.role); err != nil {
+		return fmt.Errorf("error decoding ProjectTeamMemberLink.role: %w", err)
+	}
+	if err := dec.Decode(&l.roleIsNull); err != nil {
+		return fmt.Errorf("error decoding ProjectTeamMemberLink.roleIsNull: %w", err)
+	}
+	if err := dec.Decode(&l.hoursPerWeek); err != nil {
+		return fmt.Errorf("error decoding ProjectTeamMemberLink.hoursPerWeek: %w", err)
+	}
+	if err := dec.Decode(&l.isDirty); err != nil {
+		return fmt.Errorf("error decoding ProjectTeamMemberLink.isDirty: %w", err)
+	}
+	return nil
+}
+
+// TeamMemberLinks returns the links to the loaded Person objects, in the same order as TeamMembers().
+// Changes to the values of the links will be written when Save() is called. If the TeamMembers are not loaded, it will return nil.
+func (o *projectBase) TeamMemberLinks() []*ProjectTeamMemberLink {
+	if o.teamMembers.Len() == 0 {
+		return nil
+	}
+	links := make([]*ProjectTeamMemberLink, 0, o.teamMembers.Len())
+	for obj := range o.teamMembers.ValuesIter() {
+		links = append(links, o.TeamMemberLink(obj.PrimaryKey()))
+	}
+	return links
+}
+
+// TeamMemberLink returns the link to the loaded Person object with primary key pk, or nil
+// if that object is not loaded.
+func (o *projectBase) TeamMemberLink(pk string) *ProjectTeamMemberLink {
+	obj := o.teamMembers.Get(pk)
+	if obj == nil {
+		return nil
+	}
+	l := o.teamMemberLinks[pk]
+	if l == nil {
+		l = NewProjectTeamMemberLink(obj)
+		if o.teamMemberLinks == nil {
+			o.teamMemberLinks = make(map[string]*ProjectTeamMemberLink)
+		}
+		o.teamMemberLinks[pk] = l
+	}
+	return l
+}
+
+// SetTeamMemberLinks associates the objects in the given links, along with the values of the links,
+// in preparation for saving. The associations will not be updated until Save() is called.
+func (o *projectBase) SetTeamMemberLinks(links ...*ProjectTeamMemberLink) {
+	o.teamMembers.Clear()
+	o.teamMembersIsDirty = true
+	o.teamMembersPks = nil
+	o.teamMemberLinks = make(map[string]*ProjectTeamMemberLink, len(links))
+	for _, l := range links {
+		o.teamMembers.Set(l.teamMembers.PrimaryKey(), l.teamMembers)
+		o.teamMemberLinks[l.teamMembers.PrimaryKey()] = l
+	}
+}
+
+// rekeyTeamMemberLinks moves the link at oldPk to newPk after the linked object gets a new primary key when saved.
+func (o *projectBase) rekeyTeamMemberLinks(oldPk, newPk string) {
+	if l, ok := o.teamMemberLinks[oldPk]; ok {
+		delete(o.teamMemberLinks, oldPk)
+		o.teamMemberLinks[newPk] = l
+	}
+}
+
+// teamMemberLinksAreDirty returns true if the values of any of the links have changed.
+func (o *projectBase) teamMemberLinksAreDirty() bool {
+	for _, l := range o.teamMemberLinks {
+		if l.isDirty {
+			return true
+		}
+	}
+	return false
+}
+
 // Child returns a single Project object by primary key, if one was loaded.
 // Otherwise, it will return nil. It will not return Project objects that are not saved.
 func (o *projectBase) Child(pk string) *Project {
@@ -1056,6 +1315,34 @@ func HasProject(ctx context.Context, pk string) (bool, error) {
 	return v > 0, err
 }
 
+// LoadProjectsByEndDate queries Project objects by the given index values.
+// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
+// See [ProjectsBuilder.Select].
+// If you need a more elaborate query, use QueryProjects() to start a query builder.
+func LoadProjectsByEndDate(ctx context.Context, endDate interface{}, selectNodes ...query.Node) ([]*Project, error) {
+	q := queryProjects(ctx)
+	if endDate == nil {
+		q = q.Where(op.IsNull(node.Project().EndDate()))
+	} else {
+		q = q.Where(op.Equal(node.Project().EndDate(), endDate))
+	}
+	return q.Select(selectNodes...).Load()
+}
+
+// HasProjectByEndDate returns true if the
+// given index values exist in the database.
+// doc: type=Project
+func HasProjectByEndDate(ctx context.Context, endDate interface{}) (bool, error) {
+	q := queryProjects(ctx)
+	if endDate == nil {
+		q = q.Where(op.IsNull(node.Project().EndDate()))
+	} else {
+		q = q.Where(op.Equal(node.Project().EndDate(), endDate))
+	}
+	v, err := q.Count()
+	return v > 0, err
+}
+
 // LoadProjectByNum queries for a single Project object by the given unique index values.
 // selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
 // See [ProjectsBuilder.Select].
@@ -1289,7 +1576,8 @@ func (b *ProjectBuilder) OrderBy(nodes ...query.Sorter) *ProjectBuilder {
 // Limit will return a subset of the data, limited to the offset and number of rows specified.
 // For large data sets and specific types of queries, this can be slow, because it will perform
 // the entire query before computing the limit.
-// You cannot limit a query that has embedded arrays.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the Project objects. You cannot otherwise limit a query that has embedded arrays.
 func (b *ProjectBuilder) Limit(maxRowCount int, offset int) *ProjectBuilder {
 	b.builder.Limit(maxRowCount, offset)
 	return b
@@ -1302,11 +1590,22 @@ func (b *ProjectBuilder) Limit(maxRowCount int, offset int) *ProjectBuilder {
 // If columns in related tables are specified, then only those columns will be queried and loaded.
 // Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
 // will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
 func (b *ProjectBuilder) Select(nodes ...query.Node) *ProjectBuilder {
 	b.builder.Select(nodes...)
 	return b
 }
 
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded Project objects at once, rather than joining them to the query.
+// This avoids repeating the data of each Project object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *ProjectBuilder) Preload(nodes ...query.Node) *ProjectBuilder {
+	b.builder.Preload(nodes...)
+	return b
+}
+
 // Calculation adds operation as an aliased value onto base.
 // After the query, you can read the data by passing alias to GetAlias on the returned object.
 func (b *ProjectBuilder) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *ProjectBuilder {
@@ -1333,6 +1632,35 @@ func (b *ProjectBuilder) Having(node query.Node) *ProjectBuilder {
 	return b
 }
 
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *ProjectBuilder) ForUpdate() *ProjectBuilder {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *ProjectBuilder) ForShare() *ProjectBuilder {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *ProjectBuilder) SkipLocked() *ProjectBuilder {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *ProjectBuilder) NoWait() *ProjectBuilder {
+	b.builder.NoWait()
+	return b
+}
+
 // Count terminates a query and returns just the number of items in the result.
 // If you have Select or Calculation columns in the query, it will count NULL results as well.
 // To not count NULL values, use Where in the builder with a NotNull operation.
@@ -1354,6 +1682,16 @@ func CountProjects(ctx context.Context) (int, error) {
 	return QueryProjects(ctx).Count()
 }
 
+// CountProjectsByEndDate queries the database and returns the number of Project objects that
+// have endDate.
+// doc: type=Project
+func CountProjectsByEndDate(ctx context.Context, endDate time.Time) (int, error) {
+	v_endDate := endDate
+	return QueryProjects(ctx).
+		Where(op.Equal(node.Project().EndDate(), v_endDate)).
+		Count()
+}
+
 // CountProjectsByNum queries the database and returns the number of Project objects that
 // have num.
 // doc: type=Project
@@ -1628,11 +1966,18 @@ func (o *projectBase) unpack(m map[string]interface{}, objThis *Project) {
 	if v, ok := m["teamMembers"]; ok {
 		if v2, ok2 := v.([]map[string]any); ok2 {
 			o.teamMembers.Clear()
+			o.teamMemberLinks = nil
 
 			for _, v3 := range v2 {
 				obj := new(Person)
 				obj.unpack(v3, obj)
 				o.teamMembers.Set(obj.PrimaryKey(), obj)
+				if l := NewProjectTeamMemberLink(obj); l.unpack(v3) {
+					if o.teamMemberLinks == nil {
+						o.teamMemberLinks = make(map[string]*ProjectTeamMemberLink)
+					}
+					o.teamMemberLinks[obj.PrimaryKey()] = l
+				}
 			}
 			o.teamMembersPks = nil
 		} else {
@@ -1641,6 +1986,7 @@ func (o *projectBase) unpack(m map[string]interface{}, objThis *Project) {
 	} else {
 		o.teamMembers.Clear()
 		o.teamMembersPks = nil
+		o.teamMemberLinks = nil
 	}
 
 	// Reverse references
@@ -1847,29 +2193,23 @@ func (o *projectBase) update(ctx context.Context) error {
 					// update key in the slice map without changing the order
 					o.teamMembers.Delete(k)
 					o.teamMembers.SetAt(i, obj.PrimaryKey(), obj)
+					o.rekeyTeamMemberLinks(k, obj.PrimaryKey())
 				}
 			}
-			if o.teamMembersIsDirty {
-				if len(o.teamMembersPks) != 0 {
-					if err := db.AssociateOnly(ctx,
-						d,
-						"team_member_project_assn",
-						"project_id",
-						o.PrimaryKey(),
-						"team_member_id",
-						o.teamMembersPks); err != nil {
-						return err
-					}
-				} else {
-					if err := db.AssociateOnly(ctx,
-						d,
-						"team_member_project_assn",
-						"project_id",
-						o.PrimaryKey(),
-						"team_member_id",
-						o.teamMembers.Keys()); err != nil {
-						return err
-					}
+			if o.teamMembersIsDirty || o.teamMemberLinksAreDirty() {
+				pks := o.teamMembersPks
+				if len(pks) == 0 {
+					pks = o.teamMembers.Keys()
+				}
+				if err := db.AssociateOnly(ctx,
+					d,
+					"team_member_project_assn",
+					"project_id",
+					o.PrimaryKey(),
+					"team_member_id",
+					pks,
+					o.teamMembersAssnValues(pks)...); err != nil {
+					return err
 				}
 			}
 		}
@@ -1971,6 +2311,7 @@ func (o *projectBase) insert(ctx context.Context) (err error) {
 				if k != obj.PrimaryKey() {
 					o.teamMembers.Delete(k)
 					o.teamMembers.SetAt(i, obj.PrimaryKey(), obj)
+					o.rekeyTeamMemberLinks(k, obj.PrimaryKey())
 				}
 				db.Associate(ctx,
 					d,
@@ -1979,10 +2320,11 @@ func (o *projectBase) insert(ctx context.Context) (err error) {
 					o.PrimaryKey(),
 					"team_member_id",
 					obj.PrimaryKey(),
+					o.teamMembersAssnValue(i, obj.PrimaryKey()),
 				)
 			}
 		} else if len(o.teamMembersPks) > 0 {
-			for _, k := range o.teamMembersPks {
+			for i, k := range o.teamMembersPks {
 				obj, err2 := LoadPerson(ctx, k)
 				if err2 != nil {
 					return err2
@@ -1995,6 +2337,7 @@ func (o *projectBase) insert(ctx context.Context) (err error) {
 						o.PrimaryKey(),
 						"team_member_id",
 						k,
+						o.teamMembersAssnValue(i, k),
 					)
 				}
 			}
@@ -2138,8 +2481,8 @@ func (o *projectBase) getInsertFields() (fields map[string]interface{}) {
 
 // Delete deletes the record from the database.
 //
-// Associated Child will have their Parent field set to NULL.
-// Associated Milestone will also be deleted since their Project fields are not nullable.
+// Associated Children will have their Parent field set to NULL.
+// Associated Milestones will also be deleted.
 func (o *projectBase) Delete(ctx context.Context) (err error) {
 	if o == nil {
 		return // allow deleting of a nil object to be a noop
@@ -2255,6 +2598,9 @@ func (o *projectBase) resetDirtyStatus() {
 	o.milestonesIsDirty = false
 	o.teamMembersIsDirty = false
 	o.teamMembersPks = nil
+	for _, l := range o.teamMemberLinks {
+		l.isDirty = false
+	}
 
 }
 
@@ -2293,6 +2639,7 @@ func (o *projectBase) IsDirty() (dirty bool) {
 	for obj := range o.teamMembers.ValuesIter() {
 		dirty = dirty || obj.IsDirty()
 	}
+	dirty = dirty || o.teamMemberLinksAreDirty()
 
 	return
 }
@@ -2573,6 +2920,17 @@ func (o *projectBase) encodeTo(enc db.Encoder) error {
 			return fmt.Errorf("error encoding Project.teamMembersPks: %w", err)
 		}
 	}
+	if err := enc.Encode(len(o.teamMemberLinks)); err != nil {
+		return fmt.Errorf("error encoding Project.teamMemberLinks length: %w", err)
+	}
+	for k, l := range o.teamMemberLinks {
+		if err := enc.Encode(k); err != nil {
+			return fmt.Errorf("error encoding Project.teamMemberLinks key: %w", err)
+		}
+		if err := l.encode(enc); err != nil {
+			return err
+		}
+	}
 
 	if o._aliases == nil {
 		if err := enc.Encode(false); err != nil {
@@ -2785,6 +3143,27 @@ func (o *projectBase) decodeFrom(dec db.Decoder) (err error) {
 			return fmt.Errorf("error decoding Project.teamMembersPks: %w", err)
 		}
 	}
+	{
+		var n int
+		if err = dec.Decode(&n); err != nil {
+			return fmt.Errorf("error decoding Project.teamMemberLinks length: %w", err)
+		}
+		o.teamMemberLinks = nil
+		for range n {
+			var k string
+			if err = dec.Decode(&k); err != nil {
+				return fmt.Errorf("error decoding Project.teamMemberLinks key: %w", err)
+			}
+			l := &ProjectTeamMemberLink{teamMembers: o.teamMembers.Get(k)}
+			if err = l.decode(dec); err != nil {
+				return err
+			}
+			if o.teamMemberLinks == nil {
+				o.teamMemberLinks = make(map[string]*ProjectTeamMemberLink)
+			}
+			o.teamMemberLinks[k] = l
+		}
+	}
 	if err = dec.Decode(&isPtr); err != nil {
 		return fmt.Errorf("error decoding Project._aliases isPtr: %w", err)
 	}
@@ -762,6 +762,12 @@ func TestProject_Count(t *testing.T) {
 
 	// reread in case there are data limitations imposed by the database
 	obj2, _ := LoadProject(ctx, obj.PrimaryKey())
+	assert.Positive(t,
+		func() int {
+			i, _ := CountProjectsByEndDate(ctx,
+				obj2.EndDate())
+			return i
+		}())
 	assert.Positive(t,
 		func() int {
 			i, _ := CountProjectsByNum(ctx,
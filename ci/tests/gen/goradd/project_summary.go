@@ -0,0 +1,81 @@
+package goradd
+
+// This is the implementation file for the ProjectSummary ORM object.
+// This is where you build the api to your data model for your web application and potentially mobile apps.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+)
+
+// ProjectSummary represents an item in the project_summary table in the database.
+type ProjectSummary struct {
+	projectSummaryBase
+}
+
+// NewProjectSummary creates a new ProjectSummary object and initializes it to default values.
+func NewProjectSummary() *ProjectSummary {
+	o := new(ProjectSummary)
+	o.Initialize()
+	return o
+}
+
+// Initialize will initialize or re-initialize a ProjectSummary database object to default values.
+func (o *ProjectSummary) Initialize() {
+	o.projectSummaryBase.Initialize()
+	// Add your own initializations here
+}
+
+// String implements the Stringer interface and returns a description of the record, primarily for debugging.
+func (o *ProjectSummary) String() string {
+	if o == nil {
+		return ""
+	}
+	var pk string
+
+	pk += fmt.Sprintf(" %v", o.ID())
+
+	return "ProjectSummary" + pk
+}
+
+// Key returns a unique key for the object, among a list of similar objects.
+func (o *ProjectSummary) Key() string {
+	if o == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", o.PrimaryKey())
+}
+
+// Label returns a human-readable label of the object.
+// This would be what a user would see as a description of the object if choosing from a list.
+func (o *ProjectSummary) Label() string {
+	if o == nil {
+		return ""
+	}
+	return o.Name()
+}
+
+// QueryProjectSummaries returns a new query builder.
+// See ProjectSummaryBuilder for doc on how to use the builder.
+// You should pass a context that has a timeout with it to protect against a long delay from
+// the database possibly hanging your application. You can set a ReadTimeout value on the schema
+// to do this by default during code generation.
+func QueryProjectSummaries(ctx context.Context) *ProjectSummaryBuilder {
+	return queryProjectSummaries(ctx)
+}
+
+// queryProjectSummaries creates a new builder and is the central spot where all queries are directed.
+// You can modify this function to enforce restrictions on queries, for example to make sure the user is authorized to
+// access the data.
+func queryProjectSummaries(ctx context.Context) *ProjectSummaryBuilder {
+	// Note: the context is provided here so that you can use it to enforce credentials if needed.
+	// It is stored in the builder and later used in the terminating functions, like Load(), Get(), etc.
+	// A QueryBuilder is meant to be a short-lived structure.
+	return newProjectSummaryBuilder(ctx)
+}
+
+func init() {
+	gob.RegisterName("goraddProjectSummary", new(ProjectSummary))
+}
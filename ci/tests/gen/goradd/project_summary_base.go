@@ -0,0 +1,814 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd/node"
+	"github.com/goradd/gro/db"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+)
+
+// ProjectSummaryBase is embedded in a ProjectSummary object and provides the ORM access to the database.
+// The member variables of the structure are private and should not normally be accessed by the ProjectSummary embedder.
+// Instead, use the accessor functions.
+type projectSummaryBase struct {
+	id                  string
+	idIsLoaded          bool
+	name                string
+	nameIsLoaded        bool
+	memberCount         int64
+	memberCountIsLoaded bool
+	managerID           string
+	managerIDIsNull     bool
+	managerIDIsLoaded   bool
+
+	// References
+	manager *Person
+
+	// Custom aliases, if specified
+	_aliases map[string]any
+
+	// Indicates whether this is a new object, or one loaded from the database. Used by Save to know whether to Insert or Update.
+	_restored bool
+
+	_originalPK string
+}
+
+// IDs used to access the ProjectSummary object fields by name using the Get function.
+// doc: type=ProjectSummary
+const (
+	ProjectSummaryIDField          = `id`
+	ProjectSummaryNameField        = `name`
+	ProjectSummaryMemberCountField = `memberCount`
+	ProjectSummaryManagerIDField   = `managerID`
+	ProjectSummaryManagerField     = `manager`
+)
+
+const ProjectSummaryIDMaxLength = 5        // The number of runes the column can hold
+const ProjectSummaryNameMaxLength = 100    // The number of runes the column can hold
+const ProjectSummaryManagerIDMaxLength = 5 // The number of runes the column can hold
+
+// Initialize or re-initialize a ProjectSummary database object to default values.
+func (o *projectSummaryBase) Initialize() {
+	o.id = ""
+	o.idIsLoaded = false
+
+	o.name = ""
+	o.nameIsLoaded = false
+
+	o.memberCount = 0
+	o.memberCountIsLoaded = false
+
+	o.managerID = ""
+	o.managerIDIsNull = true
+	o.managerIDIsLoaded = false
+
+	o._aliases = nil
+	o._restored = false
+}
+
+// OriginalPrimaryKey returns the value of the primary key that was originally loaded into the object when it was
+// read from the database.
+func (o *projectSummaryBase) OriginalPrimaryKey() string {
+	return o._originalPK
+}
+
+// PrimaryKey returns the value of the primary key of the record.
+func (o *projectSummaryBase) PrimaryKey() string {
+	if o._restored && !o.idIsLoaded {
+		panic("ID was not selected in the last query and has not been set, and so PrimaryKey is not valid")
+	}
+	return o.id
+}
+
+// ID returns the loaded value of the id field in the database.
+func (o *projectSummaryBase) ID() string {
+	if o._restored && !o.idIsLoaded {
+		panic("ID was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.id
+}
+
+// IDIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *projectSummaryBase) IDIsLoaded() bool {
+	return o.idIsLoaded
+}
+
+// Name returns the value of the loaded name field in the database.
+func (o *projectSummaryBase) Name() string {
+	if o._restored && !o.nameIsLoaded {
+		panic("Name was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.name
+}
+
+// NameIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *projectSummaryBase) NameIsLoaded() bool {
+	return o.nameIsLoaded
+}
+
+// MemberCount returns the value of the loaded member_count field in the database.
+func (o *projectSummaryBase) MemberCount() int64 {
+	if o._restored && !o.memberCountIsLoaded {
+		panic("MemberCount was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.memberCount
+}
+
+// MemberCountIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *projectSummaryBase) MemberCountIsLoaded() bool {
+	return o.memberCountIsLoaded
+}
+
+// ManagerID returns the value of the loaded manager_id field in the database.
+func (o *projectSummaryBase) ManagerID() string {
+	if o._restored && !o.managerIDIsLoaded {
+		panic("ManagerID was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.managerID
+}
+
+// ManagerIDIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *projectSummaryBase) ManagerIDIsLoaded() bool {
+	return o.managerIDIsLoaded
+}
+
+// ManagerIDIsNull returns true if the related database value is null.
+func (o *projectSummaryBase) ManagerIDIsNull() bool {
+	return o.managerIDIsNull
+}
+
+// Manager returns the current value of the loaded Manager, and nil if its not loaded.
+func (o *projectSummaryBase) Manager() *Person {
+	return o.manager
+}
+
+// LoadManager returns the related Manager. If it is not already loaded,
+// it will attempt to load it, provided the ManagerID column has been loaded first.
+func (o *projectSummaryBase) LoadManager(ctx context.Context) (*Person, error) {
+	var err error
+
+	if o.manager == nil {
+		if !o.managerIDIsLoaded {
+			panic("ManagerID must be selected in the previous query")
+		}
+		// Load and cache
+		o.manager, err = LoadPerson(ctx, o.managerID)
+	}
+	return o.manager, err
+}
+
+// GetAlias returns the value for the Alias node aliasKey that was returned in the most
+// recent query.
+func (o *projectSummaryBase) GetAlias(aliasKey string) query.AliasValue {
+	if a, ok := o._aliases[aliasKey]; ok {
+		return query.NewAliasValue(a)
+	} else {
+		panic("Alias " + aliasKey + " not found.")
+	}
+}
+
+// IsNew returns true if the object will create a new record when saved.
+func (o *projectSummaryBase) IsNew() bool {
+	return !o._restored
+}
+
+// LoadProjectSummary returns a ProjectSummary from the database.
+// selectNodes lets you provide nodes for selecting specific fields or additional fields from related tables.
+// See [ProjectSummariesBuilder.Select] for more info.
+func LoadProjectSummary(ctx context.Context, pk string, selectNodes ...query.Node) (*ProjectSummary, error) {
+	return queryProjectSummaries(ctx).
+		Where(op.Equal(node.ProjectSummary().ID(), pk)).
+		Select(selectNodes...).
+		Get()
+}
+
+// HasProjectSummary returns true if a ProjectSummary with the given primary key exists in the database.
+// doc: type=ProjectSummary
+func HasProjectSummary(ctx context.Context, pk string) (bool, error) {
+	v, err := queryProjectSummaries(ctx).
+		Where(op.Equal(node.ProjectSummary().ID(), pk)).
+		Count()
+	return v > 0, err
+}
+
+// LoadProjectSummariesByManagerID queries ProjectSummary objects by the given index values.
+// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
+// See [ProjectSummariesBuilder.Select].
+// If you need a more elaborate query, use QueryProjectSummaries() to start a query builder.
+func LoadProjectSummariesByManagerID(ctx context.Context, managerID interface{}, selectNodes ...query.Node) ([]*ProjectSummary, error) {
+	q := queryProjectSummaries(ctx)
+	if managerID == nil {
+		q = q.Where(op.IsNull(node.ProjectSummary().ManagerID()))
+	} else {
+		q = q.Where(op.Equal(node.ProjectSummary().ManagerID(), managerID))
+	}
+	return q.Select(selectNodes...).Load()
+}
+
+// HasProjectSummaryByManagerID returns true if the
+// given index values exist in the database.
+// doc: type=ProjectSummary
+func HasProjectSummaryByManagerID(ctx context.Context, managerID interface{}) (bool, error) {
+	q := queryProjectSummaries(ctx)
+	if managerID == nil {
+		q = q.Where(op.IsNull(node.ProjectSummary().ManagerID()))
+	} else {
+		q = q.Where(op.Equal(node.ProjectSummary().ManagerID(), managerID))
+	}
+	v, err := q.Count()
+	return v > 0, err
+}
+
+// The ProjectSummaryBuilder uses a builder pattern to create a query on the database.
+// Create a ProjectSummaryBuilder by calling QueryProjectSummaries, which will select all
+// the ProjectSummary object in the database. Then filter and arrange those objects
+// by calling Where, Select, etc.
+// End a query by calling either Load, LoadI, LoadCursor, Get, or Count.
+// A ProjectSummaryBuilder stores the context it will use to perform the query, and thus is
+// meant to be a short-lived object. You should not save it for later use.
+type ProjectSummaryBuilder struct {
+	builder *query.Builder
+	ctx     context.Context
+}
+
+func newProjectSummaryBuilder(ctx context.Context) *ProjectSummaryBuilder {
+	b := ProjectSummaryBuilder{
+		builder: query.NewBuilder(node.ProjectSummary()),
+		ctx:     ctx,
+	}
+	return &b
+}
+
+// Load terminates the query builder, performs the query, and returns a slice of ProjectSummary objects.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *ProjectSummaryBuilder) Load() (projectSummaries []*ProjectSummary, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+	database := db.GetDatabase("goradd")
+	var results any
+
+	ctx := b.ctx
+	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _, item := range results.([]map[string]any) {
+		o := new(ProjectSummary)
+		o.unpack(item, o)
+		projectSummaries = append(projectSummaries, o)
+	}
+	return
+}
+
+// LoadI terminates the query builder, performs the query, and returns a slice of interfaces.
+// This can then satisfy a variety of interfaces that load arrays of objects, including KeyLabeler.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *ProjectSummaryBuilder) LoadI() (projectSummaries []query.OrmObj, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+	database := db.GetDatabase("goradd")
+	var results any
+
+	ctx := b.ctx
+	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _, item := range results.([]map[string]any) {
+		o := new(ProjectSummary)
+		o.unpack(item, o)
+		projectSummaries = append(projectSummaries, o)
+	}
+	return
+}
+
+// LoadCursor terminates the query builder, performs the query, and returns a cursor to the query.
+//
+// A query cursor is useful for dealing with large amounts of query results. However, there are some
+// limitations to its use. When working with SQL databases, you cannot use a cursor while querying
+// many-to-many or reverse relationships that will create an array of values.
+//
+// Call Next() on the returned cursor object to step through the results. Make sure you call Close
+// on the cursor object when you are done. You should use
+//
+//	defer cursor.Close()
+//
+// to make sure the cursor gets closed.
+func (b *ProjectSummaryBuilder) LoadCursor() (projectSummariesCursor, error) {
+	b.builder.Command = query.BuilderCommandLoadCursor
+	database := db.GetDatabase("goradd")
+	result, err := database.BuilderQuery(b.ctx, b.builder)
+	var cursor query.CursorI
+	if result != nil {
+		cursor = result.(query.CursorI)
+	}
+	return projectSummariesCursor{cursor}, err
+}
+
+type projectSummariesCursor struct {
+	query.CursorI
+}
+
+// Next returns the current ProjectSummary object and moves the cursor to the next one.
+//
+// If there are no more records, it returns nil.
+func (c projectSummariesCursor) Next() (*ProjectSummary, error) {
+	if c.CursorI == nil {
+		return nil, nil
+	}
+
+	row, err := c.CursorI.Next()
+	if row == nil || err != nil {
+		return nil, err
+	}
+	o := new(ProjectSummary)
+	o.unpack(row, o)
+	return o, nil
+}
+
+// Get is a convenience method to return only the first item found in a query.
+// The entire query is performed, so you should generally use this only if you know
+// you are selecting on one or very few items.
+// If an error occurs, or no results are found, a nil is returned.
+func (b *ProjectSummaryBuilder) Get() (*ProjectSummary, error) {
+	results, err := b.Load()
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// Where adds a condition to filter what gets selected.
+// Calling Where multiple times will AND the conditions together.
+func (b *ProjectSummaryBuilder) Where(c query.Node) *ProjectSummaryBuilder {
+	b.builder.Where(c)
+	return b
+}
+
+// OrderBy specifies how the resulting data should be sorted.
+// By default, the given nodes are sorted in ascending order.
+// Add Descending() to the node to specify that it should be sorted in descending order.
+func (b *ProjectSummaryBuilder) OrderBy(nodes ...query.Sorter) *ProjectSummaryBuilder {
+	b.builder.OrderBy(nodes...)
+	return b
+}
+
+// Limit will return a subset of the data, limited to the offset and number of rows specified.
+// For large data sets and specific types of queries, this can be slow, because it will perform
+// the entire query before computing the limit.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the ProjectSummary objects. You cannot otherwise limit a query that has embedded arrays.
+func (b *ProjectSummaryBuilder) Limit(maxRowCount int, offset int) *ProjectSummaryBuilder {
+	b.builder.Limit(maxRowCount, offset)
+	return b
+}
+
+// Select specifies what specific columns will be loaded with data.
+// By default, all the columns of the project_summary table will be queried and loaded.
+// If nodes contains columns from the project_summary table, that will limit the columns queried and loaded to only those columns.
+// If related tables are specified, then all the columns from those tables are queried, selected and joined to the result.
+// If columns in related tables are specified, then only those columns will be queried and loaded.
+// Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
+// will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
+func (b *ProjectSummaryBuilder) Select(nodes ...query.Node) *ProjectSummaryBuilder {
+	b.builder.Select(nodes...)
+	return b
+}
+
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded ProjectSummary objects at once, rather than joining them to the query.
+// This avoids repeating the data of each ProjectSummary object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *ProjectSummaryBuilder) Preload(nodes ...query.Node) *ProjectSummaryBuilder {
+	b.builder.Preload(nodes...)
+	return b
+}
+
+// Calculation adds operation as an aliased value onto base.
+// After the query, you can read the data by passing alias to GetAlias on the returned object.
+func (b *ProjectSummaryBuilder) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *ProjectSummaryBuilder {
+	b.builder.Calculation(base, alias, operation)
+	return b
+}
+
+// Distinct removes duplicates from the results of the query.
+// Adding a Select() is usually required.
+func (b *ProjectSummaryBuilder) Distinct() *ProjectSummaryBuilder {
+	b.builder.Distinct()
+	return b
+}
+
+// GroupBy controls how results are grouped when using aggregate functions with Calculation.
+func (b *ProjectSummaryBuilder) GroupBy(nodes ...query.Node) *ProjectSummaryBuilder {
+	b.builder.GroupBy(nodes...)
+	return b
+}
+
+// Having does additional filtering on the results of the query after the query is performed.
+func (b *ProjectSummaryBuilder) Having(node query.Node) *ProjectSummaryBuilder {
+	b.builder.Having(node)
+	return b
+}
+
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *ProjectSummaryBuilder) ForUpdate() *ProjectSummaryBuilder {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *ProjectSummaryBuilder) ForShare() *ProjectSummaryBuilder {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *ProjectSummaryBuilder) SkipLocked() *ProjectSummaryBuilder {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *ProjectSummaryBuilder) NoWait() *ProjectSummaryBuilder {
+	b.builder.NoWait()
+	return b
+}
+
+// Count terminates a query and returns just the number of items in the result.
+// If you have Select or Calculation columns in the query, it will count NULL results as well.
+// To not count NULL values, use Where in the builder with a NotNull operation.
+// To count distinct combinations of items, call Distinct() on the builder.
+func (b *ProjectSummaryBuilder) Count() (int, error) {
+	b.builder.Command = query.BuilderCommandCount
+	database := db.GetDatabase("goradd")
+
+	ctx := b.ctx
+	results, err := database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return 0, err
+	}
+	return results.(int), nil
+}
+
+// CountProjectSummaries returns the total number of items in the project_summary table.
+func CountProjectSummaries(ctx context.Context) (int, error) {
+	return QueryProjectSummaries(ctx).Count()
+}
+
+// CountProjectSummariesByManagerID queries the database and returns the number of ProjectSummary objects that
+// have managerID.
+// doc: type=ProjectSummary
+func CountProjectSummariesByManagerID(ctx context.Context, managerID string) (int, error) {
+	v_managerID := managerID
+	return QueryProjectSummaries(ctx).
+		Where(op.Equal(node.ProjectSummary().ManagerID(), v_managerID)).
+		Count()
+}
+
+// unpack recursively transforms data coming from the database into ORM objects.
+func (o *projectSummaryBase) unpack(m map[string]interface{}, objThis *ProjectSummary) {
+
+	if v, ok := m["id"]; ok && v != nil {
+		if o.id, ok = v.(string); ok {
+			o.idIsLoaded = true
+			o._originalPK = o.id
+		} else {
+			panic("Wrong type found for id.")
+		}
+	} else {
+		o.idIsLoaded = false
+		o.id = ""
+	}
+
+	if v, ok := m["name"]; ok && v != nil {
+		if o.name, ok = v.(string); ok {
+			o.nameIsLoaded = true
+		} else {
+			panic("Wrong type found for name.")
+		}
+	} else {
+		o.nameIsLoaded = false
+		o.name = ""
+	}
+
+	if v, ok := m["memberCount"]; ok && v != nil {
+		if o.memberCount, ok = v.(int64); ok {
+			o.memberCountIsLoaded = true
+		} else {
+			panic("Wrong type found for memberCount.")
+		}
+	} else {
+		o.memberCountIsLoaded = false
+		o.memberCount = 0
+	}
+
+	if v, ok := m["managerID"]; ok {
+		if v == nil {
+			o.managerID = ""
+			o.managerIDIsNull = true
+			o.managerIDIsLoaded = true
+		} else if o.managerID, ok = v.(string); ok {
+			o.managerIDIsNull = false
+			o.managerIDIsLoaded = true
+		} else {
+			panic("Wrong type found for managerID.")
+		}
+	} else {
+		o.managerIDIsLoaded = false
+		o.managerIDIsNull = true
+		o.managerID = ""
+	}
+
+	if v, ok := m["manager"]; ok {
+		if manager, ok2 := v.(map[string]any); ok2 {
+			o.manager = new(Person)
+			o.manager.unpack(manager, o.manager)
+			// mirror foreign key with loaded object
+			o.managerID = o.manager.PrimaryKey()
+			o.managerIDIsNull = false
+			o.managerIDIsLoaded = true
+		} else {
+			panic("Wrong type found for Manager object.")
+		}
+	} else {
+		o.manager = nil
+	}
+
+	if v, ok := m["aliases_"]; ok {
+		o._aliases = v.(map[string]any)
+	}
+
+	o._restored = true
+
+}
+
+// Get returns the value of a field in the object based on the field's name.
+// It will also get related objects if they are loaded.
+// Invalid fields and objects are returned as nil.
+// Get can be used to retrieve a value by using the Field() of a node.
+func (o *projectSummaryBase) Get(key string) interface{} {
+	switch key {
+	case ProjectSummaryIDField:
+		if !o.idIsLoaded {
+			return nil
+		}
+		return o.id
+	case ProjectSummaryNameField:
+		if !o.nameIsLoaded {
+			return nil
+		}
+		return o.name
+	case ProjectSummaryMemberCountField:
+		if !o.memberCountIsLoaded {
+			return nil
+		}
+		return o.memberCount
+	case ProjectSummaryManagerIDField:
+		if !o.managerIDIsLoaded {
+			return nil
+		}
+		return o.managerID
+	case ProjectSummaryManagerField:
+		return o.Manager()
+	}
+	return nil
+}
+
+// MarshalBinary serializes the object into a buffer that is deserializable using UnmarshalBinary.
+// It should be used for transmitting database objects over the wire, or for temporary storage. It does not send
+// a version number, so if the data format changes, its up to you to invalidate the old stored objects.
+// The framework uses this to serialize the object when it is stored in a control.
+func (o *projectSummaryBase) MarshalBinary() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := gob.NewEncoder(buf)
+	if err := o.encodeTo(enc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (o *projectSummaryBase) encodeTo(enc db.Encoder) error {
+
+	if err := enc.Encode(o.id); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary.id: %w", err)
+	}
+	if err := enc.Encode(o.idIsLoaded); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary.idIsLoaded: %w", err)
+	}
+
+	if err := enc.Encode(o.name); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary.name: %w", err)
+	}
+	if err := enc.Encode(o.nameIsLoaded); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary.nameIsLoaded: %w", err)
+	}
+
+	if err := enc.Encode(o.memberCount); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary.memberCount: %w", err)
+	}
+	if err := enc.Encode(o.memberCountIsLoaded); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary.memberCountIsLoaded: %w", err)
+	}
+
+	if err := enc.Encode(o.managerID); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary.managerID: %w", err)
+	}
+	if err := enc.Encode(o.managerIDIsNull); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary.managerIDIsNull: %w", err)
+	}
+	if err := enc.Encode(o.managerIDIsLoaded); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary.managerIDIsLoaded: %w", err)
+	}
+
+	if o.manager == nil {
+		if err := enc.Encode(false); err != nil {
+			return err
+		}
+	} else {
+		if err := enc.Encode(true); err != nil {
+			return err
+		}
+		if err := enc.Encode(o.manager); err != nil {
+			return fmt.Errorf("error encoding ProjectSummary.manager: %w", err)
+		}
+	}
+
+	if o._aliases == nil {
+		if err := enc.Encode(false); err != nil {
+			return err
+		}
+	} else {
+		if err := enc.Encode(true); err != nil {
+			return err
+		}
+		if err := enc.Encode(o._aliases); err != nil {
+			return fmt.Errorf("error encoding ProjectSummary._aliases: %w", err)
+		}
+	}
+
+	if err := enc.Encode(o._restored); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary._restored: %w", err)
+	}
+	if err := enc.Encode(o._originalPK); err != nil {
+		return fmt.Errorf("error encoding ProjectSummary._originalPK: %w", err)
+	}
+	return nil
+}
+
+// UnmarshalBinary converts a structure that was created with MarshalBinary into a ProjectSummary object.
+func (o *projectSummaryBase) UnmarshalBinary(data []byte) (err error) {
+	buf := bytes.NewReader(data)
+	dec := gob.NewDecoder(buf)
+	return o.decodeFrom(dec)
+}
+
+func (o *projectSummaryBase) decodeFrom(dec db.Decoder) (err error) {
+	var isPtr bool
+
+	_ = isPtr
+	if err = dec.Decode(&o.id); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.id: %w", err)
+	}
+	if err = dec.Decode(&o.idIsLoaded); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.idIsLoaded: %w", err)
+	}
+
+	if err = dec.Decode(&o.name); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.name: %w", err)
+	}
+	if err = dec.Decode(&o.nameIsLoaded); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.nameIsLoaded: %w", err)
+	}
+
+	if err = dec.Decode(&o.memberCount); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.memberCount: %w", err)
+	}
+	if err = dec.Decode(&o.memberCountIsLoaded); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.memberCountIsLoaded: %w", err)
+	}
+
+	if err = dec.Decode(&o.managerID); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.managerID: %w", err)
+	}
+	if err = dec.Decode(&o.managerIDIsNull); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.managerIDIsNull: %w", err)
+	}
+	if err = dec.Decode(&o.managerIDIsLoaded); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.managerIDIsLoaded: %w", err)
+	}
+
+	if err = dec.Decode(&isPtr); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary.manager isPtr: %w", err)
+	}
+	if isPtr {
+		if err = dec.Decode(&o.manager); err != nil {
+			return fmt.Errorf("error decoding ProjectSummary.manager: %w", err)
+		}
+	}
+	if err = dec.Decode(&isPtr); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary._aliases isPtr: %w", err)
+	}
+	if isPtr {
+		if err = dec.Decode(&o._aliases); err != nil {
+			return fmt.Errorf("error decoding ProjectSummary._aliases: %w", err)
+		}
+	}
+
+	if err = dec.Decode(&o._restored); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary._restored: %w", err)
+	}
+	if err = dec.Decode(&o._originalPK); err != nil {
+		return fmt.Errorf("error decoding ProjectSummary._originalPK: %w", err)
+	}
+	return
+}
+
+// MarshalJSON serializes the object into a JSON object.
+// Only valid data will be serialized, meaning, you can control what gets serialized by using Select to
+// select only the fields you want when you query for the object. Another way to control the output
+// is to call MarshalStringMap, modify the map, then encode the map.
+func (o *projectSummaryBase) MarshalJSON() (data []byte, err error) {
+	v := o.MarshalStringMap()
+	return json.Marshal(v)
+}
+
+// MarshalStringMap serializes the object into a string map of interfaces.
+// Only valid data will be serialized, meaning, you can control what gets serialized by using Select to
+// select only the fields you want when you query for the object. The keys are the same as the json keys.
+func (o *projectSummaryBase) MarshalStringMap() map[string]interface{} {
+	v := make(map[string]interface{})
+
+	if o.idIsLoaded {
+		v["id"] = o.id
+	}
+
+	if o.nameIsLoaded {
+		v["name"] = o.name
+	}
+
+	if o.memberCountIsLoaded {
+		v["memberCount"] = o.memberCount
+	}
+
+	if o.managerIDIsLoaded {
+		if o.managerIDIsNull {
+			v["managerID"] = nil
+		} else {
+			v["managerID"] = o.managerID
+		}
+	}
+
+	if val := o.manager; val != nil {
+		v["manager"] = val.MarshalStringMap()
+	}
+	for _k, _v := range o._aliases {
+		v[_k] = _v
+	}
+	return v
+}
+
+// UnmarshalJSON unmarshalls the given json data into the ProjectSummary. The ProjectSummary can be a
+// newly created object, or one loaded from the database.
+//
+// After unmarshalling, the object is not  saved. You must call Save to insert it into the database
+// or update it.
+//
+// Unmarshalling of sub-objects, as in objects linked via foreign keys, is not currently supported.
+//
+// The fields it expects are:
+//
+//	"id" - string
+//	"name" - string
+//	"memberCount" - int64
+func (o *projectSummaryBase) UnmarshalJSON(data []byte) (err error) {
+	var v map[string]interface{}
+	if len(data) == 0 {
+		return
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber() // use a number to avoid precision errors
+	if err = d.Decode(&v); err != nil {
+		return err
+	}
+	return o.UnmarshalStringMap(v)
+}
+
+// UnmarshalStringMap returns an error, since ProjectSummary is a read-only view.
+func (o *projectSummaryBase) UnmarshalStringMap(m map[string]interface{}) (err error) {
+	return fmt.Errorf("ProjectSummary is a view and cannot be unmarshalled")
+}
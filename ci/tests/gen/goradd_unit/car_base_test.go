@@ -0,0 +1,387 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleCar creates an unsaved minimal version of a Car object
+// for testing.
+func createMinimalSampleCar() *Car {
+	obj := NewCar()
+	updateMinimalSampleCar(obj)
+	obj.Vehicle = createMinimalSampleVehicle()
+
+	return obj
+}
+
+// updateMinimalSampleCar sets the values of a minimal sample to new, random values.
+func updateMinimalSampleCar(obj *Car) {
+	updateMinimalSampleVehicle(obj.Vehicle)
+
+	obj.SetDoorCount(test.RandomValue[int](32))
+
+}
+
+// createMaximalSampleCar creates an unsaved version of a Car object
+// for testing that includes references to minimal objects.
+func createMaximalSampleCar(ctx context.Context) *Car {
+	obj := NewCar()
+	obj.Vehicle = createMinimalSampleVehicle()
+	updateMaximalSampleCar(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleCar sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleCar(ctx context.Context, obj *Car) {
+	updateMinimalSampleCar(obj)
+
+}
+
+// deleteSampleCar deletes an object created and saved by one of the sample creator functions.
+func deleteSampleCar(ctx context.Context, obj *Car) {
+	if obj == nil {
+		return
+	}
+
+	_ = obj.Delete(ctx)
+	deleteSampleVehicle(ctx, obj.Vehicle)
+}
+
+// assertEqualFieldsCar compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsCar(t *testing.T, obj1, obj2 *Car) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.DoorCountIsLoaded() && obj2.DoorCountIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.DoorCount(), obj2.DoorCount())
+	}
+
+}
+
+func TestCar_SetID(t *testing.T) {
+
+	obj := NewCar()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[query.AutoPrimaryKey](32)
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.AutoPrimaryKey{}
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestCar_SetDoorCount(t *testing.T) {
+
+	obj := NewCar()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[int](32)
+	obj.SetDoorCount(val)
+	assert.Equal(t, val, obj.DoorCount())
+
+	// test default
+	var d int = 0
+	obj.SetDoorCount(d)
+	assert.EqualValues(t, d, obj.DoorCount(), "set default")
+
+}
+
+func TestCar_Copy(t *testing.T) {
+	obj := createMinimalSampleCar()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.ID(), obj2.ID())
+	assert.Equal(t, obj.DoorCount(), obj2.DoorCount())
+
+}
+
+func TestCar_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleCar()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCar(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadCar(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.DoorCountIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.doorCountIsDirty)
+	obj2.SetDoorCount(obj2.DoorCount())
+	assert.False(t, obj2.doorCountIsDirty)
+
+}
+
+func TestCar_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleCar()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.doorCountIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.doorCountIsLoaded = true
+
+}
+
+func TestCar_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleCar()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCar(ctx, obj)
+	updateMinimalSampleCar(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadCar(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.DoorCount(), obj.DoorCount(), "DoorCount did not update")
+}
+
+func TestCar_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleCar(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCar(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+
+	// Test lazy loading
+	obj2, err := LoadCar(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadCar(ctx, obj.PrimaryKey(),
+		node.Car().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	// test eager loading
+	obj3, err3 := LoadCar(ctx, obj.PrimaryKey())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestCar_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleCar(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCar(ctx, obj)
+
+	obj2, err := LoadCar(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleCar(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleCar(ctx, obj2)
+
+	obj3, err2 := LoadCar(ctx, obj2.PrimaryKey())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestCar_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleCar(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCar(ctx, obj)
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadCar(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+}
+func TestCar_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewCar()
+
+	assert.Zero(t, obj.ID())
+}
+
+func TestCar_Getters(t *testing.T) {
+	obj := createMinimalSampleCar()
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleCar(ctx, obj)
+
+	has, _ := HasCar(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadCar(ctx, obj.PrimaryKey(),
+		node.Car().ID())
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+
+	assert.Equal(t, obj.ID(), obj.Get(CarIDField))
+	assert.Equal(t, obj.DoorCount(), obj.Get(CarDoorCountField))
+	assert.Panics(t, func() { obj2.DoorCount() })
+	assert.Nil(t, obj2.Get(CarDoorCountField))
+
+}
+
+func TestCar_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleCar()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCar(ctx, obj)
+
+	objs, err := QueryCars(ctx).
+		Where(op.Equal(node.Car().ID(), obj.ID())).
+		OrderBy(node.Car().ID()). // exercise order by
+		Limit(1, 0).              // exercise limit
+		Calculation(node.Car(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestCar_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleCar()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleCar(ctx, obj)
+
+	objs, _ := QueryCars(ctx).
+		Where(op.Equal(node.Car().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*Car).PrimaryKey())
+}
+func TestCar_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleCar()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCar(ctx, obj)
+
+	cursor, err := QueryCars(ctx).
+		Where(op.Equal(node.Car().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QueryCars(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestCar_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleCar(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleCar(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountCars(ctx); return i }())
+
+}
+
+func TestCar_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleCar()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewCar()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsCar(t, obj, obj2)
+}
+
+func TestCar_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleCar()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewCar()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsCar(t, obj, obj2)
+}
+
+func TestCar_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleCar()
+	var err error
+
+	for i := 0; i < 9; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 10; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestCar_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleCar()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewCar()
+	for i := 0; i < 9; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleCar()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewCar()
+	for i := 0; i < 10; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,51 @@
+package goradd_unit
+
+// This is the test file for the Car ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCar_String(t *testing.T) {
+	var obj *Car
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewCar()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "Car"))
+}
+
+func TestCar_Key(t *testing.T) {
+	var obj *Car
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewCar()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestCar_Label(t *testing.T) {
+	var obj *Car
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewCar()
+	s := obj.Label()
+	assert.True(t, strings.HasPrefix(s, "Car"))
+}
+
+func TestCar_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleCar()
+	assert.NoError(t, obj.Save(ctx))
+	assert.NoError(t, DeleteCar(ctx, obj.PrimaryKey()))
+	obj2, err := LoadCar(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,1094 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/goradd/anyutil"
+	"github.com/goradd/gro/broadcast"
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/db"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/maps"
+)
+
+// CategoryBase is embedded in a Category object and provides the ORM access to the database.
+// The member variables of the structure are private and should not normally be accessed by the Category embedder.
+// Instead, use the accessor functions.
+type categoryBase struct {
+	id           query.AutoPrimaryKey
+	idIsLoaded   bool
+	idIsDirty    bool
+	code         string
+	codeIsLoaded bool
+	codeIsDirty  bool
+	name         string
+	nameIsLoaded bool
+	nameIsDirty  bool
+
+	// Custom aliases, if specified
+	_aliases map[string]any
+
+	// Indicates whether this is a new object, or one loaded from the database. Used by Save to know whether to Insert or Update.
+	_restored bool
+
+	_originalPK query.AutoPrimaryKey
+}
+
+// IDs used to access the Category object fields by name using the Get function.
+// doc: type=Category
+const (
+	CategoryIDField   = `id`
+	CategoryCodeField = `code`
+	CategoryNameField = `name`
+)
+
+const CategoryCodeMaxLength = 20 // The number of runes the column can hold
+const CategoryNameMaxLength = 50 // The number of runes the column can hold
+
+// Initialize or re-initialize a Category database object to default values.
+// The primary key will get a temporary unique value which will be replaced when the object is saved.
+func (o *categoryBase) Initialize() {
+	o.id = query.TempAutoPrimaryKey()
+	o.idIsLoaded = true
+	o.idIsDirty = false
+
+	o.code = ""
+	o.codeIsLoaded = false
+	o.codeIsDirty = false
+
+	o.name = ""
+	o.nameIsLoaded = false
+	o.nameIsDirty = false
+
+	o._aliases = nil
+	o._restored = false
+}
+
+// Copy copies most fields to a new Category object.
+// Forward reference ids will be copied, but reverse and many-many references will not.
+// Attached objects will not be included in the copy.
+// Automatically generated fields will not be included in the copy.
+// The primary key field will not be copied, since it is normally auto-generated.
+// Call Save() on the new object to save it into the database.
+// Copy might panic if any fields in the database were set to a size larger than the
+// maximum size through a process that accessed the database outside of the ORM.
+func (o *categoryBase) Copy() (newObject *Category) {
+	newObject = NewCategory()
+	if o.idIsLoaded {
+		newObject.SetID(o.id)
+	}
+	if o.codeIsLoaded {
+		newObject.SetCode(o.code)
+	}
+	if o.nameIsLoaded {
+		newObject.SetName(o.name)
+	}
+	return
+}
+
+// OriginalPrimaryKey returns the value of the primary key that was originally loaded into the object when it was
+// read from the database.
+func (o *categoryBase) OriginalPrimaryKey() query.AutoPrimaryKey {
+	return o._originalPK
+}
+
+// PrimaryKey returns the value of the primary key of the record.
+func (o *categoryBase) PrimaryKey() query.AutoPrimaryKey {
+	if o._restored && !o.idIsLoaded {
+		panic("ID was not selected in the last query and has not been set, and so PrimaryKey is not valid")
+	}
+	return o.id
+}
+
+// SetPrimaryKey sets the value of the primary key in the object, to be saved later in the database using the Save() function.
+// Normally you will not need to call this function, since the primary key value is automatically generated by the
+// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
+// merging data.
+// You cannot change a primary key for a record that has been written to the database. While SQL databases will
+// allow it, NoSql databases will not. Save a copy and delete this one instead.
+func (o *categoryBase) SetPrimaryKey(v query.AutoPrimaryKey) {
+	o.SetID(v)
+}
+
+// ID returns the loaded value of the id field in the database.
+func (o *categoryBase) ID() query.AutoPrimaryKey {
+	if o._restored && !o.idIsLoaded {
+		panic("ID was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.id
+}
+
+// IDIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *categoryBase) IDIsLoaded() bool {
+	return o.idIsLoaded
+}
+
+// SetID sets the value of ID in the object, to be saved later in the database using the Save() function.
+// Normally you will not need to call this function, since the ID value is automatically generated by the
+// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
+// merging data.
+// You cannot change a primary key for a record that has been written to the database. While SQL databases will
+// allow it, NoSql databases will not. Save a copy and delete this one instead.
+func (o *categoryBase) SetID(v query.AutoPrimaryKey) {
+	if o._restored {
+		panic("error: Do not change a primary key for a record that has been saved. Instead, save a copy and delete the original.")
+	}
+	o.idIsLoaded = true
+	o.idIsDirty = true
+	o.id = v
+}
+
+// Code returns the value of the loaded code field in the database.
+func (o *categoryBase) Code() string {
+	if o._restored && !o.codeIsLoaded {
+		panic("Code was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.code
+}
+
+// CodeIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *categoryBase) CodeIsLoaded() bool {
+	return o.codeIsLoaded
+}
+
+// SetCode sets the value of Code in the object, to be saved later in the database using the Save() function.
+func (o *categoryBase) SetCode(v string) {
+	if utf8.RuneCountInString(v) > CategoryCodeMaxLength {
+		panic("attempted to set Category.Code to a value larger than its maximum length in runes")
+	}
+	if o._restored &&
+		o.codeIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		o.code == v {
+		// no change
+		return
+	}
+
+	o.codeIsLoaded = true
+	o.code = v
+	o.codeIsDirty = true
+}
+
+// Name returns the value of the loaded name field in the database.
+func (o *categoryBase) Name() string {
+	if o._restored && !o.nameIsLoaded {
+		panic("Name was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.name
+}
+
+// NameIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *categoryBase) NameIsLoaded() bool {
+	return o.nameIsLoaded
+}
+
+// SetName sets the value of Name in the object, to be saved later in the database using the Save() function.
+func (o *categoryBase) SetName(v string) {
+	if utf8.RuneCountInString(v) > CategoryNameMaxLength {
+		panic("attempted to set Category.Name to a value larger than its maximum length in runes")
+	}
+	if o._restored &&
+		o.nameIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		o.name == v {
+		// no change
+		return
+	}
+
+	o.nameIsLoaded = true
+	o.name = v
+	o.nameIsDirty = true
+}
+
+// GetAlias returns the value for the Alias node aliasKey that was returned in the most
+// recent query.
+func (o *categoryBase) GetAlias(aliasKey string) query.AliasValue {
+	if a, ok := o._aliases[aliasKey]; ok {
+		return query.NewAliasValue(a)
+	} else {
+		panic("Alias " + aliasKey + " not found.")
+	}
+}
+
+// IsNew returns true if the object will create a new record when saved.
+func (o *categoryBase) IsNew() bool {
+	return !o._restored
+}
+
+// LoadCategory returns a Category from the database.
+// selectNodes lets you provide nodes for selecting specific fields or additional fields from related tables.
+// See [CategoriesBuilder.Select] for more info.
+func LoadCategory(ctx context.Context, pk query.AutoPrimaryKey, selectNodes ...query.Node) (*Category, error) {
+	return queryCategories(ctx).
+		Where(op.Equal(node.Category().ID(), pk)).
+		Select(selectNodes...).
+		Get()
+}
+
+// HasCategory returns true if a Category with the given primary key exists in the database.
+// doc: type=Category
+func HasCategory(ctx context.Context, pk query.AutoPrimaryKey) (bool, error) {
+	v, err := queryCategories(ctx).
+		Where(op.Equal(node.Category().ID(), pk)).
+		Count()
+	return v > 0, err
+}
+
+// LoadCategoryByCode queries for a single Category object by the given unique index values.
+// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
+// See [CategoriesBuilder.Select].
+// If you need a more elaborate query, use QueryCategories() to start a query builder.
+func LoadCategoryByCode(ctx context.Context, code string, selectNodes ...query.Node) (*Category, error) {
+	q := queryCategories(ctx)
+	q = q.Where(op.Equal(node.Category().Code(), code))
+	return q.Select(selectNodes...).Get()
+}
+
+// HasCategoryByCode returns true if the
+// given unique index values exist in the database.
+// doc: type=Category
+func HasCategoryByCode(ctx context.Context, code string) (bool, error) {
+	q := queryCategories(ctx)
+	q = q.Where(op.Equal(node.Category().Code(), code))
+	v, err := q.Count()
+	return v > 0, err
+}
+
+// categoryRegistry holds the Category objects loaded by LoadCategoryRegistry.
+var categoryRegistry = struct {
+	sync.RWMutex
+	loaded bool
+	items  maps.SliceMap[query.AutoPrimaryKey, *Category]
+	byCode map[string]*Category
+}{}
+
+func init() {
+	broadcast.Listen("goradd_unit", "category", reloadCategoryRegistry)
+}
+
+// LoadCategoryRegistry loads all the Category records into the registry,
+// replacing what was there before. Call it when the application starts.
+//
+// Once loaded, the registry is reloaded whenever a change to the table is broadcast. This includes
+// changes made by other processes if the application's broadcaster reports them with broadcast.Notify.
+// The reload happens in the background after the change is committed. Call broadcast.Wait to wait for it.
+func LoadCategoryRegistry(ctx context.Context) error {
+	objs, err := QueryCategories(ctx).
+		OrderBy(node.Category().ID()).
+		Load()
+	if err != nil {
+		return err
+	}
+	var items maps.SliceMap[query.AutoPrimaryKey, *Category]
+	byCode := make(map[string]*Category, len(objs))
+	for _, o := range objs {
+		items.Set(o.PrimaryKey(), o)
+		byCode[o.Code()] = o
+	}
+
+	categoryRegistry.Lock()
+	defer categoryRegistry.Unlock()
+	categoryRegistry.loaded = true
+	categoryRegistry.items = items
+	categoryRegistry.byCode = byCode
+	return nil
+}
+
+// reloadCategoryRegistry reloads the registry if it was loaded.
+// It is called after a change to the table is broadcast and committed.
+func reloadCategoryRegistry(ctx context.Context, _ string, _ string) {
+	categoryRegistry.RLock()
+	loaded := categoryRegistry.loaded
+	categoryRegistry.RUnlock()
+	if !loaded {
+		return
+	}
+	if err := LoadCategoryRegistry(ctx); err != nil {
+		slog.Error("Error reloading the Category registry",
+			slog.Any("error", err))
+	}
+}
+
+// CategoryFromRegistry returns the Category with the given primary key from the registry,
+// or nil if it is not there.
+// The object is shared by all callers and should not be modified.
+func CategoryFromRegistry(pk query.AutoPrimaryKey) *Category {
+	categoryRegistry.RLock()
+	defer categoryRegistry.RUnlock()
+	return categoryRegistry.items.Get(pk)
+}
+
+// CategoryFromRegistryByCode returns the Category with the given
+// code from the registry, or nil if it is not there.
+// The object is shared by all callers and should not be modified.
+func CategoryFromRegistryByCode(code string) *Category {
+	categoryRegistry.RLock()
+	defer categoryRegistry.RUnlock()
+	return categoryRegistry.byCode[code]
+}
+
+// CategoriesFromRegistry returns all the Category objects in the registry,
+// in primary key order.
+// The objects are shared by all callers and should not be modified.
+func CategoriesFromRegistry() []*Category {
+	categoryRegistry.RLock()
+	defer categoryRegistry.RUnlock()
+	return categoryRegistry.items.Values()
+}
+
+// The CategoryBuilder uses a builder pattern to create a query on the database.
+// Create a CategoryBuilder by calling QueryCategories, which will select all
+// the Category object in the database. Then filter and arrange those objects
+// by calling Where, Select, etc.
+// End a query by calling either Load, LoadI, LoadCursor, Get, or Count.
+// A CategoryBuilder stores the context it will use to perform the query, and thus is
+// meant to be a short-lived object. You should not save it for later use.
+type CategoryBuilder struct {
+	builder *query.Builder
+	ctx     context.Context
+}
+
+func newCategoryBuilder(ctx context.Context) *CategoryBuilder {
+	b := CategoryBuilder{
+		builder: query.NewBuilder(node.Category()),
+		ctx:     ctx,
+	}
+	return &b
+}
+
+// Load terminates the query builder, performs the query, and returns a slice of Category objects.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *CategoryBuilder) Load() (categories []*Category, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+	database := db.GetDatabase("goradd_unit")
+	var results any
+
+	ctx := b.ctx
+	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _, item := range results.([]map[string]any) {
+		o := new(Category)
+		o.unpack(item, o)
+		categories = append(categories, o)
+	}
+	return
+}
+
+// LoadI terminates the query builder, performs the query, and returns a slice of interfaces.
+// This can then satisfy a variety of interfaces that load arrays of objects, including KeyLabeler.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *CategoryBuilder) LoadI() (categories []query.OrmObj, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+	database := db.GetDatabase("goradd_unit")
+	var results any
+
+	ctx := b.ctx
+	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _, item := range results.([]map[string]any) {
+		o := new(Category)
+		o.unpack(item, o)
+		categories = append(categories, o)
+	}
+	return
+}
+
+// LoadCursor terminates the query builder, performs the query, and returns a cursor to the query.
+//
+// A query cursor is useful for dealing with large amounts of query results. However, there are some
+// limitations to its use. When working with SQL databases, you cannot use a cursor while querying
+// many-to-many or reverse relationships that will create an array of values.
+//
+// Call Next() on the returned cursor object to step through the results. Make sure you call Close
+// on the cursor object when you are done. You should use
+//
+//	defer cursor.Close()
+//
+// to make sure the cursor gets closed.
+func (b *CategoryBuilder) LoadCursor() (categoriesCursor, error) {
+	b.builder.Command = query.BuilderCommandLoadCursor
+	database := db.GetDatabase("goradd_unit")
+	result, err := database.BuilderQuery(b.ctx, b.builder)
+	var cursor query.CursorI
+	if result != nil {
+		cursor = result.(query.CursorI)
+	}
+	return categoriesCursor{cursor}, err
+}
+
+type categoriesCursor struct {
+	query.CursorI
+}
+
+// Next returns the current Category object and moves the cursor to the next one.
+//
+// If there are no more records, it returns nil.
+func (c categoriesCursor) Next() (*Category, error) {
+	if c.CursorI == nil {
+		return nil, nil
+	}
+
+	row, err := c.CursorI.Next()
+	if row == nil || err != nil {
+		return nil, err
+	}
+	o := new(Category)
+	o.unpack(row, o)
+	return o, nil
+}
+
+// Get is a convenience method to return only the first item found in a query.
+// The entire query is performed, so you should generally use this only if you know
+// you are selecting on one or very few items.
+// If an error occurs, or no results are found, a nil is returned.
+func (b *CategoryBuilder) Get() (*Category, error) {
+	results, err := b.Load()
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// Where adds a condition to filter what gets selected.
+// Calling Where multiple times will AND the conditions together.
+func (b *CategoryBuilder) Where(c query.Node) *CategoryBuilder {
+	b.builder.Where(c)
+	return b
+}
+
+// OrderBy specifies how the resulting data should be sorted.
+// By default, the given nodes are sorted in ascending order.
+// Add Descending() to the node to specify that it should be sorted in descending order.
+func (b *CategoryBuilder) OrderBy(nodes ...query.Sorter) *CategoryBuilder {
+	b.builder.OrderBy(nodes...)
+	return b
+}
+
+// Limit will return a subset of the data, limited to the offset and number of rows specified.
+// For large data sets and specific types of queries, this can be slow, because it will perform
+// the entire query before computing the limit.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the Category objects. You cannot otherwise limit a query that has embedded arrays.
+func (b *CategoryBuilder) Limit(maxRowCount int, offset int) *CategoryBuilder {
+	b.builder.Limit(maxRowCount, offset)
+	return b
+}
+
+// Select specifies what specific columns will be loaded with data.
+// By default, all the columns of the category table will be queried and loaded.
+// If nodes contains columns from the category table, that will limit the columns queried and loaded to only those columns.
+// If related tables are specified, then all the columns from those tables are queried, selected and joined to the result.
+// If columns in related tables are specified, then only those columns will be queried and loaded.
+// Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
+// will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
+func (b *CategoryBuilder) Select(nodes ...query.Node) *CategoryBuilder {
+	b.builder.Select(nodes...)
+	return b
+}
+
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded Category objects at once, rather than joining them to the query.
+// This avoids repeating the data of each Category object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *CategoryBuilder) Preload(nodes ...query.Node) *CategoryBuilder {
+	b.builder.Preload(nodes...)
+	return b
+}
+
+// Calculation adds operation as an aliased value onto base.
+// After the query, you can read the data by passing alias to GetAlias on the returned object.
+func (b *CategoryBuilder) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *CategoryBuilder {
+	b.builder.Calculation(base, alias, operation)
+	return b
+}
+
+// Distinct removes duplicates from the results of the query.
+// Adding a Select() is usually required.
+func (b *CategoryBuilder) Distinct() *CategoryBuilder {
+	b.builder.Distinct()
+	return b
+}
+
+// GroupBy controls how results are grouped when using aggregate functions with Calculation.
+func (b *CategoryBuilder) GroupBy(nodes ...query.Node) *CategoryBuilder {
+	b.builder.GroupBy(nodes...)
+	return b
+}
+
+// Having does additional filtering on the results of the query after the query is performed.
+func (b *CategoryBuilder) Having(node query.Node) *CategoryBuilder {
+	b.builder.Having(node)
+	return b
+}
+
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *CategoryBuilder) ForUpdate() *CategoryBuilder {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *CategoryBuilder) ForShare() *CategoryBuilder {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *CategoryBuilder) SkipLocked() *CategoryBuilder {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *CategoryBuilder) NoWait() *CategoryBuilder {
+	b.builder.NoWait()
+	return b
+}
+
+// Count terminates a query and returns just the number of items in the result.
+// If you have Select or Calculation columns in the query, it will count NULL results as well.
+// To not count NULL values, use Where in the builder with a NotNull operation.
+// To count distinct combinations of items, call Distinct() on the builder.
+func (b *CategoryBuilder) Count() (int, error) {
+	b.builder.Command = query.BuilderCommandCount
+	database := db.GetDatabase("goradd_unit")
+
+	ctx := b.ctx
+	results, err := database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return 0, err
+	}
+	return results.(int), nil
+}
+
+// CountCategories returns the total number of items in the category table.
+func CountCategories(ctx context.Context) (int, error) {
+	return QueryCategories(ctx).Count()
+}
+
+// CountCategoriesByCode queries the database and returns the number of Category objects that
+// have code.
+// doc: type=Category
+func CountCategoriesByCode(ctx context.Context, code string) (int, error) {
+	v_code := code
+	return QueryCategories(ctx).
+		Where(op.Equal(node.Category().Code(), v_code)).
+		Count()
+}
+
+// unpack recursively transforms data coming from the database into ORM objects.
+func (o *categoryBase) unpack(m map[string]interface{}, objThis *Category) {
+
+	if v, ok := m["id"]; ok && v != nil {
+		if o.id, ok = v.(query.AutoPrimaryKey); ok {
+			o.idIsLoaded = true
+			o.idIsDirty = false
+			o._originalPK = o.id
+		} else {
+			panic("Wrong type found for id.")
+		}
+	} else {
+		o.idIsLoaded = false
+		o.id = query.TempAutoPrimaryKey()
+		o.idIsDirty = false
+	}
+
+	if v, ok := m["code"]; ok && v != nil {
+		if o.code, ok = v.(string); ok {
+			o.codeIsLoaded = true
+			o.codeIsDirty = false
+		} else {
+			panic("Wrong type found for code.")
+		}
+	} else {
+		o.codeIsLoaded = false
+		o.code = ""
+		o.codeIsDirty = false
+	}
+
+	if v, ok := m["name"]; ok && v != nil {
+		if o.name, ok = v.(string); ok {
+			o.nameIsLoaded = true
+			o.nameIsDirty = false
+		} else {
+			panic("Wrong type found for name.")
+		}
+	} else {
+		o.nameIsLoaded = false
+		o.name = ""
+		o.nameIsDirty = false
+	}
+
+	if v, ok := m["aliases_"]; ok {
+		o._aliases = v.(map[string]any)
+	}
+
+	o._restored = true
+
+}
+
+// save will update or insert the object, depending on the state of the object.
+func (o *categoryBase) save(ctx context.Context) error {
+	if o._restored {
+		return o.update(ctx)
+	} else {
+		return o.insert(ctx)
+	}
+}
+
+// update will update the values in the database, saving any changed values.
+// If the table has auto-generated values, those will be updated automatically.
+func (o *categoryBase) update(ctx context.Context) error {
+	if !o._restored {
+		panic("cannot update a record that was not originally read from the database.")
+	}
+	if !o.IsDirty() {
+		return nil // nothing to save
+	}
+
+	var modifiedFields map[string]interface{}
+
+	d := Database()
+	err := db.WithTransaction(ctx, d, func(ctx context.Context) error {
+
+		modifiedFields = getCategoryUpdateFields(o)
+		if len(modifiedFields) != 0 {
+			err2 := d.Update(ctx, "category",
+				map[string]any{
+					"id": o._originalPK,
+				},
+				modifiedFields,
+				"",
+				0,
+			)
+			if err2 != nil {
+				return err2
+			}
+		}
+
+		return nil
+	}) // transaction
+	if err != nil {
+		return err
+	}
+
+	o.resetDirtyStatus()
+	if len(modifiedFields) != 0 {
+		broadcast.Update(ctx, "goradd_unit", "category", o._originalPK, anyutil.SortedKeys(modifiedFields)...)
+	}
+
+	return nil
+}
+
+// insert will insert the object into the database. Related items will be saved.
+func (o *categoryBase) insert(ctx context.Context) (err error) {
+	var insertFields map[string]interface{}
+	d := Database()
+	err = db.WithTransaction(ctx, d, func(ctx context.Context) error {
+		if !o.codeIsLoaded {
+			panic("a value for Code is required, and there is no default value. Call SetCode() before inserting the record.")
+		}
+		if !o.nameIsLoaded {
+			panic("a value for Name is required, and there is no default value. Call SetName() before inserting the record.")
+		}
+		insertFields = getCategoryInsertFields(o)
+		err = d.Insert(ctx, "category", insertFields, "id")
+		if err != nil {
+			return err
+		}
+		o.id = insertFields["id"].(query.AutoPrimaryKey)
+		o._originalPK = o.id
+		o.idIsLoaded = true
+
+		return nil
+
+	}) // transaction
+
+	if err != nil {
+		return
+	}
+
+	o.resetDirtyStatus()
+	o._restored = true
+	broadcast.Insert(ctx, "goradd_unit", "category", o.PrimaryKey())
+	return
+}
+
+// getUpdateFields returns the database columns that will be sent to the update process.
+// This will include timestamp fields only if some other column has changed.
+func (o *categoryBase) getUpdateFields() (fields map[string]interface{}) {
+	fields = map[string]interface{}{}
+	if o.idIsDirty {
+		fields["id"] = o.id
+	}
+	if o.codeIsDirty {
+		fields["code"] = o.code
+	}
+	if o.nameIsDirty {
+		fields["name"] = o.name
+	}
+	return
+}
+
+// getInsertFields returns the fields that will be specified in an insert operation.
+// Optional fields that have not been set and have no default will be returned as nil.
+// NoSql databases should interpret this as no value. Sql databases should interpret this as
+// explicitly setting a NULL value, which would override any database specific default value.
+// Auto-generated fields will be returned here with their generated values, except AutoPK fields, which are returned
+// as a new AutoPrimaryKey to indicate that the driver will fill this in after the insert.
+func (o *categoryBase) getInsertFields() (fields map[string]interface{}) {
+	fields = map[string]interface{}{}
+	if o.idIsDirty {
+		fields["id"] = o.id
+	}
+
+	fields["code"] = o.code
+
+	fields["name"] = o.name
+	return
+}
+
+// Delete deletes the record from the database.
+func (o *categoryBase) Delete(ctx context.Context) (err error) {
+	if o == nil {
+		return // allow deleting of a nil object to be a noop
+	}
+	if !o._restored {
+		panic("Cannot delete a record that has no primary key value.")
+	}
+	d := Database()
+	err = d.Delete(ctx, "category",
+		map[string]any{
+			"id": o._originalPK,
+		},
+		"",
+		0,
+	)
+	if err != nil {
+		return err
+	}
+	broadcast.Delete(ctx, "goradd_unit", "category", o._originalPK)
+	return
+}
+
+// deleteCategory deletes the Category with primary key pk from the database
+// and handles associated records.
+func deleteCategory(ctx context.Context, pk query.AutoPrimaryKey) error {
+	d := db.GetDatabase("goradd_unit")
+	err := d.Delete(ctx, "category",
+		map[string]any{
+			"id": pk,
+		},
+		"", 0)
+
+	if err != nil {
+		return err
+	}
+	broadcast.Delete(ctx, "goradd_unit", "category", pk)
+	return err
+}
+
+// resetDirtyStatus resets the dirty status of every field in the object.
+func (o *categoryBase) resetDirtyStatus() {
+	o.idIsDirty = false
+	o.codeIsDirty = false
+	o.nameIsDirty = false
+
+}
+
+// IsDirty returns true if the object has been changed since it was read from the database or created.
+func (o *categoryBase) IsDirty() (dirty bool) {
+	dirty = o.idIsDirty ||
+		o.codeIsDirty ||
+		o.nameIsDirty
+
+	return
+}
+
+// Get returns the value of a field in the object based on the field's name.
+// It will also get related objects if they are loaded.
+// Invalid fields and objects are returned as nil.
+// Get can be used to retrieve a value by using the Field() of a node.
+func (o *categoryBase) Get(key string) interface{} {
+	switch key {
+	case CategoryIDField:
+		if !o.idIsLoaded {
+			return nil
+		}
+		return o.id
+	case CategoryCodeField:
+		if !o.codeIsLoaded {
+			return nil
+		}
+		return o.code
+	case CategoryNameField:
+		if !o.nameIsLoaded {
+			return nil
+		}
+		return o.name
+	}
+	return nil
+}
+
+// MarshalBinary serializes the object into a buffer that is deserializable using UnmarshalBinary.
+// It should be used for transmitting database objects over the wire, or for temporary storage. It does not send
+// a version number, so if the data format changes, its up to you to invalidate the old stored objects.
+// The framework uses this to serialize the object when it is stored in a control.
+func (o *categoryBase) MarshalBinary() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := gob.NewEncoder(buf)
+	if err := o.encodeTo(enc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (o *categoryBase) encodeTo(enc db.Encoder) error {
+
+	if err := enc.Encode(o.id); err != nil {
+		return fmt.Errorf("error encoding Category.id: %w", err)
+	}
+	if err := enc.Encode(o.idIsLoaded); err != nil {
+		return fmt.Errorf("error encoding Category.idIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.idIsDirty); err != nil {
+		return fmt.Errorf("error encoding Category.idIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.code); err != nil {
+		return fmt.Errorf("error encoding Category.code: %w", err)
+	}
+	if err := enc.Encode(o.codeIsLoaded); err != nil {
+		return fmt.Errorf("error encoding Category.codeIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.codeIsDirty); err != nil {
+		return fmt.Errorf("error encoding Category.codeIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.name); err != nil {
+		return fmt.Errorf("error encoding Category.name: %w", err)
+	}
+	if err := enc.Encode(o.nameIsLoaded); err != nil {
+		return fmt.Errorf("error encoding Category.nameIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.nameIsDirty); err != nil {
+		return fmt.Errorf("error encoding Category.nameIsDirty: %w", err)
+	}
+
+	if o._aliases == nil {
+		if err := enc.Encode(false); err != nil {
+			return err
+		}
+	} else {
+		if err := enc.Encode(true); err != nil {
+			return err
+		}
+		if err := enc.Encode(o._aliases); err != nil {
+			return fmt.Errorf("error encoding Category._aliases: %w", err)
+		}
+	}
+
+	if err := enc.Encode(o._restored); err != nil {
+		return fmt.Errorf("error encoding Category._restored: %w", err)
+	}
+	if err := enc.Encode(o._originalPK); err != nil {
+		return fmt.Errorf("error encoding Category._originalPK: %w", err)
+	}
+	return nil
+}
+
+// UnmarshalBinary converts a structure that was created with MarshalBinary into a Category object.
+func (o *categoryBase) UnmarshalBinary(data []byte) (err error) {
+	buf := bytes.NewReader(data)
+	dec := gob.NewDecoder(buf)
+	return o.decodeFrom(dec)
+}
+
+func (o *categoryBase) decodeFrom(dec db.Decoder) (err error) {
+	var isPtr bool
+
+	_ = isPtr
+	if err = dec.Decode(&o.id); err != nil {
+		return fmt.Errorf("error decoding Category.id: %w", err)
+	}
+	if err = dec.Decode(&o.idIsLoaded); err != nil {
+		return fmt.Errorf("error decoding Category.idIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.idIsDirty); err != nil {
+		return fmt.Errorf("error decoding Category.idIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.code); err != nil {
+		return fmt.Errorf("error decoding Category.code: %w", err)
+	}
+	if err = dec.Decode(&o.codeIsLoaded); err != nil {
+		return fmt.Errorf("error decoding Category.codeIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.codeIsDirty); err != nil {
+		return fmt.Errorf("error decoding Category.codeIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.name); err != nil {
+		return fmt.Errorf("error decoding Category.name: %w", err)
+	}
+	if err = dec.Decode(&o.nameIsLoaded); err != nil {
+		return fmt.Errorf("error decoding Category.nameIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.nameIsDirty); err != nil {
+		return fmt.Errorf("error decoding Category.nameIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&isPtr); err != nil {
+		return fmt.Errorf("error decoding Category._aliases isPtr: %w", err)
+	}
+	if isPtr {
+		if err = dec.Decode(&o._aliases); err != nil {
+			return fmt.Errorf("error decoding Category._aliases: %w", err)
+		}
+	}
+
+	if err = dec.Decode(&o._restored); err != nil {
+		return fmt.Errorf("error decoding Category._restored: %w", err)
+	}
+	if err = dec.Decode(&o._originalPK); err != nil {
+		return fmt.Errorf("error decoding Category._originalPK: %w", err)
+	}
+	return
+}
+
+// MarshalJSON serializes the object into a JSON object.
+// Only valid data will be serialized, meaning, you can control what gets serialized by using Select to
+// select only the fields you want when you query for the object. Another way to control the output
+// is to call MarshalStringMap, modify the map, then encode the map.
+func (o *categoryBase) MarshalJSON() (data []byte, err error) {
+	v := o.MarshalStringMap()
+	return json.Marshal(v)
+}
+
+// MarshalStringMap serializes the object into a string map of interfaces.
+// Only valid data will be serialized, meaning, you can control what gets serialized by using Select to
+// select only the fields you want when you query for the object. The keys are the same as the json keys.
+func (o *categoryBase) MarshalStringMap() map[string]interface{} {
+	v := make(map[string]interface{})
+
+	if o.idIsLoaded {
+		v["id"] = o.id
+	}
+
+	if o.codeIsLoaded {
+		v["code"] = o.code
+	}
+
+	if o.nameIsLoaded {
+		v["name"] = o.name
+	}
+
+	for _k, _v := range o._aliases {
+		v[_k] = _v
+	}
+	return v
+}
+
+// UnmarshalJSON unmarshalls the given json data into the Category. The Category can be a
+// newly created object, or one loaded from the database.
+//
+// After unmarshalling, the object is not  saved. You must call Save to insert it into the database
+// or update it.
+//
+// Unmarshalling of sub-objects, as in objects linked via foreign keys, is not currently supported.
+//
+// The fields it expects are:
+//
+//	"id" - query.AutoPrimaryKey
+//	"code" - string
+//	"name" - string
+func (o *categoryBase) UnmarshalJSON(data []byte) (err error) {
+	var v map[string]interface{}
+	if len(data) == 0 {
+		return
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber() // use a number to avoid precision errors
+	if err = d.Decode(&v); err != nil {
+		return err
+	}
+	return o.UnmarshalStringMap(v)
+}
+
+// UnmarshalStringMap will load the values from the stringmap into the object.
+//
+// Override this in Category to modify the json before sending it here.
+func (o *categoryBase) UnmarshalStringMap(m map[string]interface{}) (err error) {
+	for k, v := range m {
+		switch k {
+
+		case "id":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				if u, ok := Database().(db.AutoPrimaryKeyJsonUnmarshaller); ok {
+					u.AutoPrimaryKeyJsonUnmarshal(v)
+				} else {
+					switch n := v.(type) {
+					case json.Number:
+						n2, err := n.Int64()
+						if err != nil {
+							return err
+						}
+						o.SetID(query.NewAutoPrimaryKey(n2))
+					default:
+						o.SetID(query.NewAutoPrimaryKey(v))
+					}
+				}
+			}
+		case "code":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				if s, ok := v.(string); !ok {
+					return fmt.Errorf("json field %s must be a string", k)
+				} else {
+					o.SetCode(s)
+				}
+			}
+		case "name":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				if s, ok := v.(string); !ok {
+					return fmt.Errorf("json field %s must be a string", k)
+				} else {
+					o.SetName(s)
+				}
+			}
+		}
+	}
+	return
+}
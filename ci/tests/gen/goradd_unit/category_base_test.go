@@ -0,0 +1,451 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleCategory creates an unsaved minimal version of a Category object
+// for testing.
+func createMinimalSampleCategory() *Category {
+	obj := NewCategory()
+	updateMinimalSampleCategory(obj)
+
+	return obj
+}
+
+// updateMinimalSampleCategory sets the values of a minimal sample to new, random values.
+func updateMinimalSampleCategory(obj *Category) {
+
+	obj.SetCode(test.RandomValue[string](20))
+
+	obj.SetName(test.RandomValue[string](50))
+
+}
+
+// createMaximalSampleCategory creates an unsaved version of a Category object
+// for testing that includes references to minimal objects.
+func createMaximalSampleCategory(ctx context.Context) *Category {
+	obj := NewCategory()
+	updateMaximalSampleCategory(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleCategory sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleCategory(ctx context.Context, obj *Category) {
+	updateMinimalSampleCategory(obj)
+
+}
+
+// deleteSampleCategory deletes an object created and saved by one of the sample creator functions.
+func deleteSampleCategory(ctx context.Context, obj *Category) {
+	if obj == nil {
+		return
+	}
+
+	_ = obj.Delete(ctx)
+}
+
+// assertEqualFieldsCategory compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsCategory(t *testing.T, obj1, obj2 *Category) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.CodeIsLoaded() && obj2.CodeIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Code(), obj2.Code())
+	}
+	if obj1.NameIsLoaded() && obj2.NameIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Name(), obj2.Name())
+	}
+
+}
+
+func TestCategory_SetID(t *testing.T) {
+
+	obj := NewCategory()
+
+	assert.True(t, obj.IsNew())
+	val := query.NewAutoPrimaryKey(test.RandomNumberString())
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.TempAutoPrimaryKey()
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestCategory_SetCode(t *testing.T) {
+
+	obj := NewCategory()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](20)
+	obj.SetCode(val)
+	assert.Equal(t, val, obj.Code())
+
+	// test default
+	var d string = ""
+	obj.SetCode(d)
+	assert.EqualValues(t, d, obj.Code(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](21)
+	assert.Panics(t, func() {
+		obj.SetCode(val)
+	})
+}
+func TestCategory_SetName(t *testing.T) {
+
+	obj := NewCategory()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](50)
+	obj.SetName(val)
+	assert.Equal(t, val, obj.Name())
+
+	// test default
+	var d string = ""
+	obj.SetName(d)
+	assert.EqualValues(t, d, obj.Name(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](51)
+	assert.Panics(t, func() {
+		obj.SetName(val)
+	})
+}
+
+func TestCategory_Copy(t *testing.T) {
+	obj := createMinimalSampleCategory()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.Code(), obj2.Code())
+	assert.Equal(t, obj.Name(), obj2.Name())
+
+}
+
+func TestCategory_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleCategory()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCategory(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadCategory(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.CodeIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.codeIsDirty)
+	obj2.SetCode(obj2.Code())
+	assert.False(t, obj2.codeIsDirty)
+
+	assert.True(t, obj2.NameIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.nameIsDirty)
+	obj2.SetName(obj2.Name())
+	assert.False(t, obj2.nameIsDirty)
+
+}
+
+func TestCategory_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleCategory()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.codeIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.codeIsLoaded = true
+
+	obj.nameIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.nameIsLoaded = true
+
+}
+
+func TestCategory_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleCategory()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCategory(ctx, obj)
+	updateMinimalSampleCategory(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadCategory(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Code(), obj.Code(), "Code did not update")
+	assert.Equal(t, obj2.Name(), obj.Name(), "Name did not update")
+}
+
+func TestCategory_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleCategory(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCategory(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+
+	// Test lazy loading
+	obj2, err := LoadCategory(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadCategory(ctx, obj.PrimaryKey(),
+		node.Category().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	// test eager loading
+	obj3, err3 := LoadCategory(ctx, obj.PrimaryKey())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestCategory_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleCategory(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCategory(ctx, obj)
+
+	obj2, err := LoadCategory(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleCategory(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleCategory(ctx, obj2)
+
+	obj3, err2 := LoadCategory(ctx, obj2.PrimaryKey())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestCategory_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleCategory(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCategory(ctx, obj)
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadCategory(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+}
+func TestCategory_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewCategory()
+
+	assert.True(t, obj.ID().IsTemp())
+}
+
+func TestCategory_Getters(t *testing.T) {
+	obj := createMinimalSampleCategory()
+
+	assert.True(t, obj.ID().IsTemp())
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleCategory(ctx, obj)
+
+	has, _ := HasCategory(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadCategory(ctx, obj.PrimaryKey(),
+		node.Category().ID())
+
+	assert.Equal(t, obj.ID(), obj.Get(CategoryIDField))
+	assert.Equal(t, obj.Code(), obj.Get(CategoryCodeField))
+	assert.Panics(t, func() { obj2.Code() })
+	assert.Nil(t, obj2.Get(CategoryCodeField))
+	assert.Equal(t, obj.Name(), obj.Get(CategoryNameField))
+	assert.Panics(t, func() { obj2.Name() })
+	assert.Nil(t, obj2.Get(CategoryNameField))
+
+}
+
+func TestCategory_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleCategory()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCategory(ctx, obj)
+
+	objs, err := QueryCategories(ctx).
+		Where(op.Equal(node.Category().ID(), obj.ID())).
+		OrderBy(node.Category().ID()). // exercise order by
+		Limit(1, 0).                   // exercise limit
+		Calculation(node.Category(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestCategory_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleCategory()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleCategory(ctx, obj)
+
+	objs, _ := QueryCategories(ctx).
+		Where(op.Equal(node.Category().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*Category).PrimaryKey())
+}
+func TestCategory_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleCategory()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleCategory(ctx, obj)
+
+	cursor, err := QueryCategories(ctx).
+		Where(op.Equal(node.Category().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QueryCategories(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestCategory_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleCategory(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleCategory(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountCategories(ctx); return i }())
+
+	// reread in case there are data limitations imposed by the database
+	obj2, _ := LoadCategory(ctx, obj.PrimaryKey())
+	assert.Positive(t,
+		func() int {
+			i, _ := CountCategoriesByCode(ctx,
+				obj2.Code())
+			return i
+		}())
+
+}
+
+func TestCategory_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleCategory()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewCategory()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsCategory(t, obj, obj2)
+}
+
+func TestCategory_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleCategory()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewCategory()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsCategory(t, obj, obj2)
+}
+
+func TestCategory_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleCategory()
+	var err error
+
+	for i := 0; i < 12; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 13; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestCategory_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleCategory()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewCategory()
+	for i := 0; i < 12; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleCategory()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewCategory()
+	for i := 0; i < 13; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
+
+func TestCategory_Indexes(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleCategory(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleCategory(ctx, obj)
+
+	var obj2 *Category
+	obj2, _ = LoadCategoryByCode(ctx, obj.Code())
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	assert.True(t, func() bool { h, _ := HasCategoryByCode(ctx, obj.Code()); return h }())
+
+}
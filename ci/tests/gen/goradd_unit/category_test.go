@@ -0,0 +1,51 @@
+package goradd_unit
+
+// This is the test file for the Category ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategory_String(t *testing.T) {
+	var obj *Category
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewCategory()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "Category"))
+}
+
+func TestCategory_Key(t *testing.T) {
+	var obj *Category
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewCategory()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestCategory_Label(t *testing.T) {
+	var obj *Category
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewCategory()
+	s := obj.Label()
+	assert.Equal(t, "", s)
+}
+
+func TestCategory_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleCategory()
+	assert.NoError(t, obj.Save(ctx))
+	assert.NoError(t, DeleteCategory(ctx, obj.PrimaryKey()))
+	obj2, err := LoadCategory(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,406 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleChecklist creates an unsaved minimal version of a Checklist object
+// for testing.
+func createMinimalSampleChecklist() *Checklist {
+	obj := NewChecklist()
+	updateMinimalSampleChecklist(obj)
+
+	return obj
+}
+
+// updateMinimalSampleChecklist sets the values of a minimal sample to new, random values.
+func updateMinimalSampleChecklist(obj *Checklist) {
+
+	obj.SetName(test.RandomValue[string](100))
+
+}
+
+// createMaximalSampleChecklist creates an unsaved version of a Checklist object
+// for testing that includes references to minimal objects.
+func createMaximalSampleChecklist(ctx context.Context) *Checklist {
+	obj := NewChecklist()
+	updateMaximalSampleChecklist(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleChecklist sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleChecklist(ctx context.Context, obj *Checklist) {
+	updateMinimalSampleChecklist(obj)
+
+	obj.SetChecklistItems(createMinimalSampleChecklistItem())
+}
+
+// deleteSampleChecklist deletes an object created and saved by one of the sample creator functions.
+func deleteSampleChecklist(ctx context.Context, obj *Checklist) {
+	if obj == nil {
+		return
+	}
+
+	for _, item := range obj.ChecklistItems() {
+		deleteSampleChecklistItem(ctx, item)
+	}
+
+	_ = obj.Delete(ctx)
+}
+
+// assertEqualFieldsChecklist compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsChecklist(t *testing.T, obj1, obj2 *Checklist) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.NameIsLoaded() && obj2.NameIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Name(), obj2.Name())
+	}
+
+}
+
+func TestChecklist_SetID(t *testing.T) {
+
+	obj := NewChecklist()
+
+	assert.True(t, obj.IsNew())
+	val := query.NewAutoPrimaryKey(test.RandomNumberString())
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.TempAutoPrimaryKey()
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestChecklist_SetName(t *testing.T) {
+
+	obj := NewChecklist()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](100)
+	obj.SetName(val)
+	assert.Equal(t, val, obj.Name())
+
+	// test default
+	var d string = ""
+	obj.SetName(d)
+	assert.EqualValues(t, d, obj.Name(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](101)
+	assert.Panics(t, func() {
+		obj.SetName(val)
+	})
+}
+
+func TestChecklist_Copy(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.Name(), obj2.Name())
+
+}
+
+func TestChecklist_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklist(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadChecklist(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.NameIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.nameIsDirty)
+	obj2.SetName(obj2.Name())
+	assert.False(t, obj2.nameIsDirty)
+
+}
+
+func TestChecklist_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.nameIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.nameIsLoaded = true
+
+}
+
+func TestChecklist_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklist(ctx, obj)
+	updateMinimalSampleChecklist(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadChecklist(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Name(), obj.Name(), "Name did not update")
+}
+
+func TestChecklist_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleChecklist(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklist(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+
+	// Test lazy loading
+	obj2, err := LoadChecklist(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadChecklist(ctx, obj.PrimaryKey(),
+		node.Checklist().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	assert.Nil(t, obj2.ChecklistItems(), "ChecklistItem is not loaded initially")
+	v_ChecklistItems, _ := obj2.LoadChecklistItems(ctx)
+	assert.NotNil(t, v_ChecklistItems)
+	assert.Len(t, v_ChecklistItems, 1)
+
+	// test eager loading
+	obj3, err3 := LoadChecklist(ctx, obj.PrimaryKey(), node.Checklist().ChecklistItems())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, len(obj2.ChecklistItems()), len(obj3.ChecklistItems()))
+
+}
+
+func TestChecklist_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleChecklist(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklist(ctx, obj)
+
+	obj2, err := LoadChecklist(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleChecklist(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleChecklist(ctx, obj2)
+
+	obj3, err2 := LoadChecklist(ctx, obj2.PrimaryKey(), node.Checklist().ChecklistItems())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, len(obj2.ChecklistItems()), len(obj3.ChecklistItems()))
+
+}
+
+func TestChecklist_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleChecklist(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklist(ctx, obj)
+
+	updateMinimalSampleChecklistItem(obj.ChecklistItems()[0])
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadChecklist(ctx, obj.PrimaryKey(), node.Checklist().ChecklistItems())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+	assertEqualFieldsChecklistItem(t, obj2.ChecklistItems()[0], obj.ChecklistItems()[0])
+
+}
+func TestChecklist_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewChecklist()
+
+	assert.True(t, obj.ID().IsTemp())
+}
+
+func TestChecklist_Getters(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+
+	assert.True(t, obj.ID().IsTemp())
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklist(ctx, obj)
+
+	has, _ := HasChecklist(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadChecklist(ctx, obj.PrimaryKey(),
+		node.Checklist().ID())
+
+	assert.Equal(t, obj.ID(), obj.Get(ChecklistIDField))
+	assert.Equal(t, obj.Name(), obj.Get(ChecklistNameField))
+	assert.Panics(t, func() { obj2.Name() })
+	assert.Nil(t, obj2.Get(ChecklistNameField))
+
+}
+
+func TestChecklist_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklist(ctx, obj)
+
+	objs, err := QueryChecklists(ctx).
+		Where(op.Equal(node.Checklist().ID(), obj.ID())).
+		OrderBy(node.Checklist().ID()). // exercise order by
+		Limit(1, 0).                    // exercise limit
+		Calculation(node.Checklist(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestChecklist_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleChecklist(ctx, obj)
+
+	objs, _ := QueryChecklists(ctx).
+		Where(op.Equal(node.Checklist().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*Checklist).PrimaryKey())
+}
+func TestChecklist_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklist(ctx, obj)
+
+	cursor, err := QueryChecklists(ctx).
+		Where(op.Equal(node.Checklist().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QueryChecklists(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestChecklist_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleChecklist(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleChecklist(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountChecklists(ctx); return i }())
+
+}
+
+func TestChecklist_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewChecklist()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsChecklist(t, obj, obj2)
+}
+
+func TestChecklist_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewChecklist()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsChecklist(t, obj, obj2)
+}
+
+func TestChecklist_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+	var err error
+
+	for i := 0; i < 11; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 12; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestChecklist_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleChecklist()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewChecklist()
+	for i := 0; i < 11; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleChecklist()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewChecklist()
+	for i := 0; i < 12; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,1244 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/goradd/anyutil"
+	"github.com/goradd/gro/broadcast"
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/db"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+)
+
+// ChecklistItemBase is embedded in a ChecklistItem object and provides the ORM access to the database.
+// The member variables of the structure are private and should not normally be accessed by the ChecklistItem embedder.
+// Instead, use the accessor functions.
+type checklistItemBase struct {
+	id                  query.AutoPrimaryKey
+	idIsLoaded          bool
+	idIsDirty           bool
+	name                string
+	nameIsLoaded        bool
+	nameIsDirty         bool
+	position            int
+	positionIsLoaded    bool
+	positionIsDirty     bool
+	checklistID         query.AutoPrimaryKey
+	checklistIDIsLoaded bool
+	checklistIDIsDirty  bool
+
+	// References
+	checklist *Checklist
+
+	// Custom aliases, if specified
+	_aliases map[string]any
+
+	// Indicates whether this is a new object, or one loaded from the database. Used by Save to know whether to Insert or Update.
+	_restored bool
+
+	_originalPK query.AutoPrimaryKey
+}
+
+// IDs used to access the ChecklistItem object fields by name using the Get function.
+// doc: type=ChecklistItem
+const (
+	ChecklistItemIDField          = `id`
+	ChecklistItemNameField        = `name`
+	ChecklistItemPositionField    = `position`
+	ChecklistItemChecklistIDField = `checklistID`
+	ChecklistItemChecklistField   = `checklist`
+)
+
+const ChecklistItemNameMaxLength = 100 // The number of runes the column can hold
+const ChecklistItemPositionMax = 2147483647
+const ChecklistItemPositionMin = -2147483648
+
+// Initialize or re-initialize a ChecklistItem database object to default values.
+// The primary key will get a temporary unique value which will be replaced when the object is saved.
+func (o *checklistItemBase) Initialize() {
+	o.id = query.TempAutoPrimaryKey()
+	o.idIsLoaded = true
+	o.idIsDirty = false
+
+	o.name = ""
+	o.nameIsLoaded = false
+	o.nameIsDirty = false
+
+	o.position = 0
+	o.positionIsLoaded = false
+	o.positionIsDirty = false
+
+	o.checklistID = query.AutoPrimaryKey{}
+	o.checklistIDIsLoaded = false
+	o.checklistIDIsDirty = false
+
+	o._aliases = nil
+	o._restored = false
+}
+
+// Copy copies most fields to a new ChecklistItem object.
+// Forward reference ids will be copied, but reverse and many-many references will not.
+// Attached objects will not be included in the copy.
+// Automatically generated fields will not be included in the copy.
+// The primary key field will not be copied, since it is normally auto-generated.
+// Call Save() on the new object to save it into the database.
+// Copy might panic if any fields in the database were set to a size larger than the
+// maximum size through a process that accessed the database outside of the ORM.
+func (o *checklistItemBase) Copy() (newObject *ChecklistItem) {
+	newObject = NewChecklistItem()
+	if o.idIsLoaded {
+		newObject.SetID(o.id)
+	}
+	if o.nameIsLoaded {
+		newObject.SetName(o.name)
+	}
+	if o.positionIsLoaded {
+		newObject.SetPosition(o.position)
+	}
+	if o.checklistIDIsLoaded {
+		newObject.SetChecklistID(o.checklistID)
+	}
+	return
+}
+
+// OriginalPrimaryKey returns the value of the primary key that was originally loaded into the object when it was
+// read from the database.
+func (o *checklistItemBase) OriginalPrimaryKey() query.AutoPrimaryKey {
+	return o._originalPK
+}
+
+// PrimaryKey returns the value of the primary key of the record.
+func (o *checklistItemBase) PrimaryKey() query.AutoPrimaryKey {
+	if o._restored && !o.idIsLoaded {
+		panic("ID was not selected in the last query and has not been set, and so PrimaryKey is not valid")
+	}
+	return o.id
+}
+
+// SetPrimaryKey sets the value of the primary key in the object, to be saved later in the database using the Save() function.
+// Normally you will not need to call this function, since the primary key value is automatically generated by the
+// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
+// merging data.
+// You cannot change a primary key for a record that has been written to the database. While SQL databases will
+// allow it, NoSql databases will not. Save a copy and delete this one instead.
+func (o *checklistItemBase) SetPrimaryKey(v query.AutoPrimaryKey) {
+	o.SetID(v)
+}
+
+// ID returns the loaded value of the id field in the database.
+func (o *checklistItemBase) ID() query.AutoPrimaryKey {
+	if o._restored && !o.idIsLoaded {
+		panic("ID was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.id
+}
+
+// IDIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *checklistItemBase) IDIsLoaded() bool {
+	return o.idIsLoaded
+}
+
+// SetID sets the value of ID in the object, to be saved later in the database using the Save() function.
+// Normally you will not need to call this function, since the ID value is automatically generated by the
+// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
+// merging data.
+// You cannot change a primary key for a record that has been written to the database. While SQL databases will
+// allow it, NoSql databases will not. Save a copy and delete this one instead.
+func (o *checklistItemBase) SetID(v query.AutoPrimaryKey) {
+	if o._restored {
+		panic("error: Do not change a primary key for a record that has been saved. Instead, save a copy and delete the original.")
+	}
+	o.idIsLoaded = true
+	o.idIsDirty = true
+	o.id = v
+}
+
+// Name returns the value of the loaded name field in the database.
+func (o *checklistItemBase) Name() string {
+	if o._restored && !o.nameIsLoaded {
+		panic("Name was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.name
+}
+
+// NameIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *checklistItemBase) NameIsLoaded() bool {
+	return o.nameIsLoaded
+}
+
+// SetName sets the value of Name in the object, to be saved later in the database using the Save() function.
+func (o *checklistItemBase) SetName(v string) {
+	if utf8.RuneCountInString(v) > ChecklistItemNameMaxLength {
+		panic("attempted to set ChecklistItem.Name to a value larger than its maximum length in runes")
+	}
+	if o._restored &&
+		o.nameIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		o.name == v {
+		// no change
+		return
+	}
+
+	o.nameIsLoaded = true
+	o.name = v
+	o.nameIsDirty = true
+}
+
+// Position returns the value of the loaded position field in the database.
+func (o *checklistItemBase) Position() int {
+	if o._restored && !o.positionIsLoaded {
+		panic("Position was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.position
+}
+
+// PositionIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *checklistItemBase) PositionIsLoaded() bool {
+	return o.positionIsLoaded
+}
+
+// SetPosition sets the value of Position in the object, to be saved later in the database using the Save() function.
+func (o *checklistItemBase) SetPosition(v int) {
+	if o._restored &&
+		o.positionIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		o.position == v {
+		// no change
+		return
+	}
+
+	o.positionIsLoaded = true
+	o.position = v
+	o.positionIsDirty = true
+}
+
+// ChecklistID returns the value of the loaded checklist_id field in the database.
+func (o *checklistItemBase) ChecklistID() query.AutoPrimaryKey {
+	if o._restored && !o.checklistIDIsLoaded {
+		panic("ChecklistID was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.checklistID
+}
+
+// ChecklistIDIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *checklistItemBase) ChecklistIDIsLoaded() bool {
+	return o.checklistIDIsLoaded
+}
+
+// SetChecklistID sets the value of ChecklistID in the object, to be saved later in the database using the Save() function.
+func (o *checklistItemBase) SetChecklistID(v query.AutoPrimaryKey) {
+	if o._restored &&
+		o.checklistIDIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		o.checklistID == v {
+		// no change
+		return
+	}
+
+	o.checklistIDIsLoaded = true
+	o.checklistID = v
+	o.checklistIDIsDirty = true
+	if o.checklist != nil &&
+		o.checklistID != o.checklist.PrimaryKey() {
+		o.checklist = nil
+	}
+}
+
+// Checklist returns the current value of the loaded Checklist, and nil if its not loaded.
+func (o *checklistItemBase) Checklist() *Checklist {
+	return o.checklist
+}
+
+// LoadChecklist returns the related Checklist. If it is not already loaded,
+// it will attempt to load it, provided the ChecklistID column has been loaded first.
+func (o *checklistItemBase) LoadChecklist(ctx context.Context) (*Checklist, error) {
+	var err error
+
+	if o.checklist == nil {
+		if !o.checklistIDIsLoaded {
+			panic("ChecklistID must be selected in the previous query")
+		}
+		// Load and cache
+		o.checklist, err = LoadChecklist(ctx, o.checklistID)
+	}
+	return o.checklist, err
+}
+
+// SetChecklist sets the value of Checklist in the object, to be saved later using the Save() function.
+func (o *checklistItemBase) SetChecklist(checklist *Checklist) {
+	if checklist == nil {
+		panic("Cannot set Checklist to a nil value since ChecklistID is not nullable.")
+	} else {
+		o.checklist = checklist
+		o.checklistIDIsLoaded = true
+		if o.checklistID != checklist.PrimaryKey() {
+			o.checklistID = checklist.PrimaryKey()
+			o.checklistIDIsDirty = true
+		}
+	}
+}
+
+// GetAlias returns the value for the Alias node aliasKey that was returned in the most
+// recent query.
+func (o *checklistItemBase) GetAlias(aliasKey string) query.AliasValue {
+	if a, ok := o._aliases[aliasKey]; ok {
+		return query.NewAliasValue(a)
+	} else {
+		panic("Alias " + aliasKey + " not found.")
+	}
+}
+
+// IsNew returns true if the object will create a new record when saved.
+func (o *checklistItemBase) IsNew() bool {
+	return !o._restored
+}
+
+// LoadChecklistItem returns a ChecklistItem from the database.
+// selectNodes lets you provide nodes for selecting specific fields or additional fields from related tables.
+// See [ChecklistItemsBuilder.Select] for more info.
+func LoadChecklistItem(ctx context.Context, pk query.AutoPrimaryKey, selectNodes ...query.Node) (*ChecklistItem, error) {
+	return queryChecklistItems(ctx).
+		Where(op.Equal(node.ChecklistItem().ID(), pk)).
+		Select(selectNodes...).
+		Get()
+}
+
+// HasChecklistItem returns true if a ChecklistItem with the given primary key exists in the database.
+// doc: type=ChecklistItem
+func HasChecklistItem(ctx context.Context, pk query.AutoPrimaryKey) (bool, error) {
+	v, err := queryChecklistItems(ctx).
+		Where(op.Equal(node.ChecklistItem().ID(), pk)).
+		Count()
+	return v > 0, err
+}
+
+// LoadChecklistItemsByChecklistID queries ChecklistItem objects by the given index values.
+// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
+// See [ChecklistItemsBuilder.Select].
+// If you need a more elaborate query, use QueryChecklistItems() to start a query builder.
+func LoadChecklistItemsByChecklistID(ctx context.Context, checklistID query.AutoPrimaryKey, selectNodes ...query.Node) ([]*ChecklistItem, error) {
+	q := queryChecklistItems(ctx)
+	q = q.Where(op.Equal(node.ChecklistItem().ChecklistID(), checklistID))
+	return q.Select(selectNodes...).Load()
+}
+
+// HasChecklistItemByChecklistID returns true if the
+// given index values exist in the database.
+// doc: type=ChecklistItem
+func HasChecklistItemByChecklistID(ctx context.Context, checklistID query.AutoPrimaryKey) (bool, error) {
+	q := queryChecklistItems(ctx)
+	q = q.Where(op.Equal(node.ChecklistItem().ChecklistID(), checklistID))
+	v, err := q.Count()
+	return v > 0, err
+}
+
+// The ChecklistItemBuilder uses a builder pattern to create a query on the database.
+// Create a ChecklistItemBuilder by calling QueryChecklistItems, which will select all
+// the ChecklistItem object in the database. Then filter and arrange those objects
+// by calling Where, Select, etc.
+// End a query by calling either Load, LoadI, LoadCursor, Get, or Count.
+// A ChecklistItemBuilder stores the context it will use to perform the query, and thus is
+// meant to be a short-lived object. You should not save it for later use.
+type ChecklistItemBuilder struct {
+	builder *query.Builder
+	ctx     context.Context
+}
+
+func newChecklistItemBuilder(ctx context.Context) *ChecklistItemBuilder {
+	b := ChecklistItemBuilder{
+		builder: query.NewBuilder(node.ChecklistItem()),
+		ctx:     ctx,
+	}
+	return &b
+}
+
+// Load terminates the query builder, performs the query, and returns a slice of ChecklistItem objects.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *ChecklistItemBuilder) Load() (checklistItems []*ChecklistItem, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+	database := db.GetDatabase("goradd_unit")
+	var results any
+
+	ctx := b.ctx
+	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _, item := range results.([]map[string]any) {
+		o := new(ChecklistItem)
+		o.unpack(item, o)
+		checklistItems = append(checklistItems, o)
+	}
+	return
+}
+
+// LoadI terminates the query builder, performs the query, and returns a slice of interfaces.
+// This can then satisfy a variety of interfaces that load arrays of objects, including KeyLabeler.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *ChecklistItemBuilder) LoadI() (checklistItems []query.OrmObj, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+	database := db.GetDatabase("goradd_unit")
+	var results any
+
+	ctx := b.ctx
+	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _, item := range results.([]map[string]any) {
+		o := new(ChecklistItem)
+		o.unpack(item, o)
+		checklistItems = append(checklistItems, o)
+	}
+	return
+}
+
+// LoadCursor terminates the query builder, performs the query, and returns a cursor to the query.
+//
+// A query cursor is useful for dealing with large amounts of query results. However, there are some
+// limitations to its use. When working with SQL databases, you cannot use a cursor while querying
+// many-to-many or reverse relationships that will create an array of values.
+//
+// Call Next() on the returned cursor object to step through the results. Make sure you call Close
+// on the cursor object when you are done. You should use
+//
+//	defer cursor.Close()
+//
+// to make sure the cursor gets closed.
+func (b *ChecklistItemBuilder) LoadCursor() (checklistItemsCursor, error) {
+	b.builder.Command = query.BuilderCommandLoadCursor
+	database := db.GetDatabase("goradd_unit")
+	result, err := database.BuilderQuery(b.ctx, b.builder)
+	var cursor query.CursorI
+	if result != nil {
+		cursor = result.(query.CursorI)
+	}
+	return checklistItemsCursor{cursor}, err
+}
+
+type checklistItemsCursor struct {
+	query.CursorI
+}
+
+// Next returns the current ChecklistItem object and moves the cursor to the next one.
+//
+// If there are no more records, it returns nil.
+func (c checklistItemsCursor) Next() (*ChecklistItem, error) {
+	if c.CursorI == nil {
+		return nil, nil
+	}
+
+	row, err := c.CursorI.Next()
+	if row == nil || err != nil {
+		return nil, err
+	}
+	o := new(ChecklistItem)
+	o.unpack(row, o)
+	return o, nil
+}
+
+// Get is a convenience method to return only the first item found in a query.
+// The entire query is performed, so you should generally use this only if you know
+// you are selecting on one or very few items.
+// If an error occurs, or no results are found, a nil is returned.
+func (b *ChecklistItemBuilder) Get() (*ChecklistItem, error) {
+	results, err := b.Load()
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// Where adds a condition to filter what gets selected.
+// Calling Where multiple times will AND the conditions together.
+func (b *ChecklistItemBuilder) Where(c query.Node) *ChecklistItemBuilder {
+	b.builder.Where(c)
+	return b
+}
+
+// OrderBy specifies how the resulting data should be sorted.
+// By default, the given nodes are sorted in ascending order.
+// Add Descending() to the node to specify that it should be sorted in descending order.
+func (b *ChecklistItemBuilder) OrderBy(nodes ...query.Sorter) *ChecklistItemBuilder {
+	b.builder.OrderBy(nodes...)
+	return b
+}
+
+// Limit will return a subset of the data, limited to the offset and number of rows specified.
+// For large data sets and specific types of queries, this can be slow, because it will perform
+// the entire query before computing the limit.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the ChecklistItem objects. You cannot otherwise limit a query that has embedded arrays.
+func (b *ChecklistItemBuilder) Limit(maxRowCount int, offset int) *ChecklistItemBuilder {
+	b.builder.Limit(maxRowCount, offset)
+	return b
+}
+
+// Select specifies what specific columns will be loaded with data.
+// By default, all the columns of the checklist_item table will be queried and loaded.
+// If nodes contains columns from the checklist_item table, that will limit the columns queried and loaded to only those columns.
+// If related tables are specified, then all the columns from those tables are queried, selected and joined to the result.
+// If columns in related tables are specified, then only those columns will be queried and loaded.
+// Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
+// will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
+func (b *ChecklistItemBuilder) Select(nodes ...query.Node) *ChecklistItemBuilder {
+	b.builder.Select(nodes...)
+	return b
+}
+
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded ChecklistItem objects at once, rather than joining them to the query.
+// This avoids repeating the data of each ChecklistItem object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *ChecklistItemBuilder) Preload(nodes ...query.Node) *ChecklistItemBuilder {
+	b.builder.Preload(nodes...)
+	return b
+}
+
+// Calculation adds operation as an aliased value onto base.
+// After the query, you can read the data by passing alias to GetAlias on the returned object.
+func (b *ChecklistItemBuilder) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *ChecklistItemBuilder {
+	b.builder.Calculation(base, alias, operation)
+	return b
+}
+
+// Distinct removes duplicates from the results of the query.
+// Adding a Select() is usually required.
+func (b *ChecklistItemBuilder) Distinct() *ChecklistItemBuilder {
+	b.builder.Distinct()
+	return b
+}
+
+// GroupBy controls how results are grouped when using aggregate functions with Calculation.
+func (b *ChecklistItemBuilder) GroupBy(nodes ...query.Node) *ChecklistItemBuilder {
+	b.builder.GroupBy(nodes...)
+	return b
+}
+
+// Having does additional filtering on the results of the query after the query is performed.
+func (b *ChecklistItemBuilder) Having(node query.Node) *ChecklistItemBuilder {
+	b.builder.Having(node)
+	return b
+}
+
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *ChecklistItemBuilder) ForUpdate() *ChecklistItemBuilder {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *ChecklistItemBuilder) ForShare() *ChecklistItemBuilder {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *ChecklistItemBuilder) SkipLocked() *ChecklistItemBuilder {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *ChecklistItemBuilder) NoWait() *ChecklistItemBuilder {
+	b.builder.NoWait()
+	return b
+}
+
+// Count terminates a query and returns just the number of items in the result.
+// If you have Select or Calculation columns in the query, it will count NULL results as well.
+// To not count NULL values, use Where in the builder with a NotNull operation.
+// To count distinct combinations of items, call Distinct() on the builder.
+func (b *ChecklistItemBuilder) Count() (int, error) {
+	b.builder.Command = query.BuilderCommandCount
+	database := db.GetDatabase("goradd_unit")
+
+	ctx := b.ctx
+	results, err := database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return 0, err
+	}
+	return results.(int), nil
+}
+
+// CountChecklistItems returns the total number of items in the checklist_item table.
+func CountChecklistItems(ctx context.Context) (int, error) {
+	return QueryChecklistItems(ctx).Count()
+}
+
+// CountChecklistItemsByChecklistID queries the database and returns the number of ChecklistItem objects that
+// have checklistID.
+// doc: type=ChecklistItem
+func CountChecklistItemsByChecklistID(ctx context.Context, checklistID query.AutoPrimaryKey) (int, error) {
+	v_checklistID := checklistID
+	return QueryChecklistItems(ctx).
+		Where(op.Equal(node.ChecklistItem().ChecklistID(), v_checklistID)).
+		Count()
+}
+
+// unpack recursively transforms data coming from the database into ORM objects.
+func (o *checklistItemBase) unpack(m map[string]interface{}, objThis *ChecklistItem) {
+
+	if v, ok := m["id"]; ok && v != nil {
+		if o.id, ok = v.(query.AutoPrimaryKey); ok {
+			o.idIsLoaded = true
+			o.idIsDirty = false
+			o._originalPK = o.id
+		} else {
+			panic("Wrong type found for id.")
+		}
+	} else {
+		o.idIsLoaded = false
+		o.id = query.TempAutoPrimaryKey()
+		o.idIsDirty = false
+	}
+
+	if v, ok := m["name"]; ok && v != nil {
+		if o.name, ok = v.(string); ok {
+			o.nameIsLoaded = true
+			o.nameIsDirty = false
+		} else {
+			panic("Wrong type found for name.")
+		}
+	} else {
+		o.nameIsLoaded = false
+		o.name = ""
+		o.nameIsDirty = false
+	}
+
+	if v, ok := m["position"]; ok && v != nil {
+		if o.position, ok = v.(int); ok {
+			o.positionIsLoaded = true
+			o.positionIsDirty = false
+		} else {
+			panic("Wrong type found for position.")
+		}
+	} else {
+		o.positionIsLoaded = false
+		o.position = 0
+		o.positionIsDirty = false
+	}
+
+	if v, ok := m["checklistID"]; ok && v != nil {
+		if o.checklistID, ok = v.(query.AutoPrimaryKey); ok {
+			o.checklistIDIsLoaded = true
+			o.checklistIDIsDirty = false
+		} else {
+			panic("Wrong type found for checklistID.")
+		}
+	} else {
+		o.checklistIDIsLoaded = false
+		o.checklistID = query.AutoPrimaryKey{}
+		o.checklistIDIsDirty = false
+	}
+
+	if v, ok := m["checklist"]; ok {
+		if checklist, ok2 := v.(map[string]any); ok2 {
+			o.checklist = new(Checklist)
+			o.checklist.unpack(checklist, o.checklist)
+			// mirror foreign key with loaded object
+			o.checklistID = o.checklist.PrimaryKey()
+			o.checklistIDIsLoaded = true
+			o.checklistIDIsDirty = false
+		} else {
+			panic("Wrong type found for Checklist object.")
+		}
+	} else {
+		o.checklist = nil
+	}
+
+	if v, ok := m["aliases_"]; ok {
+		o._aliases = v.(map[string]any)
+	}
+
+	o._restored = true
+
+}
+
+// save will update or insert the object, depending on the state of the object.
+func (o *checklistItemBase) save(ctx context.Context) error {
+	if o._restored {
+		return o.update(ctx)
+	} else {
+		return o.insert(ctx)
+	}
+}
+
+// update will update the values in the database, saving any changed values.
+// If the table has auto-generated values, those will be updated automatically.
+func (o *checklistItemBase) update(ctx context.Context) error {
+	if !o._restored {
+		panic("cannot update a record that was not originally read from the database.")
+	}
+	if !o.IsDirty() {
+		return nil // nothing to save
+	}
+
+	var modifiedFields map[string]interface{}
+
+	d := Database()
+	err := db.WithTransaction(ctx, d, func(ctx context.Context) error {
+		// Save loaded Checklist object to get its new pk and update it here.
+		if o.checklist != nil {
+			if err := o.checklist.Save(ctx); err != nil {
+				return err
+			}
+			o.SetChecklistID(o.checklist.PrimaryKey())
+		}
+
+		modifiedFields = getChecklistItemUpdateFields(o)
+		if len(modifiedFields) != 0 {
+			err2 := d.Update(ctx, "checklist_item",
+				map[string]any{
+					"id": o._originalPK,
+				},
+				modifiedFields,
+				"",
+				0,
+			)
+			if err2 != nil {
+				return err2
+			}
+		}
+
+		return nil
+	}) // transaction
+	if err != nil {
+		return err
+	}
+
+	o.resetDirtyStatus()
+	if len(modifiedFields) != 0 {
+		broadcast.Update(ctx, "goradd_unit", "checklist_item", o._originalPK, anyutil.SortedKeys(modifiedFields)...)
+	}
+
+	return nil
+}
+
+// insert will insert the object into the database. Related items will be saved.
+func (o *checklistItemBase) insert(ctx context.Context) (err error) {
+	var insertFields map[string]interface{}
+	d := Database()
+	err = db.WithTransaction(ctx, d, func(ctx context.Context) error {
+		// Save loaded Checklist object to get its new pk and update it here.
+		if o.checklist != nil {
+			if err := o.checklist.Save(ctx); err != nil {
+				return err
+			}
+			o.SetChecklistID(o.checklist.PrimaryKey())
+		}
+		if !o.nameIsLoaded {
+			panic("a value for Name is required, and there is no default value. Call SetName() before inserting the record.")
+		}
+		if !o.positionIsLoaded {
+			panic("a value for Position is required, and there is no default value. Call SetPosition() before inserting the record.")
+		}
+		if !o.checklistIDIsLoaded {
+			panic("a value for ChecklistID is required, and there is no default value. Call SetChecklistID() before inserting the record.")
+		}
+		insertFields = getChecklistItemInsertFields(o)
+		err = d.Insert(ctx, "checklist_item", insertFields, "id")
+		if err != nil {
+			return err
+		}
+		o.id = insertFields["id"].(query.AutoPrimaryKey)
+		o._originalPK = o.id
+		o.idIsLoaded = true
+
+		return nil
+
+	}) // transaction
+
+	if err != nil {
+		return
+	}
+
+	o.resetDirtyStatus()
+	o._restored = true
+	broadcast.Insert(ctx, "goradd_unit", "checklist_item", o.PrimaryKey())
+	return
+}
+
+// getUpdateFields returns the database columns that will be sent to the update process.
+// This will include timestamp fields only if some other column has changed.
+func (o *checklistItemBase) getUpdateFields() (fields map[string]interface{}) {
+	fields = map[string]interface{}{}
+	if o.idIsDirty {
+		fields["id"] = o.id
+	}
+	if o.nameIsDirty {
+		fields["name"] = o.name
+	}
+	if o.positionIsDirty {
+		fields["position"] = o.position
+	}
+	if o.checklistIDIsDirty {
+		fields["checklist_id"] = o.checklistID
+	}
+	return
+}
+
+// getInsertFields returns the fields that will be specified in an insert operation.
+// Optional fields that have not been set and have no default will be returned as nil.
+// NoSql databases should interpret this as no value. Sql databases should interpret this as
+// explicitly setting a NULL value, which would override any database specific default value.
+// Auto-generated fields will be returned here with their generated values, except AutoPK fields, which are returned
+// as a new AutoPrimaryKey to indicate that the driver will fill this in after the insert.
+func (o *checklistItemBase) getInsertFields() (fields map[string]interface{}) {
+	fields = map[string]interface{}{}
+	if o.idIsDirty {
+		fields["id"] = o.id
+	}
+
+	fields["name"] = o.name
+
+	fields["position"] = o.position
+
+	fields["checklist_id"] = o.checklistID
+	return
+}
+
+// Delete deletes the record from the database.
+func (o *checklistItemBase) Delete(ctx context.Context) (err error) {
+	if o == nil {
+		return // allow deleting of a nil object to be a noop
+	}
+	if !o._restored {
+		panic("Cannot delete a record that has no primary key value.")
+	}
+	d := Database()
+	err = d.Delete(ctx, "checklist_item",
+		map[string]any{
+			"id": o._originalPK,
+		},
+		"",
+		0,
+	)
+	if err != nil {
+		return err
+	}
+	broadcast.Delete(ctx, "goradd_unit", "checklist_item", o._originalPK)
+	return
+}
+
+// deleteChecklistItem deletes the ChecklistItem with primary key pk from the database
+// and handles associated records.
+func deleteChecklistItem(ctx context.Context, pk query.AutoPrimaryKey) error {
+	d := db.GetDatabase("goradd_unit")
+	err := d.Delete(ctx, "checklist_item",
+		map[string]any{
+			"id": pk,
+		},
+		"", 0)
+
+	if err != nil {
+		return err
+	}
+	broadcast.Delete(ctx, "goradd_unit", "checklist_item", pk)
+	return err
+}
+
+// resetDirtyStatus resets the dirty status of every field in the object.
+func (o *checklistItemBase) resetDirtyStatus() {
+	o.idIsDirty = false
+	o.nameIsDirty = false
+	o.positionIsDirty = false
+	o.checklistIDIsDirty = false
+
+}
+
+// IsDirty returns true if the object has been changed since it was read from the database or created.
+func (o *checklistItemBase) IsDirty() (dirty bool) {
+	dirty = o.idIsDirty ||
+		o.nameIsDirty ||
+		o.positionIsDirty ||
+		o.checklistIDIsDirty
+
+	dirty = dirty ||
+		o.checklist != nil && o.checklist.IsDirty()
+
+	return
+}
+
+// Get returns the value of a field in the object based on the field's name.
+// It will also get related objects if they are loaded.
+// Invalid fields and objects are returned as nil.
+// Get can be used to retrieve a value by using the Field() of a node.
+func (o *checklistItemBase) Get(key string) interface{} {
+	switch key {
+	case ChecklistItemIDField:
+		if !o.idIsLoaded {
+			return nil
+		}
+		return o.id
+	case ChecklistItemNameField:
+		if !o.nameIsLoaded {
+			return nil
+		}
+		return o.name
+	case ChecklistItemPositionField:
+		if !o.positionIsLoaded {
+			return nil
+		}
+		return o.position
+	case ChecklistItemChecklistIDField:
+		if !o.checklistIDIsLoaded {
+			return nil
+		}
+		return o.checklistID
+	case ChecklistItemChecklistField:
+		return o.Checklist()
+	}
+	return nil
+}
+
+// MarshalBinary serializes the object into a buffer that is deserializable using UnmarshalBinary.
+// It should be used for transmitting database objects over the wire, or for temporary storage. It does not send
+// a version number, so if the data format changes, its up to you to invalidate the old stored objects.
+// The framework uses this to serialize the object when it is stored in a control.
+func (o *checklistItemBase) MarshalBinary() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := gob.NewEncoder(buf)
+	if err := o.encodeTo(enc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (o *checklistItemBase) encodeTo(enc db.Encoder) error {
+
+	if err := enc.Encode(o.id); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.id: %w", err)
+	}
+	if err := enc.Encode(o.idIsLoaded); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.idIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.idIsDirty); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.idIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.name); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.name: %w", err)
+	}
+	if err := enc.Encode(o.nameIsLoaded); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.nameIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.nameIsDirty); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.nameIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.position); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.position: %w", err)
+	}
+	if err := enc.Encode(o.positionIsLoaded); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.positionIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.positionIsDirty); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.positionIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.checklistID); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.checklistID: %w", err)
+	}
+	if err := enc.Encode(o.checklistIDIsLoaded); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.checklistIDIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.checklistIDIsDirty); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem.checklistIDIsDirty: %w", err)
+	}
+
+	if o.checklist == nil {
+		if err := enc.Encode(false); err != nil {
+			return err
+		}
+	} else {
+		if err := enc.Encode(true); err != nil {
+			return err
+		}
+		if err := enc.Encode(o.checklist); err != nil {
+			return fmt.Errorf("error encoding ChecklistItem.checklist: %w", err)
+		}
+	}
+
+	if o._aliases == nil {
+		if err := enc.Encode(false); err != nil {
+			return err
+		}
+	} else {
+		if err := enc.Encode(true); err != nil {
+			return err
+		}
+		if err := enc.Encode(o._aliases); err != nil {
+			return fmt.Errorf("error encoding ChecklistItem._aliases: %w", err)
+		}
+	}
+
+	if err := enc.Encode(o._restored); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem._restored: %w", err)
+	}
+	if err := enc.Encode(o._originalPK); err != nil {
+		return fmt.Errorf("error encoding ChecklistItem._originalPK: %w", err)
+	}
+	return nil
+}
+
+// UnmarshalBinary converts a structure that was created with MarshalBinary into a ChecklistItem object.
+func (o *checklistItemBase) UnmarshalBinary(data []byte) (err error) {
+	buf := bytes.NewReader(data)
+	dec := gob.NewDecoder(buf)
+	return o.decodeFrom(dec)
+}
+
+func (o *checklistItemBase) decodeFrom(dec db.Decoder) (err error) {
+	var isPtr bool
+
+	_ = isPtr
+	if err = dec.Decode(&o.id); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.id: %w", err)
+	}
+	if err = dec.Decode(&o.idIsLoaded); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.idIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.idIsDirty); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.idIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.name); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.name: %w", err)
+	}
+	if err = dec.Decode(&o.nameIsLoaded); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.nameIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.nameIsDirty); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.nameIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.position); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.position: %w", err)
+	}
+	if err = dec.Decode(&o.positionIsLoaded); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.positionIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.positionIsDirty); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.positionIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.checklistID); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.checklistID: %w", err)
+	}
+	if err = dec.Decode(&o.checklistIDIsLoaded); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.checklistIDIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.checklistIDIsDirty); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.checklistIDIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&isPtr); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem.checklist isPtr: %w", err)
+	}
+	if isPtr {
+		if err = dec.Decode(&o.checklist); err != nil {
+			return fmt.Errorf("error decoding ChecklistItem.checklist: %w", err)
+		}
+	}
+	if err = dec.Decode(&isPtr); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem._aliases isPtr: %w", err)
+	}
+	if isPtr {
+		if err = dec.Decode(&o._aliases); err != nil {
+			return fmt.Errorf("error decoding ChecklistItem._aliases: %w", err)
+		}
+	}
+
+	if err = dec.Decode(&o._restored); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem._restored: %w", err)
+	}
+	if err = dec.Decode(&o._originalPK); err != nil {
+		return fmt.Errorf("error decoding ChecklistItem._originalPK: %w", err)
+	}
+	return
+}
+
+// MarshalJSON serializes the object into a JSON object.
+// Only valid data will be serialized, meaning, you can control what gets serialized by using Select to
+// select only the fields you want when you query for the object. Another way to control the output
+// is to call MarshalStringMap, modify the map, then encode the map.
+func (o *checklistItemBase) MarshalJSON() (data []byte, err error) {
+	v := o.MarshalStringMap()
+	return json.Marshal(v)
+}
+
+// MarshalStringMap serializes the object into a string map of interfaces.
+// Only valid data will be serialized, meaning, you can control what gets serialized by using Select to
+// select only the fields you want when you query for the object. The keys are the same as the json keys.
+func (o *checklistItemBase) MarshalStringMap() map[string]interface{} {
+	v := make(map[string]interface{})
+
+	if o.idIsLoaded {
+		v["id"] = o.id
+	}
+
+	if o.nameIsLoaded {
+		v["name"] = o.name
+	}
+
+	if o.positionIsLoaded {
+		v["position"] = o.position
+	}
+
+	if o.checklistIDIsLoaded {
+		v["checklistID"] = o.checklistID
+	}
+
+	if val := o.checklist; val != nil {
+		v["checklist"] = val.MarshalStringMap()
+	}
+	for _k, _v := range o._aliases {
+		v[_k] = _v
+	}
+	return v
+}
+
+// UnmarshalJSON unmarshalls the given json data into the ChecklistItem. The ChecklistItem can be a
+// newly created object, or one loaded from the database.
+//
+// After unmarshalling, the object is not  saved. You must call Save to insert it into the database
+// or update it.
+//
+// Unmarshalling of sub-objects, as in objects linked via foreign keys, is not currently supported.
+//
+// The fields it expects are:
+//
+//	"id" - query.AutoPrimaryKey
+//	"name" - string
+//	"position" - int
+func (o *checklistItemBase) UnmarshalJSON(data []byte) (err error) {
+	var v map[string]interface{}
+	if len(data) == 0 {
+		return
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber() // use a number to avoid precision errors
+	if err = d.Decode(&v); err != nil {
+		return err
+	}
+	return o.UnmarshalStringMap(v)
+}
+
+// UnmarshalStringMap will load the values from the stringmap into the object.
+//
+// Override this in ChecklistItem to modify the json before sending it here.
+func (o *checklistItemBase) UnmarshalStringMap(m map[string]interface{}) (err error) {
+	for k, v := range m {
+		switch k {
+
+		case "id":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				if u, ok := Database().(db.AutoPrimaryKeyJsonUnmarshaller); ok {
+					u.AutoPrimaryKeyJsonUnmarshal(v)
+				} else {
+					switch n := v.(type) {
+					case json.Number:
+						n2, err := n.Int64()
+						if err != nil {
+							return err
+						}
+						o.SetID(query.NewAutoPrimaryKey(n2))
+					default:
+						o.SetID(query.NewAutoPrimaryKey(v))
+					}
+				}
+			}
+		case "name":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				if s, ok := v.(string); !ok {
+					return fmt.Errorf("json field %s must be a string", k)
+				} else {
+					o.SetName(s)
+				}
+			}
+		case "position":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				switch n := v.(type) {
+				case json.Number:
+					n2, err := n.Int64()
+					if err != nil {
+						return err
+					}
+					o.SetPosition(int(n2))
+				case int:
+					o.SetPosition(n)
+				case float64:
+					o.SetPosition(int(n))
+				default:
+					return fmt.Errorf("field %s must be a number", k)
+				}
+			}
+		case "checklistID":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				if _, ok := m["checklist"]; ok {
+					continue // importing the foreign key will remove the object
+				}
+
+				if u, ok := Database().(db.AutoPrimaryKeyJsonUnmarshaller); ok {
+					u.AutoPrimaryKeyJsonUnmarshal(v)
+				} else {
+					switch n := v.(type) {
+					case json.Number:
+						n2, err := n.Int64()
+						if err != nil {
+							return err
+						}
+						o.SetChecklistID(query.NewAutoPrimaryKey(n2))
+					default:
+						o.SetChecklistID(query.NewAutoPrimaryKey(v))
+					}
+				}
+			}
+
+		case "checklist":
+			v2 := NewChecklist()
+			m2, ok := v.(map[string]any)
+			if !ok {
+				return fmt.Errorf("json field %s must be a map", k)
+			}
+			err = v2.UnmarshalStringMap(m2)
+			if err != nil {
+				return
+			}
+			o.SetChecklist(v2)
+
+		}
+	}
+	return
+}
@@ -0,0 +1,491 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleChecklistItem creates an unsaved minimal version of a ChecklistItem object
+// for testing.
+func createMinimalSampleChecklistItem() *ChecklistItem {
+	obj := NewChecklistItem()
+	updateMinimalSampleChecklistItem(obj)
+
+	// A required forward reference will need to be fulfilled just to save the minimal version of this object
+	// If the database is configured so that the referenced object points back here, either directly or through multiple
+	// forward references, it possible this could create an endless loop.
+	obj.SetChecklist(createMinimalSampleChecklist())
+
+	return obj
+}
+
+// updateMinimalSampleChecklistItem sets the values of a minimal sample to new, random values.
+func updateMinimalSampleChecklistItem(obj *ChecklistItem) {
+
+	obj.SetName(test.RandomValue[string](100))
+
+	obj.SetPosition(test.RandomValue[int](32))
+
+}
+
+// createMaximalSampleChecklistItem creates an unsaved version of a ChecklistItem object
+// for testing that includes references to minimal objects.
+func createMaximalSampleChecklistItem(ctx context.Context) *ChecklistItem {
+	obj := NewChecklistItem()
+	updateMaximalSampleChecklistItem(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleChecklistItem sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleChecklistItem(ctx context.Context, obj *ChecklistItem) {
+	updateMinimalSampleChecklistItem(obj)
+	obj.SetChecklist(createMinimalSampleChecklist())
+
+}
+
+// deleteSampleChecklistItem deletes an object created and saved by one of the sample creator functions.
+func deleteSampleChecklistItem(ctx context.Context, obj *ChecklistItem) {
+	if obj == nil {
+		return
+	}
+
+	_ = obj.Delete(ctx)
+	deleteSampleChecklist(ctx, obj.Checklist())
+}
+
+// assertEqualFieldsChecklistItem compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsChecklistItem(t *testing.T, obj1, obj2 *ChecklistItem) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.NameIsLoaded() && obj2.NameIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Name(), obj2.Name())
+	}
+	if obj1.PositionIsLoaded() && obj2.PositionIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Position(), obj2.Position())
+	}
+
+}
+
+func TestChecklistItem_SetID(t *testing.T) {
+
+	obj := NewChecklistItem()
+
+	assert.True(t, obj.IsNew())
+	val := query.NewAutoPrimaryKey(test.RandomNumberString())
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.TempAutoPrimaryKey()
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestChecklistItem_SetName(t *testing.T) {
+
+	obj := NewChecklistItem()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](100)
+	obj.SetName(val)
+	assert.Equal(t, val, obj.Name())
+
+	// test default
+	var d string = ""
+	obj.SetName(d)
+	assert.EqualValues(t, d, obj.Name(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](101)
+	assert.Panics(t, func() {
+		obj.SetName(val)
+	})
+}
+func TestChecklistItem_SetPosition(t *testing.T) {
+
+	obj := NewChecklistItem()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[int](32)
+	obj.SetPosition(val)
+	assert.Equal(t, val, obj.Position())
+
+	// test default
+	var d int = 0
+	obj.SetPosition(d)
+	assert.EqualValues(t, d, obj.Position(), "set default")
+
+}
+func TestChecklistItem_SetChecklistID(t *testing.T) {
+
+	obj := NewChecklistItem()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[query.AutoPrimaryKey](32)
+	obj.SetChecklistID(val)
+	assert.Equal(t, val, obj.ChecklistID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.AutoPrimaryKey{}
+	obj.SetChecklistID(d)
+	assert.EqualValues(t, d, obj.ChecklistID(), "set default")
+
+}
+
+func TestChecklistItem_Copy(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.Name(), obj2.Name())
+	assert.Equal(t, obj.Position(), obj2.Position())
+	assert.Equal(t, obj.ChecklistID(), obj2.ChecklistID())
+
+}
+
+func TestChecklistItem_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklistItem(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadChecklistItem(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.NameIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.nameIsDirty)
+	obj2.SetName(obj2.Name())
+	assert.False(t, obj2.nameIsDirty)
+
+	assert.True(t, obj2.PositionIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.positionIsDirty)
+	obj2.SetPosition(obj2.Position())
+	assert.False(t, obj2.positionIsDirty)
+
+}
+
+func TestChecklistItem_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.checklist = nil
+
+	obj.nameIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.nameIsLoaded = true
+
+	obj.positionIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.positionIsLoaded = true
+
+	obj.checklistIDIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.checklistIDIsLoaded = true
+
+}
+
+func TestChecklistItem_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklistItem(ctx, obj)
+	updateMinimalSampleChecklistItem(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadChecklistItem(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Name(), obj.Name(), "Name did not update")
+	assert.Equal(t, obj2.Position(), obj.Position(), "Position did not update")
+}
+
+func TestChecklistItem_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleChecklistItem(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklistItem(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+	assert.NotNil(t, obj.Checklist())
+	assert.False(t, obj.Checklist().PrimaryKey().IsTemp())
+	assert.False(t, obj.Checklist().PrimaryKey().IsZero())
+
+	// Test lazy loading
+	obj2, err := LoadChecklistItem(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadChecklistItem(ctx, obj.PrimaryKey(),
+		node.ChecklistItem().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	assert.Nil(t, obj2.Checklist(), "Checklist is not loaded initially")
+	v_Checklist, _ := obj2.LoadChecklist(ctx)
+	assert.NotNil(t, v_Checklist)
+	assert.Equal(t, v_Checklist.PrimaryKey(), obj2.Checklist().PrimaryKey())
+	assert.Equal(t, obj.Checklist().PrimaryKey(), obj2.Checklist().PrimaryKey())
+	assert.True(t, obj2.ChecklistIDIsLoaded())
+
+	assert.False(t, objPkOnly.ChecklistIDIsLoaded())
+	assert.Panics(t, func() { _, _ = objPkOnly.LoadChecklist(ctx) })
+
+	assert.Panics(t, func() {
+		objPkOnly.SetChecklist(nil)
+	})
+
+	// test eager loading
+	obj3, err3 := LoadChecklistItem(ctx, obj.PrimaryKey(), node.ChecklistItem().Checklist())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, obj2.Checklist().PrimaryKey(), obj3.Checklist().PrimaryKey())
+
+}
+
+func TestChecklistItem_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleChecklistItem(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklistItem(ctx, obj)
+
+	obj2, err := LoadChecklistItem(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleChecklistItem(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleChecklistItem(ctx, obj2)
+
+	obj3, err2 := LoadChecklistItem(ctx, obj2.PrimaryKey(), node.ChecklistItem().Checklist())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, obj2.Checklist().PrimaryKey(), obj3.Checklist().PrimaryKey())
+
+}
+
+func TestChecklistItem_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleChecklistItem(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklistItem(ctx, obj)
+
+	updateMinimalSampleChecklist(obj.Checklist())
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadChecklistItem(ctx, obj.PrimaryKey(), node.ChecklistItem().Checklist())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+	assertEqualFieldsChecklist(t, obj2.Checklist(), obj.Checklist())
+
+}
+func TestChecklistItem_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewChecklistItem()
+
+	assert.True(t, obj.ID().IsTemp())
+}
+
+func TestChecklistItem_Getters(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+
+	assert.True(t, obj.ID().IsTemp())
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklistItem(ctx, obj)
+
+	has, _ := HasChecklistItem(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadChecklistItem(ctx, obj.PrimaryKey(),
+		node.ChecklistItem().ID())
+
+	assert.Equal(t, obj.ID(), obj.Get(ChecklistItemIDField))
+	assert.Equal(t, obj.Name(), obj.Get(ChecklistItemNameField))
+	assert.Panics(t, func() { obj2.Name() })
+	assert.Nil(t, obj2.Get(ChecklistItemNameField))
+	assert.Equal(t, obj.Position(), obj.Get(ChecklistItemPositionField))
+	assert.Panics(t, func() { obj2.Position() })
+	assert.Nil(t, obj2.Get(ChecklistItemPositionField))
+	// Not loaded
+	assert.Nil(t, obj2.Checklist())
+	assert.Nil(t, obj2.Get(ChecklistItemChecklistField))
+	assert.Panics(t, func() { obj2.ChecklistID() })
+	assert.Nil(t, obj2.Get(ChecklistItemChecklistIDField))
+
+}
+
+func TestChecklistItem_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklistItem(ctx, obj)
+
+	objs, err := QueryChecklistItems(ctx).
+		Where(op.Equal(node.ChecklistItem().ID(), obj.ID())).
+		OrderBy(node.ChecklistItem().ID()). // exercise order by
+		Limit(1, 0).                        // exercise limit
+		Calculation(node.ChecklistItem(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestChecklistItem_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleChecklistItem(ctx, obj)
+
+	objs, _ := QueryChecklistItems(ctx).
+		Where(op.Equal(node.ChecklistItem().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*ChecklistItem).PrimaryKey())
+}
+func TestChecklistItem_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleChecklistItem(ctx, obj)
+
+	cursor, err := QueryChecklistItems(ctx).
+		Where(op.Equal(node.ChecklistItem().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QueryChecklistItems(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestChecklistItem_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleChecklistItem(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleChecklistItem(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountChecklistItems(ctx); return i }())
+
+	// reread in case there are data limitations imposed by the database
+	obj2, _ := LoadChecklistItem(ctx, obj.PrimaryKey())
+	assert.Positive(t,
+		func() int {
+			i, _ := CountChecklistItemsByChecklistID(ctx,
+				obj2.ChecklistID())
+			return i
+		}())
+
+}
+
+func TestChecklistItem_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewChecklistItem()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsChecklistItem(t, obj, obj2)
+}
+
+func TestChecklistItem_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewChecklistItem()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsChecklistItem(t, obj, obj2)
+}
+
+func TestChecklistItem_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+	var err error
+
+	for i := 0; i < 12; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 13; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestChecklistItem_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleChecklistItem()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewChecklistItem()
+	for i := 0; i < 12; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleChecklistItem()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewChecklistItem()
+	for i := 0; i < 13; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
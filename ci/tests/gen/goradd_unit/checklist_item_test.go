@@ -0,0 +1,52 @@
+package goradd_unit
+
+// This is the test file for the ChecklistItem ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecklistItem_String(t *testing.T) {
+	var obj *ChecklistItem
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewChecklistItem()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "ChecklistItem"))
+}
+
+func TestChecklistItem_Key(t *testing.T) {
+	var obj *ChecklistItem
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewChecklistItem()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestChecklistItem_Label(t *testing.T) {
+	var obj *ChecklistItem
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewChecklistItem()
+	s := obj.Label()
+	assert.Equal(t, "", s)
+}
+
+func TestChecklistItem_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleChecklistItem()
+	assert.NoError(t, obj.Save(ctx))
+	defer obj.Checklist().Delete(ctx)
+	assert.NoError(t, DeleteChecklistItem(ctx, obj.PrimaryKey()))
+	obj2, err := LoadChecklistItem(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
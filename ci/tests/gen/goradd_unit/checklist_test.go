@@ -0,0 +1,51 @@
+package goradd_unit
+
+// This is the test file for the Checklist ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecklist_String(t *testing.T) {
+	var obj *Checklist
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewChecklist()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "Checklist"))
+}
+
+func TestChecklist_Key(t *testing.T) {
+	var obj *Checklist
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewChecklist()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestChecklist_Label(t *testing.T) {
+	var obj *Checklist
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewChecklist()
+	s := obj.Label()
+	assert.Equal(t, "", s)
+}
+
+func TestChecklist_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleChecklist()
+	assert.NoError(t, obj.Save(ctx))
+	assert.NoError(t, DeleteChecklist(ctx, obj.PrimaryKey()))
+	obj2, err := LoadChecklist(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,480 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleComment creates an unsaved minimal version of a Comment object
+// for testing.
+func createMinimalSampleComment() *Comment {
+	obj := NewComment()
+	updateMinimalSampleComment(obj)
+
+	obj.SetCommentable(createMinimalSampleChecklist())
+
+	return obj
+}
+
+// updateMinimalSampleComment sets the values of a minimal sample to new, random values.
+func updateMinimalSampleComment(obj *Comment) {
+
+	obj.SetBody(test.RandomValue[string](200))
+
+}
+
+// createMaximalSampleComment creates an unsaved version of a Comment object
+// for testing that includes references to minimal objects.
+func createMaximalSampleComment(ctx context.Context) *Comment {
+	obj := NewComment()
+	updateMaximalSampleComment(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleComment sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleComment(ctx context.Context, obj *Comment) {
+	updateMinimalSampleComment(obj)
+	obj.SetCommentable(createMinimalSampleChecklist())
+
+}
+
+// deleteSampleComment deletes an object created and saved by one of the sample creator functions.
+func deleteSampleComment(ctx context.Context, obj *Comment) {
+	if obj == nil {
+		return
+	}
+
+	_ = obj.Delete(ctx)
+	switch v := obj.Commentable().(type) {
+	case *Checklist:
+		deleteSampleChecklist(ctx, v)
+	case *Playlist:
+		deleteSamplePlaylist(ctx, v)
+	}
+}
+
+// assertEqualFieldsComment compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsComment(t *testing.T, obj1, obj2 *Comment) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.BodyIsLoaded() && obj2.BodyIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Body(), obj2.Body())
+	}
+	if obj1.CommentableTypeIsLoaded() && obj2.CommentableTypeIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.CommentableType(), obj2.CommentableType())
+	}
+	if obj1.CommentableIDIsLoaded() && obj2.CommentableIDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.CommentableID(), obj2.CommentableID())
+	}
+
+}
+
+func TestComment_SetID(t *testing.T) {
+
+	obj := NewComment()
+
+	assert.True(t, obj.IsNew())
+	val := query.NewAutoPrimaryKey(test.RandomNumberString())
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.TempAutoPrimaryKey()
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestComment_SetBody(t *testing.T) {
+
+	obj := NewComment()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](200)
+	obj.SetBody(val)
+	assert.Equal(t, val, obj.Body())
+
+	// test default
+	var d string = ""
+	obj.SetBody(d)
+	assert.EqualValues(t, d, obj.Body(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](201)
+	assert.Panics(t, func() {
+		obj.SetBody(val)
+	})
+}
+func TestComment_SetCommentableType(t *testing.T) {
+
+	obj := NewComment()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](100)
+	obj.SetCommentableType(val)
+	assert.Equal(t, val, obj.CommentableType())
+
+	// test default
+	var d string = ""
+	obj.SetCommentableType(d)
+	assert.EqualValues(t, d, obj.CommentableType(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](101)
+	assert.Panics(t, func() {
+		obj.SetCommentableType(val)
+	})
+}
+func TestComment_SetCommentableID(t *testing.T) {
+
+	obj := NewComment()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[query.AutoPrimaryKey](32)
+	obj.SetCommentableID(val)
+	assert.Equal(t, val, obj.CommentableID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.AutoPrimaryKey{}
+	obj.SetCommentableID(d)
+	assert.EqualValues(t, d, obj.CommentableID(), "set default")
+
+}
+
+func TestComment_Copy(t *testing.T) {
+	obj := createMinimalSampleComment()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.Body(), obj2.Body())
+	assert.Equal(t, obj.CommentableType(), obj2.CommentableType())
+	assert.Equal(t, obj.CommentableID(), obj2.CommentableID())
+
+}
+
+func TestComment_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleComment()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleComment(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadComment(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.BodyIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.bodyIsDirty)
+	obj2.SetBody(obj2.Body())
+	assert.False(t, obj2.bodyIsDirty)
+
+	assert.True(t, obj2.CommentableTypeIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.commentableTypeIsDirty)
+	obj2.SetCommentableType(obj2.CommentableType())
+	assert.False(t, obj2.commentableTypeIsDirty)
+
+	assert.True(t, obj2.CommentableIDIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.commentableIDIsDirty)
+	obj2.SetCommentableID(obj2.CommentableID())
+	assert.False(t, obj2.commentableIDIsDirty)
+
+}
+
+func TestComment_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleComment()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.commentable = nil
+
+	obj.bodyIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.bodyIsLoaded = true
+
+	obj.commentableTypeIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.commentableTypeIsLoaded = true
+
+	obj.commentableIDIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.commentableIDIsLoaded = true
+
+}
+
+func TestComment_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleComment()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleComment(ctx, obj)
+	updateMinimalSampleComment(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadComment(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Body(), obj.Body(), "Body did not update")
+	assert.Equal(t, obj2.CommentableType(), obj.CommentableType(), "CommentableType did not update")
+	assert.Equal(t, obj2.CommentableID(), obj.CommentableID(), "CommentableID did not update")
+}
+
+func TestComment_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleComment(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleComment(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+
+	// Test lazy loading
+	obj2, err := LoadComment(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadComment(ctx, obj.PrimaryKey(),
+		node.Comment().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	// test eager loading
+	obj3, err3 := LoadComment(ctx, obj.PrimaryKey())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestComment_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleComment(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleComment(ctx, obj)
+
+	obj2, err := LoadComment(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleComment(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleComment(ctx, obj2)
+
+	obj3, err2 := LoadComment(ctx, obj2.PrimaryKey())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestComment_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleComment(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleComment(ctx, obj)
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadComment(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+}
+func TestComment_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewComment()
+
+	assert.True(t, obj.ID().IsTemp())
+}
+
+func TestComment_Getters(t *testing.T) {
+	obj := createMinimalSampleComment()
+
+	assert.True(t, obj.ID().IsTemp())
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleComment(ctx, obj)
+
+	has, _ := HasComment(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadComment(ctx, obj.PrimaryKey(),
+		node.Comment().ID())
+
+	assert.Equal(t, obj.ID(), obj.Get(CommentIDField))
+	assert.Equal(t, obj.Body(), obj.Get(CommentBodyField))
+	assert.Panics(t, func() { obj2.Body() })
+	assert.Nil(t, obj2.Get(CommentBodyField))
+	assert.Equal(t, obj.CommentableType(), obj.Get(CommentCommentableTypeField))
+	assert.Panics(t, func() { obj2.CommentableType() })
+	assert.Nil(t, obj2.Get(CommentCommentableTypeField))
+	assert.Equal(t, obj.CommentableID(), obj.Get(CommentCommentableIDField))
+	assert.Panics(t, func() { obj2.CommentableID() })
+	assert.Nil(t, obj2.Get(CommentCommentableIDField))
+
+}
+
+func TestComment_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleComment()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleComment(ctx, obj)
+
+	objs, err := QueryComments(ctx).
+		Where(op.Equal(node.Comment().ID(), obj.ID())).
+		OrderBy(node.Comment().ID()). // exercise order by
+		Limit(1, 0).                  // exercise limit
+		Calculation(node.Comment(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestComment_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleComment()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleComment(ctx, obj)
+
+	objs, _ := QueryComments(ctx).
+		Where(op.Equal(node.Comment().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*Comment).PrimaryKey())
+}
+func TestComment_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleComment()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleComment(ctx, obj)
+
+	cursor, err := QueryComments(ctx).
+		Where(op.Equal(node.Comment().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QueryComments(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestComment_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleComment(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleComment(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountComments(ctx); return i }())
+
+	// reread in case there are data limitations imposed by the database
+	obj2, _ := LoadComment(ctx, obj.PrimaryKey())
+	assert.Positive(t,
+		func() int {
+			i, _ := CountCommentsByCommentableTypeCommentableID(ctx,
+				obj2.CommentableType(),
+				obj2.CommentableID())
+			return i
+		}())
+
+}
+
+func TestComment_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleComment()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewComment()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsComment(t, obj, obj2)
+}
+
+func TestComment_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleComment()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewComment()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsComment(t, obj, obj2)
+}
+
+func TestComment_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleComment()
+	var err error
+
+	for i := 0; i < 15; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 16; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestComment_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleComment()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewComment()
+	for i := 0; i < 15; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleComment()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewComment()
+	for i := 0; i < 16; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,51 @@
+package goradd_unit
+
+// This is the test file for the Comment ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComment_String(t *testing.T) {
+	var obj *Comment
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewComment()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "Comment"))
+}
+
+func TestComment_Key(t *testing.T) {
+	var obj *Comment
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewComment()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestComment_Label(t *testing.T) {
+	var obj *Comment
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewComment()
+	s := obj.Label()
+	assert.True(t, strings.HasPrefix(s, "Comment"))
+}
+
+func TestComment_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleComment()
+	assert.NoError(t, obj.Save(ctx))
+	assert.NoError(t, DeleteComment(ctx, obj.PrimaryKey()))
+	obj2, err := LoadComment(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
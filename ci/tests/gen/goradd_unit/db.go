@@ -48,6 +48,7 @@ func ClearAll(ctx context.Context) {
 	d := Database()
 	db.WithConstraintsOff(ctx, d, func(ctx context.Context) error {
 		_ = d.DeleteWhere(ctx, "leaf_nl_assn", nil)
+		_ = d.DeleteWhere(ctx, "playlist_song_assn", nil)
 
 		_ = d.DeleteWhere(ctx, "leaf_unl", nil)
 		_ = d.DeleteWhere(ctx, "leaf_un", nil)
@@ -57,11 +58,17 @@ func ClearAll(ctx context.Context) {
 		_ = d.DeleteWhere(ctx, "leaf_n", nil)
 		_ = d.DeleteWhere(ctx, "leaf_l", nil)
 		_ = d.DeleteWhere(ctx, "leaf", nil)
+		_ = d.DeleteWhere(ctx, "comment", nil)
 		_ = d.DeleteWhere(ctx, "alt_leaf_un", nil)
+		_ = d.DeleteWhere(ctx, "vehicle", nil)
 		_ = d.DeleteWhere(ctx, "unsupported_type", nil)
 		_ = d.DeleteWhere(ctx, "type_test", nil)
 		_ = d.DeleteWhere(ctx, "two_key", nil)
+		_ = d.DeleteWhere(ctx, "truck", nil)
 		_ = d.DeleteWhere(ctx, "timeout_test", nil)
+		_ = d.DeleteWhere(ctx, "ticket", nil)
+		_ = d.DeleteWhere(ctx, "song_note", nil)
+		_ = d.DeleteWhere(ctx, "song", nil)
 		_ = d.DeleteWhere(ctx, "root_unl", nil)
 		_ = d.DeleteWhere(ctx, "root_un", nil)
 		_ = d.DeleteWhere(ctx, "root_ul", nil)
@@ -70,8 +77,13 @@ func ClearAll(ctx context.Context) {
 		_ = d.DeleteWhere(ctx, "root_n", nil)
 		_ = d.DeleteWhere(ctx, "root_l", nil)
 		_ = d.DeleteWhere(ctx, "root", nil)
+		_ = d.DeleteWhere(ctx, "playlist", nil)
 		_ = d.DeleteWhere(ctx, "multi_parent", nil)
 		_ = d.DeleteWhere(ctx, "double_index", nil)
+		_ = d.DeleteWhere(ctx, "checklist_item", nil)
+		_ = d.DeleteWhere(ctx, "checklist", nil)
+		_ = d.DeleteWhere(ctx, "category", nil)
+		_ = d.DeleteWhere(ctx, "car", nil)
 		_ = d.DeleteWhere(ctx, "auto_gen", nil)
 		_ = d.DeleteWhere(ctx, "alt_root_un", nil)
 		// Ignore errors, since one error generated might be that there is no data, which we don't care about.
@@ -188,19 +200,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write DoubleIndices
+	{ // Write Cars
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"double_index"`); err != nil {
+		if _, err := io.WriteString(writer, `"car"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryDoubleIndices(ctx).LoadCursor()
+		cursor, err := QueryCars(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -238,19 +250,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write MultiParents
+	{ // Write Categories
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"multi_parent"`); err != nil {
+		if _, err := io.WriteString(writer, `"category"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryMultiParents(ctx).LoadCursor()
+		cursor, err := QueryCategories(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -288,19 +300,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write Roots
+	{ // Write Checklists
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"root"`); err != nil {
+		if _, err := io.WriteString(writer, `"checklist"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryRoots(ctx).LoadCursor()
+		cursor, err := QueryChecklists(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -338,19 +350,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write RootLs
+	{ // Write ChecklistItems
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"root_l"`); err != nil {
+		if _, err := io.WriteString(writer, `"checklist_item"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryRootLs(ctx).LoadCursor()
+		cursor, err := QueryChecklistItems(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -388,19 +400,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write RootNs
+	{ // Write DoubleIndices
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"root_n"`); err != nil {
+		if _, err := io.WriteString(writer, `"double_index"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryRootNs(ctx).LoadCursor()
+		cursor, err := QueryDoubleIndices(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -438,19 +450,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write RootNls
+	{ // Write MultiParents
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"root_nl"`); err != nil {
+		if _, err := io.WriteString(writer, `"multi_parent"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryRootNls(ctx).LoadCursor()
+		cursor, err := QueryMultiParents(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -488,19 +500,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write RootUs
+	{ // Write Playlists
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"root_u"`); err != nil {
+		if _, err := io.WriteString(writer, `"playlist"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryRootUs(ctx).LoadCursor()
+		cursor, err := QueryPlaylists(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -538,19 +550,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write RootUls
+	{ // Write Roots
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"root_ul"`); err != nil {
+		if _, err := io.WriteString(writer, `"root"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryRootUls(ctx).LoadCursor()
+		cursor, err := QueryRoots(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -588,19 +600,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write RootUns
+	{ // Write RootLs
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"root_un"`); err != nil {
+		if _, err := io.WriteString(writer, `"root_l"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryRootUns(ctx).LoadCursor()
+		cursor, err := QueryRootLs(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -638,19 +650,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write RootUnls
+	{ // Write RootNs
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"root_unl"`); err != nil {
+		if _, err := io.WriteString(writer, `"root_n"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryRootUnls(ctx).LoadCursor()
+		cursor, err := QueryRootNs(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -688,19 +700,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write TimeoutTests
+	{ // Write RootNls
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"timeout_test"`); err != nil {
+		if _, err := io.WriteString(writer, `"root_nl"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryTimeoutTests(ctx).LoadCursor()
+		cursor, err := QueryRootNls(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -738,19 +750,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write TwoKeys
+	{ // Write RootUs
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"two_key"`); err != nil {
+		if _, err := io.WriteString(writer, `"root_u"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryTwoKeys(ctx).LoadCursor()
+		cursor, err := QueryRootUs(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -788,19 +800,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write TypeTests
+	{ // Write RootUls
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"type_test"`); err != nil {
+		if _, err := io.WriteString(writer, `"root_ul"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryTypeTests(ctx).LoadCursor()
+		cursor, err := QueryRootUls(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -838,19 +850,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write UnsupportedTypes
+	{ // Write RootUns
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"unsupported_type"`); err != nil {
+		if _, err := io.WriteString(writer, `"root_un"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryUnsupportedTypes(ctx).LoadCursor()
+		cursor, err := QueryRootUns(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -888,19 +900,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write AltLeafUns
+	{ // Write RootUnls
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"alt_leaf_un"`); err != nil {
+		if _, err := io.WriteString(writer, `"root_unl"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryAltLeafUns(ctx).LoadCursor()
+		cursor, err := QueryRootUnls(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -938,19 +950,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write Leafs
+	{ // Write Songs
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"leaf"`); err != nil {
+		if _, err := io.WriteString(writer, `"song"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryLeafs(ctx).LoadCursor()
+		cursor, err := QuerySongs(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -988,19 +1000,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write LeafLs
+	{ // Write SongNotes
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"leaf_l"`); err != nil {
+		if _, err := io.WriteString(writer, `"song_note"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryLeafLs(ctx).LoadCursor()
+		cursor, err := QuerySongNotes(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -1038,19 +1050,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write LeafNs
+	{ // Write Tickets
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"leaf_n"`); err != nil {
+		if _, err := io.WriteString(writer, `"ticket"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryLeafNs(ctx).LoadCursor()
+		cursor, err := QueryTickets(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -1088,19 +1100,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write LeafNls
+	{ // Write TimeoutTests
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"leaf_nl"`); err != nil {
+		if _, err := io.WriteString(writer, `"timeout_test"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryLeafNls(ctx).LoadCursor()
+		cursor, err := QueryTimeoutTests(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -1138,19 +1150,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write LeafUs
+	{ // Write Trucks
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"leaf_u"`); err != nil {
+		if _, err := io.WriteString(writer, `"truck"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryLeafUs(ctx).LoadCursor()
+		cursor, err := QueryTrucks(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -1188,19 +1200,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write LeafUls
+	{ // Write TwoKeys
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"leaf_ul"`); err != nil {
+		if _, err := io.WriteString(writer, `"two_key"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryLeafUls(ctx).LoadCursor()
+		cursor, err := QueryTwoKeys(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -1238,19 +1250,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write LeafUns
+	{ // Write TypeTests
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"leaf_un"`); err != nil {
+		if _, err := io.WriteString(writer, `"type_test"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryLeafUns(ctx).LoadCursor()
+		cursor, err := QueryTypeTests(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -1288,19 +1300,19 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
-	{ // Write LeafUnls
+	{ // Write UnsupportedTypes
 		if _, err := io.WriteString(writer, "["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		if _, err := io.WriteString(writer, `"leaf_unl"`); err != nil {
+		if _, err := io.WriteString(writer, `"unsupported_type"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := QueryLeafUnls(ctx).LoadCursor()
+		cursor, err := QueryUnsupportedTypes(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
@@ -1331,109 +1343,704 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
 		if _, err := io.WriteString(writer, "\n"); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 	}
+	{ // Write Vehicles
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
 
-	db := Database()
-	{ // Write leaf_nl_assn
-		if _, err := io.WriteString(writer, ",\n["); err != nil {
+		if _, err := io.WriteString(writer, `"vehicle"`); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
-		if _, err := io.WriteString(writer, `"leaf_nl_assn",[`); err != nil {
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
 
-		cursor, err := db.Query(ctx, "leaf_nl_assn",
-			map[string]query.ReceiverType{
-				"leaf_2_id": query.ColTypeAutoPrimaryKey,
-				"leaf_1_id": query.ColTypeAutoPrimaryKey,
-			},
-			nil,
-			[]string{"leaf_2_id", "leaf_1_id"})
+		cursor, err := QueryVehicles(ctx).LoadCursor()
 		if err != nil {
 			return fmt.Errorf("query error: %w", err)
 		}
-		if rec, err2 := cursor.Next(); err2 != nil {
-			return fmt.Errorf("database cursor error: %w", err2)
-		} else if rec != nil {
-			if err = encoder.Encode(rec); err != nil {
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
 				return fmt.Errorf("encoding error: %w", err)
 			}
 		}
-		for {
-			rec, err2 := cursor.Next()
-			if err2 != nil {
-				return fmt.Errorf("database cursor error: %w", err2)
-			}
-			if rec == nil {
-				break
-			}
 
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
 			if _, err := io.WriteString(writer, ",\n"); err != nil {
 				return fmt.Errorf("writer error: %w", err)
 			}
-			if err := encoder.Encode(rec); err != nil {
+			if err := encoder.Encode(obj); err != nil {
 				return fmt.Errorf("encoding error: %w", err)
 			}
 		}
-		if _, err := io.WriteString(writer, `]]`); err != nil {
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
 			return fmt.Errorf("writer error: %w", err)
 		}
-	}
 
-	if _, err := io.WriteString(writer, "]"); err != nil {
-		return fmt.Errorf("writer error: %w", err)
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
 	}
-	return nil
-}
-
-// JsonDecodeAll imports the entire database from JSON that was created using JsonEncodeAll.
-// This is done within a transaction and with constraints off in case there are circular references.
-func JsonDecodeAll(ctx context.Context, reader io.Reader) error {
-	database := Database()
-	return db.WithConstraintsOff(ctx, database, func(ctx context.Context) error {
-		return jsonDecodeAll(ctx, reader)
-	})
-}
+	{ // Write AltLeafUns
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
 
-func jsonDecodeAll(ctx context.Context, reader io.Reader) error {
-	decoder := json.NewDecoder(reader)
+		if _, err := io.WriteString(writer, `"alt_leaf_un"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
 
-	token, err := decoder.Token()
-	if err != nil {
-		return fmt.Errorf("error reading opening token: %w", err)
-	}
-	// Ensure the first token is a start of an array
-	if delim, ok := token.(json.Delim); !ok || delim != '[' {
-		return fmt.Errorf("expected the JSON to start with an array in jsonDecodeAll")
-	}
+		cursor, err := QueryAltLeafUns(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
 
-	for decoder.More() {
-		if err := jsonDecodeTable(ctx, decoder); err != nil {
-			return err
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
 		}
-	}
 
-	// Check if the last token is the end of the array
-	token, err = decoder.Token()
-	if err != nil {
-		return fmt.Errorf("Error reading the last token: %w", err)
-	}
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
 
-	if delim, ok := token.(json.Delim); !ok || delim != ']' {
-		fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeAll")
-		return err
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
 	}
+	{ // Write Comments
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
 
-	return nil
-}
+		if _, err := io.WriteString(writer, `"comment"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
 
-func jsonDecodeTable(ctx context.Context, decoder *json.Decoder) error {
-	token, err := decoder.Token()
-	if err != nil {
-		return fmt.Errorf("error reading opening token: %w", err)
+		cursor, err := QueryComments(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+	{ // Write Leafs
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, `"leaf"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := QueryLeafs(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+	{ // Write LeafLs
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, `"leaf_l"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := QueryLeafLs(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+	{ // Write LeafNs
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, `"leaf_n"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := QueryLeafNs(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+	{ // Write LeafNls
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, `"leaf_nl"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := QueryLeafNls(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+	{ // Write LeafUs
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, `"leaf_u"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := QueryLeafUs(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+	{ // Write LeafUls
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, `"leaf_ul"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := QueryLeafUls(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+	{ // Write LeafUns
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, `"leaf_un"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := QueryLeafUns(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, ","); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+	{ // Write LeafUnls
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, `"leaf_unl"`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := QueryLeafUnls(ctx).LoadCursor()
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		defer cursor.Close()
+		obj, err2 := cursor.Next()
+		if err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+		if obj != nil {
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+
+		for obj, err = cursor.Next(); obj != nil && err == nil; obj, err = cursor.Next() {
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(obj); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("database cursor error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "]\n]"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		if _, err := io.WriteString(writer, "\n"); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+
+	db := Database()
+	{ // Write leaf_nl_assn
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, `"leaf_nl_assn",[`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := db.Query(ctx, "leaf_nl_assn",
+			map[string]query.ReceiverType{
+				"leaf_2_id": query.ColTypeAutoPrimaryKey,
+				"leaf_1_id": query.ColTypeAutoPrimaryKey,
+			},
+			nil,
+			[]string{"leaf_2_id", "leaf_1_id"})
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		if rec, err2 := cursor.Next(); err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err2)
+		} else if rec != nil {
+			if err = encoder.Encode(rec); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		for {
+			rec, err2 := cursor.Next()
+			if err2 != nil {
+				return fmt.Errorf("database cursor error: %w", err2)
+			}
+			if rec == nil {
+				break
+			}
+
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(rec); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if _, err := io.WriteString(writer, `]]`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+	{ // Write playlist_song_assn
+		if _, err := io.WriteString(writer, ",\n["); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+		if _, err := io.WriteString(writer, `"playlist_song_assn",[`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+
+		cursor, err := db.Query(ctx, "playlist_song_assn",
+			map[string]query.ReceiverType{
+				"song_id":     query.ColTypeAutoPrimaryKey,
+				"playlist_id": query.ColTypeAutoPrimaryKey,
+			},
+			nil,
+			[]string{"song_id", "playlist_id"})
+		if err != nil {
+			return fmt.Errorf("query error: %w", err)
+		}
+		if rec, err2 := cursor.Next(); err2 != nil {
+			return fmt.Errorf("database cursor error: %w", err2)
+		} else if rec != nil {
+			if err = encoder.Encode(rec); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		for {
+			rec, err2 := cursor.Next()
+			if err2 != nil {
+				return fmt.Errorf("database cursor error: %w", err2)
+			}
+			if rec == nil {
+				break
+			}
+
+			if _, err := io.WriteString(writer, ",\n"); err != nil {
+				return fmt.Errorf("writer error: %w", err)
+			}
+			if err := encoder.Encode(rec); err != nil {
+				return fmt.Errorf("encoding error: %w", err)
+			}
+		}
+		if _, err := io.WriteString(writer, `]]`); err != nil {
+			return fmt.Errorf("writer error: %w", err)
+		}
+	}
+
+	if _, err := io.WriteString(writer, "]"); err != nil {
+		return fmt.Errorf("writer error: %w", err)
+	}
+	return nil
+}
+
+// JsonDecodeAll imports the entire database from JSON that was created using JsonEncodeAll.
+// This is done within a transaction and with constraints off in case there are circular references.
+func JsonDecodeAll(ctx context.Context, reader io.Reader) error {
+	database := Database()
+	return db.WithConstraintsOff(ctx, database, func(ctx context.Context) error {
+		return jsonDecodeAll(ctx, reader)
+	})
+}
+
+func jsonDecodeAll(ctx context.Context, reader io.Reader) error {
+	decoder := json.NewDecoder(reader)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the JSON to start with an array in jsonDecodeAll")
+	}
+
+	for decoder.More() {
+		if err := jsonDecodeTable(ctx, decoder); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("Error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeAll")
+		return err
+	}
+
+	return nil
+}
+
+func jsonDecodeTable(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
 	}
 	// Ensure the first token is a start of an array
 	if delim, ok := token.(json.Delim); !ok || delim != '[' {
@@ -1456,10 +2063,20 @@ func jsonDecodeTable(ctx context.Context, decoder *json.Decoder) error {
 			err = jsonDecodeAltRootUns(ctx, decoder)
 		case "auto_gen":
 			err = jsonDecodeAutoGens(ctx, decoder)
+		case "car":
+			err = jsonDecodeCars(ctx, decoder)
+		case "category":
+			err = jsonDecodeCategories(ctx, decoder)
+		case "checklist":
+			err = jsonDecodeChecklists(ctx, decoder)
+		case "checklist_item":
+			err = jsonDecodeChecklistItems(ctx, decoder)
 		case "double_index":
 			err = jsonDecodeDoubleIndices(ctx, decoder)
 		case "multi_parent":
 			err = jsonDecodeMultiParents(ctx, decoder)
+		case "playlist":
+			err = jsonDecodePlaylists(ctx, decoder)
 		case "root":
 			err = jsonDecodeRoots(ctx, decoder)
 		case "root_l":
@@ -1476,16 +2093,28 @@ func jsonDecodeTable(ctx context.Context, decoder *json.Decoder) error {
 			err = jsonDecodeRootUns(ctx, decoder)
 		case "root_unl":
 			err = jsonDecodeRootUnls(ctx, decoder)
+		case "song":
+			err = jsonDecodeSongs(ctx, decoder)
+		case "song_note":
+			err = jsonDecodeSongNotes(ctx, decoder)
+		case "ticket":
+			err = jsonDecodeTickets(ctx, decoder)
 		case "timeout_test":
 			err = jsonDecodeTimeoutTests(ctx, decoder)
+		case "truck":
+			err = jsonDecodeTrucks(ctx, decoder)
 		case "two_key":
 			err = jsonDecodeTwoKeys(ctx, decoder)
 		case "type_test":
 			err = jsonDecodeTypeTests(ctx, decoder)
 		case "unsupported_type":
 			err = jsonDecodeUnsupportedTypes(ctx, decoder)
+		case "vehicle":
+			err = jsonDecodeVehicles(ctx, decoder)
 		case "alt_leaf_un":
 			err = jsonDecodeAltLeafUns(ctx, decoder)
+		case "comment":
+			err = jsonDecodeComments(ctx, decoder)
 		case "leaf":
 			err = jsonDecodeLeafs(ctx, decoder)
 		case "leaf_l":
@@ -1504,10 +2133,141 @@ func jsonDecodeTable(ctx context.Context, decoder *json.Decoder) error {
 			err = jsonDecodeLeafUnls(ctx, decoder)
 		case "leaf_nl_assn":
 			err = jsonDecodeLeafNlAssn(ctx, decoder)
+		case "playlist_song_assn":
+			err = jsonDecodePlaylistSongAssn(ctx, decoder)
 		default:
 			return fmt.Errorf("unknown table: %s", tableName)
 		}
-		if err != nil {
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeTable for table %s", tableName)
+	}
+
+	return nil
+}
+
+func jsonDecodeAltRootUns(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the AltRootUn list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewAltRootUn()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeAltRootUns")
+	}
+
+	return nil
+}
+func jsonDecodeAutoGens(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the AutoGen list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewAutoGen()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeAutoGens")
+	}
+
+	return nil
+}
+func jsonDecodeCars(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the Car list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewCar()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeCars")
+	}
+
+	return nil
+}
+func jsonDecodeCategories(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the Category list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewCategory()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
 			return err
 		}
 	}
@@ -1519,24 +2279,23 @@ func jsonDecodeTable(ctx context.Context, decoder *json.Decoder) error {
 	}
 
 	if delim, ok := token.(json.Delim); !ok || delim != ']' {
-		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeTable for table %s", tableName)
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeCategories")
 	}
 
 	return nil
 }
-
-func jsonDecodeAltRootUns(ctx context.Context, decoder *json.Decoder) error {
+func jsonDecodeChecklists(ctx context.Context, decoder *json.Decoder) error {
 	token, err := decoder.Token()
 	if err != nil {
 		return fmt.Errorf("error reading opening token: %w", err)
 	}
 	// Ensure the first token is a start of an array
 	if delim, ok := token.(json.Delim); !ok || delim != '[' {
-		return fmt.Errorf("expected the AltRootUn list to start with an array")
+		return fmt.Errorf("expected the Checklist list to start with an array")
 	}
 
 	for decoder.More() {
-		obj := NewAltRootUn()
+		obj := NewChecklist()
 		if err = decoder.Decode(&obj); err != nil {
 			return err
 		}
@@ -1552,23 +2311,23 @@ func jsonDecodeAltRootUns(ctx context.Context, decoder *json.Decoder) error {
 	}
 
 	if delim, ok := token.(json.Delim); !ok || delim != ']' {
-		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeAltRootUns")
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeChecklists")
 	}
 
 	return nil
 }
-func jsonDecodeAutoGens(ctx context.Context, decoder *json.Decoder) error {
+func jsonDecodeChecklistItems(ctx context.Context, decoder *json.Decoder) error {
 	token, err := decoder.Token()
 	if err != nil {
 		return fmt.Errorf("error reading opening token: %w", err)
 	}
 	// Ensure the first token is a start of an array
 	if delim, ok := token.(json.Delim); !ok || delim != '[' {
-		return fmt.Errorf("expected the AutoGen list to start with an array")
+		return fmt.Errorf("expected the ChecklistItem list to start with an array")
 	}
 
 	for decoder.More() {
-		obj := NewAutoGen()
+		obj := NewChecklistItem()
 		if err = decoder.Decode(&obj); err != nil {
 			return err
 		}
@@ -1584,7 +2343,7 @@ func jsonDecodeAutoGens(ctx context.Context, decoder *json.Decoder) error {
 	}
 
 	if delim, ok := token.(json.Delim); !ok || delim != ']' {
-		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeAutoGens")
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeChecklistItems")
 	}
 
 	return nil
@@ -1653,6 +2412,38 @@ func jsonDecodeMultiParents(ctx context.Context, decoder *json.Decoder) error {
 
 	return nil
 }
+func jsonDecodePlaylists(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the Playlist list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewPlaylist()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodePlaylists")
+	}
+
+	return nil
+}
 func jsonDecodeRoots(ctx context.Context, decoder *json.Decoder) error {
 	token, err := decoder.Token()
 	if err != nil {
@@ -1909,6 +2700,102 @@ func jsonDecodeRootUnls(ctx context.Context, decoder *json.Decoder) error {
 
 	return nil
 }
+func jsonDecodeSongs(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the Song list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewSong()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeSongs")
+	}
+
+	return nil
+}
+func jsonDecodeSongNotes(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the SongNote list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewSongNote()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeSongNotes")
+	}
+
+	return nil
+}
+func jsonDecodeTickets(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the Ticket list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewTicket()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeTickets")
+	}
+
+	return nil
+}
 func jsonDecodeTimeoutTests(ctx context.Context, decoder *json.Decoder) error {
 	token, err := decoder.Token()
 	if err != nil {
@@ -1941,6 +2828,38 @@ func jsonDecodeTimeoutTests(ctx context.Context, decoder *json.Decoder) error {
 
 	return nil
 }
+func jsonDecodeTrucks(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the Truck list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewTruck()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeTrucks")
+	}
+
+	return nil
+}
 func jsonDecodeTwoKeys(ctx context.Context, decoder *json.Decoder) error {
 	token, err := decoder.Token()
 	if err != nil {
@@ -2037,6 +2956,38 @@ func jsonDecodeUnsupportedTypes(ctx context.Context, decoder *json.Decoder) erro
 
 	return nil
 }
+func jsonDecodeVehicles(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the Vehicle list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewVehicle()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeVehicles")
+	}
+
+	return nil
+}
 func jsonDecodeAltLeafUns(ctx context.Context, decoder *json.Decoder) error {
 	token, err := decoder.Token()
 	if err != nil {
@@ -2069,6 +3020,38 @@ func jsonDecodeAltLeafUns(ctx context.Context, decoder *json.Decoder) error {
 
 	return nil
 }
+func jsonDecodeComments(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected the Comment list to start with an array")
+	}
+
+	for decoder.More() {
+		obj := NewComment()
+		if err = decoder.Decode(&obj); err != nil {
+			return err
+		}
+		if err = obj.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodeComments")
+	}
+
+	return nil
+}
 func jsonDecodeLeafs(ctx context.Context, decoder *json.Decoder) error {
 	token, err := decoder.Token()
 	if err != nil {
@@ -2361,3 +3344,38 @@ func jsonDecodeLeafNlAssn(ctx context.Context, decoder *json.Decoder) error {
 
 	return nil
 }
+func jsonDecodePlaylistSongAssn(ctx context.Context, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("Error reading opening token: %w", err)
+	}
+	// Ensure the first token is a start of an array
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("Error: Expected the PlaylistSongAssn list to start with an array")
+	}
+
+	database := Database()
+	for decoder.More() {
+		var imp struct {
+			Src  query.AutoPrimaryKey `json:"song_id"`
+			Dest query.AutoPrimaryKey `json:"playlist_id"`
+		}
+
+		if err = decoder.Decode(&imp); err != nil {
+			return err
+		}
+		db.Associate(ctx, database, "playlist_song_assn", "song_id", imp.Src, "playlist_id", imp.Dest)
+	}
+
+	// Check if the last token is the end of the array
+	token, err = decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error reading the last token: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != ']' {
+		return fmt.Errorf("expected the JSON to end with a closing array token in jsonDecodePlaylistSongAssn")
+	}
+
+	return nil
+}
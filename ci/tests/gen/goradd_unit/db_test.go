@@ -56,12 +56,27 @@ func TestDbJson(t *testing.T) {
 	v_AutoGen, _ := QueryAutoGens(ctx).
 		OrderBy(node.AutoGen().ID()).
 		Get() // gets first record
+	v_Car, _ := QueryCars(ctx).
+		OrderBy(node.Car().ID()).
+		Get() // gets first record
+	v_Category, _ := QueryCategories(ctx).
+		OrderBy(node.Category().ID()).
+		Get() // gets first record
+	v_Checklist, _ := QueryChecklists(ctx).
+		OrderBy(node.Checklist().ID()).
+		Get() // gets first record
+	v_ChecklistItem, _ := QueryChecklistItems(ctx).
+		OrderBy(node.ChecklistItem().ID()).
+		Get() // gets first record
 	v_DoubleIndex, _ := QueryDoubleIndices(ctx).
 		OrderBy(node.DoubleIndex().ID()).
 		Get() // gets first record
 	v_MultiParent, _ := QueryMultiParents(ctx).
 		OrderBy(node.MultiParent().ID()).
 		Get() // gets first record
+	v_Playlist, _ := QueryPlaylists(ctx).
+		OrderBy(node.Playlist().ID()).
+		Get() // gets first record
 	v_Root, _ := QueryRoots(ctx).
 		OrderBy(node.Root().ID()).
 		Get() // gets first record
@@ -86,9 +101,21 @@ func TestDbJson(t *testing.T) {
 	v_RootUnl, _ := QueryRootUnls(ctx).
 		OrderBy(node.RootUnl().ID()).
 		Get() // gets first record
+	v_Song, _ := QuerySongs(ctx).
+		OrderBy(node.Song().ID()).
+		Get() // gets first record
+	v_SongNote, _ := QuerySongNotes(ctx).
+		OrderBy(node.SongNote().ID()).
+		Get() // gets first record
+	v_Ticket, _ := QueryTickets(ctx).
+		OrderBy(node.Ticket().ID()).
+		Get() // gets first record
 	v_TimeoutTest, _ := QueryTimeoutTests(ctx).
 		OrderBy(node.TimeoutTest().ID()).
 		Get() // gets first record
+	v_Truck, _ := QueryTrucks(ctx).
+		OrderBy(node.Truck().ID()).
+		Get() // gets first record
 	v_TwoKey, _ := QueryTwoKeys(ctx).
 		OrderBy(node.TwoKey().Server(), node.TwoKey().Directory()).
 		Get() // gets first record
@@ -98,9 +125,15 @@ func TestDbJson(t *testing.T) {
 	v_UnsupportedType, _ := QueryUnsupportedTypes(ctx).
 		OrderBy(node.UnsupportedType().TypeSerial()).
 		Get() // gets first record
+	v_Vehicle, _ := QueryVehicles(ctx).
+		OrderBy(node.Vehicle().ID()).
+		Get() // gets first record
 	v_AltLeafUn, _ := QueryAltLeafUns(ctx).
 		OrderBy(node.AltLeafUn().ID()).
 		Get() // gets first record
+	v_Comment, _ := QueryComments(ctx).
+		OrderBy(node.Comment().ID()).
+		Get() // gets first record
 	v_Leaf, _ := QueryLeafs(ctx).
 		OrderBy(node.Leaf().ID()).
 		Get() // gets first record
@@ -127,8 +160,13 @@ func TestDbJson(t *testing.T) {
 		Get() // gets first record
 	v_AltRootUnCount, _ := CountAltRootUns(ctx)
 	v_AutoGenCount, _ := CountAutoGens(ctx)
+	v_CarCount, _ := CountCars(ctx)
+	v_CategoryCount, _ := CountCategories(ctx)
+	v_ChecklistCount, _ := CountChecklists(ctx)
+	v_ChecklistItemCount, _ := CountChecklistItems(ctx)
 	v_DoubleIndexCount, _ := CountDoubleIndices(ctx)
 	v_MultiParentCount, _ := CountMultiParents(ctx)
+	v_PlaylistCount, _ := CountPlaylists(ctx)
 	v_RootCount, _ := CountRoots(ctx)
 	v_RootLCount, _ := CountRootLs(ctx)
 	v_RootNCount, _ := CountRootNs(ctx)
@@ -137,11 +175,17 @@ func TestDbJson(t *testing.T) {
 	v_RootUlCount, _ := CountRootUls(ctx)
 	v_RootUnCount, _ := CountRootUns(ctx)
 	v_RootUnlCount, _ := CountRootUnls(ctx)
+	v_SongCount, _ := CountSongs(ctx)
+	v_SongNoteCount, _ := CountSongNotes(ctx)
+	v_TicketCount, _ := CountTickets(ctx)
 	v_TimeoutTestCount, _ := CountTimeoutTests(ctx)
+	v_TruckCount, _ := CountTrucks(ctx)
 	v_TwoKeyCount, _ := CountTwoKeys(ctx)
 	v_TypeTestCount, _ := CountTypeTests(ctx)
 	v_UnsupportedTypeCount, _ := CountUnsupportedTypes(ctx)
+	v_VehicleCount, _ := CountVehicles(ctx)
 	v_AltLeafUnCount, _ := CountAltLeafUns(ctx)
+	v_CommentCount, _ := CountComments(ctx)
 	v_LeafCount, _ := CountLeafs(ctx)
 	v_LeafLCount, _ := CountLeafLs(ctx)
 	v_LeafNCount, _ := CountLeafNs(ctx)
@@ -158,8 +202,13 @@ func TestDbJson(t *testing.T) {
 	ClearAll(ctx)
 	assert.Equal(t, 0, func() int { i, _ := CountAltRootUns(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountAutoGens(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountCars(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountCategories(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountChecklists(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountChecklistItems(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountDoubleIndices(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountMultiParents(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountPlaylists(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountRoots(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountRootLs(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountRootNs(ctx); return i }())
@@ -168,11 +217,17 @@ func TestDbJson(t *testing.T) {
 	assert.Equal(t, 0, func() int { i, _ := CountRootUls(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountRootUns(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountRootUnls(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountSongs(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountSongNotes(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountTickets(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountTimeoutTests(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountTrucks(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountTwoKeys(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountTypeTests(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountUnsupportedTypes(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountVehicles(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountAltLeafUns(ctx); return i }())
+	assert.Equal(t, 0, func() int { i, _ := CountComments(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountLeafs(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountLeafLs(ctx); return i }())
 	assert.Equal(t, 0, func() int { i, _ := CountLeafNs(ctx); return i }())
@@ -197,6 +252,30 @@ func TestDbJson(t *testing.T) {
 			Get()
 		assertEqualFieldsAutoGen(t, v_AutoGen, obj)
 	}
+	if v_Car != nil {
+		obj, _ := QueryCars(ctx).
+			OrderBy(node.Car().ID()).
+			Get()
+		assertEqualFieldsCar(t, v_Car, obj)
+	}
+	if v_Category != nil {
+		obj, _ := QueryCategories(ctx).
+			OrderBy(node.Category().ID()).
+			Get()
+		assertEqualFieldsCategory(t, v_Category, obj)
+	}
+	if v_Checklist != nil {
+		obj, _ := QueryChecklists(ctx).
+			OrderBy(node.Checklist().ID()).
+			Get()
+		assertEqualFieldsChecklist(t, v_Checklist, obj)
+	}
+	if v_ChecklistItem != nil {
+		obj, _ := QueryChecklistItems(ctx).
+			OrderBy(node.ChecklistItem().ID()).
+			Get()
+		assertEqualFieldsChecklistItem(t, v_ChecklistItem, obj)
+	}
 	if v_DoubleIndex != nil {
 		obj, _ := QueryDoubleIndices(ctx).
 			OrderBy(node.DoubleIndex().ID()).
@@ -209,6 +288,12 @@ func TestDbJson(t *testing.T) {
 			Get()
 		assertEqualFieldsMultiParent(t, v_MultiParent, obj)
 	}
+	if v_Playlist != nil {
+		obj, _ := QueryPlaylists(ctx).
+			OrderBy(node.Playlist().ID()).
+			Get()
+		assertEqualFieldsPlaylist(t, v_Playlist, obj)
+	}
 	if v_Root != nil {
 		obj, _ := QueryRoots(ctx).
 			OrderBy(node.Root().ID()).
@@ -257,8 +342,32 @@ func TestDbJson(t *testing.T) {
 			Get()
 		assertEqualFieldsRootUnl(t, v_RootUnl, obj)
 	}
+	if v_Song != nil {
+		obj, _ := QuerySongs(ctx).
+			OrderBy(node.Song().ID()).
+			Get()
+		assertEqualFieldsSong(t, v_Song, obj)
+	}
+	if v_SongNote != nil {
+		obj, _ := QuerySongNotes(ctx).
+			OrderBy(node.SongNote().ID()).
+			Get()
+		assertEqualFieldsSongNote(t, v_SongNote, obj)
+	}
+	if v_Ticket != nil {
+		obj, _ := QueryTickets(ctx).
+			OrderBy(node.Ticket().ID()).
+			Get()
+		assertEqualFieldsTicket(t, v_Ticket, obj)
+	}
 	if v_TimeoutTest != nil {
 	}
+	if v_Truck != nil {
+		obj, _ := QueryTrucks(ctx).
+			OrderBy(node.Truck().ID()).
+			Get()
+		assertEqualFieldsTruck(t, v_Truck, obj)
+	}
 	if v_TwoKey != nil {
 		obj, _ := QueryTwoKeys(ctx).
 			OrderBy(node.TwoKey().Server(), node.TwoKey().Directory()).
@@ -277,12 +386,24 @@ func TestDbJson(t *testing.T) {
 			Get()
 		assertEqualFieldsUnsupportedType(t, v_UnsupportedType, obj)
 	}
+	if v_Vehicle != nil {
+		obj, _ := QueryVehicles(ctx).
+			OrderBy(node.Vehicle().ID()).
+			Get()
+		assertEqualFieldsVehicle(t, v_Vehicle, obj)
+	}
 	if v_AltLeafUn != nil {
 		obj, _ := QueryAltLeafUns(ctx).
 			OrderBy(node.AltLeafUn().ID()).
 			Get()
 		assertEqualFieldsAltLeafUn(t, v_AltLeafUn, obj)
 	}
+	if v_Comment != nil {
+		obj, _ := QueryComments(ctx).
+			OrderBy(node.Comment().ID()).
+			Get()
+		assertEqualFieldsComment(t, v_Comment, obj)
+	}
 	if v_Leaf != nil {
 		obj, _ := QueryLeafs(ctx).
 			OrderBy(node.Leaf().ID()).
@@ -333,8 +454,13 @@ func TestDbJson(t *testing.T) {
 	}
 	assert.Equal(t, v_AltRootUnCount, func() int { i, _ := CountAltRootUns(ctx); return i }())
 	assert.Equal(t, v_AutoGenCount, func() int { i, _ := CountAutoGens(ctx); return i }())
+	assert.Equal(t, v_CarCount, func() int { i, _ := CountCars(ctx); return i }())
+	assert.Equal(t, v_CategoryCount, func() int { i, _ := CountCategories(ctx); return i }())
+	assert.Equal(t, v_ChecklistCount, func() int { i, _ := CountChecklists(ctx); return i }())
+	assert.Equal(t, v_ChecklistItemCount, func() int { i, _ := CountChecklistItems(ctx); return i }())
 	assert.Equal(t, v_DoubleIndexCount, func() int { i, _ := CountDoubleIndices(ctx); return i }())
 	assert.Equal(t, v_MultiParentCount, func() int { i, _ := CountMultiParents(ctx); return i }())
+	assert.Equal(t, v_PlaylistCount, func() int { i, _ := CountPlaylists(ctx); return i }())
 	assert.Equal(t, v_RootCount, func() int { i, _ := CountRoots(ctx); return i }())
 	assert.Equal(t, v_RootLCount, func() int { i, _ := CountRootLs(ctx); return i }())
 	assert.Equal(t, v_RootNCount, func() int { i, _ := CountRootNs(ctx); return i }())
@@ -343,11 +469,17 @@ func TestDbJson(t *testing.T) {
 	assert.Equal(t, v_RootUlCount, func() int { i, _ := CountRootUls(ctx); return i }())
 	assert.Equal(t, v_RootUnCount, func() int { i, _ := CountRootUns(ctx); return i }())
 	assert.Equal(t, v_RootUnlCount, func() int { i, _ := CountRootUnls(ctx); return i }())
+	assert.Equal(t, v_SongCount, func() int { i, _ := CountSongs(ctx); return i }())
+	assert.Equal(t, v_SongNoteCount, func() int { i, _ := CountSongNotes(ctx); return i }())
+	assert.Equal(t, v_TicketCount, func() int { i, _ := CountTickets(ctx); return i }())
 	assert.Equal(t, v_TimeoutTestCount, func() int { i, _ := CountTimeoutTests(ctx); return i }())
+	assert.Equal(t, v_TruckCount, func() int { i, _ := CountTrucks(ctx); return i }())
 	assert.Equal(t, v_TwoKeyCount, func() int { i, _ := CountTwoKeys(ctx); return i }())
 	assert.Equal(t, v_TypeTestCount, func() int { i, _ := CountTypeTests(ctx); return i }())
 	assert.Equal(t, v_UnsupportedTypeCount, func() int { i, _ := CountUnsupportedTypes(ctx); return i }())
+	assert.Equal(t, v_VehicleCount, func() int { i, _ := CountVehicles(ctx); return i }())
 	assert.Equal(t, v_AltLeafUnCount, func() int { i, _ := CountAltLeafUns(ctx); return i }())
+	assert.Equal(t, v_CommentCount, func() int { i, _ := CountComments(ctx); return i }())
 	assert.Equal(t, v_LeafCount, func() int { i, _ := CountLeafs(ctx); return i }())
 	assert.Equal(t, v_LeafLCount, func() int { i, _ := CountLeafLs(ctx); return i }())
 	assert.Equal(t, v_LeafNCount, func() int { i, _ := CountLeafNs(ctx); return i }())
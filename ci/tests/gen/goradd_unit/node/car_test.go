@@ -0,0 +1,38 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeTableCarTable(t *testing.T) {
+	var n query.Node = Car()
+
+	assert.Equal(t, "car", n.TableName_())
+	assert.Equal(t, query.TableNodeType, n.NodeType_())
+	assert.Equal(t, "goradd_unit", n.DatabaseKey_())
+
+	n2 := serNode(t, n)
+
+	assert.Equal(t, "car", n2.TableName_())
+	assert.Equal(t, query.TableNodeType, n2.NodeType_())
+	assert.Equal(t, "goradd_unit", n2.DatabaseKey_())
+
+	nodes := carTable{}.ColumnNodes_()
+	for _, cn := range nodes {
+		cn2 := serNode(t, cn)
+		assert.Equal(t, "car", cn2.TableName_())
+		assert.Equal(t, query.TableNodeType, query.NodeParent(cn2).NodeType_())
+	}
+}
+
+func TestSerializeReferencesCarTable(t *testing.T) {
+}
+
+func TestSerializeReverseReferencesCarTable(t *testing.T) {
+}
+
+func TestSerializeAssociationsCarTable(t *testing.T) {
+}
@@ -0,0 +1,121 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package node
+
+import (
+	"encoding/gob"
+
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
+)
+
+// CategoryNode is the builder interface to the Category nodes.
+type CategoryNode interface {
+	query.TableNodeI
+	// PrimaryKey returns the column node representing the primary key of the table
+	PrimaryKey() *query.ColumnNode
+	// ID represents the id column in the database.
+	ID() *query.ColumnNode
+	// Code represents the code column in the database.
+	Code() *query.ColumnNode
+	// Name represents the name column in the database.
+	Name() *query.ColumnNode
+}
+
+// categoryTable represents the category table in a query. It uses a builder pattern to chain
+// together other tables and columns to form a node chain in a query.
+//
+// To use the categoryTable, call [Category()] to start a reference chain when querying the category table.
+type categoryTable struct {
+}
+
+// Category returns a table node that starts a node chain that begins with the category table.
+func Category() CategoryNode {
+	return categoryTable{}
+}
+
+// TableName_ returns the query name of the table the node is associated with.
+func (n categoryTable) TableName_() string {
+	return "category"
+}
+
+// NodeType_ returns the query.NodeType of the node.
+func (n categoryTable) NodeType_() query.NodeType {
+	return query.TableNodeType
+}
+
+// DatabaseKey_ returns the database key of the database the node is associated with.
+func (n categoryTable) DatabaseKey_() string {
+	return "goradd_unit"
+}
+
+// ColumnNodes_ returns a list of all the column nodes in this node.
+func (n categoryTable) ColumnNodes_() (nodes []query.Node) {
+	nodes = append(nodes, n.ID())
+	nodes = append(nodes, n.Code())
+	nodes = append(nodes, n.Name())
+	return nodes
+}
+
+// PrimaryKeys returns the primary key column nodes to satisfy the PrimaryKeyer interface.
+func (n categoryTable) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n categoryTable) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+func (n categoryTable) ID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"id",
+		"id",
+		query.ColTypeAutoPrimaryKey,
+		schema.ColTypeAutoPrimaryKey,
+		schema.ColSubTypeNone,
+		true,
+		n,
+	)
+	return cn
+}
+
+func (n categoryTable) Code() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"code",
+		"code",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n categoryTable) Name() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"name",
+		"name",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n categoryTable) GobEncode() (data []byte, err error) {
+	return
+}
+
+func (n *categoryTable) GobDecode(data []byte) (err error) {
+	return
+}
+
+func init() {
+	gob.Register(new(categoryTable))
+}
@@ -0,0 +1,38 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeTableCategoryTable(t *testing.T) {
+	var n query.Node = Category()
+
+	assert.Equal(t, "category", n.TableName_())
+	assert.Equal(t, query.TableNodeType, n.NodeType_())
+	assert.Equal(t, "goradd_unit", n.DatabaseKey_())
+
+	n2 := serNode(t, n)
+
+	assert.Equal(t, "category", n2.TableName_())
+	assert.Equal(t, query.TableNodeType, n2.NodeType_())
+	assert.Equal(t, "goradd_unit", n2.DatabaseKey_())
+
+	nodes := categoryTable{}.ColumnNodes_()
+	for _, cn := range nodes {
+		cn2 := serNode(t, cn)
+		assert.Equal(t, "category", cn2.TableName_())
+		assert.Equal(t, query.TableNodeType, query.NodeParent(cn2).NodeType_())
+	}
+}
+
+func TestSerializeReferencesCategoryTable(t *testing.T) {
+}
+
+func TestSerializeReverseReferencesCategoryTable(t *testing.T) {
+}
+
+func TestSerializeAssociationsCategoryTable(t *testing.T) {
+}
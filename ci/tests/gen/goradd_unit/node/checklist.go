@@ -0,0 +1,219 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package node
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
+)
+
+// ChecklistNode is the builder interface to the Checklist nodes.
+type ChecklistNode interface {
+	query.TableNodeI
+	// PrimaryKey returns the column node representing the primary key of the table
+	PrimaryKey() *query.ColumnNode
+	// ID represents the id column in the database.
+	ID() *query.ColumnNode
+	// Name represents the name column in the database.
+	Name() *query.ColumnNode
+	// ChecklistItem represents the ChecklistItem reverse reference to ChecklistItem objects
+	// through the ChecklistID foreign key there.
+	ChecklistItems() ChecklistItemNode
+	// Comments represents the reverse reference to Comment objects
+	// through the Commentable polymorphic reference there.
+	Comments() CommentNode
+}
+
+// checklistTable represents the checklist table in a query. It uses a builder pattern to chain
+// together other tables and columns to form a node chain in a query.
+//
+// To use the checklistTable, call [Checklist()] to start a reference chain when querying the checklist table.
+type checklistTable struct {
+}
+
+type checklistReference struct {
+	checklistTable
+	query.ReferenceNode
+}
+
+// Checklist returns a table node that starts a node chain that begins with the checklist table.
+func Checklist() ChecklistNode {
+	return checklistTable{}
+}
+
+// TableName_ returns the query name of the table the node is associated with.
+func (n checklistTable) TableName_() string {
+	return "checklist"
+}
+
+// NodeType_ returns the query.NodeType of the node.
+func (n checklistTable) NodeType_() query.NodeType {
+	return query.TableNodeType
+}
+
+// DatabaseKey_ returns the database key of the database the node is associated with.
+func (n checklistTable) DatabaseKey_() string {
+	return "goradd_unit"
+}
+
+// ColumnNodes_ returns a list of all the column nodes in this node.
+func (n checklistTable) ColumnNodes_() (nodes []query.Node) {
+	nodes = append(nodes, n.ID())
+	nodes = append(nodes, n.Name())
+	return nodes
+}
+
+func (n *checklistReference) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.checklistTable.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	return
+}
+
+func (n *checklistReference) NodeType_() query.NodeType {
+	return query.ReferenceNodeType
+}
+
+// PrimaryKeys returns the primary key column nodes to satisfy the PrimaryKeyer interface.
+func (n checklistTable) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n checklistTable) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+// PrimaryKeys returns the primary key column nodes.
+func (n *checklistReference) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n checklistReference) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+func (n checklistTable) ID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"id",
+		"id",
+		query.ColTypeAutoPrimaryKey,
+		schema.ColTypeAutoPrimaryKey,
+		schema.ColSubTypeNone,
+		true,
+		n,
+	)
+	return cn
+}
+
+func (n *checklistReference) ID() *query.ColumnNode {
+	cn := n.checklistTable.ID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n checklistTable) Name() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"name",
+		"name",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *checklistReference) Name() *query.ColumnNode {
+	cn := n.checklistTable.Name()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// ChecklistItem represents the many-to-one relationship formed by the reverse reference from the
+// checklist_id column in the checklist_item table.
+func (n checklistTable) ChecklistItems() ChecklistItemNode {
+	cn := &checklistItemReverse{
+		ReverseNode: query.ReverseNode{
+			ForeignKey: "checklist_id",
+			PrimaryKey: "id",
+			Field:      "checklistItems",
+			IsUnique:   false,
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *checklistReference) ChecklistItems() ChecklistItemNode {
+	cn := n.checklistTable.ChecklistItems().(*checklistItemReverse)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// Comments represents the many-to-one relationship formed by the Commentable
+// polymorphic reference from the comment table.
+func (n checklistTable) Comments() CommentNode {
+	cn := &commentReverse{
+		ReverseNode: query.ReverseNode{
+			ForeignKey: "commentable_id",
+			PrimaryKey: "id",
+			Field:      "comments",
+			IsUnique:   false,
+			TypeColumn: "commentable_type",
+			TypeValue:  "checklist",
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *checklistReference) Comments() CommentNode {
+	cn := n.checklistTable.Comments().(*commentReverse)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n checklistTable) GobEncode() (data []byte, err error) {
+	return
+}
+
+func (n *checklistTable) GobDecode(data []byte) (err error) {
+	return
+}
+
+func (n *checklistReference) GobEncode() (data []byte, err error) {
+	var buf bytes.Buffer
+	e := gob.NewEncoder(&buf)
+
+	if err = e.Encode(&n.ReferenceNode); err != nil {
+		panic(err)
+	}
+	data = buf.Bytes()
+	return
+}
+
+func (n *checklistReference) GobDecode(data []byte) (err error) {
+	buf := bytes.NewBuffer(data)
+	dec := gob.NewDecoder(buf)
+
+	if err = dec.Decode(&n.ReferenceNode); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func init() {
+	gob.Register(new(checklistTable))
+	gob.Register(new(checklistReference))
+}
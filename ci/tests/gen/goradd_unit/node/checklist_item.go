@@ -0,0 +1,235 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package node
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
+)
+
+// ChecklistItemNode is the builder interface to the ChecklistItem nodes.
+type ChecklistItemNode interface {
+	query.TableNodeI
+	// PrimaryKey returns the column node representing the primary key of the table
+	PrimaryKey() *query.ColumnNode
+	// ID represents the id column in the database.
+	ID() *query.ColumnNode
+	// Name represents the name column in the database.
+	Name() *query.ColumnNode
+	// Position represents the position column in the database.
+	Position() *query.ColumnNode
+	// ChecklistID represents the checklist_id foreign key column in the database
+	// that references the Checklist object.
+	ChecklistID() *query.ColumnNode
+	// Checklist references the Checklist object whose primary key is ChecklistID.
+	Checklist() ChecklistNode
+}
+
+// checklistItemTable represents the checklist_item table in a query. It uses a builder pattern to chain
+// together other tables and columns to form a node chain in a query.
+//
+// To use the checklistItemTable, call [ChecklistItem()] to start a reference chain when querying the checklist_item table.
+type checklistItemTable struct {
+}
+
+type checklistItemReverse struct {
+	checklistItemTable
+	query.ReverseNode
+}
+
+// ChecklistItem returns a table node that starts a node chain that begins with the checklist_item table.
+func ChecklistItem() ChecklistItemNode {
+	return checklistItemTable{}
+}
+
+// TableName_ returns the query name of the table the node is associated with.
+func (n checklistItemTable) TableName_() string {
+	return "checklist_item"
+}
+
+// NodeType_ returns the query.NodeType of the node.
+func (n checklistItemTable) NodeType_() query.NodeType {
+	return query.TableNodeType
+}
+
+// DatabaseKey_ returns the database key of the database the node is associated with.
+func (n checklistItemTable) DatabaseKey_() string {
+	return "goradd_unit"
+}
+
+// ColumnNodes_ returns a list of all the column nodes in this node.
+func (n checklistItemTable) ColumnNodes_() (nodes []query.Node) {
+	nodes = append(nodes, n.ID())
+	nodes = append(nodes, n.Name())
+	nodes = append(nodes, n.Position())
+	nodes = append(nodes, n.ChecklistID())
+	return nodes
+}
+
+func (n *checklistItemReverse) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.checklistItemTable.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	return
+}
+
+func (n *checklistItemReverse) NodeType_() query.NodeType {
+	return query.ReverseNodeType
+}
+
+// PrimaryKeys returns the primary key column nodes to satisfy the PrimaryKeyer interface.
+func (n checklistItemTable) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n checklistItemTable) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+// PrimaryKeys returns the primary key column nodes.
+func (n *checklistItemReverse) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n checklistItemReverse) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+func (n checklistItemTable) ID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"id",
+		"id",
+		query.ColTypeAutoPrimaryKey,
+		schema.ColTypeAutoPrimaryKey,
+		schema.ColSubTypeNone,
+		true,
+		n,
+	)
+	return cn
+}
+
+func (n *checklistItemReverse) ID() *query.ColumnNode {
+	cn := n.checklistItemTable.ID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n checklistItemTable) Name() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"name",
+		"name",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *checklistItemReverse) Name() *query.ColumnNode {
+	cn := n.checklistItemTable.Name()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n checklistItemTable) Position() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"position",
+		"position",
+		query.ColTypeInteger,
+		schema.ColTypeInt,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *checklistItemReverse) Position() *query.ColumnNode {
+	cn := n.checklistItemTable.Position()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n checklistItemTable) ChecklistID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"checklist_id",
+		"checklistID",
+		query.ColTypeAutoPrimaryKey,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *checklistItemReverse) ChecklistID() *query.ColumnNode {
+	cn := n.checklistItemTable.ChecklistID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// Checklist represents the link to a Checklist object.
+func (n checklistItemTable) Checklist() ChecklistNode {
+	cn := &checklistReference{
+		ReferenceNode: query.ReferenceNode{
+			ForeignKey: "checklist_id",
+			PrimaryKey: "id",
+			Field:      "checklist",
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *checklistItemReverse) Checklist() ChecklistNode {
+	cn := n.checklistItemTable.Checklist().(*checklistReference)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n checklistItemTable) GobEncode() (data []byte, err error) {
+	return
+}
+
+func (n *checklistItemTable) GobDecode(data []byte) (err error) {
+	return
+}
+
+func (n *checklistItemReverse) GobEncode() (data []byte, err error) {
+	var buf bytes.Buffer
+	e := gob.NewEncoder(&buf)
+
+	if err = e.Encode(&n.ReverseNode); err != nil {
+		panic(err)
+	}
+	data = buf.Bytes()
+	return
+}
+
+func (n *checklistItemReverse) GobDecode(data []byte) (err error) {
+	buf := bytes.NewBuffer(data)
+	dec := gob.NewDecoder(buf)
+
+	if err = dec.Decode(&n.ReverseNode); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func init() {
+	gob.Register(new(checklistItemTable))
+	gob.Register(new(checklistItemReverse))
+}
@@ -0,0 +1,58 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeTableChecklistItemTable(t *testing.T) {
+	var n query.Node = ChecklistItem()
+
+	assert.Equal(t, "checklist_item", n.TableName_())
+	assert.Equal(t, query.TableNodeType, n.NodeType_())
+	assert.Equal(t, "goradd_unit", n.DatabaseKey_())
+
+	n2 := serNode(t, n)
+
+	assert.Equal(t, "checklist_item", n2.TableName_())
+	assert.Equal(t, query.TableNodeType, n2.NodeType_())
+	assert.Equal(t, "goradd_unit", n2.DatabaseKey_())
+
+	nodes := checklistItemTable{}.ColumnNodes_()
+	for _, cn := range nodes {
+		cn2 := serNode(t, cn)
+		assert.Equal(t, "checklist_item", cn2.TableName_())
+		assert.Equal(t, query.TableNodeType, query.NodeParent(cn2).NodeType_())
+	}
+}
+
+func TestSerializeReferencesChecklistItemTable(t *testing.T) {
+	{
+		n := ChecklistItem().Checklist()
+		n2 := serNode(t, n)
+		parentNode := query.NodeParent(n2)
+		assert.Equal(t, query.TableNodeType, parentNode.NodeType_())
+		assert.Equal(t, "checklist_item", parentNode.TableName_())
+
+		nodes := n.(query.TableNodeI).ColumnNodes_()
+		for _, cn := range nodes {
+			cn2 := serNode(t, cn)
+			assert.Equal(t, n.TableName_(), cn2.TableName_())
+			assert.Equal(t, query.ReferenceNodeType, query.NodeParent(cn2).NodeType_())
+		}
+
+		assert.True(t, query.NodesMatch(ChecklistItem().Checklist().ID(), n2.(ChecklistNode).ID()))
+		assert.True(t, query.NodesMatch(ChecklistItem().Checklist().Name(), n2.(ChecklistNode).Name()))
+		assert.True(t, query.NodesMatch(ChecklistItem().Checklist().ChecklistItems(), n2.(ChecklistNode).ChecklistItems()))
+
+	}
+
+}
+
+func TestSerializeReverseReferencesChecklistItemTable(t *testing.T) {
+}
+
+func TestSerializeAssociationsChecklistItemTable(t *testing.T) {
+}
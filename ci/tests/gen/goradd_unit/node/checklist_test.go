@@ -0,0 +1,60 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeTableChecklistTable(t *testing.T) {
+	var n query.Node = Checklist()
+
+	assert.Equal(t, "checklist", n.TableName_())
+	assert.Equal(t, query.TableNodeType, n.NodeType_())
+	assert.Equal(t, "goradd_unit", n.DatabaseKey_())
+
+	n2 := serNode(t, n)
+
+	assert.Equal(t, "checklist", n2.TableName_())
+	assert.Equal(t, query.TableNodeType, n2.NodeType_())
+	assert.Equal(t, "goradd_unit", n2.DatabaseKey_())
+
+	nodes := checklistTable{}.ColumnNodes_()
+	for _, cn := range nodes {
+		cn2 := serNode(t, cn)
+		assert.Equal(t, "checklist", cn2.TableName_())
+		assert.Equal(t, query.TableNodeType, query.NodeParent(cn2).NodeType_())
+	}
+}
+
+func TestSerializeReferencesChecklistTable(t *testing.T) {
+}
+
+func TestSerializeReverseReferencesChecklistTable(t *testing.T) {
+	{
+		n := Checklist().ChecklistItems()
+		n2 := serNode(t, n)
+		parentNode := query.NodeParent(n2)
+		assert.Equal(t, query.TableNodeType, parentNode.NodeType_())
+		assert.Equal(t, "checklist", parentNode.TableName_())
+
+		nodes := n.(query.TableNodeI).ColumnNodes_()
+		for _, cn := range nodes {
+			cn2 := serNode(t, cn)
+			assert.Equal(t, n.TableName_(), cn2.TableName_())
+			assert.Equal(t, query.ReverseNodeType, query.NodeParent(cn2).NodeType_())
+		}
+
+		assert.True(t, query.NodesMatch(Checklist().ChecklistItems().ID(), n2.(ChecklistItemNode).ID()))
+		assert.True(t, query.NodesMatch(Checklist().ChecklistItems().Name(), n2.(ChecklistItemNode).Name()))
+		assert.True(t, query.NodesMatch(Checklist().ChecklistItems().Position(), n2.(ChecklistItemNode).Position()))
+		assert.True(t, query.NodesMatch(Checklist().ChecklistItems().ChecklistID(), n2.(ChecklistItemNode).ChecklistID()))
+		assert.True(t, query.NodesMatch(Checklist().ChecklistItems().Checklist(), n2.(ChecklistItemNode).Checklist()))
+
+	}
+
+}
+
+func TestSerializeAssociationsChecklistTable(t *testing.T) {
+}
@@ -0,0 +1,263 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package node
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
+)
+
+// CommentNode is the builder interface to the Comment nodes.
+type CommentNode interface {
+	query.TableNodeI
+	// PrimaryKey returns the column node representing the primary key of the table
+	PrimaryKey() *query.ColumnNode
+	// ID represents the id column in the database.
+	ID() *query.ColumnNode
+	// Body represents the body column in the database.
+	Body() *query.ColumnNode
+	// CommentableType represents the commentable_type column in the database.
+	CommentableType() *query.ColumnNode
+	// CommentableID represents the commentable_id column in the database.
+	CommentableID() *query.ColumnNode
+	// CommentableChecklist references the Checklist object whose primary key is CommentableID
+	// when CommentableType is "checklist".
+	CommentableChecklist() ChecklistNode
+	// CommentablePlaylist references the Playlist object whose primary key is CommentableID
+	// when CommentableType is "playlist".
+	CommentablePlaylist() PlaylistNode
+}
+
+// commentTable represents the comment table in a query. It uses a builder pattern to chain
+// together other tables and columns to form a node chain in a query.
+//
+// To use the commentTable, call [Comment()] to start a reference chain when querying the comment table.
+type commentTable struct {
+}
+
+type commentReverse struct {
+	commentTable
+	query.ReverseNode
+}
+
+// Comment returns a table node that starts a node chain that begins with the comment table.
+func Comment() CommentNode {
+	return commentTable{}
+}
+
+// TableName_ returns the query name of the table the node is associated with.
+func (n commentTable) TableName_() string {
+	return "comment"
+}
+
+// NodeType_ returns the query.NodeType of the node.
+func (n commentTable) NodeType_() query.NodeType {
+	return query.TableNodeType
+}
+
+// DatabaseKey_ returns the database key of the database the node is associated with.
+func (n commentTable) DatabaseKey_() string {
+	return "goradd_unit"
+}
+
+// ColumnNodes_ returns a list of all the column nodes in this node.
+func (n commentTable) ColumnNodes_() (nodes []query.Node) {
+	nodes = append(nodes, n.ID())
+	nodes = append(nodes, n.Body())
+	nodes = append(nodes, n.CommentableType())
+	nodes = append(nodes, n.CommentableID())
+	return nodes
+}
+
+func (n *commentReverse) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.commentTable.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	return
+}
+
+func (n *commentReverse) NodeType_() query.NodeType {
+	return query.ReverseNodeType
+}
+
+// PrimaryKeys returns the primary key column nodes to satisfy the PrimaryKeyer interface.
+func (n commentTable) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n commentTable) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+// PrimaryKeys returns the primary key column nodes.
+func (n *commentReverse) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n commentReverse) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+func (n commentTable) ID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"id",
+		"id",
+		query.ColTypeAutoPrimaryKey,
+		schema.ColTypeAutoPrimaryKey,
+		schema.ColSubTypeNone,
+		true,
+		n,
+	)
+	return cn
+}
+
+func (n *commentReverse) ID() *query.ColumnNode {
+	cn := n.commentTable.ID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n commentTable) Body() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"body",
+		"body",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *commentReverse) Body() *query.ColumnNode {
+	cn := n.commentTable.Body()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n commentTable) CommentableType() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"commentable_type",
+		"commentableType",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *commentReverse) CommentableType() *query.ColumnNode {
+	cn := n.commentTable.CommentableType()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n commentTable) CommentableID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"commentable_id",
+		"commentableID",
+		query.ColTypeAutoPrimaryKey,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *commentReverse) CommentableID() *query.ColumnNode {
+	cn := n.commentTable.CommentableID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// CommentableChecklist represents the link to a Checklist object through the Commentable
+// polymorphic reference. Only rows whose commentable_type column is "checklist" are joined.
+func (n commentTable) CommentableChecklist() ChecklistNode {
+	cn := &checklistReference{
+		ReferenceNode: query.ReferenceNode{
+			ForeignKey: "commentable_id",
+			PrimaryKey: "id",
+			Field:      "commentableChecklist",
+			TypeColumn: "commentable_type",
+			TypeValue:  "checklist",
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *commentReverse) CommentableChecklist() ChecklistNode {
+	cn := n.commentTable.CommentableChecklist().(*checklistReference)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// CommentablePlaylist represents the link to a Playlist object through the Commentable
+// polymorphic reference. Only rows whose commentable_type column is "playlist" are joined.
+func (n commentTable) CommentablePlaylist() PlaylistNode {
+	cn := &playlistReference{
+		ReferenceNode: query.ReferenceNode{
+			ForeignKey: "commentable_id",
+			PrimaryKey: "id",
+			Field:      "commentablePlaylist",
+			TypeColumn: "commentable_type",
+			TypeValue:  "playlist",
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *commentReverse) CommentablePlaylist() PlaylistNode {
+	cn := n.commentTable.CommentablePlaylist().(*playlistReference)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n commentTable) GobEncode() (data []byte, err error) {
+	return
+}
+
+func (n *commentTable) GobDecode(data []byte) (err error) {
+	return
+}
+
+func (n *commentReverse) GobEncode() (data []byte, err error) {
+	var buf bytes.Buffer
+	e := gob.NewEncoder(&buf)
+
+	if err = e.Encode(&n.ReverseNode); err != nil {
+		panic(err)
+	}
+	data = buf.Bytes()
+	return
+}
+
+func (n *commentReverse) GobDecode(data []byte) (err error) {
+	buf := bytes.NewBuffer(data)
+	dec := gob.NewDecoder(buf)
+
+	if err = dec.Decode(&n.ReverseNode); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func init() {
+	gob.Register(new(commentTable))
+	gob.Register(new(commentReverse))
+}
@@ -0,0 +1,38 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeTableCommentTable(t *testing.T) {
+	var n query.Node = Comment()
+
+	assert.Equal(t, "comment", n.TableName_())
+	assert.Equal(t, query.TableNodeType, n.NodeType_())
+	assert.Equal(t, "goradd_unit", n.DatabaseKey_())
+
+	n2 := serNode(t, n)
+
+	assert.Equal(t, "comment", n2.TableName_())
+	assert.Equal(t, query.TableNodeType, n2.NodeType_())
+	assert.Equal(t, "goradd_unit", n2.DatabaseKey_())
+
+	nodes := commentTable{}.ColumnNodes_()
+	for _, cn := range nodes {
+		cn2 := serNode(t, cn)
+		assert.Equal(t, "comment", cn2.TableName_())
+		assert.Equal(t, query.TableNodeType, query.NodeParent(cn2).NodeType_())
+	}
+}
+
+func TestSerializeReferencesCommentTable(t *testing.T) {
+}
+
+func TestSerializeReverseReferencesCommentTable(t *testing.T) {
+}
+
+func TestSerializeAssociationsCommentTable(t *testing.T) {
+}
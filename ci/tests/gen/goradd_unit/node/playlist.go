@@ -0,0 +1,324 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package node
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
+)
+
+// PlaylistNode is the builder interface to the Playlist nodes.
+type PlaylistNode interface {
+	query.TableNodeI
+	// PrimaryKey returns the column node representing the primary key of the table
+	PrimaryKey() *query.ColumnNode
+	// ID represents the id column in the database.
+	ID() *query.ColumnNode
+	// Name represents the name column in the database.
+	Name() *query.ColumnNode
+	// Songs represents the many-many reference to Song objects.
+	Songs() PlaylistSongsNode
+	// Comments represents the reverse reference to Comment objects
+	// through the Commentable polymorphic reference there.
+	Comments() CommentNode
+}
+
+// playlistTable represents the playlist table in a query. It uses a builder pattern to chain
+// together other tables and columns to form a node chain in a query.
+//
+// To use the playlistTable, call [Playlist()] to start a reference chain when querying the playlist table.
+type playlistTable struct {
+}
+
+type playlistReference struct {
+	playlistTable
+	query.ReferenceNode
+}
+
+type playlistAssociation struct {
+	playlistTable
+	query.ManyManyNode
+}
+
+// PlaylistSongsNode is the builder interface to the Songs many-many relationship.
+// Besides the nodes of the Song objects, it gives access to the extra columns
+// of the playlist_song_assn association table.
+type PlaylistSongsNode interface {
+	SongNode
+	// Position represents the position column in the playlist_song_assn association table,
+	// which holds the position of each Song in the list.
+	Position() *query.ColumnNode
+}
+
+type playlistSongsAssociation struct {
+	songAssociation
+}
+
+// Playlist returns a table node that starts a node chain that begins with the playlist table.
+func Playlist() PlaylistNode {
+	return playlistTable{}
+}
+
+// TableName_ returns the query name of the table the node is associated with.
+func (n playlistTable) TableName_() string {
+	return "playlist"
+}
+
+// NodeType_ returns the query.NodeType of the node.
+func (n playlistTable) NodeType_() query.NodeType {
+	return query.TableNodeType
+}
+
+// DatabaseKey_ returns the database key of the database the node is associated with.
+func (n playlistTable) DatabaseKey_() string {
+	return "goradd_unit"
+}
+
+// ColumnNodes_ returns a list of all the column nodes in this node.
+func (n playlistTable) ColumnNodes_() (nodes []query.Node) {
+	nodes = append(nodes, n.ID())
+	nodes = append(nodes, n.Name())
+	return nodes
+}
+
+func (n *playlistReference) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.playlistTable.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	return
+}
+
+func (n *playlistAssociation) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.playlistTable.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	return
+}
+
+func (n *playlistReference) NodeType_() query.NodeType {
+	return query.ReferenceNodeType
+}
+
+func (n *playlistAssociation) NodeType_() query.NodeType {
+	return query.ManyManyNodeType
+}
+
+// PrimaryKeys returns the primary key column nodes to satisfy the PrimaryKeyer interface.
+func (n playlistTable) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n playlistTable) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+// PrimaryKeys returns the primary key column nodes.
+func (n *playlistReference) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n playlistReference) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+// PrimaryKeys returns the primary key column nodes.
+func (n *playlistAssociation) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n playlistAssociation) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+func (n playlistTable) ID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"id",
+		"id",
+		query.ColTypeAutoPrimaryKey,
+		schema.ColTypeAutoPrimaryKey,
+		schema.ColSubTypeNone,
+		true,
+		n,
+	)
+	return cn
+}
+
+func (n *playlistReference) ID() *query.ColumnNode {
+	cn := n.playlistTable.ID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *playlistAssociation) ID() *query.ColumnNode {
+	cn := n.playlistTable.ID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n playlistTable) Name() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"name",
+		"name",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *playlistReference) Name() *query.ColumnNode {
+	cn := n.playlistTable.Name()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *playlistAssociation) Name() *query.ColumnNode {
+	cn := n.playlistTable.Name()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// Songs represents the many-to-many relationship formed by the playlist_song_assn table.
+func (n playlistTable) Songs() PlaylistSongsNode {
+	cn := &playlistSongsAssociation{
+		songAssociation: songAssociation{
+			ManyManyNode: query.ManyManyNode{
+				AssnTableQueryName: "playlist_song_assn",
+				ParentForeignKey:   "playlist_id",
+				ParentPrimaryKey:   "id",
+				Field:              "songs",
+				RefForeignKey:      "song_id",
+				RefPrimaryKey:      "id",
+			},
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// Position represents the position column in the playlist_song_assn association table,
+// which holds the position of each Song in the list.
+func (n *playlistSongsAssociation) Position() *query.ColumnNode {
+	return query.NewAssociationColumnNode(
+		"position",
+		"_linkPosition",
+		query.ColTypeInteger,
+		schema.ColTypeInt,
+		schema.ColSubTypeNone,
+		n,
+	)
+}
+
+func (n *playlistReference) Songs() PlaylistSongsNode {
+	cn := n.playlistTable.Songs().(*playlistSongsAssociation)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *playlistAssociation) Songs() PlaylistSongsNode {
+	cn := n.playlistTable.Songs().(*playlistSongsAssociation)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// Comments represents the many-to-one relationship formed by the Commentable
+// polymorphic reference from the comment table.
+func (n playlistTable) Comments() CommentNode {
+	cn := &commentReverse{
+		ReverseNode: query.ReverseNode{
+			ForeignKey: "commentable_id",
+			PrimaryKey: "id",
+			Field:      "comments",
+			IsUnique:   false,
+			TypeColumn: "commentable_type",
+			TypeValue:  "playlist",
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *playlistReference) Comments() CommentNode {
+	cn := n.playlistTable.Comments().(*commentReverse)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *playlistAssociation) Comments() CommentNode {
+	cn := n.playlistTable.Comments().(*commentReverse)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n playlistTable) GobEncode() (data []byte, err error) {
+	return
+}
+
+func (n *playlistTable) GobDecode(data []byte) (err error) {
+	return
+}
+
+func (n *playlistReference) GobEncode() (data []byte, err error) {
+	var buf bytes.Buffer
+	e := gob.NewEncoder(&buf)
+
+	if err = e.Encode(&n.ReferenceNode); err != nil {
+		panic(err)
+	}
+	data = buf.Bytes()
+	return
+}
+
+func (n *playlistReference) GobDecode(data []byte) (err error) {
+	buf := bytes.NewBuffer(data)
+	dec := gob.NewDecoder(buf)
+
+	if err = dec.Decode(&n.ReferenceNode); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (n *playlistAssociation) GobEncode() (data []byte, err error) {
+	var buf bytes.Buffer
+	e := gob.NewEncoder(&buf)
+
+	if err = e.Encode(&n.ManyManyNode); err != nil {
+		panic(err)
+	}
+	data = buf.Bytes()
+	return
+}
+
+func (n *playlistAssociation) GobDecode(data []byte) (err error) {
+	buf := bytes.NewBuffer(data)
+	dec := gob.NewDecoder(buf)
+
+	if err = dec.Decode(&n.ManyManyNode); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func init() {
+	gob.Register(new(playlistTable))
+	gob.Register(new(playlistReference))
+	gob.Register(new(playlistAssociation))
+	gob.Register(new(playlistSongsAssociation))
+}
@@ -0,0 +1,62 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeTablePlaylistTable(t *testing.T) {
+	var n query.Node = Playlist()
+
+	assert.Equal(t, "playlist", n.TableName_())
+	assert.Equal(t, query.TableNodeType, n.NodeType_())
+	assert.Equal(t, "goradd_unit", n.DatabaseKey_())
+
+	n2 := serNode(t, n)
+
+	assert.Equal(t, "playlist", n2.TableName_())
+	assert.Equal(t, query.TableNodeType, n2.NodeType_())
+	assert.Equal(t, "goradd_unit", n2.DatabaseKey_())
+
+	nodes := playlistTable{}.ColumnNodes_()
+	for _, cn := range nodes {
+		cn2 := serNode(t, cn)
+		assert.Equal(t, "playlist", cn2.TableName_())
+		assert.Equal(t, query.TableNodeType, query.NodeParent(cn2).NodeType_())
+	}
+}
+
+func TestSerializeReferencesPlaylistTable(t *testing.T) {
+}
+
+func TestSerializeReverseReferencesPlaylistTable(t *testing.T) {
+}
+
+func TestSerializeAssociationsPlaylistTable(t *testing.T) {
+
+	{
+		n := Playlist().Songs()
+		n2 := serNode(t, n)
+		assert.Equal(t, query.ManyManyNodeType, n2.NodeType_())
+		parentNode := query.NodeParent(n2)
+		assert.Equal(t, query.TableNodeType, parentNode.NodeType_())
+		assert.Equal(t, "playlist", parentNode.TableName_())
+
+		nodes := n.(query.TableNodeI).ColumnNodes_()
+		for _, cn := range nodes {
+			cn2 := serNode(t, cn)
+			//        assert.Equal(t, query.ColumnNodeType, cn2.NodeType_())
+			parentNode = query.NodeParent(cn2)
+			assert.Equal(t, query.ManyManyNodeType, parentNode.NodeType_())
+		}
+
+		assert.True(t, query.NodesMatch(Playlist().Songs().ID(), n2.(SongNode).ID()))
+		assert.True(t, query.NodesMatch(Playlist().Songs().Title(), n2.(SongNode).Title()))
+		assert.True(t, query.NodesMatch(Playlist().Songs().SongNotes(), n2.(SongNode).SongNotes()))
+		assert.True(t, query.NodesMatch(Playlist().Songs().Playlists(), n2.(SongNode).Playlists()))
+
+	}
+
+}
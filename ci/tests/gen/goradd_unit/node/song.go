@@ -0,0 +1,292 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package node
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
+)
+
+// SongNode is the builder interface to the Song nodes.
+type SongNode interface {
+	query.TableNodeI
+	// PrimaryKey returns the column node representing the primary key of the table
+	PrimaryKey() *query.ColumnNode
+	// ID represents the id column in the database.
+	ID() *query.ColumnNode
+	// Title represents the title column in the database.
+	Title() *query.ColumnNode
+	// Playlists represents the many-many reference to Playlist objects.
+	Playlists() PlaylistNode
+	// SongNote represents the SongNote reverse reference to SongNote objects
+	// through the SongID foreign key there.
+	SongNotes() SongNoteNode
+}
+
+// songTable represents the song table in a query. It uses a builder pattern to chain
+// together other tables and columns to form a node chain in a query.
+//
+// To use the songTable, call [Song()] to start a reference chain when querying the song table.
+type songTable struct {
+}
+
+type songReference struct {
+	songTable
+	query.ReferenceNode
+}
+
+type songAssociation struct {
+	songTable
+	query.ManyManyNode
+}
+
+// Song returns a table node that starts a node chain that begins with the song table.
+func Song() SongNode {
+	return songTable{}
+}
+
+// TableName_ returns the query name of the table the node is associated with.
+func (n songTable) TableName_() string {
+	return "song"
+}
+
+// NodeType_ returns the query.NodeType of the node.
+func (n songTable) NodeType_() query.NodeType {
+	return query.TableNodeType
+}
+
+// DatabaseKey_ returns the database key of the database the node is associated with.
+func (n songTable) DatabaseKey_() string {
+	return "goradd_unit"
+}
+
+// ColumnNodes_ returns a list of all the column nodes in this node.
+func (n songTable) ColumnNodes_() (nodes []query.Node) {
+	nodes = append(nodes, n.ID())
+	nodes = append(nodes, n.Title())
+	return nodes
+}
+
+func (n *songReference) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.songTable.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	return
+}
+
+func (n *songAssociation) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.songTable.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	return
+}
+
+func (n *songReference) NodeType_() query.NodeType {
+	return query.ReferenceNodeType
+}
+
+func (n *songAssociation) NodeType_() query.NodeType {
+	return query.ManyManyNodeType
+}
+
+// PrimaryKeys returns the primary key column nodes to satisfy the PrimaryKeyer interface.
+func (n songTable) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n songTable) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+// PrimaryKeys returns the primary key column nodes.
+func (n *songReference) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n songReference) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+// PrimaryKeys returns the primary key column nodes.
+func (n *songAssociation) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n songAssociation) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+func (n songTable) ID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"id",
+		"id",
+		query.ColTypeAutoPrimaryKey,
+		schema.ColTypeAutoPrimaryKey,
+		schema.ColSubTypeNone,
+		true,
+		n,
+	)
+	return cn
+}
+
+func (n *songReference) ID() *query.ColumnNode {
+	cn := n.songTable.ID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *songAssociation) ID() *query.ColumnNode {
+	cn := n.songTable.ID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n songTable) Title() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"title",
+		"title",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *songReference) Title() *query.ColumnNode {
+	cn := n.songTable.Title()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *songAssociation) Title() *query.ColumnNode {
+	cn := n.songTable.Title()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// Playlists represents the many-to-many relationship formed by the playlist_song_assn table.
+func (n songTable) Playlists() PlaylistNode {
+	cn := &playlistAssociation{
+		ManyManyNode: query.ManyManyNode{
+			AssnTableQueryName: "playlist_song_assn",
+			ParentForeignKey:   "song_id",
+			ParentPrimaryKey:   "id",
+			Field:              "playlists",
+			RefForeignKey:      "playlist_id",
+			RefPrimaryKey:      "id",
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *songReference) Playlists() PlaylistNode {
+	cn := n.songTable.Playlists().(*playlistAssociation)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *songAssociation) Playlists() PlaylistNode {
+	cn := n.songTable.Playlists().(*playlistAssociation)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// SongNote represents the many-to-one relationship formed by the reverse reference from the
+// song_id column in the song_note table.
+func (n songTable) SongNotes() SongNoteNode {
+	cn := &songNoteReverse{
+		ReverseNode: query.ReverseNode{
+			ForeignKey: "song_id",
+			PrimaryKey: "id",
+			Field:      "songNotes",
+			IsUnique:   false,
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *songReference) SongNotes() SongNoteNode {
+	cn := n.songTable.SongNotes().(*songNoteReverse)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *songAssociation) SongNotes() SongNoteNode {
+	cn := n.songTable.SongNotes().(*songNoteReverse)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n songTable) GobEncode() (data []byte, err error) {
+	return
+}
+
+func (n *songTable) GobDecode(data []byte) (err error) {
+	return
+}
+
+func (n *songReference) GobEncode() (data []byte, err error) {
+	var buf bytes.Buffer
+	e := gob.NewEncoder(&buf)
+
+	if err = e.Encode(&n.ReferenceNode); err != nil {
+		panic(err)
+	}
+	data = buf.Bytes()
+	return
+}
+
+func (n *songReference) GobDecode(data []byte) (err error) {
+	buf := bytes.NewBuffer(data)
+	dec := gob.NewDecoder(buf)
+
+	if err = dec.Decode(&n.ReferenceNode); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (n *songAssociation) GobEncode() (data []byte, err error) {
+	var buf bytes.Buffer
+	e := gob.NewEncoder(&buf)
+
+	if err = e.Encode(&n.ManyManyNode); err != nil {
+		panic(err)
+	}
+	data = buf.Bytes()
+	return
+}
+
+func (n *songAssociation) GobDecode(data []byte) (err error) {
+	buf := bytes.NewBuffer(data)
+	dec := gob.NewDecoder(buf)
+
+	if err = dec.Decode(&n.ManyManyNode); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func init() {
+	gob.Register(new(songTable))
+	gob.Register(new(songReference))
+	gob.Register(new(songAssociation))
+}
@@ -0,0 +1,59 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeTableSongNoteTable(t *testing.T) {
+	var n query.Node = SongNote()
+
+	assert.Equal(t, "song_note", n.TableName_())
+	assert.Equal(t, query.TableNodeType, n.NodeType_())
+	assert.Equal(t, "goradd_unit", n.DatabaseKey_())
+
+	n2 := serNode(t, n)
+
+	assert.Equal(t, "song_note", n2.TableName_())
+	assert.Equal(t, query.TableNodeType, n2.NodeType_())
+	assert.Equal(t, "goradd_unit", n2.DatabaseKey_())
+
+	nodes := songNoteTable{}.ColumnNodes_()
+	for _, cn := range nodes {
+		cn2 := serNode(t, cn)
+		assert.Equal(t, "song_note", cn2.TableName_())
+		assert.Equal(t, query.TableNodeType, query.NodeParent(cn2).NodeType_())
+	}
+}
+
+func TestSerializeReferencesSongNoteTable(t *testing.T) {
+	{
+		n := SongNote().Song()
+		n2 := serNode(t, n)
+		parentNode := query.NodeParent(n2)
+		assert.Equal(t, query.TableNodeType, parentNode.NodeType_())
+		assert.Equal(t, "song_note", parentNode.TableName_())
+
+		nodes := n.(query.TableNodeI).ColumnNodes_()
+		for _, cn := range nodes {
+			cn2 := serNode(t, cn)
+			assert.Equal(t, n.TableName_(), cn2.TableName_())
+			assert.Equal(t, query.ReferenceNodeType, query.NodeParent(cn2).NodeType_())
+		}
+
+		assert.True(t, query.NodesMatch(SongNote().Song().ID(), n2.(SongNode).ID()))
+		assert.True(t, query.NodesMatch(SongNote().Song().Title(), n2.(SongNode).Title()))
+		assert.True(t, query.NodesMatch(SongNote().Song().SongNotes(), n2.(SongNode).SongNotes()))
+		assert.True(t, query.NodesMatch(SongNote().Song().Playlists(), n2.(SongNode).Playlists()))
+
+	}
+
+}
+
+func TestSerializeReverseReferencesSongNoteTable(t *testing.T) {
+}
+
+func TestSerializeAssociationsSongNoteTable(t *testing.T) {
+}
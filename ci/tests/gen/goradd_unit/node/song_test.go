@@ -0,0 +1,82 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeTableSongTable(t *testing.T) {
+	var n query.Node = Song()
+
+	assert.Equal(t, "song", n.TableName_())
+	assert.Equal(t, query.TableNodeType, n.NodeType_())
+	assert.Equal(t, "goradd_unit", n.DatabaseKey_())
+
+	n2 := serNode(t, n)
+
+	assert.Equal(t, "song", n2.TableName_())
+	assert.Equal(t, query.TableNodeType, n2.NodeType_())
+	assert.Equal(t, "goradd_unit", n2.DatabaseKey_())
+
+	nodes := songTable{}.ColumnNodes_()
+	for _, cn := range nodes {
+		cn2 := serNode(t, cn)
+		assert.Equal(t, "song", cn2.TableName_())
+		assert.Equal(t, query.TableNodeType, query.NodeParent(cn2).NodeType_())
+	}
+}
+
+func TestSerializeReferencesSongTable(t *testing.T) {
+}
+
+func TestSerializeReverseReferencesSongTable(t *testing.T) {
+	{
+		n := Song().SongNotes()
+		n2 := serNode(t, n)
+		parentNode := query.NodeParent(n2)
+		assert.Equal(t, query.TableNodeType, parentNode.NodeType_())
+		assert.Equal(t, "song", parentNode.TableName_())
+
+		nodes := n.(query.TableNodeI).ColumnNodes_()
+		for _, cn := range nodes {
+			cn2 := serNode(t, cn)
+			assert.Equal(t, n.TableName_(), cn2.TableName_())
+			assert.Equal(t, query.ReverseNodeType, query.NodeParent(cn2).NodeType_())
+		}
+
+		assert.True(t, query.NodesMatch(Song().SongNotes().ID(), n2.(SongNoteNode).ID()))
+		assert.True(t, query.NodesMatch(Song().SongNotes().Text(), n2.(SongNoteNode).Text()))
+		assert.True(t, query.NodesMatch(Song().SongNotes().SongID(), n2.(SongNoteNode).SongID()))
+		assert.True(t, query.NodesMatch(Song().SongNotes().Song(), n2.(SongNoteNode).Song()))
+
+	}
+
+}
+
+func TestSerializeAssociationsSongTable(t *testing.T) {
+
+	{
+		n := Song().Playlists()
+		n2 := serNode(t, n)
+		assert.Equal(t, query.ManyManyNodeType, n2.NodeType_())
+		parentNode := query.NodeParent(n2)
+		assert.Equal(t, query.TableNodeType, parentNode.NodeType_())
+		assert.Equal(t, "song", parentNode.TableName_())
+
+		nodes := n.(query.TableNodeI).ColumnNodes_()
+		for _, cn := range nodes {
+			cn2 := serNode(t, cn)
+			//        assert.Equal(t, query.ColumnNodeType, cn2.NodeType_())
+			parentNode = query.NodeParent(cn2)
+			assert.Equal(t, query.ManyManyNodeType, parentNode.NodeType_())
+		}
+
+		assert.True(t, query.NodesMatch(Song().Playlists().ID(), n2.(PlaylistNode).ID()))
+		assert.True(t, query.NodesMatch(Song().Playlists().Name(), n2.(PlaylistNode).Name()))
+		assert.True(t, query.NodesMatch(Song().Playlists().Songs(), n2.(PlaylistNode).Songs()))
+
+	}
+
+}
@@ -36,6 +36,20 @@ type TypeTestNode interface {
 	TestFloat64() *query.ColumnNode
 	// TestNumeric represents the test_numeric column in the database.
 	TestNumeric() *query.ColumnNode
+	// TestDecimal represents the test_decimal column in the database.
+	TestDecimal() *query.ColumnNode
+	// TestPoint represents the test_point column in the database.
+	TestPoint() *query.ColumnNode
+	// TestPolygon represents the test_polygon column in the database.
+	TestPolygon() *query.ColumnNode
+	// TestDuration represents the test_duration column in the database.
+	TestDuration() *query.ColumnNode
+	// TestStringArray represents the test_string_array column in the database.
+	TestStringArray() *query.ColumnNode
+	// TestIntArray represents the test_int_array column in the database.
+	TestIntArray() *query.ColumnNode
+	// TestJSON represents the test_json column in the database.
+	TestJSON() *query.ColumnNode
 	// TestBool represents the test_bool column in the database.
 	TestBool() *query.ColumnNode
 	// TestUnlimitedString represents the test_unlimited_string column in the database.
@@ -92,6 +106,13 @@ func (n typeTestTable) ColumnNodes_() (nodes []query.Node) {
 	nodes = append(nodes, n.TestFloat32())
 	nodes = append(nodes, n.TestFloat64())
 	nodes = append(nodes, n.TestNumeric())
+	nodes = append(nodes, n.TestDecimal())
+	nodes = append(nodes, n.TestPoint())
+	nodes = append(nodes, n.TestPolygon())
+	nodes = append(nodes, n.TestDuration())
+	nodes = append(nodes, n.TestStringArray())
+	nodes = append(nodes, n.TestIntArray())
+	nodes = append(nodes, n.TestJSON())
 	nodes = append(nodes, n.TestBool())
 	nodes = append(nodes, n.TestUnlimitedString())
 	nodes = append(nodes, n.TestLimitedString())
@@ -257,6 +278,97 @@ func (n typeTestTable) TestNumeric() *query.ColumnNode {
 	return cn
 }
 
+func (n typeTestTable) TestDecimal() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"test_decimal",
+		"testDecimal",
+		query.ColTypeDecimal,
+		schema.ColTypeString,
+		schema.ColSubTypeDecimal,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n typeTestTable) TestPoint() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"test_point",
+		"testPoint",
+		query.ColTypeGeometry,
+		schema.ColTypeGeometry,
+		schema.ColSubTypePoint,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n typeTestTable) TestPolygon() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"test_polygon",
+		"testPolygon",
+		query.ColTypeGeometry,
+		schema.ColTypeGeometry,
+		schema.ColSubTypePolygon,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n typeTestTable) TestDuration() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"test_duration",
+		"testDuration",
+		query.ColTypeDuration,
+		schema.ColTypeDuration,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n typeTestTable) TestStringArray() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"test_string_array",
+		"testStringArray",
+		query.ColTypeStringArray,
+		schema.ColTypeStringArray,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n typeTestTable) TestIntArray() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"test_int_array",
+		"testIntArray",
+		query.ColTypeIntArray,
+		schema.ColTypeIntArray,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n typeTestTable) TestJSON() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"test_json",
+		"testJSON",
+		query.ColTypeString,
+		schema.ColTypeJSON,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
 func (n typeTestTable) TestBool() *query.ColumnNode {
 	cn := query.NewColumnNode(
 		"test_bool",
@@ -0,0 +1,215 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package node
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
+)
+
+// VehicleNode is the builder interface to the Vehicle nodes.
+type VehicleNode interface {
+	query.TableNodeI
+	// PrimaryKey returns the column node representing the primary key of the table
+	PrimaryKey() *query.ColumnNode
+	// ID represents the id column in the database.
+	ID() *query.ColumnNode
+	// Name represents the name column in the database.
+	Name() *query.ColumnNode
+	// Car represents the Car subtype object that extends the object.
+	Car() CarNode
+	// Truck represents the Truck subtype object that extends the object.
+	Truck() TruckNode
+}
+
+// vehicleTable represents the vehicle table in a query. It uses a builder pattern to chain
+// together other tables and columns to form a node chain in a query.
+//
+// To use the vehicleTable, call [Vehicle()] to start a reference chain when querying the vehicle table.
+type vehicleTable struct {
+}
+
+type vehicleReference struct {
+	vehicleTable
+	query.ReferenceNode
+}
+
+// Vehicle returns a table node that starts a node chain that begins with the vehicle table.
+func Vehicle() VehicleNode {
+	return vehicleTable{}
+}
+
+// TableName_ returns the query name of the table the node is associated with.
+func (n vehicleTable) TableName_() string {
+	return "vehicle"
+}
+
+// NodeType_ returns the query.NodeType of the node.
+func (n vehicleTable) NodeType_() query.NodeType {
+	return query.TableNodeType
+}
+
+// DatabaseKey_ returns the database key of the database the node is associated with.
+func (n vehicleTable) DatabaseKey_() string {
+	return "goradd_unit"
+}
+
+// ColumnNodes_ returns a list of all the column nodes in this node.
+func (n vehicleTable) ColumnNodes_() (nodes []query.Node) {
+	nodes = append(nodes, n.ID())
+	nodes = append(nodes, n.Name())
+	return nodes
+}
+
+func (n *vehicleReference) ColumnNodes_() (nodes []query.Node) {
+	nodes = n.vehicleTable.ColumnNodes_()
+	for _, cn := range nodes {
+		query.NodeSetParent(cn, n)
+	}
+	return
+}
+
+func (n *vehicleReference) NodeType_() query.NodeType {
+	return query.ReferenceNodeType
+}
+
+// PrimaryKeys returns the primary key column nodes to satisfy the PrimaryKeyer interface.
+func (n vehicleTable) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n vehicleTable) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+// PrimaryKeys returns the primary key column nodes.
+func (n *vehicleReference) PrimaryKeys() []*query.ColumnNode {
+	return []*query.ColumnNode{
+		n.ID(),
+	}
+}
+
+// PrimaryKey returns the single primary key column node.
+func (n vehicleReference) PrimaryKey() *query.ColumnNode {
+	return n.ID()
+}
+
+func (n vehicleTable) ID() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"id",
+		"id",
+		query.ColTypeAutoPrimaryKey,
+		schema.ColTypeAutoPrimaryKey,
+		schema.ColSubTypeNone,
+		true,
+		n,
+	)
+	return cn
+}
+
+func (n *vehicleReference) ID() *query.ColumnNode {
+	cn := n.vehicleTable.ID()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n vehicleTable) Name() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"name",
+		"name",
+		query.ColTypeString,
+		schema.ColTypeString,
+		schema.ColSubTypeNone,
+		false,
+		n,
+	)
+	return cn
+}
+
+func (n *vehicleReference) Name() *query.ColumnNode {
+	cn := n.vehicleTable.Name()
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// Car represents the one-to-one relationship formed by the reverse reference from the
+// id column in the car table.
+func (n vehicleTable) Car() CarNode {
+	cn := &carReverse{
+		ReverseNode: query.ReverseNode{
+			ForeignKey: "id",
+			PrimaryKey: "id",
+			Field:      "car",
+			IsUnique:   true,
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *vehicleReference) Car() CarNode {
+	cn := n.vehicleTable.Car().(*carReverse)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+// Truck represents the one-to-one relationship formed by the reverse reference from the
+// id column in the truck table.
+func (n vehicleTable) Truck() TruckNode {
+	cn := &truckReverse{
+		ReverseNode: query.ReverseNode{
+			ForeignKey: "id",
+			PrimaryKey: "id",
+			Field:      "truck",
+			IsUnique:   true,
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n *vehicleReference) Truck() TruckNode {
+	cn := n.vehicleTable.Truck().(*truckReverse)
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+func (n vehicleTable) GobEncode() (data []byte, err error) {
+	return
+}
+
+func (n *vehicleTable) GobDecode(data []byte) (err error) {
+	return
+}
+
+func (n *vehicleReference) GobEncode() (data []byte, err error) {
+	var buf bytes.Buffer
+	e := gob.NewEncoder(&buf)
+
+	if err = e.Encode(&n.ReferenceNode); err != nil {
+		panic(err)
+	}
+	data = buf.Bytes()
+	return
+}
+
+func (n *vehicleReference) GobDecode(data []byte) (err error) {
+	buf := bytes.NewBuffer(data)
+	dec := gob.NewDecoder(buf)
+
+	if err = dec.Decode(&n.ReferenceNode); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func init() {
+	gob.Register(new(vehicleTable))
+	gob.Register(new(vehicleReference))
+}
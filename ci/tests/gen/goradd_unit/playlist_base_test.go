@@ -0,0 +1,405 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSamplePlaylist creates an unsaved minimal version of a Playlist object
+// for testing.
+func createMinimalSamplePlaylist() *Playlist {
+	obj := NewPlaylist()
+	updateMinimalSamplePlaylist(obj)
+
+	return obj
+}
+
+// updateMinimalSamplePlaylist sets the values of a minimal sample to new, random values.
+func updateMinimalSamplePlaylist(obj *Playlist) {
+
+	obj.SetName(test.RandomValue[string](100))
+
+}
+
+// createMaximalSamplePlaylist creates an unsaved version of a Playlist object
+// for testing that includes references to minimal objects.
+func createMaximalSamplePlaylist(ctx context.Context) *Playlist {
+	obj := NewPlaylist()
+	updateMaximalSamplePlaylist(ctx, obj)
+	return obj
+}
+
+// updateMaximalSamplePlaylist sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSamplePlaylist(ctx context.Context, obj *Playlist) {
+	updateMinimalSamplePlaylist(obj)
+
+	obj.SetSongs(createMinimalSampleSong())
+}
+
+// deleteSamplePlaylist deletes an object created and saved by one of the sample creator functions.
+func deleteSamplePlaylist(ctx context.Context, obj *Playlist) {
+	if obj == nil {
+		return
+	}
+
+	for _, item := range obj.Songs() {
+		deleteSampleSong(ctx, item)
+	}
+
+	_ = obj.Delete(ctx)
+}
+
+// assertEqualFieldsPlaylist compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsPlaylist(t *testing.T, obj1, obj2 *Playlist) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.NameIsLoaded() && obj2.NameIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Name(), obj2.Name())
+	}
+
+}
+
+func TestPlaylist_SetID(t *testing.T) {
+
+	obj := NewPlaylist()
+
+	assert.True(t, obj.IsNew())
+	val := query.NewAutoPrimaryKey(test.RandomNumberString())
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.TempAutoPrimaryKey()
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestPlaylist_SetName(t *testing.T) {
+
+	obj := NewPlaylist()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](100)
+	obj.SetName(val)
+	assert.Equal(t, val, obj.Name())
+
+	// test default
+	var d string = ""
+	obj.SetName(d)
+	assert.EqualValues(t, d, obj.Name(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](101)
+	assert.Panics(t, func() {
+		obj.SetName(val)
+	})
+}
+
+func TestPlaylist_Copy(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.Name(), obj2.Name())
+
+}
+
+func TestPlaylist_BasicInsert(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSamplePlaylist(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadPlaylist(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.NameIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.nameIsDirty)
+	obj2.SetName(obj2.Name())
+	assert.False(t, obj2.nameIsDirty)
+
+}
+
+func TestPlaylist_InsertPanics(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.nameIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.nameIsLoaded = true
+
+}
+
+func TestPlaylist_BasicUpdate(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSamplePlaylist(ctx, obj)
+	updateMinimalSamplePlaylist(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadPlaylist(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Name(), obj.Name(), "Name did not update")
+}
+
+func TestPlaylist_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSamplePlaylist(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSamplePlaylist(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+
+	// Test lazy loading
+	obj2, err := LoadPlaylist(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadPlaylist(ctx, obj.PrimaryKey(),
+		node.Playlist().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	assert.Nil(t, obj2.Songs(), "Songs is not loaded initially")
+	v_Songs, _ := obj2.LoadSongs(ctx)
+	assert.NotNil(t, v_Songs)
+	assert.Len(t, v_Songs, 1)
+
+	// test eager loading
+	obj3, err3 := LoadPlaylist(ctx, obj.PrimaryKey(), node.Playlist().Songs())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, len(obj2.Songs()), len(obj3.Songs()))
+
+}
+
+func TestPlaylist_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSamplePlaylist(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSamplePlaylist(ctx, obj)
+
+	obj2, err := LoadPlaylist(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSamplePlaylist(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSamplePlaylist(ctx, obj2)
+
+	obj3, err2 := LoadPlaylist(ctx, obj2.PrimaryKey(), node.Playlist().Songs())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, len(obj2.Songs()), len(obj3.Songs()))
+
+}
+
+func TestPlaylist_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSamplePlaylist(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSamplePlaylist(ctx, obj)
+
+	updateMinimalSampleSong(obj.Songs()[0])
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadPlaylist(ctx, obj.PrimaryKey(), node.Playlist().Songs())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+	assertEqualFieldsSong(t, obj2.Songs()[0], obj.Songs()[0])
+}
+func TestPlaylist_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewPlaylist()
+
+	assert.True(t, obj.ID().IsTemp())
+}
+
+func TestPlaylist_Getters(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+
+	assert.True(t, obj.ID().IsTemp())
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSamplePlaylist(ctx, obj)
+
+	has, _ := HasPlaylist(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadPlaylist(ctx, obj.PrimaryKey(),
+		node.Playlist().ID())
+
+	assert.Equal(t, obj.ID(), obj.Get(PlaylistIDField))
+	assert.Equal(t, obj.Name(), obj.Get(PlaylistNameField))
+	assert.Panics(t, func() { obj2.Name() })
+	assert.Nil(t, obj2.Get(PlaylistNameField))
+
+}
+
+func TestPlaylist_QueryLoad(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSamplePlaylist(ctx, obj)
+
+	objs, err := QueryPlaylists(ctx).
+		Where(op.Equal(node.Playlist().ID(), obj.ID())).
+		OrderBy(node.Playlist().ID()). // exercise order by
+		Limit(1, 0).                   // exercise limit
+		Calculation(node.Playlist(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestPlaylist_QueryLoadI(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSamplePlaylist(ctx, obj)
+
+	objs, _ := QueryPlaylists(ctx).
+		Where(op.Equal(node.Playlist().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*Playlist).PrimaryKey())
+}
+func TestPlaylist_QueryCursor(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSamplePlaylist(ctx, obj)
+
+	cursor, err := QueryPlaylists(ctx).
+		Where(op.Equal(node.Playlist().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QueryPlaylists(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestPlaylist_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSamplePlaylist(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSamplePlaylist(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountPlaylists(ctx); return i }())
+
+}
+
+func TestPlaylist_MarshalJSON(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewPlaylist()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsPlaylist(t, obj, obj2)
+}
+
+func TestPlaylist_MarshalBinary(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewPlaylist()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsPlaylist(t, obj, obj2)
+}
+
+func TestPlaylist_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+	var err error
+
+	for i := 0; i < 11; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 12; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestPlaylist_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSamplePlaylist()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewPlaylist()
+	for i := 0; i < 11; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSamplePlaylist()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewPlaylist()
+	for i := 0; i < 12; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,51 @@
+package goradd_unit
+
+// This is the test file for the Playlist ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaylist_String(t *testing.T) {
+	var obj *Playlist
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewPlaylist()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "Playlist"))
+}
+
+func TestPlaylist_Key(t *testing.T) {
+	var obj *Playlist
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewPlaylist()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestPlaylist_Label(t *testing.T) {
+	var obj *Playlist
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewPlaylist()
+	s := obj.Label()
+	assert.Equal(t, "", s)
+}
+
+func TestPlaylist_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSamplePlaylist()
+	assert.NoError(t, obj.Save(ctx))
+	assert.NoError(t, DeletePlaylist(ctx, obj.PrimaryKey()))
+	obj2, err := LoadPlaylist(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,109 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriority_String(t *testing.T) {
+	assert.Equal(t, PriorityLow.String(), "PriorityLow")
+	assert.Equal(t, PriorityMedium.String(), "PriorityMedium")
+	assert.Equal(t, PriorityHigh.String(), "PriorityHigh")
+}
+
+func TestPriority_Keys(t *testing.T) {
+	var keys []string
+
+	keys = append(keys, PriorityLow.Key())
+	keys = append(keys, PriorityMedium.Key())
+	keys = append(keys, PriorityHigh.Key())
+	v := PrioritiesFromKeys(keys)
+	assert.Equal(t, Priorities(), v)
+
+	assert.Equal(t, Priority(0), PriorityFromKey(""))
+}
+
+func TestPriority_Values(t *testing.T) {
+	a1 := Priorities()
+	a2 := PrioritiesI()
+	for i, v1 := range a1 {
+		assert.Equal(t, v1, a2[i].(Priority))
+		assert.True(t, IsValidPriority(int(v1)))
+	}
+	assert.False(t, IsValidPriority(0))
+}
+
+func TestPriority_FromKey(t *testing.T) {
+	var v Priority
+
+	v = PriorityFromKey(Priority(1).Key())
+	assert.Equal(t, Priority(1), v)
+	v = PriorityFromKey(Priority(2).Key())
+	assert.Equal(t, Priority(2), v)
+	v = PriorityFromKey(Priority(3).Key())
+	assert.Equal(t, Priority(3), v)
+}
+
+func TestPriority_FromInterface(t *testing.T) {
+	v := PriorityLow
+
+	v2, err := PriorityFromInterface(int(v))
+	assert.NoError(t, err)
+	assert.Equal(t, v, v2)
+
+	v2, err = PriorityFromInterface(float64(v))
+	assert.NoError(t, err)
+	assert.Equal(t, v, v2)
+
+	v2, err = PriorityFromInterface(json.Number(fmt.Sprintf("%d", v)))
+	assert.NoError(t, err)
+	assert.Equal(t, v, v2)
+
+	v2, err = PriorityFromInterface(fmt.Sprintf("%d", v))
+	assert.NoError(t, err)
+	assert.Equal(t, v, v2)
+
+	v2, err = PriorityFromInterface(0)
+	assert.Error(t, err)
+	assert.Equal(t, Priority(0), v2)
+
+	var t1 time.Time
+	v2, err = PriorityFromInterface(t1)
+	assert.Error(t, err)
+	assert.Equal(t, Priority(0), v2)
+}
+
+func TestPriority_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Priority
+		wantErr  bool
+	}{
+		// Integer JSON
+		{input: `1`, expected: PriorityLow, wantErr: false},
+		// Float JSON
+		{input: `1.0`, expected: PriorityLow, wantErr: false},
+		// Stringified numbers
+		{input: `"1"`, expected: PriorityLow, wantErr: false},
+		// Invalid values
+		{input: `"1.1.1"`, expected: Priority(0), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var s Priority
+		err := json.Unmarshal([]byte(tt.input), &s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("UnmarshalJSON(%s) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err == nil && s != tt.expected {
+			t.Errorf("UnmarshalJSON(%s) = %v, want %v", tt.input, s, tt.expected)
+		}
+	}
+}
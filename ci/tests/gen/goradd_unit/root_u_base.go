@@ -382,7 +382,8 @@ func (b *RootUBuilder) OrderBy(nodes ...query.Sorter) *RootUBuilder {
 // Limit will return a subset of the data, limited to the offset and number of rows specified.
 // For large data sets and specific types of queries, this can be slow, because it will perform
 // the entire query before computing the limit.
-// You cannot limit a query that has embedded arrays.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the RootU objects. You cannot otherwise limit a query that has embedded arrays.
 func (b *RootUBuilder) Limit(maxRowCount int, offset int) *RootUBuilder {
 	b.builder.Limit(maxRowCount, offset)
 	return b
@@ -395,11 +396,22 @@ func (b *RootUBuilder) Limit(maxRowCount int, offset int) *RootUBuilder {
 // If columns in related tables are specified, then only those columns will be queried and loaded.
 // Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
 // will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
 func (b *RootUBuilder) Select(nodes ...query.Node) *RootUBuilder {
 	b.builder.Select(nodes...)
 	return b
 }
 
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded RootU objects at once, rather than joining them to the query.
+// This avoids repeating the data of each RootU object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *RootUBuilder) Preload(nodes ...query.Node) *RootUBuilder {
+	b.builder.Preload(nodes...)
+	return b
+}
+
 // Calculation adds operation as an aliased value onto base.
 // After the query, you can read the data by passing alias to GetAlias on the returned object.
 func (b *RootUBuilder) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *RootUBuilder {
@@ -426,6 +438,35 @@ func (b *RootUBuilder) Having(node query.Node) *RootUBuilder {
 	return b
 }
 
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *RootUBuilder) ForUpdate() *RootUBuilder {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *RootUBuilder) ForShare() *RootUBuilder {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *RootUBuilder) SkipLocked() *RootUBuilder {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *RootUBuilder) NoWait() *RootUBuilder {
+	b.builder.NoWait()
+	return b
+}
+
 // Count terminates a query and returns just the number of items in the result.
 // If you have Select or Calculation columns in the query, it will count NULL results as well.
 // To not count NULL values, use Where in the builder with a NotNull operation.
@@ -659,7 +700,7 @@ func (o *rootUBase) getInsertFields() (fields map[string]interface{}) {
 
 // Delete deletes the record from the database.
 //
-// An associated {= rev.ReverseIdentifier  will also be deleted since its RootU field is not nullable.
+// An associated LeafU will also be deleted.
 func (o *rootUBase) Delete(ctx context.Context) (err error) {
 	if o == nil {
 		return // allow deleting of a nil object to be a noop
@@ -0,0 +1,109 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverity_String(t *testing.T) {
+	assert.Equal(t, SeverityMinor.String(), "SeverityMinor")
+	assert.Equal(t, SeverityMajor.String(), "SeverityMajor")
+	assert.Equal(t, SeverityCritical.String(), "SeverityCritical")
+}
+
+func TestSeverity_Keys(t *testing.T) {
+	var keys []string
+
+	keys = append(keys, SeverityMinor.Key())
+	keys = append(keys, SeverityMajor.Key())
+	keys = append(keys, SeverityCritical.Key())
+	v := SeveritiesFromKeys(keys)
+	assert.Equal(t, Severities(), v)
+
+	assert.Equal(t, Severity(0), SeverityFromKey(""))
+}
+
+func TestSeverity_Values(t *testing.T) {
+	a1 := Severities()
+	a2 := SeveritiesI()
+	for i, v1 := range a1 {
+		assert.Equal(t, v1, a2[i].(Severity))
+		assert.True(t, IsValidSeverity(int(v1)))
+	}
+	assert.False(t, IsValidSeverity(0))
+}
+
+func TestSeverity_FromKey(t *testing.T) {
+	var v Severity
+
+	v = SeverityFromKey(Severity(1).Key())
+	assert.Equal(t, Severity(1), v)
+	v = SeverityFromKey(Severity(2).Key())
+	assert.Equal(t, Severity(2), v)
+	v = SeverityFromKey(Severity(3).Key())
+	assert.Equal(t, Severity(3), v)
+}
+
+func TestSeverity_FromInterface(t *testing.T) {
+	v := SeverityMinor
+
+	v2, err := SeverityFromInterface(int(v))
+	assert.NoError(t, err)
+	assert.Equal(t, v, v2)
+
+	v2, err = SeverityFromInterface(float64(v))
+	assert.NoError(t, err)
+	assert.Equal(t, v, v2)
+
+	v2, err = SeverityFromInterface(json.Number(fmt.Sprintf("%d", v)))
+	assert.NoError(t, err)
+	assert.Equal(t, v, v2)
+
+	v2, err = SeverityFromInterface(fmt.Sprintf("%d", v))
+	assert.NoError(t, err)
+	assert.Equal(t, v, v2)
+
+	v2, err = SeverityFromInterface(0)
+	assert.Error(t, err)
+	assert.Equal(t, Severity(0), v2)
+
+	var t1 time.Time
+	v2, err = SeverityFromInterface(t1)
+	assert.Error(t, err)
+	assert.Equal(t, Severity(0), v2)
+}
+
+func TestSeverity_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Severity
+		wantErr  bool
+	}{
+		// Integer JSON
+		{input: `1`, expected: SeverityMinor, wantErr: false},
+		// Float JSON
+		{input: `1.0`, expected: SeverityMinor, wantErr: false},
+		// Stringified numbers
+		{input: `"1"`, expected: SeverityMinor, wantErr: false},
+		// Invalid values
+		{input: `"1.1.1"`, expected: Severity(0), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var s Severity
+		err := json.Unmarshal([]byte(tt.input), &s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("UnmarshalJSON(%s) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err == nil && s != tt.expected {
+			t.Errorf("UnmarshalJSON(%s) = %v, want %v", tt.input, s, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleSong creates an unsaved minimal version of a Song object
+// for testing.
+func createMinimalSampleSong() *Song {
+	obj := NewSong()
+	updateMinimalSampleSong(obj)
+
+	return obj
+}
+
+// updateMinimalSampleSong sets the values of a minimal sample to new, random values.
+func updateMinimalSampleSong(obj *Song) {
+
+	obj.SetTitle(test.RandomValue[string](100))
+
+}
+
+// createMaximalSampleSong creates an unsaved version of a Song object
+// for testing that includes references to minimal objects.
+func createMaximalSampleSong(ctx context.Context) *Song {
+	obj := NewSong()
+	updateMaximalSampleSong(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleSong sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleSong(ctx context.Context, obj *Song) {
+	updateMinimalSampleSong(obj)
+
+	obj.SetSongNotes(createMinimalSampleSongNote())
+	obj.SetPlaylists(createMinimalSamplePlaylist())
+}
+
+// deleteSampleSong deletes an object created and saved by one of the sample creator functions.
+func deleteSampleSong(ctx context.Context, obj *Song) {
+	if obj == nil {
+		return
+	}
+
+	for _, item := range obj.SongNotes() {
+		deleteSampleSongNote(ctx, item)
+	}
+
+	for _, item := range obj.Playlists() {
+		deleteSamplePlaylist(ctx, item)
+	}
+
+	_ = obj.Delete(ctx)
+}
+
+// assertEqualFieldsSong compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsSong(t *testing.T, obj1, obj2 *Song) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.TitleIsLoaded() && obj2.TitleIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Title(), obj2.Title())
+	}
+
+}
+
+func TestSong_SetID(t *testing.T) {
+
+	obj := NewSong()
+
+	assert.True(t, obj.IsNew())
+	val := query.NewAutoPrimaryKey(test.RandomNumberString())
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.TempAutoPrimaryKey()
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestSong_SetTitle(t *testing.T) {
+
+	obj := NewSong()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](100)
+	obj.SetTitle(val)
+	assert.Equal(t, val, obj.Title())
+
+	// test default
+	var d string = ""
+	obj.SetTitle(d)
+	assert.EqualValues(t, d, obj.Title(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](101)
+	assert.Panics(t, func() {
+		obj.SetTitle(val)
+	})
+}
+
+func TestSong_Copy(t *testing.T) {
+	obj := createMinimalSampleSong()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.Title(), obj2.Title())
+
+}
+
+func TestSong_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleSong()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSong(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadSong(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.TitleIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.titleIsDirty)
+	obj2.SetTitle(obj2.Title())
+	assert.False(t, obj2.titleIsDirty)
+
+}
+
+func TestSong_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleSong()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.titleIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.titleIsLoaded = true
+
+}
+
+func TestSong_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleSong()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSong(ctx, obj)
+	updateMinimalSampleSong(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadSong(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Title(), obj.Title(), "Title did not update")
+}
+
+func TestSong_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleSong(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSong(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+
+	// Test lazy loading
+	obj2, err := LoadSong(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadSong(ctx, obj.PrimaryKey(),
+		node.Song().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	assert.Nil(t, obj2.SongNotes(), "SongNote is not loaded initially")
+	v_SongNotes, _ := obj2.LoadSongNotes(ctx)
+	assert.NotNil(t, v_SongNotes)
+	assert.Len(t, v_SongNotes, 1)
+
+	assert.Nil(t, obj2.Playlists(), "Playlists is not loaded initially")
+	v_Playlists, _ := obj2.LoadPlaylists(ctx)
+	assert.NotNil(t, v_Playlists)
+	assert.Len(t, v_Playlists, 1)
+
+	// test eager loading
+	obj3, err3 := LoadSong(ctx, obj.PrimaryKey(), node.Song().SongNotes(),
+		node.Song().Playlists(),
+	)
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, len(obj2.SongNotes()), len(obj3.SongNotes()))
+	assert.Equal(t, len(obj2.Playlists()), len(obj3.Playlists()))
+
+}
+
+func TestSong_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleSong(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSong(ctx, obj)
+
+	obj2, err := LoadSong(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleSong(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleSong(ctx, obj2)
+
+	obj3, err2 := LoadSong(ctx, obj2.PrimaryKey(), node.Song().SongNotes(),
+		node.Song().Playlists(),
+	)
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, len(obj2.SongNotes()), len(obj3.SongNotes()))
+
+	assert.Equal(t, len(obj2.Playlists()), len(obj3.Playlists()))
+
+}
+
+func TestSong_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleSong(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSong(ctx, obj)
+
+	updateMinimalSampleSongNote(obj.SongNotes()[0])
+	updateMinimalSamplePlaylist(obj.Playlists()[0])
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadSong(ctx, obj.PrimaryKey(), node.Song().SongNotes(),
+		node.Song().Playlists(),
+	)
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+	assertEqualFieldsSongNote(t, obj2.SongNotes()[0], obj.SongNotes()[0])
+
+	assertEqualFieldsPlaylist(t, obj2.Playlists()[0], obj.Playlists()[0])
+}
+func TestSong_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewSong()
+
+	assert.True(t, obj.ID().IsTemp())
+}
+
+func TestSong_Getters(t *testing.T) {
+	obj := createMinimalSampleSong()
+
+	assert.True(t, obj.ID().IsTemp())
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleSong(ctx, obj)
+
+	has, _ := HasSong(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadSong(ctx, obj.PrimaryKey(),
+		node.Song().ID())
+
+	assert.Equal(t, obj.ID(), obj.Get(SongIDField))
+	assert.Equal(t, obj.Title(), obj.Get(SongTitleField))
+	assert.Panics(t, func() { obj2.Title() })
+	assert.Nil(t, obj2.Get(SongTitleField))
+
+}
+
+func TestSong_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleSong()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSong(ctx, obj)
+
+	objs, err := QuerySongs(ctx).
+		Where(op.Equal(node.Song().ID(), obj.ID())).
+		OrderBy(node.Song().ID()). // exercise order by
+		Limit(1, 0).               // exercise limit
+		Calculation(node.Song(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestSong_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleSong()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleSong(ctx, obj)
+
+	objs, _ := QuerySongs(ctx).
+		Where(op.Equal(node.Song().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*Song).PrimaryKey())
+}
+func TestSong_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleSong()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSong(ctx, obj)
+
+	cursor, err := QuerySongs(ctx).
+		Where(op.Equal(node.Song().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QuerySongs(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestSong_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleSong(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleSong(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountSongs(ctx); return i }())
+
+}
+
+func TestSong_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleSong()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewSong()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsSong(t, obj, obj2)
+}
+
+func TestSong_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleSong()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewSong()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsSong(t, obj, obj2)
+}
+
+func TestSong_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleSong()
+	var err error
+
+	for i := 0; i < 13; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 14; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestSong_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleSong()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewSong()
+	for i := 0; i < 13; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleSong()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewSong()
+	for i := 0; i < 14; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
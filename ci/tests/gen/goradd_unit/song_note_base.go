@@ -0,0 +1,1130 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/goradd/anyutil"
+	"github.com/goradd/gro/broadcast"
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/db"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+)
+
+// SongNoteBase is embedded in a SongNote object and provides the ORM access to the database.
+// The member variables of the structure are private and should not normally be accessed by the SongNote embedder.
+// Instead, use the accessor functions.
+type songNoteBase struct {
+	id             query.AutoPrimaryKey
+	idIsLoaded     bool
+	idIsDirty      bool
+	text           string
+	textIsLoaded   bool
+	textIsDirty    bool
+	songID         query.AutoPrimaryKey
+	songIDIsLoaded bool
+	songIDIsDirty  bool
+
+	// References
+	song *Song
+
+	// Custom aliases, if specified
+	_aliases map[string]any
+
+	// Indicates whether this is a new object, or one loaded from the database. Used by Save to know whether to Insert or Update.
+	_restored bool
+
+	_originalPK query.AutoPrimaryKey
+}
+
+// IDs used to access the SongNote object fields by name using the Get function.
+// doc: type=SongNote
+const (
+	SongNoteIDField     = `id`
+	SongNoteTextField   = `text`
+	SongNoteSongIDField = `songID`
+	SongNoteSongField   = `song`
+)
+
+const SongNoteTextMaxLength = 200 // The number of runes the column can hold
+
+// Initialize or re-initialize a SongNote database object to default values.
+// The primary key will get a temporary unique value which will be replaced when the object is saved.
+func (o *songNoteBase) Initialize() {
+	o.id = query.TempAutoPrimaryKey()
+	o.idIsLoaded = true
+	o.idIsDirty = false
+
+	o.text = ""
+	o.textIsLoaded = false
+	o.textIsDirty = false
+
+	o.songID = query.AutoPrimaryKey{}
+	o.songIDIsLoaded = false
+	o.songIDIsDirty = false
+
+	o._aliases = nil
+	o._restored = false
+}
+
+// Copy copies most fields to a new SongNote object.
+// Forward reference ids will be copied, but reverse and many-many references will not.
+// Attached objects will not be included in the copy.
+// Automatically generated fields will not be included in the copy.
+// The primary key field will not be copied, since it is normally auto-generated.
+// Call Save() on the new object to save it into the database.
+// Copy might panic if any fields in the database were set to a size larger than the
+// maximum size through a process that accessed the database outside of the ORM.
+func (o *songNoteBase) Copy() (newObject *SongNote) {
+	newObject = NewSongNote()
+	if o.idIsLoaded {
+		newObject.SetID(o.id)
+	}
+	if o.textIsLoaded {
+		newObject.SetText(o.text)
+	}
+	if o.songIDIsLoaded {
+		newObject.SetSongID(o.songID)
+	}
+	return
+}
+
+// OriginalPrimaryKey returns the value of the primary key that was originally loaded into the object when it was
+// read from the database.
+func (o *songNoteBase) OriginalPrimaryKey() query.AutoPrimaryKey {
+	return o._originalPK
+}
+
+// PrimaryKey returns the value of the primary key of the record.
+func (o *songNoteBase) PrimaryKey() query.AutoPrimaryKey {
+	if o._restored && !o.idIsLoaded {
+		panic("ID was not selected in the last query and has not been set, and so PrimaryKey is not valid")
+	}
+	return o.id
+}
+
+// SetPrimaryKey sets the value of the primary key in the object, to be saved later in the database using the Save() function.
+// Normally you will not need to call this function, since the primary key value is automatically generated by the
+// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
+// merging data.
+// You cannot change a primary key for a record that has been written to the database. While SQL databases will
+// allow it, NoSql databases will not. Save a copy and delete this one instead.
+func (o *songNoteBase) SetPrimaryKey(v query.AutoPrimaryKey) {
+	o.SetID(v)
+}
+
+// ID returns the loaded value of the id field in the database.
+func (o *songNoteBase) ID() query.AutoPrimaryKey {
+	if o._restored && !o.idIsLoaded {
+		panic("ID was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.id
+}
+
+// IDIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *songNoteBase) IDIsLoaded() bool {
+	return o.idIsLoaded
+}
+
+// SetID sets the value of ID in the object, to be saved later in the database using the Save() function.
+// Normally you will not need to call this function, since the ID value is automatically generated by the
+// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
+// merging data.
+// You cannot change a primary key for a record that has been written to the database. While SQL databases will
+// allow it, NoSql databases will not. Save a copy and delete this one instead.
+func (o *songNoteBase) SetID(v query.AutoPrimaryKey) {
+	if o._restored {
+		panic("error: Do not change a primary key for a record that has been saved. Instead, save a copy and delete the original.")
+	}
+	o.idIsLoaded = true
+	o.idIsDirty = true
+	o.id = v
+}
+
+// Text returns the value of the loaded text field in the database.
+func (o *songNoteBase) Text() string {
+	if o._restored && !o.textIsLoaded {
+		panic("Text was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.text
+}
+
+// TextIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *songNoteBase) TextIsLoaded() bool {
+	return o.textIsLoaded
+}
+
+// SetText sets the value of Text in the object, to be saved later in the database using the Save() function.
+func (o *songNoteBase) SetText(v string) {
+	if utf8.RuneCountInString(v) > SongNoteTextMaxLength {
+		panic("attempted to set SongNote.Text to a value larger than its maximum length in runes")
+	}
+	if o._restored &&
+		o.textIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		o.text == v {
+		// no change
+		return
+	}
+
+	o.textIsLoaded = true
+	o.text = v
+	o.textIsDirty = true
+}
+
+// SongID returns the value of the loaded song_id field in the database.
+func (o *songNoteBase) SongID() query.AutoPrimaryKey {
+	if o._restored && !o.songIDIsLoaded {
+		panic("SongID was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.songID
+}
+
+// SongIDIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *songNoteBase) SongIDIsLoaded() bool {
+	return o.songIDIsLoaded
+}
+
+// SetSongID sets the value of SongID in the object, to be saved later in the database using the Save() function.
+func (o *songNoteBase) SetSongID(v query.AutoPrimaryKey) {
+	if o._restored &&
+		o.songIDIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		o.songID == v {
+		// no change
+		return
+	}
+
+	o.songIDIsLoaded = true
+	o.songID = v
+	o.songIDIsDirty = true
+	if o.song != nil &&
+		o.songID != o.song.PrimaryKey() {
+		o.song = nil
+	}
+}
+
+// Song returns the current value of the loaded Song, and nil if its not loaded.
+func (o *songNoteBase) Song() *Song {
+	return o.song
+}
+
+// LoadSong returns the related Song. If it is not already loaded,
+// it will attempt to load it, provided the SongID column has been loaded first.
+func (o *songNoteBase) LoadSong(ctx context.Context) (*Song, error) {
+	var err error
+
+	if o.song == nil {
+		if !o.songIDIsLoaded {
+			panic("SongID must be selected in the previous query")
+		}
+		// Load and cache
+		o.song, err = LoadSong(ctx, o.songID)
+	}
+	return o.song, err
+}
+
+// SetSong sets the value of Song in the object, to be saved later using the Save() function.
+func (o *songNoteBase) SetSong(song *Song) {
+	if song == nil {
+		panic("Cannot set Song to a nil value since SongID is not nullable.")
+	} else {
+		o.song = song
+		o.songIDIsLoaded = true
+		if o.songID != song.PrimaryKey() {
+			o.songID = song.PrimaryKey()
+			o.songIDIsDirty = true
+		}
+	}
+}
+
+// GetAlias returns the value for the Alias node aliasKey that was returned in the most
+// recent query.
+func (o *songNoteBase) GetAlias(aliasKey string) query.AliasValue {
+	if a, ok := o._aliases[aliasKey]; ok {
+		return query.NewAliasValue(a)
+	} else {
+		panic("Alias " + aliasKey + " not found.")
+	}
+}
+
+// IsNew returns true if the object will create a new record when saved.
+func (o *songNoteBase) IsNew() bool {
+	return !o._restored
+}
+
+// LoadSongNote returns a SongNote from the database.
+// selectNodes lets you provide nodes for selecting specific fields or additional fields from related tables.
+// See [SongNotesBuilder.Select] for more info.
+func LoadSongNote(ctx context.Context, pk query.AutoPrimaryKey, selectNodes ...query.Node) (*SongNote, error) {
+	return querySongNotes(ctx).
+		Where(op.Equal(node.SongNote().ID(), pk)).
+		Select(selectNodes...).
+		Get()
+}
+
+// HasSongNote returns true if a SongNote with the given primary key exists in the database.
+// doc: type=SongNote
+func HasSongNote(ctx context.Context, pk query.AutoPrimaryKey) (bool, error) {
+	v, err := querySongNotes(ctx).
+		Where(op.Equal(node.SongNote().ID(), pk)).
+		Count()
+	return v > 0, err
+}
+
+// LoadSongNotesBySongID queries SongNote objects by the given index values.
+// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
+// See [SongNotesBuilder.Select].
+// If you need a more elaborate query, use QuerySongNotes() to start a query builder.
+func LoadSongNotesBySongID(ctx context.Context, songID query.AutoPrimaryKey, selectNodes ...query.Node) ([]*SongNote, error) {
+	q := querySongNotes(ctx)
+	q = q.Where(op.Equal(node.SongNote().SongID(), songID))
+	return q.Select(selectNodes...).Load()
+}
+
+// HasSongNoteBySongID returns true if the
+// given index values exist in the database.
+// doc: type=SongNote
+func HasSongNoteBySongID(ctx context.Context, songID query.AutoPrimaryKey) (bool, error) {
+	q := querySongNotes(ctx)
+	q = q.Where(op.Equal(node.SongNote().SongID(), songID))
+	v, err := q.Count()
+	return v > 0, err
+}
+
+// The SongNoteBuilder uses a builder pattern to create a query on the database.
+// Create a SongNoteBuilder by calling QuerySongNotes, which will select all
+// the SongNote object in the database. Then filter and arrange those objects
+// by calling Where, Select, etc.
+// End a query by calling either Load, LoadI, LoadCursor, Get, or Count.
+// A SongNoteBuilder stores the context it will use to perform the query, and thus is
+// meant to be a short-lived object. You should not save it for later use.
+type SongNoteBuilder struct {
+	builder *query.Builder
+	ctx     context.Context
+}
+
+func newSongNoteBuilder(ctx context.Context) *SongNoteBuilder {
+	b := SongNoteBuilder{
+		builder: query.NewBuilder(node.SongNote()),
+		ctx:     ctx,
+	}
+	return &b
+}
+
+// Load terminates the query builder, performs the query, and returns a slice of SongNote objects.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *SongNoteBuilder) Load() (songNotes []*SongNote, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+	database := db.GetDatabase("goradd_unit")
+	var results any
+
+	ctx := b.ctx
+	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _, item := range results.([]map[string]any) {
+		o := new(SongNote)
+		o.unpack(item, o)
+		songNotes = append(songNotes, o)
+	}
+	return
+}
+
+// LoadI terminates the query builder, performs the query, and returns a slice of interfaces.
+// This can then satisfy a variety of interfaces that load arrays of objects, including KeyLabeler.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *SongNoteBuilder) LoadI() (songNotes []query.OrmObj, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+	database := db.GetDatabase("goradd_unit")
+	var results any
+
+	ctx := b.ctx
+	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _, item := range results.([]map[string]any) {
+		o := new(SongNote)
+		o.unpack(item, o)
+		songNotes = append(songNotes, o)
+	}
+	return
+}
+
+// LoadCursor terminates the query builder, performs the query, and returns a cursor to the query.
+//
+// A query cursor is useful for dealing with large amounts of query results. However, there are some
+// limitations to its use. When working with SQL databases, you cannot use a cursor while querying
+// many-to-many or reverse relationships that will create an array of values.
+//
+// Call Next() on the returned cursor object to step through the results. Make sure you call Close
+// on the cursor object when you are done. You should use
+//
+//	defer cursor.Close()
+//
+// to make sure the cursor gets closed.
+func (b *SongNoteBuilder) LoadCursor() (songNotesCursor, error) {
+	b.builder.Command = query.BuilderCommandLoadCursor
+	database := db.GetDatabase("goradd_unit")
+	result, err := database.BuilderQuery(b.ctx, b.builder)
+	var cursor query.CursorI
+	if result != nil {
+		cursor = result.(query.CursorI)
+	}
+	return songNotesCursor{cursor}, err
+}
+
+type songNotesCursor struct {
+	query.CursorI
+}
+
+// Next returns the current SongNote object and moves the cursor to the next one.
+//
+// If there are no more records, it returns nil.
+func (c songNotesCursor) Next() (*SongNote, error) {
+	if c.CursorI == nil {
+		return nil, nil
+	}
+
+	row, err := c.CursorI.Next()
+	if row == nil || err != nil {
+		return nil, err
+	}
+	o := new(SongNote)
+	o.unpack(row, o)
+	return o, nil
+}
+
+// Get is a convenience method to return only the first item found in a query.
+// The entire query is performed, so you should generally use this only if you know
+// you are selecting on one or very few items.
+// If an error occurs, or no results are found, a nil is returned.
+func (b *SongNoteBuilder) Get() (*SongNote, error) {
+	results, err := b.Load()
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// Where adds a condition to filter what gets selected.
+// Calling Where multiple times will AND the conditions together.
+func (b *SongNoteBuilder) Where(c query.Node) *SongNoteBuilder {
+	b.builder.Where(c)
+	return b
+}
+
+// OrderBy specifies how the resulting data should be sorted.
+// By default, the given nodes are sorted in ascending order.
+// Add Descending() to the node to specify that it should be sorted in descending order.
+func (b *SongNoteBuilder) OrderBy(nodes ...query.Sorter) *SongNoteBuilder {
+	b.builder.OrderBy(nodes...)
+	return b
+}
+
+// Limit will return a subset of the data, limited to the offset and number of rows specified.
+// For large data sets and specific types of queries, this can be slow, because it will perform
+// the entire query before computing the limit.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the SongNote objects. You cannot otherwise limit a query that has embedded arrays.
+func (b *SongNoteBuilder) Limit(maxRowCount int, offset int) *SongNoteBuilder {
+	b.builder.Limit(maxRowCount, offset)
+	return b
+}
+
+// Select specifies what specific columns will be loaded with data.
+// By default, all the columns of the song_note table will be queried and loaded.
+// If nodes contains columns from the song_note table, that will limit the columns queried and loaded to only those columns.
+// If related tables are specified, then all the columns from those tables are queried, selected and joined to the result.
+// If columns in related tables are specified, then only those columns will be queried and loaded.
+// Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
+// will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
+func (b *SongNoteBuilder) Select(nodes ...query.Node) *SongNoteBuilder {
+	b.builder.Select(nodes...)
+	return b
+}
+
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded SongNote objects at once, rather than joining them to the query.
+// This avoids repeating the data of each SongNote object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *SongNoteBuilder) Preload(nodes ...query.Node) *SongNoteBuilder {
+	b.builder.Preload(nodes...)
+	return b
+}
+
+// Calculation adds operation as an aliased value onto base.
+// After the query, you can read the data by passing alias to GetAlias on the returned object.
+func (b *SongNoteBuilder) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *SongNoteBuilder {
+	b.builder.Calculation(base, alias, operation)
+	return b
+}
+
+// Distinct removes duplicates from the results of the query.
+// Adding a Select() is usually required.
+func (b *SongNoteBuilder) Distinct() *SongNoteBuilder {
+	b.builder.Distinct()
+	return b
+}
+
+// GroupBy controls how results are grouped when using aggregate functions with Calculation.
+func (b *SongNoteBuilder) GroupBy(nodes ...query.Node) *SongNoteBuilder {
+	b.builder.GroupBy(nodes...)
+	return b
+}
+
+// Having does additional filtering on the results of the query after the query is performed.
+func (b *SongNoteBuilder) Having(node query.Node) *SongNoteBuilder {
+	b.builder.Having(node)
+	return b
+}
+
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *SongNoteBuilder) ForUpdate() *SongNoteBuilder {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *SongNoteBuilder) ForShare() *SongNoteBuilder {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *SongNoteBuilder) SkipLocked() *SongNoteBuilder {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *SongNoteBuilder) NoWait() *SongNoteBuilder {
+	b.builder.NoWait()
+	return b
+}
+
+// Count terminates a query and returns just the number of items in the result.
+// If you have Select or Calculation columns in the query, it will count NULL results as well.
+// To not count NULL values, use Where in the builder with a NotNull operation.
+// To count distinct combinations of items, call Distinct() on the builder.
+func (b *SongNoteBuilder) Count() (int, error) {
+	b.builder.Command = query.BuilderCommandCount
+	database := db.GetDatabase("goradd_unit")
+
+	ctx := b.ctx
+	results, err := database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return 0, err
+	}
+	return results.(int), nil
+}
+
+// CountSongNotes returns the total number of items in the song_note table.
+func CountSongNotes(ctx context.Context) (int, error) {
+	return QuerySongNotes(ctx).Count()
+}
+
+// CountSongNotesBySongID queries the database and returns the number of SongNote objects that
+// have songID.
+// doc: type=SongNote
+func CountSongNotesBySongID(ctx context.Context, songID query.AutoPrimaryKey) (int, error) {
+	v_songID := songID
+	return QuerySongNotes(ctx).
+		Where(op.Equal(node.SongNote().SongID(), v_songID)).
+		Count()
+}
+
+// unpack recursively transforms data coming from the database into ORM objects.
+func (o *songNoteBase) unpack(m map[string]interface{}, objThis *SongNote) {
+
+	if v, ok := m["id"]; ok && v != nil {
+		if o.id, ok = v.(query.AutoPrimaryKey); ok {
+			o.idIsLoaded = true
+			o.idIsDirty = false
+			o._originalPK = o.id
+		} else {
+			panic("Wrong type found for id.")
+		}
+	} else {
+		o.idIsLoaded = false
+		o.id = query.TempAutoPrimaryKey()
+		o.idIsDirty = false
+	}
+
+	if v, ok := m["text"]; ok && v != nil {
+		if o.text, ok = v.(string); ok {
+			o.textIsLoaded = true
+			o.textIsDirty = false
+		} else {
+			panic("Wrong type found for text.")
+		}
+	} else {
+		o.textIsLoaded = false
+		o.text = ""
+		o.textIsDirty = false
+	}
+
+	if v, ok := m["songID"]; ok && v != nil {
+		if o.songID, ok = v.(query.AutoPrimaryKey); ok {
+			o.songIDIsLoaded = true
+			o.songIDIsDirty = false
+		} else {
+			panic("Wrong type found for songID.")
+		}
+	} else {
+		o.songIDIsLoaded = false
+		o.songID = query.AutoPrimaryKey{}
+		o.songIDIsDirty = false
+	}
+
+	if v, ok := m["song"]; ok {
+		if song, ok2 := v.(map[string]any); ok2 {
+			o.song = new(Song)
+			o.song.unpack(song, o.song)
+			// mirror foreign key with loaded object
+			o.songID = o.song.PrimaryKey()
+			o.songIDIsLoaded = true
+			o.songIDIsDirty = false
+		} else {
+			panic("Wrong type found for Song object.")
+		}
+	} else {
+		o.song = nil
+	}
+
+	if v, ok := m["aliases_"]; ok {
+		o._aliases = v.(map[string]any)
+	}
+
+	o._restored = true
+
+}
+
+// save will update or insert the object, depending on the state of the object.
+func (o *songNoteBase) save(ctx context.Context) error {
+	if o._restored {
+		return o.update(ctx)
+	} else {
+		return o.insert(ctx)
+	}
+}
+
+// update will update the values in the database, saving any changed values.
+// If the table has auto-generated values, those will be updated automatically.
+func (o *songNoteBase) update(ctx context.Context) error {
+	if !o._restored {
+		panic("cannot update a record that was not originally read from the database.")
+	}
+	if !o.IsDirty() {
+		return nil // nothing to save
+	}
+
+	var modifiedFields map[string]interface{}
+
+	d := Database()
+	err := db.WithTransaction(ctx, d, func(ctx context.Context) error {
+		// Save loaded Song object to get its new pk and update it here.
+		if o.song != nil {
+			if err := o.song.Save(ctx); err != nil {
+				return err
+			}
+			o.SetSongID(o.song.PrimaryKey())
+		}
+
+		modifiedFields = getSongNoteUpdateFields(o)
+		if len(modifiedFields) != 0 {
+			err2 := d.Update(ctx, "song_note",
+				map[string]any{
+					"id": o._originalPK,
+				},
+				modifiedFields,
+				"",
+				0,
+			)
+			if err2 != nil {
+				return err2
+			}
+		}
+
+		return nil
+	}) // transaction
+	if err != nil {
+		return err
+	}
+
+	o.resetDirtyStatus()
+	if len(modifiedFields) != 0 {
+		broadcast.Update(ctx, "goradd_unit", "song_note", o._originalPK, anyutil.SortedKeys(modifiedFields)...)
+	}
+
+	return nil
+}
+
+// insert will insert the object into the database. Related items will be saved.
+func (o *songNoteBase) insert(ctx context.Context) (err error) {
+	var insertFields map[string]interface{}
+	d := Database()
+	err = db.WithTransaction(ctx, d, func(ctx context.Context) error {
+		// Save loaded Song object to get its new pk and update it here.
+		if o.song != nil {
+			if err := o.song.Save(ctx); err != nil {
+				return err
+			}
+			o.SetSongID(o.song.PrimaryKey())
+		}
+		if !o.textIsLoaded {
+			panic("a value for Text is required, and there is no default value. Call SetText() before inserting the record.")
+		}
+		if !o.songIDIsLoaded {
+			panic("a value for SongID is required, and there is no default value. Call SetSongID() before inserting the record.")
+		}
+		insertFields = getSongNoteInsertFields(o)
+		err = d.Insert(ctx, "song_note", insertFields, "id")
+		if err != nil {
+			return err
+		}
+		o.id = insertFields["id"].(query.AutoPrimaryKey)
+		o._originalPK = o.id
+		o.idIsLoaded = true
+
+		return nil
+
+	}) // transaction
+
+	if err != nil {
+		return
+	}
+
+	o.resetDirtyStatus()
+	o._restored = true
+	broadcast.Insert(ctx, "goradd_unit", "song_note", o.PrimaryKey())
+	return
+}
+
+// getUpdateFields returns the database columns that will be sent to the update process.
+// This will include timestamp fields only if some other column has changed.
+func (o *songNoteBase) getUpdateFields() (fields map[string]interface{}) {
+	fields = map[string]interface{}{}
+	if o.idIsDirty {
+		fields["id"] = o.id
+	}
+	if o.textIsDirty {
+		fields["text"] = o.text
+	}
+	if o.songIDIsDirty {
+		fields["song_id"] = o.songID
+	}
+	return
+}
+
+// getInsertFields returns the fields that will be specified in an insert operation.
+// Optional fields that have not been set and have no default will be returned as nil.
+// NoSql databases should interpret this as no value. Sql databases should interpret this as
+// explicitly setting a NULL value, which would override any database specific default value.
+// Auto-generated fields will be returned here with their generated values, except AutoPK fields, which are returned
+// as a new AutoPrimaryKey to indicate that the driver will fill this in after the insert.
+func (o *songNoteBase) getInsertFields() (fields map[string]interface{}) {
+	fields = map[string]interface{}{}
+	if o.idIsDirty {
+		fields["id"] = o.id
+	}
+
+	fields["text"] = o.text
+
+	fields["song_id"] = o.songID
+	return
+}
+
+// Delete deletes the record from the database.
+func (o *songNoteBase) Delete(ctx context.Context) (err error) {
+	if o == nil {
+		return // allow deleting of a nil object to be a noop
+	}
+	if !o._restored {
+		panic("Cannot delete a record that has no primary key value.")
+	}
+	d := Database()
+	err = d.Delete(ctx, "song_note",
+		map[string]any{
+			"id": o._originalPK,
+		},
+		"",
+		0,
+	)
+	if err != nil {
+		return err
+	}
+	broadcast.Delete(ctx, "goradd_unit", "song_note", o._originalPK)
+	return
+}
+
+// deleteSongNote deletes the SongNote with primary key pk from the database
+// and handles associated records.
+func deleteSongNote(ctx context.Context, pk query.AutoPrimaryKey) error {
+	d := db.GetDatabase("goradd_unit")
+	err := d.Delete(ctx, "song_note",
+		map[string]any{
+			"id": pk,
+		},
+		"", 0)
+
+	if err != nil {
+		return err
+	}
+	broadcast.Delete(ctx, "goradd_unit", "song_note", pk)
+	return err
+}
+
+// resetDirtyStatus resets the dirty status of every field in the object.
+func (o *songNoteBase) resetDirtyStatus() {
+	o.idIsDirty = false
+	o.textIsDirty = false
+	o.songIDIsDirty = false
+
+}
+
+// IsDirty returns true if the object has been changed since it was read from the database or created.
+func (o *songNoteBase) IsDirty() (dirty bool) {
+	dirty = o.idIsDirty ||
+		o.textIsDirty ||
+		o.songIDIsDirty
+
+	dirty = dirty ||
+		o.song != nil && o.song.IsDirty()
+
+	return
+}
+
+// Get returns the value of a field in the object based on the field's name.
+// It will also get related objects if they are loaded.
+// Invalid fields and objects are returned as nil.
+// Get can be used to retrieve a value by using the Field() of a node.
+func (o *songNoteBase) Get(key string) interface{} {
+	switch key {
+	case SongNoteIDField:
+		if !o.idIsLoaded {
+			return nil
+		}
+		return o.id
+	case SongNoteTextField:
+		if !o.textIsLoaded {
+			return nil
+		}
+		return o.text
+	case SongNoteSongIDField:
+		if !o.songIDIsLoaded {
+			return nil
+		}
+		return o.songID
+	case SongNoteSongField:
+		return o.Song()
+	}
+	return nil
+}
+
+// MarshalBinary serializes the object into a buffer that is deserializable using UnmarshalBinary.
+// It should be used for transmitting database objects over the wire, or for temporary storage. It does not send
+// a version number, so if the data format changes, its up to you to invalidate the old stored objects.
+// The framework uses this to serialize the object when it is stored in a control.
+func (o *songNoteBase) MarshalBinary() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := gob.NewEncoder(buf)
+	if err := o.encodeTo(enc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (o *songNoteBase) encodeTo(enc db.Encoder) error {
+
+	if err := enc.Encode(o.id); err != nil {
+		return fmt.Errorf("error encoding SongNote.id: %w", err)
+	}
+	if err := enc.Encode(o.idIsLoaded); err != nil {
+		return fmt.Errorf("error encoding SongNote.idIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.idIsDirty); err != nil {
+		return fmt.Errorf("error encoding SongNote.idIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.text); err != nil {
+		return fmt.Errorf("error encoding SongNote.text: %w", err)
+	}
+	if err := enc.Encode(o.textIsLoaded); err != nil {
+		return fmt.Errorf("error encoding SongNote.textIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.textIsDirty); err != nil {
+		return fmt.Errorf("error encoding SongNote.textIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.songID); err != nil {
+		return fmt.Errorf("error encoding SongNote.songID: %w", err)
+	}
+	if err := enc.Encode(o.songIDIsLoaded); err != nil {
+		return fmt.Errorf("error encoding SongNote.songIDIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.songIDIsDirty); err != nil {
+		return fmt.Errorf("error encoding SongNote.songIDIsDirty: %w", err)
+	}
+
+	if o.song == nil {
+		if err := enc.Encode(false); err != nil {
+			return err
+		}
+	} else {
+		if err := enc.Encode(true); err != nil {
+			return err
+		}
+		if err := enc.Encode(o.song); err != nil {
+			return fmt.Errorf("error encoding SongNote.song: %w", err)
+		}
+	}
+
+	if o._aliases == nil {
+		if err := enc.Encode(false); err != nil {
+			return err
+		}
+	} else {
+		if err := enc.Encode(true); err != nil {
+			return err
+		}
+		if err := enc.Encode(o._aliases); err != nil {
+			return fmt.Errorf("error encoding SongNote._aliases: %w", err)
+		}
+	}
+
+	if err := enc.Encode(o._restored); err != nil {
+		return fmt.Errorf("error encoding SongNote._restored: %w", err)
+	}
+	if err := enc.Encode(o._originalPK); err != nil {
+		return fmt.Errorf("error encoding SongNote._originalPK: %w", err)
+	}
+	return nil
+}
+
+// UnmarshalBinary converts a structure that was created with MarshalBinary into a SongNote object.
+func (o *songNoteBase) UnmarshalBinary(data []byte) (err error) {
+	buf := bytes.NewReader(data)
+	dec := gob.NewDecoder(buf)
+	return o.decodeFrom(dec)
+}
+
+func (o *songNoteBase) decodeFrom(dec db.Decoder) (err error) {
+	var isPtr bool
+
+	_ = isPtr
+	if err = dec.Decode(&o.id); err != nil {
+		return fmt.Errorf("error decoding SongNote.id: %w", err)
+	}
+	if err = dec.Decode(&o.idIsLoaded); err != nil {
+		return fmt.Errorf("error decoding SongNote.idIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.idIsDirty); err != nil {
+		return fmt.Errorf("error decoding SongNote.idIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.text); err != nil {
+		return fmt.Errorf("error decoding SongNote.text: %w", err)
+	}
+	if err = dec.Decode(&o.textIsLoaded); err != nil {
+		return fmt.Errorf("error decoding SongNote.textIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.textIsDirty); err != nil {
+		return fmt.Errorf("error decoding SongNote.textIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.songID); err != nil {
+		return fmt.Errorf("error decoding SongNote.songID: %w", err)
+	}
+	if err = dec.Decode(&o.songIDIsLoaded); err != nil {
+		return fmt.Errorf("error decoding SongNote.songIDIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.songIDIsDirty); err != nil {
+		return fmt.Errorf("error decoding SongNote.songIDIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&isPtr); err != nil {
+		return fmt.Errorf("error decoding SongNote.song isPtr: %w", err)
+	}
+	if isPtr {
+		if err = dec.Decode(&o.song); err != nil {
+			return fmt.Errorf("error decoding SongNote.song: %w", err)
+		}
+	}
+	if err = dec.Decode(&isPtr); err != nil {
+		return fmt.Errorf("error decoding SongNote._aliases isPtr: %w", err)
+	}
+	if isPtr {
+		if err = dec.Decode(&o._aliases); err != nil {
+			return fmt.Errorf("error decoding SongNote._aliases: %w", err)
+		}
+	}
+
+	if err = dec.Decode(&o._restored); err != nil {
+		return fmt.Errorf("error decoding SongNote._restored: %w", err)
+	}
+	if err = dec.Decode(&o._originalPK); err != nil {
+		return fmt.Errorf("error decoding SongNote._originalPK: %w", err)
+	}
+	return
+}
+
+// MarshalJSON serializes the object into a JSON object.
+// Only valid data will be serialized, meaning, you can control what gets serialized by using Select to
+// select only the fields you want when you query for the object. Another way to control the output
+// is to call MarshalStringMap, modify the map, then encode the map.
+func (o *songNoteBase) MarshalJSON() (data []byte, err error) {
+	v := o.MarshalStringMap()
+	return json.Marshal(v)
+}
+
+// MarshalStringMap serializes the object into a string map of interfaces.
+// Only valid data will be serialized, meaning, you can control what gets serialized by using Select to
+// select only the fields you want when you query for the object. The keys are the same as the json keys.
+func (o *songNoteBase) MarshalStringMap() map[string]interface{} {
+	v := make(map[string]interface{})
+
+	if o.idIsLoaded {
+		v["id"] = o.id
+	}
+
+	if o.textIsLoaded {
+		v["text"] = o.text
+	}
+
+	if o.songIDIsLoaded {
+		v["songID"] = o.songID
+	}
+
+	if val := o.song; val != nil {
+		v["song"] = val.MarshalStringMap()
+	}
+	for _k, _v := range o._aliases {
+		v[_k] = _v
+	}
+	return v
+}
+
+// UnmarshalJSON unmarshalls the given json data into the SongNote. The SongNote can be a
+// newly created object, or one loaded from the database.
+//
+// After unmarshalling, the object is not  saved. You must call Save to insert it into the database
+// or update it.
+//
+// Unmarshalling of sub-objects, as in objects linked via foreign keys, is not currently supported.
+//
+// The fields it expects are:
+//
+//	"id" - query.AutoPrimaryKey
+//	"text" - string
+func (o *songNoteBase) UnmarshalJSON(data []byte) (err error) {
+	var v map[string]interface{}
+	if len(data) == 0 {
+		return
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber() // use a number to avoid precision errors
+	if err = d.Decode(&v); err != nil {
+		return err
+	}
+	return o.UnmarshalStringMap(v)
+}
+
+// UnmarshalStringMap will load the values from the stringmap into the object.
+//
+// Override this in SongNote to modify the json before sending it here.
+func (o *songNoteBase) UnmarshalStringMap(m map[string]interface{}) (err error) {
+	for k, v := range m {
+		switch k {
+
+		case "id":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				if u, ok := Database().(db.AutoPrimaryKeyJsonUnmarshaller); ok {
+					u.AutoPrimaryKeyJsonUnmarshal(v)
+				} else {
+					switch n := v.(type) {
+					case json.Number:
+						n2, err := n.Int64()
+						if err != nil {
+							return err
+						}
+						o.SetID(query.NewAutoPrimaryKey(n2))
+					default:
+						o.SetID(query.NewAutoPrimaryKey(v))
+					}
+				}
+			}
+		case "text":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				if s, ok := v.(string); !ok {
+					return fmt.Errorf("json field %s must be a string", k)
+				} else {
+					o.SetText(s)
+				}
+			}
+		case "songID":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				if _, ok := m["song"]; ok {
+					continue // importing the foreign key will remove the object
+				}
+
+				if u, ok := Database().(db.AutoPrimaryKeyJsonUnmarshaller); ok {
+					u.AutoPrimaryKeyJsonUnmarshal(v)
+				} else {
+					switch n := v.(type) {
+					case json.Number:
+						n2, err := n.Int64()
+						if err != nil {
+							return err
+						}
+						o.SetSongID(query.NewAutoPrimaryKey(n2))
+					default:
+						o.SetSongID(query.NewAutoPrimaryKey(v))
+					}
+				}
+			}
+
+		case "song":
+			v2 := NewSong()
+			m2, ok := v.(map[string]any)
+			if !ok {
+				return fmt.Errorf("json field %s must be a map", k)
+			}
+			err = v2.UnmarshalStringMap(m2)
+			if err != nil {
+				return
+			}
+			o.SetSong(v2)
+
+		}
+	}
+	return
+}
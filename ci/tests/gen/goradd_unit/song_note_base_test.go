@@ -0,0 +1,456 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleSongNote creates an unsaved minimal version of a SongNote object
+// for testing.
+func createMinimalSampleSongNote() *SongNote {
+	obj := NewSongNote()
+	updateMinimalSampleSongNote(obj)
+
+	// A required forward reference will need to be fulfilled just to save the minimal version of this object
+	// If the database is configured so that the referenced object points back here, either directly or through multiple
+	// forward references, it possible this could create an endless loop.
+	obj.SetSong(createMinimalSampleSong())
+
+	return obj
+}
+
+// updateMinimalSampleSongNote sets the values of a minimal sample to new, random values.
+func updateMinimalSampleSongNote(obj *SongNote) {
+
+	obj.SetText(test.RandomValue[string](200))
+
+}
+
+// createMaximalSampleSongNote creates an unsaved version of a SongNote object
+// for testing that includes references to minimal objects.
+func createMaximalSampleSongNote(ctx context.Context) *SongNote {
+	obj := NewSongNote()
+	updateMaximalSampleSongNote(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleSongNote sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleSongNote(ctx context.Context, obj *SongNote) {
+	updateMinimalSampleSongNote(obj)
+	obj.SetSong(createMinimalSampleSong())
+
+}
+
+// deleteSampleSongNote deletes an object created and saved by one of the sample creator functions.
+func deleteSampleSongNote(ctx context.Context, obj *SongNote) {
+	if obj == nil {
+		return
+	}
+
+	_ = obj.Delete(ctx)
+	deleteSampleSong(ctx, obj.Song())
+}
+
+// assertEqualFieldsSongNote compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsSongNote(t *testing.T, obj1, obj2 *SongNote) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.TextIsLoaded() && obj2.TextIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Text(), obj2.Text())
+	}
+
+}
+
+func TestSongNote_SetID(t *testing.T) {
+
+	obj := NewSongNote()
+
+	assert.True(t, obj.IsNew())
+	val := query.NewAutoPrimaryKey(test.RandomNumberString())
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.TempAutoPrimaryKey()
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestSongNote_SetText(t *testing.T) {
+
+	obj := NewSongNote()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](200)
+	obj.SetText(val)
+	assert.Equal(t, val, obj.Text())
+
+	// test default
+	var d string = ""
+	obj.SetText(d)
+	assert.EqualValues(t, d, obj.Text(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](201)
+	assert.Panics(t, func() {
+		obj.SetText(val)
+	})
+}
+func TestSongNote_SetSongID(t *testing.T) {
+
+	obj := NewSongNote()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[query.AutoPrimaryKey](32)
+	obj.SetSongID(val)
+	assert.Equal(t, val, obj.SongID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.AutoPrimaryKey{}
+	obj.SetSongID(d)
+	assert.EqualValues(t, d, obj.SongID(), "set default")
+
+}
+
+func TestSongNote_Copy(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.Text(), obj2.Text())
+	assert.Equal(t, obj.SongID(), obj2.SongID())
+
+}
+
+func TestSongNote_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSongNote(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadSongNote(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.TextIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.textIsDirty)
+	obj2.SetText(obj2.Text())
+	assert.False(t, obj2.textIsDirty)
+
+}
+
+func TestSongNote_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.song = nil
+
+	obj.textIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.textIsLoaded = true
+
+	obj.songIDIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.songIDIsLoaded = true
+
+}
+
+func TestSongNote_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSongNote(ctx, obj)
+	updateMinimalSampleSongNote(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadSongNote(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Text(), obj.Text(), "Text did not update")
+}
+
+func TestSongNote_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleSongNote(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSongNote(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+	assert.NotNil(t, obj.Song())
+	assert.False(t, obj.Song().PrimaryKey().IsTemp())
+	assert.False(t, obj.Song().PrimaryKey().IsZero())
+
+	// Test lazy loading
+	obj2, err := LoadSongNote(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadSongNote(ctx, obj.PrimaryKey(),
+		node.SongNote().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	assert.Nil(t, obj2.Song(), "Song is not loaded initially")
+	v_Song, _ := obj2.LoadSong(ctx)
+	assert.NotNil(t, v_Song)
+	assert.Equal(t, v_Song.PrimaryKey(), obj2.Song().PrimaryKey())
+	assert.Equal(t, obj.Song().PrimaryKey(), obj2.Song().PrimaryKey())
+	assert.True(t, obj2.SongIDIsLoaded())
+
+	assert.False(t, objPkOnly.SongIDIsLoaded())
+	assert.Panics(t, func() { _, _ = objPkOnly.LoadSong(ctx) })
+
+	assert.Panics(t, func() {
+		objPkOnly.SetSong(nil)
+	})
+
+	// test eager loading
+	obj3, err3 := LoadSongNote(ctx, obj.PrimaryKey(), node.SongNote().Song())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, obj2.Song().PrimaryKey(), obj3.Song().PrimaryKey())
+
+}
+
+func TestSongNote_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleSongNote(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSongNote(ctx, obj)
+
+	obj2, err := LoadSongNote(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleSongNote(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleSongNote(ctx, obj2)
+
+	obj3, err2 := LoadSongNote(ctx, obj2.PrimaryKey(), node.SongNote().Song())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+	assert.Equal(t, obj2.Song().PrimaryKey(), obj3.Song().PrimaryKey())
+
+}
+
+func TestSongNote_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleSongNote(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSongNote(ctx, obj)
+
+	updateMinimalSampleSong(obj.Song())
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadSongNote(ctx, obj.PrimaryKey(), node.SongNote().Song())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+	assertEqualFieldsSong(t, obj2.Song(), obj.Song())
+
+}
+func TestSongNote_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewSongNote()
+
+	assert.True(t, obj.ID().IsTemp())
+}
+
+func TestSongNote_Getters(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+
+	assert.True(t, obj.ID().IsTemp())
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleSongNote(ctx, obj)
+
+	has, _ := HasSongNote(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadSongNote(ctx, obj.PrimaryKey(),
+		node.SongNote().ID())
+
+	assert.Equal(t, obj.ID(), obj.Get(SongNoteIDField))
+	assert.Equal(t, obj.Text(), obj.Get(SongNoteTextField))
+	assert.Panics(t, func() { obj2.Text() })
+	assert.Nil(t, obj2.Get(SongNoteTextField))
+	// Not loaded
+	assert.Nil(t, obj2.Song())
+	assert.Nil(t, obj2.Get(SongNoteSongField))
+	assert.Panics(t, func() { obj2.SongID() })
+	assert.Nil(t, obj2.Get(SongNoteSongIDField))
+
+}
+
+func TestSongNote_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSongNote(ctx, obj)
+
+	objs, err := QuerySongNotes(ctx).
+		Where(op.Equal(node.SongNote().ID(), obj.ID())).
+		OrderBy(node.SongNote().ID()). // exercise order by
+		Limit(1, 0).                   // exercise limit
+		Calculation(node.SongNote(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestSongNote_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleSongNote(ctx, obj)
+
+	objs, _ := QuerySongNotes(ctx).
+		Where(op.Equal(node.SongNote().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*SongNote).PrimaryKey())
+}
+func TestSongNote_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleSongNote(ctx, obj)
+
+	cursor, err := QuerySongNotes(ctx).
+		Where(op.Equal(node.SongNote().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QuerySongNotes(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestSongNote_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleSongNote(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleSongNote(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountSongNotes(ctx); return i }())
+
+	// reread in case there are data limitations imposed by the database
+	obj2, _ := LoadSongNote(ctx, obj.PrimaryKey())
+	assert.Positive(t,
+		func() int {
+			i, _ := CountSongNotesBySongID(ctx,
+				obj2.SongID())
+			return i
+		}())
+
+}
+
+func TestSongNote_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewSongNote()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsSongNote(t, obj, obj2)
+}
+
+func TestSongNote_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewSongNote()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsSongNote(t, obj, obj2)
+}
+
+func TestSongNote_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+	var err error
+
+	for i := 0; i < 9; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 10; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestSongNote_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleSongNote()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewSongNote()
+	for i := 0; i < 9; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleSongNote()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewSongNote()
+	for i := 0; i < 10; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
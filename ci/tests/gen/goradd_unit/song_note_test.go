@@ -0,0 +1,52 @@
+package goradd_unit
+
+// This is the test file for the SongNote ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSongNote_String(t *testing.T) {
+	var obj *SongNote
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewSongNote()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "SongNote"))
+}
+
+func TestSongNote_Key(t *testing.T) {
+	var obj *SongNote
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewSongNote()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestSongNote_Label(t *testing.T) {
+	var obj *SongNote
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewSongNote()
+	s := obj.Label()
+	assert.True(t, strings.HasPrefix(s, "Song Note"))
+}
+
+func TestSongNote_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleSongNote()
+	assert.NoError(t, obj.Save(ctx))
+	defer obj.Song().Delete(ctx)
+	assert.NoError(t, DeleteSongNote(ctx, obj.PrimaryKey()))
+	obj2, err := LoadSongNote(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,51 @@
+package goradd_unit
+
+// This is the test file for the Song ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSong_String(t *testing.T) {
+	var obj *Song
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewSong()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "Song"))
+}
+
+func TestSong_Key(t *testing.T) {
+	var obj *Song
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewSong()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestSong_Label(t *testing.T) {
+	var obj *Song
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewSong()
+	s := obj.Label()
+	assert.True(t, strings.HasPrefix(s, "Song"))
+}
+
+func TestSong_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleSong()
+	assert.NoError(t, obj.Save(ctx))
+	assert.NoError(t, DeleteSong(ctx, obj.PrimaryKey()))
+	obj2, err := LoadSong(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,496 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleTicket creates an unsaved minimal version of a Ticket object
+// for testing.
+func createMinimalSampleTicket() *Ticket {
+	obj := NewTicket()
+	updateMinimalSampleTicket(obj)
+
+	return obj
+}
+
+// updateMinimalSampleTicket sets the values of a minimal sample to new, random values.
+func updateMinimalSampleTicket(obj *Ticket) {
+
+	obj.SetTitle(test.RandomValue[string](50))
+
+	obj.SetPriority(test.RandomEnum(Priorities()))
+
+	obj.SetSeverity(test.RandomEnum(Severities()))
+
+	obj.SetPlatforms(test.RandomEnumSlice(Platforms()))
+
+}
+
+// createMaximalSampleTicket creates an unsaved version of a Ticket object
+// for testing that includes references to minimal objects.
+func createMaximalSampleTicket(ctx context.Context) *Ticket {
+	obj := NewTicket()
+	updateMaximalSampleTicket(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleTicket sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleTicket(ctx context.Context, obj *Ticket) {
+	updateMinimalSampleTicket(obj)
+
+}
+
+// deleteSampleTicket deletes an object created and saved by one of the sample creator functions.
+func deleteSampleTicket(ctx context.Context, obj *Ticket) {
+	if obj == nil {
+		return
+	}
+
+	_ = obj.Delete(ctx)
+}
+
+// assertEqualFieldsTicket compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsTicket(t *testing.T, obj1, obj2 *Ticket) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.TitleIsLoaded() && obj2.TitleIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Title(), obj2.Title())
+	}
+	if obj1.PriorityIsLoaded() && obj2.PriorityIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Priority(), obj2.Priority())
+	}
+	if obj1.SeverityIsLoaded() && obj2.SeverityIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Severity(), obj2.Severity())
+	}
+	if obj1.PlatformsIsLoaded() && obj2.PlatformsIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Platforms(), obj2.Platforms())
+	}
+
+}
+
+func TestTicket_SetID(t *testing.T) {
+
+	obj := NewTicket()
+
+	assert.True(t, obj.IsNew())
+	val := query.NewAutoPrimaryKey(test.RandomNumberString())
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.TempAutoPrimaryKey()
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestTicket_SetTitle(t *testing.T) {
+
+	obj := NewTicket()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](50)
+	obj.SetTitle(val)
+	assert.Equal(t, val, obj.Title())
+
+	// test default
+	var d string = ""
+	obj.SetTitle(d)
+	assert.EqualValues(t, d, obj.Title(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](51)
+	assert.Panics(t, func() {
+		obj.SetTitle(val)
+	})
+}
+func TestTicket_SetPriority(t *testing.T) {
+
+	obj := NewTicket()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomEnum(Priorities())
+	obj.SetPriority(val)
+	assert.Equal(t, val, obj.Priority())
+
+	// test default
+	var d Priority = Priority(0)
+	obj.SetPriority(d)
+	assert.EqualValues(t, d, obj.Priority(), "set default")
+
+}
+func TestTicket_SetSeverity(t *testing.T) {
+
+	obj := NewTicket()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomEnum(Severities())
+	obj.SetSeverity(val)
+	assert.Equal(t, val, obj.Severity())
+	assert.False(t, obj.SeverityIsNull())
+
+	// Test NULL
+	obj.SetSeverityToNull()
+	assert.EqualValues(t, Severity(0), obj.Severity())
+	assert.True(t, obj.SeverityIsNull())
+
+	// test default
+	var d Severity = Severity(0)
+	obj.SetSeverity(d)
+	assert.EqualValues(t, d, obj.Severity(), "set default")
+
+}
+func TestTicket_SetPlatforms(t *testing.T) {
+
+	obj := NewTicket()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomEnumSlice(Platforms())
+	obj.SetPlatforms(val)
+	assert.Equal(t, val, obj.Platforms())
+
+	// test default
+	var d []Platform = []Platform(nil)
+	obj.SetPlatforms(d)
+	assert.EqualValues(t, d, obj.Platforms(), "set default")
+
+}
+
+func TestTicket_Copy(t *testing.T) {
+	obj := createMinimalSampleTicket()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.Title(), obj2.Title())
+	assert.Equal(t, obj.Priority(), obj2.Priority())
+	assert.Equal(t, obj.Severity(), obj2.Severity())
+	assert.Equal(t, obj.Platforms(), obj2.Platforms())
+
+}
+
+func TestTicket_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleTicket()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTicket(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadTicket(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.TitleIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.titleIsDirty)
+	obj2.SetTitle(obj2.Title())
+	assert.False(t, obj2.titleIsDirty)
+
+	assert.True(t, obj2.PriorityIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.priorityIsDirty)
+	obj2.SetPriority(obj2.Priority())
+	assert.False(t, obj2.priorityIsDirty)
+
+	assert.True(t, obj2.SeverityIsLoaded())
+	assert.False(t, obj2.SeverityIsNull())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.severityIsDirty)
+	obj2.SetSeverity(obj2.Severity())
+	assert.False(t, obj2.severityIsDirty)
+
+	assert.True(t, obj2.PlatformsIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.platformsIsDirty)
+	obj2.SetPlatforms(obj2.Platforms())
+	assert.False(t, obj2.platformsIsDirty)
+
+}
+
+func TestTicket_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleTicket()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.titleIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.titleIsLoaded = true
+
+	obj.priorityIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.priorityIsLoaded = true
+
+	obj.platformsIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.platformsIsLoaded = true
+
+}
+
+func TestTicket_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleTicket()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTicket(ctx, obj)
+	updateMinimalSampleTicket(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadTicket(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Title(), obj.Title(), "Title did not update")
+	assert.Equal(t, obj2.Priority(), obj.Priority(), "Priority did not update")
+	assert.Equal(t, obj2.Severity(), obj.Severity(), "Severity did not update")
+	assert.Equal(t, obj2.Platforms(), obj.Platforms(), "Platforms did not update")
+}
+
+func TestTicket_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleTicket(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTicket(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+
+	// Test lazy loading
+	obj2, err := LoadTicket(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadTicket(ctx, obj.PrimaryKey(),
+		node.Ticket().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	// test eager loading
+	obj3, err3 := LoadTicket(ctx, obj.PrimaryKey())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestTicket_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleTicket(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTicket(ctx, obj)
+
+	obj2, err := LoadTicket(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleTicket(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleTicket(ctx, obj2)
+
+	obj3, err2 := LoadTicket(ctx, obj2.PrimaryKey())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestTicket_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleTicket(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTicket(ctx, obj)
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadTicket(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+}
+func TestTicket_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewTicket()
+
+	assert.True(t, obj.ID().IsTemp())
+}
+
+func TestTicket_Getters(t *testing.T) {
+	obj := createMinimalSampleTicket()
+
+	assert.True(t, obj.ID().IsTemp())
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleTicket(ctx, obj)
+
+	has, _ := HasTicket(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadTicket(ctx, obj.PrimaryKey(),
+		node.Ticket().ID())
+
+	assert.Equal(t, obj.ID(), obj.Get(TicketIDField))
+	assert.Equal(t, obj.Title(), obj.Get(TicketTitleField))
+	assert.Panics(t, func() { obj2.Title() })
+	assert.Nil(t, obj2.Get(TicketTitleField))
+	assert.Equal(t, obj.Priority(), obj.Get(TicketPriorityField))
+	assert.Panics(t, func() { obj2.Priority() })
+	assert.Nil(t, obj2.Get(TicketPriorityField))
+	assert.Equal(t, obj.Severity(), obj.Get(TicketSeverityField))
+	assert.Panics(t, func() { obj2.Severity() })
+	assert.Nil(t, obj2.Get(TicketSeverityField))
+	assert.Equal(t, obj.Platforms(), obj.Get(TicketPlatformsField))
+	assert.Panics(t, func() { obj2.Platforms() })
+	assert.Nil(t, obj2.Get(TicketPlatformsField))
+
+}
+
+func TestTicket_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleTicket()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTicket(ctx, obj)
+
+	objs, err := QueryTickets(ctx).
+		Where(op.Equal(node.Ticket().ID(), obj.ID())).
+		OrderBy(node.Ticket().ID()). // exercise order by
+		Limit(1, 0).                 // exercise limit
+		Calculation(node.Ticket(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestTicket_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleTicket()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleTicket(ctx, obj)
+
+	objs, _ := QueryTickets(ctx).
+		Where(op.Equal(node.Ticket().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*Ticket).PrimaryKey())
+}
+func TestTicket_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleTicket()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTicket(ctx, obj)
+
+	cursor, err := QueryTickets(ctx).
+		Where(op.Equal(node.Ticket().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QueryTickets(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestTicket_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleTicket(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleTicket(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountTickets(ctx); return i }())
+
+}
+
+func TestTicket_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleTicket()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewTicket()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsTicket(t, obj, obj2)
+}
+
+func TestTicket_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleTicket()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewTicket()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsTicket(t, obj, obj2)
+}
+
+func TestTicket_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleTicket()
+	var err error
+
+	for i := 0; i < 19; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 20; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestTicket_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleTicket()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewTicket()
+	for i := 0; i < 19; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleTicket()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewTicket()
+	for i := 0; i < 20; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
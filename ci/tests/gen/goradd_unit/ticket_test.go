@@ -0,0 +1,51 @@
+package goradd_unit
+
+// This is the test file for the Ticket ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicket_String(t *testing.T) {
+	var obj *Ticket
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewTicket()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "Ticket"))
+}
+
+func TestTicket_Key(t *testing.T) {
+	var obj *Ticket
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewTicket()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestTicket_Label(t *testing.T) {
+	var obj *Ticket
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewTicket()
+	s := obj.Label()
+	assert.True(t, strings.HasPrefix(s, "Ticket"))
+}
+
+func TestTicket_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleTicket()
+	assert.NoError(t, obj.Save(ctx))
+	assert.NoError(t, DeleteTicket(ctx, obj.PrimaryKey()))
+	obj2, err := LoadTicket(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,387 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleTruck creates an unsaved minimal version of a Truck object
+// for testing.
+func createMinimalSampleTruck() *Truck {
+	obj := NewTruck()
+	updateMinimalSampleTruck(obj)
+	obj.Vehicle = createMinimalSampleVehicle()
+
+	return obj
+}
+
+// updateMinimalSampleTruck sets the values of a minimal sample to new, random values.
+func updateMinimalSampleTruck(obj *Truck) {
+	updateMinimalSampleVehicle(obj.Vehicle)
+
+	obj.SetPayload(test.RandomValue[int](32))
+
+}
+
+// createMaximalSampleTruck creates an unsaved version of a Truck object
+// for testing that includes references to minimal objects.
+func createMaximalSampleTruck(ctx context.Context) *Truck {
+	obj := NewTruck()
+	obj.Vehicle = createMinimalSampleVehicle()
+	updateMaximalSampleTruck(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleTruck sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleTruck(ctx context.Context, obj *Truck) {
+	updateMinimalSampleTruck(obj)
+
+}
+
+// deleteSampleTruck deletes an object created and saved by one of the sample creator functions.
+func deleteSampleTruck(ctx context.Context, obj *Truck) {
+	if obj == nil {
+		return
+	}
+
+	_ = obj.Delete(ctx)
+	deleteSampleVehicle(ctx, obj.Vehicle)
+}
+
+// assertEqualFieldsTruck compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsTruck(t *testing.T, obj1, obj2 *Truck) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.PayloadIsLoaded() && obj2.PayloadIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Payload(), obj2.Payload())
+	}
+
+}
+
+func TestTruck_SetID(t *testing.T) {
+
+	obj := NewTruck()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[query.AutoPrimaryKey](32)
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.AutoPrimaryKey{}
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestTruck_SetPayload(t *testing.T) {
+
+	obj := NewTruck()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[int](32)
+	obj.SetPayload(val)
+	assert.Equal(t, val, obj.Payload())
+
+	// test default
+	var d int = 0
+	obj.SetPayload(d)
+	assert.EqualValues(t, d, obj.Payload(), "set default")
+
+}
+
+func TestTruck_Copy(t *testing.T) {
+	obj := createMinimalSampleTruck()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.ID(), obj2.ID())
+	assert.Equal(t, obj.Payload(), obj2.Payload())
+
+}
+
+func TestTruck_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleTruck()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTruck(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadTruck(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.PayloadIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.payloadIsDirty)
+	obj2.SetPayload(obj2.Payload())
+	assert.False(t, obj2.payloadIsDirty)
+
+}
+
+func TestTruck_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleTruck()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.payloadIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.payloadIsLoaded = true
+
+}
+
+func TestTruck_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleTruck()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTruck(ctx, obj)
+	updateMinimalSampleTruck(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadTruck(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Payload(), obj.Payload(), "Payload did not update")
+}
+
+func TestTruck_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleTruck(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTruck(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+
+	// Test lazy loading
+	obj2, err := LoadTruck(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadTruck(ctx, obj.PrimaryKey(),
+		node.Truck().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	// test eager loading
+	obj3, err3 := LoadTruck(ctx, obj.PrimaryKey())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestTruck_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleTruck(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTruck(ctx, obj)
+
+	obj2, err := LoadTruck(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleTruck(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleTruck(ctx, obj2)
+
+	obj3, err2 := LoadTruck(ctx, obj2.PrimaryKey())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestTruck_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleTruck(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTruck(ctx, obj)
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadTruck(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+}
+func TestTruck_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewTruck()
+
+	assert.Zero(t, obj.ID())
+}
+
+func TestTruck_Getters(t *testing.T) {
+	obj := createMinimalSampleTruck()
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleTruck(ctx, obj)
+
+	has, _ := HasTruck(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadTruck(ctx, obj.PrimaryKey(),
+		node.Truck().ID())
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+
+	assert.Equal(t, obj.ID(), obj.Get(TruckIDField))
+	assert.Equal(t, obj.Payload(), obj.Get(TruckPayloadField))
+	assert.Panics(t, func() { obj2.Payload() })
+	assert.Nil(t, obj2.Get(TruckPayloadField))
+
+}
+
+func TestTruck_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleTruck()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTruck(ctx, obj)
+
+	objs, err := QueryTrucks(ctx).
+		Where(op.Equal(node.Truck().ID(), obj.ID())).
+		OrderBy(node.Truck().ID()). // exercise order by
+		Limit(1, 0).                // exercise limit
+		Calculation(node.Truck(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestTruck_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleTruck()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleTruck(ctx, obj)
+
+	objs, _ := QueryTrucks(ctx).
+		Where(op.Equal(node.Truck().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*Truck).PrimaryKey())
+}
+func TestTruck_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleTruck()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleTruck(ctx, obj)
+
+	cursor, err := QueryTrucks(ctx).
+		Where(op.Equal(node.Truck().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QueryTrucks(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestTruck_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleTruck(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleTruck(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountTrucks(ctx); return i }())
+
+}
+
+func TestTruck_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleTruck()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewTruck()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsTruck(t, obj, obj2)
+}
+
+func TestTruck_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleTruck()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewTruck()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsTruck(t, obj, obj2)
+}
+
+func TestTruck_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleTruck()
+	var err error
+
+	for i := 0; i < 9; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 10; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestTruck_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleTruck()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewTruck()
+	for i := 0; i < 9; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleTruck()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewTruck()
+	for i := 0; i < 10; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,51 @@
+package goradd_unit
+
+// This is the test file for the Truck ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruck_String(t *testing.T) {
+	var obj *Truck
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewTruck()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "Truck"))
+}
+
+func TestTruck_Key(t *testing.T) {
+	var obj *Truck
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewTruck()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestTruck_Label(t *testing.T) {
+	var obj *Truck
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewTruck()
+	s := obj.Label()
+	assert.True(t, strings.HasPrefix(s, "Truck"))
+}
+
+func TestTruck_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleTruck()
+	assert.NoError(t, obj.Save(ctx))
+	assert.NoError(t, DeleteTruck(ctx, obj.PrimaryKey()))
+	obj2, err := LoadTruck(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
@@ -61,6 +61,33 @@ type typeTestBase struct {
 	testNumeric                 string
 	testNumericIsLoaded         bool
 	testNumericIsDirty          bool
+	testDecimal                 query.Decimal
+	testDecimalIsNull           bool
+	testDecimalIsLoaded         bool
+	testDecimalIsDirty          bool
+	testPoint                   query.Geometry
+	testPointIsNull             bool
+	testPointIsLoaded           bool
+	testPointIsDirty            bool
+	testPolygon                 query.Geometry
+	testPolygonIsNull           bool
+	testPolygonIsLoaded         bool
+	testPolygonIsDirty          bool
+	testDuration                time.Duration
+	testDurationIsNull          bool
+	testDurationIsLoaded        bool
+	testDurationIsDirty         bool
+	testStringArray             []string
+	testStringArrayIsLoaded     bool
+	testStringArrayIsDirty      bool
+	testIntArray                []int
+	testIntArrayIsNull          bool
+	testIntArrayIsLoaded        bool
+	testIntArrayIsDirty         bool
+	testJSON                    string
+	testJSONIsNull              bool
+	testJSONIsLoaded            bool
+	testJSONIsDirty             bool
 	testBool                    bool
 	testBoolIsLoaded            bool
 	testBoolIsDirty             bool
@@ -106,6 +133,13 @@ const (
 	TypeTestTestFloat32Field         = `testFloat32`
 	TypeTestTestFloat64Field         = `testFloat64`
 	TypeTestTestNumericField         = `testNumeric`
+	TypeTestTestDecimalField         = `testDecimal`
+	TypeTestTestPointField           = `testPoint`
+	TypeTestTestPolygonField         = `testPolygon`
+	TypeTestTestDurationField        = `testDuration`
+	TypeTestTestStringArrayField     = `testStringArray`
+	TypeTestTestIntArrayField        = `testIntArray`
+	TypeTestTestJSONField            = `testJSON`
 	TypeTestTestBoolField            = `testBool`
 	TypeTestTestUnlimitedStringField = `testUnlimitedString`
 	TypeTestTestLimitedStringField   = `testLimitedString`
@@ -118,6 +152,8 @@ const (
 const TypeTestTestIntMax = 2147483647
 const TypeTestTestIntMin = -2147483648
 const TypeTestTestNumericMaxLength = 12            // The number of runes the column can hold
+const TypeTestTestDecimalPrecision = 10            // The total number of digits the column can hold
+const TypeTestTestDecimalScale = 4                 // The number of digits after the decimal point
 const TypeTestTestLimitedStringMaxLength = 10      // The number of runes the column can hold
 const TypeTestTestLongstringMaxLength = 1000000000 // The number of runes the column can hold
 const TypeTestTestLimitedBytesMaxLength = 10       // The number of bytes the column can hold
@@ -173,6 +209,40 @@ func (o *typeTestBase) Initialize() {
 	o.testNumericIsLoaded = false
 	o.testNumericIsDirty = false
 
+	o.testDecimal = query.Decimal{}
+	o.testDecimalIsNull = true
+	o.testDecimalIsLoaded = false
+	o.testDecimalIsDirty = false
+
+	o.testPoint = query.Geometry{}
+	o.testPointIsNull = true
+	o.testPointIsLoaded = false
+	o.testPointIsDirty = false
+
+	o.testPolygon = query.Geometry{}
+	o.testPolygonIsNull = true
+	o.testPolygonIsLoaded = false
+	o.testPolygonIsDirty = false
+
+	o.testDuration = time.Duration(5400000000000)
+	o.testDurationIsNull = false
+	o.testDurationIsLoaded = true
+	o.testDurationIsDirty = false
+
+	o.testStringArray = []string(nil)
+	o.testStringArrayIsLoaded = false
+	o.testStringArrayIsDirty = false
+
+	o.testIntArray = []int(nil)
+	o.testIntArrayIsNull = true
+	o.testIntArrayIsLoaded = false
+	o.testIntArrayIsDirty = false
+
+	o.testJSON = ""
+	o.testJSONIsNull = true
+	o.testJSONIsLoaded = false
+	o.testJSONIsDirty = false
+
 	o.testBool = true
 	o.testBoolIsLoaded = true
 	o.testBoolIsDirty = false
@@ -242,6 +312,27 @@ func (o *typeTestBase) Copy() (newObject *TypeTest) {
 	if o.testNumericIsLoaded {
 		newObject.SetTestNumeric(o.testNumeric)
 	}
+	if o.testDecimalIsLoaded {
+		newObject.SetTestDecimal(o.testDecimal)
+	}
+	if o.testPointIsLoaded {
+		newObject.SetTestPoint(o.testPoint)
+	}
+	if o.testPolygonIsLoaded {
+		newObject.SetTestPolygon(o.testPolygon)
+	}
+	if o.testDurationIsLoaded {
+		newObject.SetTestDuration(o.testDuration)
+	}
+	if o.testStringArrayIsLoaded {
+		newObject.SetTestStringArray(o.testStringArray)
+	}
+	if o.testIntArrayIsLoaded {
+		newObject.SetTestIntArray(o.testIntArray)
+	}
+	if o.testJSONIsLoaded {
+		newObject.SetTestJSON(o.testJSON)
+	}
 	if o.testBoolIsLoaded {
 		newObject.SetTestBool(o.testBool)
 	}
@@ -671,6 +762,312 @@ func (o *typeTestBase) SetTestNumeric(v string) {
 	o.testNumericIsDirty = true
 }
 
+// TestDecimal returns the value of the loaded test_decimal field in the database.
+func (o *typeTestBase) TestDecimal() query.Decimal {
+	if o._restored && !o.testDecimalIsLoaded {
+		panic("TestDecimal was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.testDecimal
+}
+
+// TestDecimalIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *typeTestBase) TestDecimalIsLoaded() bool {
+	return o.testDecimalIsLoaded
+}
+
+// TestDecimalIsNull returns true if the related database value is null.
+func (o *typeTestBase) TestDecimalIsNull() bool {
+	return o.testDecimalIsNull
+}
+
+// SetTestDecimal sets the value of TestDecimal in the object, to be saved later in the database using the Save() function.
+func (o *typeTestBase) SetTestDecimal(v query.Decimal) {
+	if !v.Fits(TypeTestTestDecimalPrecision, TypeTestTestDecimalScale) {
+		panic("attempted to set TypeTest.TestDecimal to a value that does not fit its precision and scale")
+	}
+	if o._restored &&
+		o.testDecimalIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		!o.testDecimalIsNull && // if the db value is null, force a set of value
+		o.testDecimal.Equal(v) {
+		// no change
+		return
+	}
+
+	o.testDecimalIsLoaded = true
+	o.testDecimal = v
+	o.testDecimalIsDirty = true
+	o.testDecimalIsNull = false
+}
+
+// SetTestDecimalToNull() will set the test_decimal value in the database to NULL.
+// TestDecimal() will return the column's default value after this.
+func (o *typeTestBase) SetTestDecimalToNull() {
+	if !o.testDecimalIsLoaded || !o.testDecimalIsNull {
+		// If we know it is null in the database, don't save it
+		o.testDecimalIsDirty = true
+	}
+	o.testDecimalIsLoaded = true
+	o.testDecimalIsNull = true
+	o.testDecimal = query.Decimal{}
+}
+
+// TestPoint returns the value of the loaded test_point field in the database.
+func (o *typeTestBase) TestPoint() query.Geometry {
+	if o._restored && !o.testPointIsLoaded {
+		panic("TestPoint was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.testPoint
+}
+
+// TestPointIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *typeTestBase) TestPointIsLoaded() bool {
+	return o.testPointIsLoaded
+}
+
+// TestPointIsNull returns true if the related database value is null.
+func (o *typeTestBase) TestPointIsNull() bool {
+	return o.testPointIsNull
+}
+
+// SetTestPoint sets the value of TestPoint in the object, to be saved later in the database using the Save() function.
+func (o *typeTestBase) SetTestPoint(v query.Geometry) {
+	if o._restored &&
+		o.testPointIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		!o.testPointIsNull && // if the db value is null, force a set of value
+		o.testPoint.Equal(v) {
+		// no change
+		return
+	}
+
+	o.testPointIsLoaded = true
+	o.testPoint = v
+	o.testPointIsDirty = true
+	o.testPointIsNull = false
+}
+
+// SetTestPointToNull() will set the test_point value in the database to NULL.
+// TestPoint() will return the column's default value after this.
+func (o *typeTestBase) SetTestPointToNull() {
+	if !o.testPointIsLoaded || !o.testPointIsNull {
+		// If we know it is null in the database, don't save it
+		o.testPointIsDirty = true
+	}
+	o.testPointIsLoaded = true
+	o.testPointIsNull = true
+	o.testPoint = query.Geometry{}
+}
+
+// TestPolygon returns the value of the loaded test_polygon field in the database.
+func (o *typeTestBase) TestPolygon() query.Geometry {
+	if o._restored && !o.testPolygonIsLoaded {
+		panic("TestPolygon was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.testPolygon
+}
+
+// TestPolygonIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *typeTestBase) TestPolygonIsLoaded() bool {
+	return o.testPolygonIsLoaded
+}
+
+// TestPolygonIsNull returns true if the related database value is null.
+func (o *typeTestBase) TestPolygonIsNull() bool {
+	return o.testPolygonIsNull
+}
+
+// SetTestPolygon sets the value of TestPolygon in the object, to be saved later in the database using the Save() function.
+func (o *typeTestBase) SetTestPolygon(v query.Geometry) {
+	if o._restored &&
+		o.testPolygonIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		!o.testPolygonIsNull && // if the db value is null, force a set of value
+		o.testPolygon.Equal(v) {
+		// no change
+		return
+	}
+
+	o.testPolygonIsLoaded = true
+	o.testPolygon = v
+	o.testPolygonIsDirty = true
+	o.testPolygonIsNull = false
+}
+
+// SetTestPolygonToNull() will set the test_polygon value in the database to NULL.
+// TestPolygon() will return the column's default value after this.
+func (o *typeTestBase) SetTestPolygonToNull() {
+	if !o.testPolygonIsLoaded || !o.testPolygonIsNull {
+		// If we know it is null in the database, don't save it
+		o.testPolygonIsDirty = true
+	}
+	o.testPolygonIsLoaded = true
+	o.testPolygonIsNull = true
+	o.testPolygon = query.Geometry{}
+}
+
+// TestDuration returns the value of the loaded test_duration field in the database.
+func (o *typeTestBase) TestDuration() time.Duration {
+	if o._restored && !o.testDurationIsLoaded {
+		panic("TestDuration was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.testDuration
+}
+
+// TestDurationIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *typeTestBase) TestDurationIsLoaded() bool {
+	return o.testDurationIsLoaded
+}
+
+// TestDurationIsNull returns true if the related database value is null.
+func (o *typeTestBase) TestDurationIsNull() bool {
+	return o.testDurationIsNull
+}
+
+// SetTestDuration sets the value of TestDuration in the object, to be saved later in the database using the Save() function.
+func (o *typeTestBase) SetTestDuration(v time.Duration) {
+	if o._restored &&
+		o.testDurationIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		!o.testDurationIsNull && // if the db value is null, force a set of value
+		o.testDuration == v {
+		// no change
+		return
+	}
+
+	o.testDurationIsLoaded = true
+	o.testDuration = v
+	o.testDurationIsDirty = true
+	o.testDurationIsNull = false
+}
+
+// SetTestDurationToNull() will set the test_duration value in the database to NULL.
+// TestDuration() will return the column's default value after this.
+func (o *typeTestBase) SetTestDurationToNull() {
+	if !o.testDurationIsLoaded || !o.testDurationIsNull {
+		// If we know it is null in the database, don't save it
+		o.testDurationIsDirty = true
+	}
+	o.testDurationIsLoaded = true
+	o.testDurationIsNull = true
+	o.testDuration = time.Duration(5400000000000)
+}
+
+// TestStringArray returns the value of the loaded test_string_array field in the database.
+func (o *typeTestBase) TestStringArray() []string {
+	if o._restored && !o.testStringArrayIsLoaded {
+		panic("TestStringArray was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.testStringArray
+}
+
+// TestStringArrayIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *typeTestBase) TestStringArrayIsLoaded() bool {
+	return o.testStringArrayIsLoaded
+}
+
+// SetTestStringArray sets the value of TestStringArray in the object, to be saved later in the database using the Save() function.
+func (o *typeTestBase) SetTestStringArray(v []string) {
+	if o._restored &&
+		o.testStringArrayIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		slices.Equal(o.testStringArray, v) {
+		// no change
+		return
+	}
+
+	o.testStringArrayIsLoaded = true
+	o.testStringArray = slices.Clone(v)
+	o.testStringArrayIsDirty = true
+}
+
+// TestIntArray returns the value of the loaded test_int_array field in the database.
+func (o *typeTestBase) TestIntArray() []int {
+	if o._restored && !o.testIntArrayIsLoaded {
+		panic("TestIntArray was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.testIntArray
+}
+
+// TestIntArrayIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *typeTestBase) TestIntArrayIsLoaded() bool {
+	return o.testIntArrayIsLoaded
+}
+
+// TestIntArrayIsNull returns true if the related database value is null.
+func (o *typeTestBase) TestIntArrayIsNull() bool {
+	return o.testIntArrayIsNull
+}
+
+// SetTestIntArray sets the value of TestIntArray in the object, to be saved later in the database using the Save() function.
+func (o *typeTestBase) SetTestIntArray(v []int) {
+	if o._restored &&
+		o.testIntArrayIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		!o.testIntArrayIsNull && // if the db value is null, force a set of value
+		slices.Equal(o.testIntArray, v) {
+		// no change
+		return
+	}
+
+	o.testIntArrayIsLoaded = true
+	o.testIntArray = slices.Clone(v)
+	o.testIntArrayIsDirty = true
+	o.testIntArrayIsNull = false
+}
+
+// SetTestIntArrayToNull() will set the test_int_array value in the database to NULL.
+// TestIntArray() will return the column's default value after this.
+func (o *typeTestBase) SetTestIntArrayToNull() {
+	if !o.testIntArrayIsLoaded || !o.testIntArrayIsNull {
+		// If we know it is null in the database, don't save it
+		o.testIntArrayIsDirty = true
+	}
+	o.testIntArrayIsLoaded = true
+	o.testIntArrayIsNull = true
+	o.testIntArray = []int(nil)
+}
+
+// TestJSON returns the value of the loaded test_json field in the database.
+func (o *typeTestBase) TestJSON() string {
+	if o._restored && !o.testJSONIsLoaded {
+		panic("TestJSON was not selected in the last query and has not been set, and so is not valid")
+	}
+	return o.testJSON
+}
+
+// TestJSONIsLoaded returns true if the value was loaded from the database or has been set.
+func (o *typeTestBase) TestJSONIsLoaded() bool {
+	return o.testJSONIsLoaded
+}
+
+// TestJSONIsNull returns true if the related database value is null.
+func (o *typeTestBase) TestJSONIsNull() bool {
+	return o.testJSONIsNull
+}
+
+// SetTestJSON sets the value of TestJSON in the object, to be saved later in the database using the Save() function.
+func (o *typeTestBase) SetTestJSON(v string) {
+	if o._restored &&
+		o.testJSONIsLoaded && // if it was not selected, then make sure it gets set, since our end comparison won't be valid
+		!o.testJSONIsNull && // if the db value is null, force a set of value
+		o.testJSON == v {
+		// no change
+		return
+	}
+
+	o.testJSONIsLoaded = true
+	o.testJSON = v
+	o.testJSONIsDirty = true
+	o.testJSONIsNull = false
+}
+
+// SetTestJSONToNull() will set the test_json value in the database to NULL.
+// TestJSON() will return the column's default value after this.
+func (o *typeTestBase) SetTestJSONToNull() {
+	if !o.testJSONIsLoaded || !o.testJSONIsNull {
+		// If we know it is null in the database, don't save it
+		o.testJSONIsDirty = true
+	}
+	o.testJSONIsLoaded = true
+	o.testJSONIsNull = true
+	o.testJSON = ""
+}
+
 // TestBool returns the value of the loaded test_bool field in the database.
 func (o *typeTestBase) TestBool() bool {
 	if o._restored && !o.testBoolIsLoaded {
@@ -1063,7 +1460,8 @@ func (b *TypeTestBuilder) OrderBy(nodes ...query.Sorter) *TypeTestBuilder {
 // Limit will return a subset of the data, limited to the offset and number of rows specified.
 // For large data sets and specific types of queries, this can be slow, because it will perform
 // the entire query before computing the limit.
-// You cannot limit a query that has embedded arrays.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the TypeTest objects. You cannot otherwise limit a query that has embedded arrays.
 func (b *TypeTestBuilder) Limit(maxRowCount int, offset int) *TypeTestBuilder {
 	b.builder.Limit(maxRowCount, offset)
 	return b
@@ -1076,11 +1474,22 @@ func (b *TypeTestBuilder) Limit(maxRowCount int, offset int) *TypeTestBuilder {
 // If columns in related tables are specified, then only those columns will be queried and loaded.
 // Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
 // will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
 func (b *TypeTestBuilder) Select(nodes ...query.Node) *TypeTestBuilder {
 	b.builder.Select(nodes...)
 	return b
 }
 
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded TypeTest objects at once, rather than joining them to the query.
+// This avoids repeating the data of each TypeTest object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *TypeTestBuilder) Preload(nodes ...query.Node) *TypeTestBuilder {
+	b.builder.Preload(nodes...)
+	return b
+}
+
 // Calculation adds operation as an aliased value onto base.
 // After the query, you can read the data by passing alias to GetAlias on the returned object.
 func (b *TypeTestBuilder) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *TypeTestBuilder {
@@ -1107,6 +1516,35 @@ func (b *TypeTestBuilder) Having(node query.Node) *TypeTestBuilder {
 	return b
 }
 
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *TypeTestBuilder) ForUpdate() *TypeTestBuilder {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *TypeTestBuilder) ForShare() *TypeTestBuilder {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *TypeTestBuilder) SkipLocked() *TypeTestBuilder {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *TypeTestBuilder) NoWait() *TypeTestBuilder {
+	b.builder.NoWait()
+	return b
+}
+
 // Count terminates a query and returns just the number of items in the result.
 // If you have Select or Calculation columns in the query, it will count NULL results as well.
 // To not count NULL values, use Where in the builder with a NotNull operation.
@@ -1306,6 +1744,139 @@ func (o *typeTestBase) unpack(m map[string]interface{}, objThis *TypeTest) {
 		o.testNumericIsDirty = false
 	}
 
+	if v, ok := m["testDecimal"]; ok {
+		if v == nil {
+			o.testDecimal = query.Decimal{}
+			o.testDecimalIsNull = true
+			o.testDecimalIsLoaded = true
+			o.testDecimalIsDirty = false
+		} else if o.testDecimal, ok = v.(query.Decimal); ok {
+			o.testDecimalIsNull = false
+			o.testDecimalIsLoaded = true
+			o.testDecimalIsDirty = false
+		} else {
+			panic("Wrong type found for testDecimal.")
+		}
+	} else {
+		o.testDecimalIsLoaded = false
+		o.testDecimalIsNull = true
+		o.testDecimal = query.Decimal{}
+		o.testDecimalIsDirty = false
+	}
+
+	if v, ok := m["testPoint"]; ok {
+		if v == nil {
+			o.testPoint = query.Geometry{}
+			o.testPointIsNull = true
+			o.testPointIsLoaded = true
+			o.testPointIsDirty = false
+		} else if o.testPoint, ok = v.(query.Geometry); ok {
+			o.testPointIsNull = false
+			o.testPointIsLoaded = true
+			o.testPointIsDirty = false
+		} else {
+			panic("Wrong type found for testPoint.")
+		}
+	} else {
+		o.testPointIsLoaded = false
+		o.testPointIsNull = true
+		o.testPoint = query.Geometry{}
+		o.testPointIsDirty = false
+	}
+
+	if v, ok := m["testPolygon"]; ok {
+		if v == nil {
+			o.testPolygon = query.Geometry{}
+			o.testPolygonIsNull = true
+			o.testPolygonIsLoaded = true
+			o.testPolygonIsDirty = false
+		} else if o.testPolygon, ok = v.(query.Geometry); ok {
+			o.testPolygonIsNull = false
+			o.testPolygonIsLoaded = true
+			o.testPolygonIsDirty = false
+		} else {
+			panic("Wrong type found for testPolygon.")
+		}
+	} else {
+		o.testPolygonIsLoaded = false
+		o.testPolygonIsNull = true
+		o.testPolygon = query.Geometry{}
+		o.testPolygonIsDirty = false
+	}
+
+	if v, ok := m["testDuration"]; ok {
+		if v == nil {
+			o.testDuration = time.Duration(5400000000000)
+			o.testDurationIsNull = true
+			o.testDurationIsLoaded = true
+			o.testDurationIsDirty = false
+		} else if o.testDuration, ok = v.(time.Duration); ok {
+			o.testDurationIsNull = false
+			o.testDurationIsLoaded = true
+			o.testDurationIsDirty = false
+		} else {
+			panic("Wrong type found for testDuration.")
+		}
+	} else {
+		o.testDurationIsLoaded = false
+		o.testDurationIsNull = true
+		o.testDuration = time.Duration(5400000000000)
+		o.testDurationIsDirty = false
+	}
+
+	if v, ok := m["testStringArray"]; ok && v != nil {
+		if o.testStringArray, ok = v.([]string); ok {
+			o.testStringArrayIsLoaded = true
+			o.testStringArrayIsDirty = false
+		} else {
+			panic("Wrong type found for testStringArray.")
+		}
+	} else {
+		o.testStringArrayIsLoaded = false
+		o.testStringArray = []string(nil)
+		o.testStringArrayIsDirty = false
+	}
+
+	if v, ok := m["testIntArray"]; ok {
+		if v == nil {
+			o.testIntArray = []int(nil)
+			o.testIntArrayIsNull = true
+			o.testIntArrayIsLoaded = true
+			o.testIntArrayIsDirty = false
+		} else if o.testIntArray, ok = v.([]int); ok {
+			o.testIntArrayIsNull = false
+			o.testIntArrayIsLoaded = true
+			o.testIntArrayIsDirty = false
+		} else {
+			panic("Wrong type found for testIntArray.")
+		}
+	} else {
+		o.testIntArrayIsLoaded = false
+		o.testIntArrayIsNull = true
+		o.testIntArray = []int(nil)
+		o.testIntArrayIsDirty = false
+	}
+
+	if v, ok := m["testJSON"]; ok {
+		if v == nil {
+			o.testJSON = ""
+			o.testJSONIsNull = true
+			o.testJSONIsLoaded = true
+			o.testJSONIsDirty = false
+		} else if o.testJSON, ok = v.(string); ok {
+			o.testJSONIsNull = false
+			o.testJSONIsLoaded = true
+			o.testJSONIsDirty = false
+		} else {
+			panic("Wrong type found for testJSON.")
+		}
+	} else {
+		o.testJSONIsLoaded = false
+		o.testJSONIsNull = true
+		o.testJSON = ""
+		o.testJSONIsDirty = false
+	}
+
 	if v, ok := m["testBool"]; ok && v != nil {
 		if o.testBool, ok = v.(bool); ok {
 			o.testBoolIsLoaded = true
@@ -1477,6 +2048,9 @@ func (o *typeTestBase) insert(ctx context.Context) (err error) {
 		if !o.testNumericIsLoaded {
 			panic("a value for TestNumeric is required, and there is no default value. Call SetTestNumeric() before inserting the record.")
 		}
+		if !o.testStringArrayIsLoaded {
+			panic("a value for TestStringArray is required, and there is no default value. Call SetTestStringArray() before inserting the record.")
+		}
 		if !o.testBoolIsLoaded {
 			panic("a value for TestBool is required, and there is no default value. Call SetTestBool() before inserting the record.")
 		}
@@ -1580,6 +2154,51 @@ func (o *typeTestBase) getUpdateFields() (fields map[string]interface{}) {
 	if o.testNumericIsDirty {
 		fields["test_numeric"] = o.testNumeric
 	}
+	if o.testDecimalIsDirty {
+		if o.testDecimalIsNull {
+			fields["test_decimal"] = nil
+		} else {
+			fields["test_decimal"] = o.testDecimal
+		}
+	}
+	if o.testPointIsDirty {
+		if o.testPointIsNull {
+			fields["test_point"] = nil
+		} else {
+			fields["test_point"] = o.testPoint
+		}
+	}
+	if o.testPolygonIsDirty {
+		if o.testPolygonIsNull {
+			fields["test_polygon"] = nil
+		} else {
+			fields["test_polygon"] = o.testPolygon
+		}
+	}
+	if o.testDurationIsDirty {
+		if o.testDurationIsNull {
+			fields["test_duration"] = nil
+		} else {
+			fields["test_duration"] = o.testDuration
+		}
+	}
+	if o.testStringArrayIsDirty {
+		fields["test_string_array"] = o.testStringArray
+	}
+	if o.testIntArrayIsDirty {
+		if o.testIntArrayIsNull {
+			fields["test_int_array"] = nil
+		} else {
+			fields["test_int_array"] = o.testIntArray
+		}
+	}
+	if o.testJSONIsDirty {
+		if o.testJSONIsNull {
+			fields["test_json"] = nil
+		} else {
+			fields["test_json"] = o.testJSON
+		}
+	}
 	if o.testBoolIsDirty {
 		fields["test_bool"] = o.testBool
 	}
@@ -1651,6 +2270,38 @@ func (o *typeTestBase) getInsertFields() (fields map[string]interface{}) {
 	fields["test_float64"] = o.testFloat64
 
 	fields["test_numeric"] = o.testNumeric
+	if o.testDecimalIsNull {
+		fields["test_decimal"] = nil
+	} else {
+		fields["test_decimal"] = o.testDecimal
+	}
+	if o.testPointIsNull {
+		fields["test_point"] = nil
+	} else {
+		fields["test_point"] = o.testPoint
+	}
+	if o.testPolygonIsNull {
+		fields["test_polygon"] = nil
+	} else {
+		fields["test_polygon"] = o.testPolygon
+	}
+	if o.testDurationIsNull {
+		fields["test_duration"] = nil
+	} else {
+		fields["test_duration"] = o.testDuration
+	}
+
+	fields["test_string_array"] = o.testStringArray
+	if o.testIntArrayIsNull {
+		fields["test_int_array"] = nil
+	} else {
+		fields["test_int_array"] = o.testIntArray
+	}
+	if o.testJSONIsNull {
+		fields["test_json"] = nil
+	} else {
+		fields["test_json"] = o.testJSON
+	}
 
 	fields["test_bool"] = o.testBool
 
@@ -1719,6 +2370,13 @@ func (o *typeTestBase) resetDirtyStatus() {
 	o.testFloat32IsDirty = false
 	o.testFloat64IsDirty = false
 	o.testNumericIsDirty = false
+	o.testDecimalIsDirty = false
+	o.testPointIsDirty = false
+	o.testPolygonIsDirty = false
+	o.testDurationIsDirty = false
+	o.testStringArrayIsDirty = false
+	o.testIntArrayIsDirty = false
+	o.testJSONIsDirty = false
 	o.testBoolIsDirty = false
 	o.testUnlimitedStringIsDirty = false
 	o.testLimitedStringIsDirty = false
@@ -1740,6 +2398,13 @@ func (o *typeTestBase) IsDirty() (dirty bool) {
 		o.testFloat32IsDirty ||
 		o.testFloat64IsDirty ||
 		o.testNumericIsDirty ||
+		o.testDecimalIsDirty ||
+		o.testPointIsDirty ||
+		o.testPolygonIsDirty ||
+		o.testDurationIsDirty ||
+		o.testStringArrayIsDirty ||
+		o.testIntArrayIsDirty ||
+		o.testJSONIsDirty ||
 		o.testBoolIsDirty ||
 		o.testUnlimitedStringIsDirty ||
 		o.testLimitedStringIsDirty ||
@@ -1812,6 +2477,41 @@ func (o *typeTestBase) Get(key string) interface{} {
 			return nil
 		}
 		return o.testNumeric
+	case TypeTestTestDecimalField:
+		if !o.testDecimalIsLoaded {
+			return nil
+		}
+		return o.testDecimal
+	case TypeTestTestPointField:
+		if !o.testPointIsLoaded {
+			return nil
+		}
+		return o.testPoint
+	case TypeTestTestPolygonField:
+		if !o.testPolygonIsLoaded {
+			return nil
+		}
+		return o.testPolygon
+	case TypeTestTestDurationField:
+		if !o.testDurationIsLoaded {
+			return nil
+		}
+		return o.testDuration
+	case TypeTestTestStringArrayField:
+		if !o.testStringArrayIsLoaded {
+			return nil
+		}
+		return o.testStringArray
+	case TypeTestTestIntArrayField:
+		if !o.testIntArrayIsLoaded {
+			return nil
+		}
+		return o.testIntArray
+	case TypeTestTestJSONField:
+		if !o.testJSONIsLoaded {
+			return nil
+		}
+		return o.testJSON
 	case TypeTestTestBoolField:
 		if !o.testBoolIsLoaded {
 			return nil
@@ -1985,6 +2685,94 @@ func (o *typeTestBase) encodeTo(enc db.Encoder) error {
 		return fmt.Errorf("error encoding TypeTest.testNumericIsDirty: %w", err)
 	}
 
+	if err := enc.Encode(o.testDecimal); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testDecimal: %w", err)
+	}
+	if err := enc.Encode(o.testDecimalIsNull); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testDecimalIsNull: %w", err)
+	}
+	if err := enc.Encode(o.testDecimalIsLoaded); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testDecimalIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.testDecimalIsDirty); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testDecimalIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.testPoint); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testPoint: %w", err)
+	}
+	if err := enc.Encode(o.testPointIsNull); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testPointIsNull: %w", err)
+	}
+	if err := enc.Encode(o.testPointIsLoaded); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testPointIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.testPointIsDirty); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testPointIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.testPolygon); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testPolygon: %w", err)
+	}
+	if err := enc.Encode(o.testPolygonIsNull); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testPolygonIsNull: %w", err)
+	}
+	if err := enc.Encode(o.testPolygonIsLoaded); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testPolygonIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.testPolygonIsDirty); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testPolygonIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.testDuration); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testDuration: %w", err)
+	}
+	if err := enc.Encode(o.testDurationIsNull); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testDurationIsNull: %w", err)
+	}
+	if err := enc.Encode(o.testDurationIsLoaded); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testDurationIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.testDurationIsDirty); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testDurationIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.testStringArray); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testStringArray: %w", err)
+	}
+	if err := enc.Encode(o.testStringArrayIsLoaded); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testStringArrayIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.testStringArrayIsDirty); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testStringArrayIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.testIntArray); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testIntArray: %w", err)
+	}
+	if err := enc.Encode(o.testIntArrayIsNull); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testIntArrayIsNull: %w", err)
+	}
+	if err := enc.Encode(o.testIntArrayIsLoaded); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testIntArrayIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.testIntArrayIsDirty); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testIntArrayIsDirty: %w", err)
+	}
+
+	if err := enc.Encode(o.testJSON); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testJSON: %w", err)
+	}
+	if err := enc.Encode(o.testJSONIsNull); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testJSONIsNull: %w", err)
+	}
+	if err := enc.Encode(o.testJSONIsLoaded); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testJSONIsLoaded: %w", err)
+	}
+	if err := enc.Encode(o.testJSONIsDirty); err != nil {
+		return fmt.Errorf("error encoding TypeTest.testJSONIsDirty: %w", err)
+	}
+
 	if err := enc.Encode(o.testBool); err != nil {
 		return fmt.Errorf("error encoding TypeTest.testBool: %w", err)
 	}
@@ -2207,6 +2995,94 @@ func (o *typeTestBase) decodeFrom(dec db.Decoder) (err error) {
 		return fmt.Errorf("error decoding TypeTest.testNumericIsDirty: %w", err)
 	}
 
+	if err = dec.Decode(&o.testDecimal); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testDecimal: %w", err)
+	}
+	if err = dec.Decode(&o.testDecimalIsNull); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testDecimalIsNull: %w", err)
+	}
+	if err = dec.Decode(&o.testDecimalIsLoaded); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testDecimalIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.testDecimalIsDirty); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testDecimalIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.testPoint); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testPoint: %w", err)
+	}
+	if err = dec.Decode(&o.testPointIsNull); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testPointIsNull: %w", err)
+	}
+	if err = dec.Decode(&o.testPointIsLoaded); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testPointIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.testPointIsDirty); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testPointIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.testPolygon); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testPolygon: %w", err)
+	}
+	if err = dec.Decode(&o.testPolygonIsNull); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testPolygonIsNull: %w", err)
+	}
+	if err = dec.Decode(&o.testPolygonIsLoaded); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testPolygonIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.testPolygonIsDirty); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testPolygonIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.testDuration); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testDuration: %w", err)
+	}
+	if err = dec.Decode(&o.testDurationIsNull); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testDurationIsNull: %w", err)
+	}
+	if err = dec.Decode(&o.testDurationIsLoaded); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testDurationIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.testDurationIsDirty); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testDurationIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.testStringArray); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testStringArray: %w", err)
+	}
+	if err = dec.Decode(&o.testStringArrayIsLoaded); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testStringArrayIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.testStringArrayIsDirty); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testStringArrayIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.testIntArray); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testIntArray: %w", err)
+	}
+	if err = dec.Decode(&o.testIntArrayIsNull); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testIntArrayIsNull: %w", err)
+	}
+	if err = dec.Decode(&o.testIntArrayIsLoaded); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testIntArrayIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.testIntArrayIsDirty); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testIntArrayIsDirty: %w", err)
+	}
+
+	if err = dec.Decode(&o.testJSON); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testJSON: %w", err)
+	}
+	if err = dec.Decode(&o.testJSONIsNull); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testJSONIsNull: %w", err)
+	}
+	if err = dec.Decode(&o.testJSONIsLoaded); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testJSONIsLoaded: %w", err)
+	}
+	if err = dec.Decode(&o.testJSONIsDirty); err != nil {
+		return fmt.Errorf("error decoding TypeTest.testJSONIsDirty: %w", err)
+	}
+
 	if err = dec.Decode(&o.testBool); err != nil {
 		return fmt.Errorf("error decoding TypeTest.testBool: %w", err)
 	}
@@ -2374,6 +3250,58 @@ func (o *typeTestBase) MarshalStringMap() map[string]interface{} {
 		v["testNumeric"] = o.testNumeric
 	}
 
+	if o.testDecimalIsLoaded {
+		if o.testDecimalIsNull {
+			v["testDecimal"] = nil
+		} else {
+			v["testDecimal"] = o.testDecimal
+		}
+	}
+
+	if o.testPointIsLoaded {
+		if o.testPointIsNull {
+			v["testPoint"] = nil
+		} else {
+			v["testPoint"] = o.testPoint
+		}
+	}
+
+	if o.testPolygonIsLoaded {
+		if o.testPolygonIsNull {
+			v["testPolygon"] = nil
+		} else {
+			v["testPolygon"] = o.testPolygon
+		}
+	}
+
+	if o.testDurationIsLoaded {
+		if o.testDurationIsNull {
+			v["testDuration"] = nil
+		} else {
+			v["testDuration"] = o.testDuration.String()
+		}
+	}
+
+	if o.testStringArrayIsLoaded {
+		v["testStringArray"] = o.testStringArray
+	}
+
+	if o.testIntArrayIsLoaded {
+		if o.testIntArrayIsNull {
+			v["testIntArray"] = nil
+		} else {
+			v["testIntArray"] = o.testIntArray
+		}
+	}
+
+	if o.testJSONIsLoaded {
+		if o.testJSONIsNull {
+			v["testJSON"] = nil
+		} else {
+			v["testJSON"] = o.testJSON
+		}
+	}
+
 	if o.testBoolIsLoaded {
 		v["testBool"] = o.testBool
 	}
@@ -2429,6 +3357,13 @@ func (o *typeTestBase) MarshalStringMap() map[string]interface{} {
 //	"testFloat32" - float32, nullable
 //	"testFloat64" - float64
 //	"testNumeric" - string
+//	"testDecimal" - query.Decimal, nullable
+//	"testPoint" - query.Geometry, nullable
+//	"testPolygon" - query.Geometry, nullable
+//	"testDuration" - time.Duration, nullable
+//	"testStringArray" - []string
+//	"testIntArray" - []int, nullable
+//	"testJSON" - string, nullable
 //	"testBool" - bool
 //	"testUnlimitedString" - string
 //	"testLimitedString" - string
@@ -2666,6 +3601,148 @@ func (o *typeTestBase) UnmarshalStringMap(m map[string]interface{}) (err error)
 					o.SetTestNumeric(s)
 				}
 			}
+		case "testDecimal":
+			{
+				if v == nil {
+					o.SetTestDecimalToNull()
+					continue
+				}
+
+				var d query.Decimal
+				switch n := v.(type) {
+				case string:
+					d, err = query.ParseDecimal(n)
+				case json.Number:
+					d, err = query.ParseDecimal(n.String())
+				case float64:
+					d = query.NewDecimalFromFloat(n)
+				default:
+					return fmt.Errorf("json field %s must be a number or a string", k)
+				}
+				if err != nil {
+					return fmt.Errorf("json field %s must be a decimal number: %w", k, err)
+				}
+				o.SetTestDecimal(d)
+			}
+		case "testPoint":
+			{
+				if v == nil {
+					o.SetTestPointToNull()
+					continue
+				}
+
+				// a GeoJSON geometry object
+				var g query.Geometry
+				if b, err2 := json.Marshal(v); err2 != nil {
+					return err2
+				} else if err2 = g.UnmarshalJSON(b); err2 != nil {
+					return fmt.Errorf("json field %s must be a GeoJSON geometry: %w", k, err2)
+				}
+				o.SetTestPoint(g)
+			}
+		case "testPolygon":
+			{
+				if v == nil {
+					o.SetTestPolygonToNull()
+					continue
+				}
+
+				// a GeoJSON geometry object
+				var g query.Geometry
+				if b, err2 := json.Marshal(v); err2 != nil {
+					return err2
+				} else if err2 = g.UnmarshalJSON(b); err2 != nil {
+					return fmt.Errorf("json field %s must be a GeoJSON geometry: %w", k, err2)
+				}
+				o.SetTestPolygon(g)
+			}
+		case "testDuration":
+			{
+				if v == nil {
+					o.SetTestDurationToNull()
+					continue
+				}
+
+				switch d := v.(type) {
+				case string:
+					// a Go duration string, as in "1h30m"
+					d2, err2 := time.ParseDuration(d)
+					if err2 != nil {
+						return fmt.Errorf("json field %s must be a duration: %w", k, err2)
+					}
+					o.SetTestDuration(d2)
+				case json.Number:
+					// a numeric value, which is a number of nanoseconds, the same as a time.Duration
+					n2, err2 := d.Int64()
+					if err2 != nil {
+						return fmt.Errorf("json field %s must be a duration: %w", k, err2)
+					}
+					o.SetTestDuration(time.Duration(n2))
+				case float64:
+					o.SetTestDuration(time.Duration(d))
+				default:
+					return fmt.Errorf("json field %s must be a number or a string", k)
+				}
+			}
+		case "testStringArray":
+			{
+				if v == nil {
+					return fmt.Errorf("field %s cannot be null", k)
+				}
+
+				a, ok := v.([]any)
+				if !ok {
+					return fmt.Errorf("json field %s must be an array of strings", k)
+				}
+				v2 := make([]string, len(a))
+				for i, i2 := range a {
+					if v2[i], ok = i2.(string); !ok {
+						return fmt.Errorf("json field %s must be an array of strings", k)
+					}
+				}
+				o.SetTestStringArray(v2)
+			}
+		case "testIntArray":
+			{
+				if v == nil {
+					o.SetTestIntArrayToNull()
+					continue
+				}
+
+				a, ok := v.([]any)
+				if !ok {
+					return fmt.Errorf("json field %s must be an array of integers", k)
+				}
+				v2 := make([]int, len(a))
+				for i, i2 := range a {
+					switch n := i2.(type) {
+					case json.Number:
+						n2, err2 := n.Int64()
+						if err2 != nil {
+							return fmt.Errorf("json field %s must be an array of integers: %w", k, err2)
+						}
+						v2[i] = int(n2)
+					case float64:
+						v2[i] = int(n)
+					default:
+						return fmt.Errorf("json field %s must be an array of integers", k)
+					}
+				}
+				o.SetTestIntArray(v2)
+			}
+		case "testJSON":
+			{
+				if v == nil {
+					o.SetTestJSONToNull()
+					continue
+				}
+
+				if s, ok := v.(string); !ok {
+					return fmt.Errorf("json field %s must be a string", k)
+				} else {
+					o.SetTestJSON(s)
+				}
+			}
 		case "testBool":
 			{
 				if v == nil {
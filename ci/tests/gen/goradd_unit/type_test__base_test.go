@@ -46,6 +46,20 @@ func updateMinimalSampleTypeTest(obj *TypeTest) {
 
 	obj.SetTestNumeric(test.RandomDecimal(10, 4))
 
+	obj.SetTestDecimal(query.MustParseDecimal(test.RandomDecimal(10, 4)))
+
+	obj.SetTestPoint(test.RandomGeometry(query.GeometryPoint))
+
+	obj.SetTestPolygon(test.RandomGeometry(query.GeometryPolygon))
+
+	obj.SetTestDuration(test.RandomValue[time.Duration](0))
+
+	obj.SetTestStringArray(test.RandomValue[[]string](0))
+
+	obj.SetTestIntArray(test.RandomValue[[]int](0))
+
+	obj.SetTestJSON(test.RandomJSON())
+
 	obj.SetTestBool(test.RandomValue[bool](0))
 
 	obj.SetTestUnlimitedString(test.RandomValue[string](0))
@@ -126,6 +140,27 @@ func assertEqualFieldsTypeTest(t *testing.T, obj1, obj2 *TypeTest) {
 	if obj1.TestNumericIsLoaded() && obj2.TestNumericIsLoaded() { // only check loaded values
 		assert.True(t, test.EqualDecimals(obj1.TestNumeric(), obj2.TestNumeric()))
 	}
+	if obj1.TestDecimalIsLoaded() && obj2.TestDecimalIsLoaded() { // only check loaded values
+		assert.True(t, obj1.TestDecimal().Equal(obj2.TestDecimal()))
+	}
+	if obj1.TestPointIsLoaded() && obj2.TestPointIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.TestPoint(), obj2.TestPoint())
+	}
+	if obj1.TestPolygonIsLoaded() && obj2.TestPolygonIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.TestPolygon(), obj2.TestPolygon())
+	}
+	if obj1.TestDurationIsLoaded() && obj2.TestDurationIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.TestDuration(), obj2.TestDuration())
+	}
+	if obj1.TestStringArrayIsLoaded() && obj2.TestStringArrayIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.TestStringArray(), obj2.TestStringArray())
+	}
+	if obj1.TestIntArrayIsLoaded() && obj2.TestIntArrayIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.TestIntArray(), obj2.TestIntArray())
+	}
+	if obj1.TestJSONIsLoaded() && obj2.TestJSONIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.TestJSON(), obj2.TestJSON())
+	}
 	if obj1.TestBoolIsLoaded() && obj2.TestBoolIsLoaded() { // only check loaded values
 		assert.EqualValues(t, obj1.TestBool(), obj2.TestBool())
 	}
@@ -320,6 +355,156 @@ func TestTypeTest_SetTestNumeric(t *testing.T) {
 	obj.SetTestNumeric(d)
 	assert.EqualValues(t, d, obj.TestNumeric(), "set default")
 
+}
+func TestTypeTest_SetTestDecimal(t *testing.T) {
+
+	obj := NewTypeTest()
+
+	assert.True(t, obj.IsNew())
+	val := query.MustParseDecimal(test.RandomDecimal(10, 4))
+	obj.SetTestDecimal(val)
+	assert.Equal(t, val, obj.TestDecimal())
+	assert.False(t, obj.TestDecimalIsNull())
+
+	// Test NULL
+	obj.SetTestDecimalToNull()
+	assert.EqualValues(t, query.Decimal{}, obj.TestDecimal())
+	assert.True(t, obj.TestDecimalIsNull())
+
+	// test default
+	var d query.Decimal = query.Decimal{}
+	obj.SetTestDecimal(d)
+	assert.EqualValues(t, d, obj.TestDecimal(), "set default")
+
+	// test panic on setting value that does not fit the precision and scale
+	val = query.NewDecimal(1, 5)
+	assert.Panics(t, func() {
+		obj.SetTestDecimal(val)
+	})
+	val = query.NewDecimal(1, -6)
+	assert.Panics(t, func() {
+		obj.SetTestDecimal(val)
+	})
+}
+func TestTypeTest_SetTestPoint(t *testing.T) {
+
+	obj := NewTypeTest()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomGeometry(query.GeometryPoint)
+	obj.SetTestPoint(val)
+	assert.Equal(t, val, obj.TestPoint())
+	assert.False(t, obj.TestPointIsNull())
+
+	// Test NULL
+	obj.SetTestPointToNull()
+	assert.EqualValues(t, query.Geometry{}, obj.TestPoint())
+	assert.True(t, obj.TestPointIsNull())
+
+	// test default
+	var d query.Geometry = query.Geometry{}
+	obj.SetTestPoint(d)
+	assert.EqualValues(t, d, obj.TestPoint(), "set default")
+
+}
+func TestTypeTest_SetTestPolygon(t *testing.T) {
+
+	obj := NewTypeTest()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomGeometry(query.GeometryPolygon)
+	obj.SetTestPolygon(val)
+	assert.Equal(t, val, obj.TestPolygon())
+	assert.False(t, obj.TestPolygonIsNull())
+
+	// Test NULL
+	obj.SetTestPolygonToNull()
+	assert.EqualValues(t, query.Geometry{}, obj.TestPolygon())
+	assert.True(t, obj.TestPolygonIsNull())
+
+	// test default
+	var d query.Geometry = query.Geometry{}
+	obj.SetTestPolygon(d)
+	assert.EqualValues(t, d, obj.TestPolygon(), "set default")
+
+}
+func TestTypeTest_SetTestDuration(t *testing.T) {
+
+	obj := NewTypeTest()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[time.Duration](0)
+	obj.SetTestDuration(val)
+	assert.Equal(t, val, obj.TestDuration())
+	assert.False(t, obj.TestDurationIsNull())
+
+	// Test NULL
+	obj.SetTestDurationToNull()
+	assert.EqualValues(t, time.Duration(5400000000000), obj.TestDuration())
+	assert.True(t, obj.TestDurationIsNull())
+
+	// test default
+	var d time.Duration = time.Duration(5400000000000)
+	obj.SetTestDuration(d)
+	assert.EqualValues(t, d, obj.TestDuration(), "set default")
+
+}
+func TestTypeTest_SetTestStringArray(t *testing.T) {
+
+	obj := NewTypeTest()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[[]string](0)
+	obj.SetTestStringArray(val)
+	assert.Equal(t, val, obj.TestStringArray())
+
+	// test default
+	var d []string = []string(nil)
+	obj.SetTestStringArray(d)
+	assert.EqualValues(t, d, obj.TestStringArray(), "set default")
+
+}
+func TestTypeTest_SetTestIntArray(t *testing.T) {
+
+	obj := NewTypeTest()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[[]int](0)
+	obj.SetTestIntArray(val)
+	assert.Equal(t, val, obj.TestIntArray())
+	assert.False(t, obj.TestIntArrayIsNull())
+
+	// Test NULL
+	obj.SetTestIntArrayToNull()
+	assert.EqualValues(t, []int(nil), obj.TestIntArray())
+	assert.True(t, obj.TestIntArrayIsNull())
+
+	// test default
+	var d []int = []int(nil)
+	obj.SetTestIntArray(d)
+	assert.EqualValues(t, d, obj.TestIntArray(), "set default")
+
+}
+func TestTypeTest_SetTestJSON(t *testing.T) {
+
+	obj := NewTypeTest()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomJSON()
+	obj.SetTestJSON(val)
+	assert.Equal(t, val, obj.TestJSON())
+	assert.False(t, obj.TestJSONIsNull())
+
+	// Test NULL
+	obj.SetTestJSONToNull()
+	assert.EqualValues(t, "", obj.TestJSON())
+	assert.True(t, obj.TestJSONIsNull())
+
+	// test default
+	var d string = ""
+	obj.SetTestJSON(d)
+	assert.EqualValues(t, d, obj.TestJSON(), "set default")
+
 }
 func TestTypeTest_SetTestBool(t *testing.T) {
 
@@ -450,6 +635,13 @@ func TestTypeTest_Copy(t *testing.T) {
 	assert.Equal(t, obj.TestFloat32(), obj2.TestFloat32())
 	assert.Equal(t, obj.TestFloat64(), obj2.TestFloat64())
 	assert.Equal(t, obj.TestNumeric(), obj2.TestNumeric())
+	assert.Equal(t, obj.TestDecimal(), obj2.TestDecimal())
+	assert.Equal(t, obj.TestPoint(), obj2.TestPoint())
+	assert.Equal(t, obj.TestPolygon(), obj2.TestPolygon())
+	assert.Equal(t, obj.TestDuration(), obj2.TestDuration())
+	assert.Equal(t, obj.TestStringArray(), obj2.TestStringArray())
+	assert.Equal(t, obj.TestIntArray(), obj2.TestIntArray())
+	assert.Equal(t, obj.TestJSON(), obj2.TestJSON())
 	assert.Equal(t, obj.TestBool(), obj2.TestBool())
 	assert.Equal(t, obj.TestUnlimitedString(), obj2.TestUnlimitedString())
 	assert.Equal(t, obj.TestLimitedString(), obj2.TestLimitedString())
@@ -536,6 +728,55 @@ func TestTypeTest_BasicInsert(t *testing.T) {
 	obj2.SetTestNumeric(obj2.TestNumeric())
 	assert.False(t, obj2.testNumericIsDirty)
 
+	assert.True(t, obj2.TestDecimalIsLoaded())
+	assert.False(t, obj2.TestDecimalIsNull())
+	assert.True(t, obj.TestDecimal().Equal(obj2.TestDecimal()))
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.testDecimalIsDirty)
+	obj2.SetTestDecimal(obj2.TestDecimal())
+	assert.False(t, obj2.testDecimalIsDirty)
+
+	assert.True(t, obj2.TestPointIsLoaded())
+	assert.False(t, obj2.TestPointIsNull())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.testPointIsDirty)
+	obj2.SetTestPoint(obj2.TestPoint())
+	assert.False(t, obj2.testPointIsDirty)
+
+	assert.True(t, obj2.TestPolygonIsLoaded())
+	assert.False(t, obj2.TestPolygonIsNull())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.testPolygonIsDirty)
+	obj2.SetTestPolygon(obj2.TestPolygon())
+	assert.False(t, obj2.testPolygonIsDirty)
+
+	assert.True(t, obj2.TestDurationIsLoaded())
+	assert.False(t, obj2.TestDurationIsNull())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.testDurationIsDirty)
+	obj2.SetTestDuration(obj2.TestDuration())
+	assert.False(t, obj2.testDurationIsDirty)
+
+	assert.True(t, obj2.TestStringArrayIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.testStringArrayIsDirty)
+	obj2.SetTestStringArray(obj2.TestStringArray())
+	assert.False(t, obj2.testStringArrayIsDirty)
+
+	assert.True(t, obj2.TestIntArrayIsLoaded())
+	assert.False(t, obj2.TestIntArrayIsNull())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.testIntArrayIsDirty)
+	obj2.SetTestIntArray(obj2.TestIntArray())
+	assert.False(t, obj2.testIntArrayIsDirty)
+
+	assert.True(t, obj2.TestJSONIsLoaded())
+	assert.False(t, obj2.TestJSONIsNull())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.testJSONIsDirty)
+	obj2.SetTestJSON(obj2.TestJSON())
+	assert.False(t, obj2.testJSONIsDirty)
+
 	assert.True(t, obj2.TestBoolIsLoaded())
 	// test that setting it to the same value will not change the dirty bit
 	assert.False(t, obj2.testBoolIsDirty)
@@ -598,6 +839,10 @@ func TestTypeTest_InsertPanics(t *testing.T) {
 	assert.Panics(t, func() { obj.Save(ctx) })
 	obj.testNumericIsLoaded = true
 
+	obj.testStringArrayIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.testStringArrayIsLoaded = true
+
 	obj.testBoolIsLoaded = false
 	assert.Panics(t, func() { obj.Save(ctx) })
 	obj.testBoolIsLoaded = true
@@ -653,6 +898,13 @@ func TestTypeTest_BasicUpdate(t *testing.T) {
 	assert.Equal(t, obj2.TestInt64(), obj.TestInt64(), "TestInt64 did not update")
 	assert.Equal(t, obj2.TestFloat32(), obj.TestFloat32(), "TestFloat32 did not update")
 	assert.Equal(t, obj2.TestFloat64(), obj.TestFloat64(), "TestFloat64 did not update")
+	assert.True(t, obj2.TestDecimal().Equal(obj.TestDecimal()), "TestDecimal did not update")
+	assert.Equal(t, obj2.TestPoint(), obj.TestPoint(), "TestPoint did not update")
+	assert.Equal(t, obj2.TestPolygon(), obj.TestPolygon(), "TestPolygon did not update")
+	assert.Equal(t, obj2.TestDuration(), obj.TestDuration(), "TestDuration did not update")
+	assert.Equal(t, obj2.TestStringArray(), obj.TestStringArray(), "TestStringArray did not update")
+	assert.Equal(t, obj2.TestIntArray(), obj.TestIntArray(), "TestIntArray did not update")
+	assert.Equal(t, obj2.TestJSON(), obj.TestJSON(), "TestJSON did not update")
 	assert.Equal(t, obj2.TestBool(), obj.TestBool(), "TestBool did not update")
 	assert.Equal(t, obj2.TestUnlimitedString(), obj.TestUnlimitedString(), "TestUnlimitedString did not update")
 	assert.Equal(t, obj2.TestLimitedString(), obj.TestLimitedString(), "TestLimitedString did not update")
@@ -769,6 +1021,27 @@ func TestTypeTest_Getters(t *testing.T) {
 	assert.Equal(t, obj.TestNumeric(), obj.Get(TypeTestTestNumericField))
 	assert.Panics(t, func() { obj2.TestNumeric() })
 	assert.Nil(t, obj2.Get(TypeTestTestNumericField))
+	assert.Equal(t, obj.TestDecimal(), obj.Get(TypeTestTestDecimalField))
+	assert.Panics(t, func() { obj2.TestDecimal() })
+	assert.Nil(t, obj2.Get(TypeTestTestDecimalField))
+	assert.Equal(t, obj.TestPoint(), obj.Get(TypeTestTestPointField))
+	assert.Panics(t, func() { obj2.TestPoint() })
+	assert.Nil(t, obj2.Get(TypeTestTestPointField))
+	assert.Equal(t, obj.TestPolygon(), obj.Get(TypeTestTestPolygonField))
+	assert.Panics(t, func() { obj2.TestPolygon() })
+	assert.Nil(t, obj2.Get(TypeTestTestPolygonField))
+	assert.Equal(t, obj.TestDuration(), obj.Get(TypeTestTestDurationField))
+	assert.Panics(t, func() { obj2.TestDuration() })
+	assert.Nil(t, obj2.Get(TypeTestTestDurationField))
+	assert.Equal(t, obj.TestStringArray(), obj.Get(TypeTestTestStringArrayField))
+	assert.Panics(t, func() { obj2.TestStringArray() })
+	assert.Nil(t, obj2.Get(TypeTestTestStringArrayField))
+	assert.Equal(t, obj.TestIntArray(), obj.Get(TypeTestTestIntArrayField))
+	assert.Panics(t, func() { obj2.TestIntArray() })
+	assert.Nil(t, obj2.Get(TypeTestTestIntArrayField))
+	assert.Equal(t, obj.TestJSON(), obj.Get(TypeTestTestJSONField))
+	assert.Panics(t, func() { obj2.TestJSON() })
+	assert.Nil(t, obj2.Get(TypeTestTestJSONField))
 	assert.Equal(t, obj.TestBool(), obj.Get(TypeTestTestBoolField))
 	assert.Panics(t, func() { obj2.TestBool() })
 	assert.Nil(t, obj2.Get(TypeTestTestBoolField))
@@ -891,14 +1164,14 @@ func TestTypeTest_FailingMarshalBinary(t *testing.T) {
 	obj := createMinimalSampleTypeTest()
 	var err error
 
-	for i := 0; i < 60; i++ {
+	for i := 0; i < 87; i++ {
 		enc := &test.GobEncoder{Count: i}
 		err = obj.encodeTo(enc)
 		assert.Error(t, err)
 	}
 	// do it again with aliases
 	obj._aliases = make(map[string]any)
-	for i := 0; i < 61; i++ {
+	for i := 0; i < 88; i++ {
 		enc := &test.GobEncoder{Count: i}
 		err = obj.encodeTo(enc)
 		assert.Error(t, err)
@@ -910,7 +1183,7 @@ func TestTypeTest_FailingUnmarshalBinary(t *testing.T) {
 	b, err := obj.MarshalBinary()
 	assert.NoError(t, err)
 	obj2 := NewTypeTest()
-	for i := 0; i < 60; i++ {
+	for i := 0; i < 87; i++ {
 		buf := bytes.NewReader(b)
 		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
 		err = obj2.decodeFrom(dec)
@@ -924,7 +1197,7 @@ func TestTypeTest_FailingUnmarshalBinary(t *testing.T) {
 	assert.NoError(t, err)
 
 	obj2 = NewTypeTest()
-	for i := 0; i < 61; i++ {
+	for i := 0; i < 88; i++ {
 		buf := bytes.NewReader(b)
 		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
 		err = obj2.decodeFrom(dec)
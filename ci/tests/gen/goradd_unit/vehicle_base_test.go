@@ -0,0 +1,388 @@
+// Code generated by goradd-orm. DO NOT EDIT.
+
+package goradd_unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/goradd/gro/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMinimalSampleVehicle creates an unsaved minimal version of a Vehicle object
+// for testing.
+func createMinimalSampleVehicle() *Vehicle {
+	obj := NewVehicle()
+	updateMinimalSampleVehicle(obj)
+
+	return obj
+}
+
+// updateMinimalSampleVehicle sets the values of a minimal sample to new, random values.
+func updateMinimalSampleVehicle(obj *Vehicle) {
+
+	obj.SetName(test.RandomValue[string](100))
+
+}
+
+// createMaximalSampleVehicle creates an unsaved version of a Vehicle object
+// for testing that includes references to minimal objects.
+func createMaximalSampleVehicle(ctx context.Context) *Vehicle {
+	obj := NewVehicle()
+	updateMaximalSampleVehicle(ctx, obj)
+	return obj
+}
+
+// updateMaximalSampleVehicle sets all the maximal sample values to new values.
+// This will set new values for references, so save the old values and delete them.
+func updateMaximalSampleVehicle(ctx context.Context, obj *Vehicle) {
+	updateMinimalSampleVehicle(obj)
+
+}
+
+// deleteSampleVehicle deletes an object created and saved by one of the sample creator functions.
+func deleteSampleVehicle(ctx context.Context, obj *Vehicle) {
+	if obj == nil {
+		return
+	}
+
+	_ = obj.Delete(ctx)
+}
+
+// assertEqualFieldsVehicle compares two objects and asserts that the basic fields are equal.
+func assertEqualFieldsVehicle(t *testing.T, obj1, obj2 *Vehicle) {
+	if obj1.IDIsLoaded() && obj2.IDIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.ID(), obj2.ID())
+	}
+	if obj1.NameIsLoaded() && obj2.NameIsLoaded() { // only check loaded values
+		assert.EqualValues(t, obj1.Name(), obj2.Name())
+	}
+
+}
+
+func TestVehicle_SetID(t *testing.T) {
+
+	obj := NewVehicle()
+
+	assert.True(t, obj.IsNew())
+	val := query.NewAutoPrimaryKey(test.RandomNumberString())
+	obj.SetID(val)
+	assert.Equal(t, val, obj.ID())
+
+	// test default
+	var d query.AutoPrimaryKey = query.TempAutoPrimaryKey()
+	obj.SetID(d)
+	assert.EqualValues(t, d, obj.ID(), "set default")
+
+}
+func TestVehicle_SetName(t *testing.T) {
+
+	obj := NewVehicle()
+
+	assert.True(t, obj.IsNew())
+	val := test.RandomValue[string](100)
+	obj.SetName(val)
+	assert.Equal(t, val, obj.Name())
+
+	// test default
+	var d string = ""
+	obj.SetName(d)
+	assert.EqualValues(t, d, obj.Name(), "set default")
+
+	// test panic on setting value larger than maximum size allowed
+	val = test.RandomValue[string](101)
+	assert.Panics(t, func() {
+		obj.SetName(val)
+	})
+}
+
+func TestVehicle_Copy(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+
+	obj2 := obj.Copy()
+
+	assert.Equal(t, obj.Name(), obj2.Name())
+
+}
+
+func TestVehicle_BasicInsert(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleVehicle(ctx, obj)
+
+	// Test retrieval
+	obj2, err := LoadVehicle(ctx, obj.PrimaryKey())
+	require.NotNil(t, obj2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.PrimaryKey(), obj2.OriginalPrimaryKey())
+
+	assert.True(t, obj2.IDIsLoaded())
+	assert.Panics(t, func() {
+		obj2.SetID(obj2.ID())
+	})
+
+	assert.True(t, obj2.NameIsLoaded())
+	// test that setting it to the same value will not change the dirty bit
+	assert.False(t, obj2.nameIsDirty)
+	obj2.SetName(obj2.Name())
+	assert.False(t, obj2.nameIsDirty)
+
+}
+
+func TestVehicle_InsertPanics(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+	_ = obj
+	ctx := context.Background()
+	_ = ctx
+
+	obj.nameIsLoaded = false
+	assert.Panics(t, func() { obj.Save(ctx) })
+	obj.nameIsLoaded = true
+
+}
+
+func TestVehicle_BasicUpdate(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleVehicle(ctx, obj)
+	updateMinimalSampleVehicle(obj)
+	assert.NoError(t, obj.Save(ctx))
+	obj2, err := LoadVehicle(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+
+	assert.Equal(t, obj2.ID(), obj.ID(), "ID did not update")
+	assert.Equal(t, obj2.Name(), obj.Name(), "Name did not update")
+}
+
+func TestVehicle_ReferenceLoad(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleVehicle(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleVehicle(ctx, obj)
+
+	// Test that referenced objects were saved and assigned ids
+
+	// Test lazy loading
+	obj2, err := LoadVehicle(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	assert.NotNil(t, obj2)
+	objPkOnly, err2 := LoadVehicle(ctx, obj.PrimaryKey(),
+		node.Vehicle().ID())
+	assert.NoError(t, err2)
+	_ = objPkOnly
+
+	// test eager loading
+	obj3, err3 := LoadVehicle(ctx, obj.PrimaryKey())
+	assert.NoError(t, err3)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestVehicle_ReferenceUpdateNewObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleVehicle(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleVehicle(ctx, obj)
+
+	obj2, err := LoadVehicle(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	updateMaximalSampleVehicle(ctx, obj2)
+	assert.NoError(t, obj2.Save(ctx))
+	defer deleteSampleVehicle(ctx, obj2)
+
+	obj3, err2 := LoadVehicle(ctx, obj2.PrimaryKey())
+	assert.NoError(t, err2)
+	_ = obj3 // avoid error if there are no references
+
+}
+
+func TestVehicle_ReferenceUpdateOldObjects(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleVehicle(ctx)
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleVehicle(ctx, obj)
+
+	assert.NoError(t, obj.Save(ctx))
+
+	obj2, err := LoadVehicle(ctx, obj.PrimaryKey())
+	assert.NoError(t, err)
+	_ = obj2 // avoid error if there are no references
+
+}
+func TestVehicle_EmptyPrimaryKeyGetter(t *testing.T) {
+	obj := NewVehicle()
+
+	assert.True(t, obj.ID().IsTemp())
+}
+
+func TestVehicle_Getters(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+
+	assert.True(t, obj.ID().IsTemp())
+
+	ctx := context.Background()
+	require.NoError(t, obj.Save(ctx))
+	defer deleteSampleVehicle(ctx, obj)
+
+	has, _ := HasVehicle(ctx, obj.PrimaryKey())
+	assert.True(t, has)
+
+	obj2, _ := LoadVehicle(ctx, obj.PrimaryKey(),
+		node.Vehicle().ID())
+
+	assert.Equal(t, obj.ID(), obj.Get(VehicleIDField))
+	assert.Equal(t, obj.Name(), obj.Get(VehicleNameField))
+	assert.Panics(t, func() { obj2.Name() })
+	assert.Nil(t, obj2.Get(VehicleNameField))
+
+}
+
+func TestVehicle_QueryLoad(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleVehicle(ctx, obj)
+
+	objs, err := QueryVehicles(ctx).
+		Where(op.Equal(node.Vehicle().ID(), obj.ID())).
+		OrderBy(node.Vehicle().ID()). // exercise order by
+		Limit(1, 0).                  // exercise limit
+		Calculation(node.Vehicle(), "IsTrue", op.Equal("A", "A")).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, obj.PrimaryKey(), objs[0].PrimaryKey())
+	assert.True(t, objs[0].GetAlias("IsTrue").Bool())
+}
+func TestVehicle_QueryLoadI(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+	ctx := context.Background()
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleVehicle(ctx, obj)
+
+	objs, _ := QueryVehicles(ctx).
+		Where(op.Equal(node.Vehicle().ID(), obj.ID())).
+		LoadI()
+
+	assert.Equal(t, obj.PrimaryKey(), objs[0].(*Vehicle).PrimaryKey())
+}
+func TestVehicle_QueryCursor(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+	ctx := context.Background()
+	assert.NoError(t, obj.Save(ctx))
+	defer deleteSampleVehicle(ctx, obj)
+
+	cursor, err := QueryVehicles(ctx).
+		Where(op.Equal(node.Vehicle().ID(), obj.ID())).
+		LoadCursor()
+	require.NoError(t, err)
+	obj2, err2 := cursor.Next()
+	assert.Equal(t, obj.PrimaryKey(), obj2.PrimaryKey())
+	require.NoError(t, err2)
+	obj2, err2 = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err2)
+	assert.NoError(t, cursor.Close())
+
+	// test empty cursor result
+	cursor, err = QueryVehicles(ctx).
+		Where(op.Equal("B", "A")).
+		LoadCursor()
+	require.NoError(t, err)
+
+	obj2, err = cursor.Next()
+	assert.Nil(t, obj2)
+	require.NoError(t, err)
+	assert.NoError(t, cursor.Close())
+}
+func TestVehicle_Count(t *testing.T) {
+	ctx := context.Background()
+	obj := createMaximalSampleVehicle(ctx)
+	err := obj.Save(ctx)
+	assert.NoError(t, err)
+	defer deleteSampleVehicle(ctx, obj)
+	assert.Positive(t, func() int { i, _ := CountVehicles(ctx); return i }())
+
+}
+
+func TestVehicle_MarshalJSON(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+
+	b, err := json.Marshal(obj)
+	assert.NoError(t, err)
+
+	obj2 := NewVehicle()
+	err = json.Unmarshal(b, &obj2)
+	assert.NoError(t, err)
+
+	assertEqualFieldsVehicle(t, obj, obj2)
+}
+
+func TestVehicle_MarshalBinary(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 := NewVehicle()
+	err = obj2.UnmarshalBinary(b)
+	assert.NoError(t, err)
+
+	assertEqualFieldsVehicle(t, obj, obj2)
+}
+
+func TestVehicle_FailingMarshalBinary(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+	var err error
+
+	for i := 0; i < 9; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+	// do it again with aliases
+	obj._aliases = make(map[string]any)
+	for i := 0; i < 10; i++ {
+		enc := &test.GobEncoder{Count: i}
+		err = obj.encodeTo(enc)
+		assert.Error(t, err)
+	}
+}
+
+func TestVehicle_FailingUnmarshalBinary(t *testing.T) {
+	obj := createMinimalSampleVehicle()
+	b, err := obj.MarshalBinary()
+	assert.NoError(t, err)
+	obj2 := NewVehicle()
+	for i := 0; i < 9; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+
+	// do it again with aliases
+	obj = createMinimalSampleVehicle()
+	obj._aliases = map[string]any{"a": 1}
+	b, err = obj.MarshalBinary()
+	assert.NoError(t, err)
+
+	obj2 = NewVehicle()
+	for i := 0; i < 10; i++ {
+		buf := bytes.NewReader(b)
+		dec := &test.GobDecoder{Decoder: gob.NewDecoder(buf), Count: i}
+		err = obj2.decodeFrom(dec)
+		assert.Error(t, err)
+	}
+}
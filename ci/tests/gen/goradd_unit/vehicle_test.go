@@ -0,0 +1,51 @@
+package goradd_unit
+
+// This is the test file for the Vehicle ORM object.
+// Add your tests to this file or modify the one provided.
+// Your edits to this file will be preserved.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVehicle_String(t *testing.T) {
+	var obj *Vehicle
+
+	assert.Equal(t, "", obj.String())
+
+	obj = NewVehicle()
+	s := obj.String()
+	assert.True(t, strings.HasPrefix(s, "Vehicle"))
+}
+
+func TestVehicle_Key(t *testing.T) {
+	var obj *Vehicle
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewVehicle()
+	assert.Equal(t, fmt.Sprintf("%v", obj.PrimaryKey()), obj.Key())
+}
+
+func TestVehicle_Label(t *testing.T) {
+	var obj *Vehicle
+	assert.Equal(t, "", obj.Key())
+
+	obj = NewVehicle()
+	s := obj.Label()
+	assert.Equal(t, "", s)
+}
+
+func TestVehicle_Delete(t *testing.T) {
+	ctx := context.Background()
+	obj := createMinimalSampleVehicle()
+	assert.NoError(t, obj.Save(ctx))
+	assert.NoError(t, DeleteVehicle(ctx, obj.PrimaryKey()))
+	obj2, err := LoadVehicle(ctx, obj.PrimaryKey())
+	assert.Nil(t, obj2)
+	assert.NoError(t, err)
+}
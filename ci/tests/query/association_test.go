@@ -172,3 +172,65 @@ func TestAssociationByPrimaryKeys(t *testing.T) {
 	assert.NoError(t, err2)
 	assert.NotNil(t, project)
 }
+
+func TestAssociationColumns(t *testing.T) {
+	ctx := context.Background()
+	project, err := goradd2.LoadProject(ctx, "1", node2.Project().TeamMembers())
+	assert.NoError(t, err)
+	links := project.TeamMemberLinks()
+	assert.Len(t, links, 5)
+
+	link := project.TeamMemberLink("2")
+	assert.Equal(t, "2", link.TeamMember().ID())
+	assert.False(t, link.RoleIsNull())
+	assert.Equal(t, "Lead Developer", link.Role())
+	assert.Equal(t, 20, link.HoursPerWeek())
+
+	link = project.TeamMemberLink("5")
+	assert.True(t, link.RoleIsNull())
+	assert.Equal(t, 40, link.HoursPerWeek())
+
+	projects, err := goradd2.QueryProjects(ctx).
+		Where(op.Equal(node2.Project().TeamMembers().Role(), "Lead Developer")).
+		Load()
+	assert.NoError(t, err)
+	assert.Len(t, projects, 1)
+	assert.Equal(t, "1", projects[0].ID())
+}
+
+func TestAssociationColumnsSave(t *testing.T) {
+	ctx := context.Background()
+	project, err := goradd2.LoadProject(ctx, "1")
+	assert.NoError(t, err)
+
+	person := goradd2.NewPerson()
+	person.SetID("101")
+	person.SetFirstName("Link")
+	person.SetLastName("Tester")
+	link := goradd2.NewPersonProjectLink(project)
+	link.SetRole("Tester")
+	person.SetProjectLinks(link)
+	assert.NoError(t, person.Save(ctx))
+	defer func() {
+		assert.NoError(t, person.Delete(ctx))
+	}()
+
+	person2, err := goradd2.LoadPerson(ctx, "101")
+	assert.NoError(t, err)
+	_, err = person2.LoadProjects(ctx)
+	assert.NoError(t, err)
+	link = person2.ProjectLink("1")
+	assert.Equal(t, "Tester", link.Role())
+	assert.Equal(t, 40, link.HoursPerWeek())
+
+	link.SetHoursPerWeek(10)
+	assert.True(t, person2.IsDirty())
+	assert.NoError(t, person2.Save(ctx))
+	assert.False(t, person2.IsDirty())
+
+	projects, err := goradd2.QueryProjects(ctx).
+		Where(op.Equal(node2.Project().TeamMembers().HoursPerWeek(), 10)).
+		Load()
+	assert.NoError(t, err)
+	assert.Len(t, projects, 1)
+}
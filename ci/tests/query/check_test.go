@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goradd/anyutil"
+	goradd2 "github.com/goradd/gro/ci/tests/gen/goradd"
+	"github.com/goradd/gro/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckConstraint(t *testing.T) {
+	ctx := context.Background()
+
+	l := goradd2.NewLogin()
+	l.SetID("106")
+	l.SetUsername("check")
+	l.SetPassword("check")
+	err := l.Save(ctx)
+	require.Error(t, err)
+	e, ok := anyutil.As[*db.CheckConstraintError](err)
+	require.True(t, ok, "error is a CheckConstraintError")
+	assert.Equal(t, "login", e.Table)
+	assert.Equal(t, "login_password_check", e.Constraint)
+
+	l.SetPassword("different")
+	require.NoError(t, l.Save(ctx))
+	defer l.Delete(ctx)
+
+	l.SetPassword("check")
+	err = l.Save(ctx)
+	_, ok = anyutil.As[*db.CheckConstraintError](err)
+	assert.True(t, ok, "an update is also checked")
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/goradd/gro/ci/tests/gen/goradd"
 	goradd2 "github.com/goradd/gro/ci/tests/gen/goradd"
 	"github.com/goradd/gro/ci/tests/gen/goradd/node"
+	"github.com/goradd/gro/query/op"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,3 +21,30 @@ func TestBasicEnum(t *testing.T) {
 		t.Error("Did not find correct project type.")
 	}
 }
+
+func TestEnumArray(t *testing.T) {
+	ctx := context.Background()
+	person := goradd2.NewPerson()
+	person.SetID("104")
+	person.SetFirstName("Ana")
+	person.SetLastName("Lima")
+	person.SetPersonTypes([]goradd.PersonType{goradd.PersonTypeManager, goradd.PersonTypeVendor})
+	assert.NoError(t, person.Save(ctx))
+	defer person.Delete(ctx)
+
+	p2, err := goradd2.LoadPerson(ctx, "104")
+	assert.NoError(t, err)
+	assert.Equal(t, []goradd.PersonType{goradd.PersonTypeManager, goradd.PersonTypeVendor}, p2.PersonTypes())
+
+	people, err := goradd2.QueryPeople(ctx).
+		Where(op.ArrayContains(node.Person().PersonTypes(), goradd.PersonTypeVendor)).
+		Load()
+	assert.NoError(t, err)
+	if assert.Len(t, people, 1) {
+		assert.Equal(t, "104", people[0].ID())
+	}
+
+	b, err := p2.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"personTypes":["manager","vendor"]`)
+}
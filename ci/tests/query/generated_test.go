@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	goradd2 "github.com/goradd/gro/ci/tests/gen/goradd"
+	"github.com/goradd/gro/ci/tests/gen/goradd/node"
+	"github.com/goradd/gro/query/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedColumn(t *testing.T) {
+	ctx := context.Background()
+
+	person, err := goradd2.LoadPerson(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "John Doe", person.FullName())
+
+	p := goradd2.NewPerson()
+	p.SetID("105")
+	p.SetFirstName("Ada")
+	p.SetLastName("Byron")
+	require.NoError(t, p.Save(ctx))
+	defer p.Delete(ctx)
+	assert.Equal(t, "Ada Byron", p.FullName(), "generated value is loaded after an insert")
+
+	p.SetLastName("Lovelace")
+	require.NoError(t, p.Save(ctx))
+	assert.Equal(t, "Ada Lovelace", p.FullName(), "generated value is loaded after an update")
+
+	people, err := goradd2.QueryPeople(ctx).
+		Where(op.Equal(node.Person().FullName(), "Ada Lovelace")).
+		Load()
+	assert.NoError(t, err)
+	if assert.Len(t, people, 1) {
+		assert.Equal(t, "105", people[0].ID())
+	}
+
+	b, err := p.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"fullName":"Ada Lovelace"`)
+}
@@ -7,9 +7,12 @@ import (
 
 	goradd2 "github.com/goradd/gro/ci/tests/gen/goradd"
 	node2 "github.com/goradd/gro/ci/tests/gen/goradd/node"
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit"
+	unit_node "github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
 	"github.com/goradd/gro/query"
 	"github.com/goradd/gro/query/op"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEqualBasic(t *testing.T) {
@@ -156,6 +159,106 @@ func TestAggregates(t *testing.T) {
 	assert.EqualValues(t, 73200.0, projects3[0].GetAlias("max").Float())
 }
 
+func TestMatch(t *testing.T) {
+	ctx := context.Background()
+	projects, err := goradd2.QueryProjects(ctx).
+		Where(op.Match(node2.Project().Description(), "website")).
+		OrderBy(node2.Project().Num()).
+		Load()
+	assert.NoError(t, err)
+	if assert.Len(t, projects, 2) {
+		assert.Equal(t, 1, projects[0].Num())
+		assert.Equal(t, 3, projects[1].Num())
+	}
+
+	projects, err = goradd2.QueryProjects(ctx).
+		OrderBy(op.MatchScore(node2.Project().Description(), "architecture").Descending(), node2.Project().Num()).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, projects[0].Num())
+}
+
+func TestConditionals(t *testing.T) {
+	ctx := context.Background()
+
+	// sort nulls last
+	projects, err := goradd2.QueryProjects(ctx).
+		OrderBy(op.Case().When(op.IsNull(node2.Project().EndDate()), 1).Else(0), node2.Project().Num()).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, projects[0].Num())
+	assert.Equal(t, 2, projects[3].Num())
+
+	// bucket values
+	projects, err = goradd2.QueryProjects(ctx).
+		Calculation(node2.Project(), "size", op.Case().When(op.LessThan(node2.Project().Num(), 3), "low").Else("high")).
+		Where(op.Equal(op.Case().When(op.GreaterThan(node2.Project().Num(), 2), 1).End(), 1)).
+		OrderBy(node2.Project().Num()).
+		Load()
+	assert.NoError(t, err)
+	if assert.Len(t, projects, 2) {
+		assert.Equal(t, "high", projects[0].GetAlias("size").String())
+	}
+
+	projects, err = goradd2.QueryProjects(ctx).
+		Calculation(node2.Project(), "num", op.Coalesce(op.NullIf(node2.Project().Num(), 2), 0)).
+		OrderBy(node2.Project().Num()).
+		Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, projects[0].GetAlias("num").Int())
+	assert.Equal(t, 0, projects[1].GetAlias("num").Int())
+}
+
+func TestDateFunctions(t *testing.T) {
+	ctx := context.Background()
+	projects, err := goradd2.QueryProjects(ctx).
+		Calculation(node2.Project(), "year", op.Year(node2.Project().StartDate())).
+		Calculation(node2.Project(), "month", op.Month(node2.Project().StartDate())).
+		Calculation(node2.Project(), "day", op.Day(node2.Project().StartDate())).
+		Calculation(node2.Project(), "dow", op.DayOfWeek(node2.Project().StartDate())).
+		Calculation(node2.Project(), "hour", op.Hour(node2.Project().StartDate())).
+		Calculation(node2.Project(), "diff", op.DateDiffSeconds(node2.Project().EndDate(), node2.Project().StartDate())).
+		Where(op.Equal(node2.Project().Num(), 1)).
+		Load()
+	assert.NoError(t, err)
+	if assert.Len(t, projects, 1) {
+		assert.Equal(t, 2004, projects[0].GetAlias("year").Int())
+		assert.Equal(t, 3, projects[0].GetAlias("month").Int())
+		assert.Equal(t, 1, projects[0].GetAlias("day").Int())
+		assert.Equal(t, 1, projects[0].GetAlias("dow").Int())
+		assert.Equal(t, 0, projects[0].GetAlias("hour").Int())
+		assert.Equal(t, 122*24*60*60, projects[0].GetAlias("diff").Int())
+	}
+
+	projects, err = goradd2.QueryProjects(ctx).
+		Where(op.Equal(op.DateTruncMonth(node2.Project().StartDate()), op.DateTruncMonth(time.Date(2006, 3, 20, 5, 0, 0, 0, time.UTC)))).
+		Load()
+	assert.NoError(t, err)
+	if assert.Len(t, projects, 1) {
+		assert.Equal(t, 3, projects[0].Num())
+	}
+
+	projects, err = goradd2.QueryProjects(ctx).
+		Where(op.Equal(op.DateTruncWeek(node2.Project().StartDate()), op.DateTruncWeek(time.Date(2006, 2, 17, 0, 0, 0, 0, time.UTC)))).
+		Load()
+	assert.NoError(t, err)
+	if assert.Len(t, projects, 1) {
+		assert.Equal(t, 2, projects[0].Num())
+	}
+
+	projects, err = goradd2.QueryProjects(ctx).
+		Where(op.Equal(op.DateTruncYear(node2.Project().StartDate()), op.DateTruncYear(op.DateTruncDay(time.Date(2006, 12, 31, 23, 0, 0, 0, time.UTC))))).
+		Load()
+	assert.NoError(t, err)
+	assert.Len(t, projects, 2)
+
+	projects, err = goradd2.QueryProjects(ctx).
+		Where(op.LessThan(node2.Project().StartDate(), op.Now())).
+		Load()
+	assert.NoError(t, err)
+	assert.Len(t, projects, 4)
+}
+
 /* TODO:
 
 func TestAliases(t *testing.T) {
@@ -190,3 +293,52 @@ func TestAliases(t *testing.T) {
 	assert.Equal(t, "Milestone B", people[2].GetAlias("min_conson").String())
 }
 */
+
+// TestJsonExtractNumber tests that numbers extracted from a JSON document are returned as text on every database.
+func TestJsonExtractNumber(t *testing.T) {
+	ctx := context.Background()
+
+	var ids []string
+	for _, n := range []string{"9", "10"} {
+		r := goradd_unit.NewTypeTest()
+		r.SetTestInt64(1)
+		r.SetTestFloat64(1)
+		r.SetTestNumeric("1")
+		r.SetTestUnlimitedString("jsonNumber")
+		r.SetTestLimitedString("jsonNumber")
+		r.SetTestLongstring("jsonNumber")
+		r.SetTestUnlimitedBytes([]byte{1})
+		r.SetTestLimitedBytes([]byte{1})
+		r.SetTypeLongBytes([]byte{1})
+		r.SetTestStringArray([]string{})
+		r.SetTestJSON(`{"n": ` + n + `, "price": 1.5}`)
+		require.NoError(t, r.Save(ctx))
+		defer r.Delete(ctx)
+		ids = append(ids, r.ID().String())
+	}
+	col := unit_node.TypeTest().TestJSON()
+	mine := op.Equal(unit_node.TypeTest().TestUnlimitedString(), "jsonNumber")
+
+	tests, err := goradd_unit.QueryTypeTests(ctx).
+		Where(op.And(mine, op.Equal(op.JsonExtract(col, "$.n"), "10"))).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, tests, 1)
+	assert.Equal(t, ids[1], tests[0].ID().String())
+
+	count, err := goradd_unit.QueryTypeTests(ctx).
+		Where(op.And(mine, op.Equal(op.JsonExtract(col, "$.price"), "1.5"))).
+		Count()
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Text sorts "10" before "9"
+	tests, err = goradd_unit.QueryTypeTests(ctx).
+		Where(mine).
+		OrderBy(op.JsonExtract(col, "$.n")).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, tests, 2)
+	assert.Equal(t, ids[1], tests[0].ID().String())
+	assert.Equal(t, ids[0], tests[1].ID().String())
+}
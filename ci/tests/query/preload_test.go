@@ -0,0 +1,131 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	goradd2 "github.com/goradd/gro/ci/tests/gen/goradd"
+	node3 "github.com/goradd/gro/ci/tests/gen/goradd/node"
+	"github.com/goradd/gro/query/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func projectIDs(projects []*goradd2.Project) (ids []string) {
+	for _, p := range projects {
+		ids = append(ids, p.ID())
+	}
+	return
+}
+
+func TestPreload(t *testing.T) {
+	ctx := context.Background()
+
+	joined, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Select(
+			node3.Person().Projects(),
+			node3.Person().ManagerProjects(),
+		).
+		Load()
+	require.NoError(t, err)
+
+	preloaded, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Preload(
+			node3.Person().Projects(),        // many many
+			node3.Person().ManagerProjects(), // reverse
+		).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, preloaded, len(joined))
+	for i, p := range preloaded {
+		assert.Equal(t, joined[i].ID(), p.ID())
+		assert.ElementsMatch(t, projectIDs(joined[i].Projects()), projectIDs(p.Projects()))
+		assert.ElementsMatch(t, projectIDs(joined[i].ManagerProjects()), projectIDs(p.ManagerProjects()))
+	}
+
+	for _, person := range preloaded {
+		if person.ID() == "7" {
+			assert.Equal(t, "Wolfe", person.LastName())
+			assert.Len(t, person.Projects(), 2)
+			assert.Len(t, person.ManagerProjects(), 2)
+		}
+	}
+}
+
+func TestPreloadNested(t *testing.T) {
+	ctx := context.Background()
+
+	people, err := goradd2.QueryPeople(ctx).
+		Where(op.Equal(node3.Person().ID(), "7")).
+		Preload(node3.Person().ManagerProjects()).
+		Select(
+			node3.Person().ManagerProjects().Milestones(),
+			node3.Person().ManagerProjects().TeamMembers().FirstName(),
+		).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, people, 1)
+	projects := people[0].ManagerProjects()
+	require.Len(t, projects, 2)
+	var milestoneCount int
+	for _, p := range projects {
+		milestoneCount += len(p.Milestones())
+		assert.NotEmpty(t, p.TeamMembers())
+	}
+	assert.NotZero(t, milestoneCount)
+
+	people2, err := goradd2.QueryPeople(ctx).
+		Where(op.Equal(node3.Person().ID(), "7")).
+		Preload(
+			node3.Person().ManagerProjects(),
+			node3.Person().ManagerProjects().Milestones(),
+		).
+		Load()
+	require.NoError(t, err)
+	var milestoneCount2 int
+	for _, p := range people2[0].ManagerProjects() {
+		milestoneCount2 += len(p.Milestones())
+	}
+	assert.Equal(t, milestoneCount, milestoneCount2)
+}
+
+func TestPreloadLimit(t *testing.T) {
+	ctx := context.Background()
+
+	all, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Select(node3.Person().ManagerProjects()).
+		Load()
+	require.NoError(t, err)
+
+	// Array relationships are preloaded automatically when the query is limited
+	limited, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Select(node3.Person().ManagerProjects()).
+		Limit(3, 5).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, limited, 3)
+	for i, p := range limited {
+		assert.Equal(t, all[i+5].ID(), p.ID())
+		assert.ElementsMatch(t, projectIDs(all[i+5].ManagerProjects()), projectIDs(p.ManagerProjects()))
+	}
+}
+
+func TestPreloadPanics(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Panics(t, func() {
+		_, _ = goradd2.QueryPeople(ctx).
+			Where(op.Equal(node3.Person().Projects().Name(), "ACME Website Redesign")).
+			Preload(node3.Person().Projects()).
+			Load()
+	})
+	assert.Panics(t, func() {
+		_, _ = goradd2.QueryPeople(ctx).
+			Preload(node3.Person().Login()).
+			Load()
+	})
+}
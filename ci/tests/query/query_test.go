@@ -9,6 +9,7 @@ import (
 	node3 "github.com/goradd/gro/ci/tests/gen/goradd/node"
 	goradd_unit2 "github.com/goradd/gro/ci/tests/gen/goradd_unit"
 	node2 "github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/db"
 	"github.com/goradd/gro/query"
 	"github.com/goradd/gro/query/op"
 	"github.com/stretchr/testify/assert"
@@ -256,6 +257,76 @@ func TestFailedGroupBy(t *testing.T) {
 	})
 }
 
+func TestLocking(t *testing.T) {
+	ctx := context.Background()
+
+	err := db.WithTransaction(ctx, db.GetDatabase("goradd"), func(ctx context.Context) error {
+		projects, err := goradd2.QueryProjects(ctx).
+			OrderBy(node3.Project().Num()).
+			Limit(2, 0).
+			ForUpdate().
+			SkipLocked().
+			Load()
+		assert.Len(t, projects, 2)
+		return err
+	})
+	assert.NoError(t, err)
+
+	assert.Panics(t, func() {
+		goradd2.QueryProjects(ctx).ForUpdate().SkipLocked().NoWait()
+	})
+
+	// Array relationships of a locking query can be preloaded
+	err = db.WithTransaction(ctx, db.GetDatabase("goradd"), func(ctx context.Context) error {
+		projects, err := goradd2.QueryProjects(ctx).
+			Where(op.Equal(node3.Project().ID(), "1")).
+			Preload(node3.Project().Milestones()).
+			ForUpdate().
+			Load()
+		require.Len(t, projects, 1)
+		assert.NotEmpty(t, projects[0].Milestones())
+		return err
+	})
+	assert.NoError(t, err)
+
+	// A locking query can join other tables
+	err = db.WithTransaction(ctx, db.GetDatabase("goradd"), func(ctx context.Context) error {
+		projects, err := goradd2.QueryProjects(ctx).
+			Where(op.Equal(node3.Project().Manager().LastName(), "Wolfe")).
+			Select(node3.Project().Manager()).
+			ForShare().
+			Load()
+		require.NotEmpty(t, projects)
+		assert.Equal(t, "Wolfe", projects[0].Manager().LastName())
+		return err
+	})
+	assert.NoError(t, err)
+}
+
+func TestLockingPanics(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Panics(t, func() {
+		_, _ = goradd2.QueryProjects(ctx).Distinct().Select(node3.Project().Name()).ForUpdate().Load()
+	}, "distinct")
+	assert.Panics(t, func() {
+		_, _ = goradd2.QueryProjects(ctx).
+			GroupBy(node3.Project().Status()).
+			Calculation(node3.Project(), "count", op.Count(node3.Project().ID())).
+			ForUpdate().
+			Load()
+	}, "group by")
+	assert.Panics(t, func() {
+		_, _ = goradd2.QueryProjects(ctx).
+			Calculation(node3.Project(), "count", op.Count(node3.Project().ID())).
+			ForShare().
+			Load()
+	}, "aggregate")
+	assert.Panics(t, func() {
+		_, _ = goradd2.QueryProjects(ctx).ForUpdate().Count()
+	}, "count")
+}
+
 // Test that we can get from an integer keyed database
 func TestIntKey(t *testing.T) {
 	ctx := context.Background()
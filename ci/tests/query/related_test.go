@@ -0,0 +1,160 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	goradd2 "github.com/goradd/gro/ci/tests/gen/goradd"
+	node3 "github.com/goradd/gro/ci/tests/gen/goradd/node"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// topProjects returns the projects sorted by descending num, limited to limit projects.
+func topProjects(projects []*goradd2.Project, limit int) (ids []string) {
+	projects = slices.Clone(projects)
+	slices.SortFunc(projects, func(a, b *goradd2.Project) int {
+		return b.Num() - a.Num()
+	})
+	return projectIDs(projects[:min(limit, len(projects))])
+}
+
+func TestRelatedLimit(t *testing.T) {
+	ctx := context.Background()
+
+	all, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Select(node3.Person().ManagerProjects()).
+		Load()
+	require.NoError(t, err)
+
+	people, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Select(query.Related(node3.Person().ManagerProjects(), query.RelatedOptions{
+			OrderBys: []query.Sorter{node3.Person().ManagerProjects().Num().Descending()},
+			Limit:    1,
+		})).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, people, len(all))
+	for i, p := range people {
+		assert.Equal(t, all[i].ID(), p.ID())
+		assert.Equal(t, topProjects(all[i].ManagerProjects(), 1), projectIDs(p.ManagerProjects()))
+	}
+
+	// The related objects of a limited query are preloaded, and are limited the same way
+	limited, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Select(query.Related(node3.Person().ManagerProjects(), query.RelatedOptions{
+			OrderBys: []query.Sorter{node3.Person().ManagerProjects().Num().Descending()},
+			Limit:    1,
+		})).
+		Limit(4, 2).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, limited, 4)
+	for i, p := range limited {
+		assert.Equal(t, all[i+2].ID(), p.ID())
+		assert.Equal(t, topProjects(all[i+2].ManagerProjects(), 1), projectIDs(p.ManagerProjects()))
+	}
+}
+
+func TestRelatedCondition(t *testing.T) {
+	ctx := context.Background()
+
+	all, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Select(node3.Person().Projects()).
+		Load()
+	require.NoError(t, err)
+
+	people, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Select(query.Related(node3.Person().Projects(), query.RelatedOptions{
+			Condition: op.Equal(node3.Person().Projects().Status(), goradd2.ProjectStatusOpen),
+			OrderBys:  []query.Sorter{node3.Person().Projects().Num()},
+		})).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, people, len(all))
+	var count int
+	for i, p := range people {
+		var expected []int
+		for _, project := range all[i].Projects() {
+			if project.Status() == goradd2.ProjectStatusOpen {
+				expected = append(expected, project.Num())
+			}
+		}
+		slices.Sort(expected)
+		var nums []int
+		for _, project := range p.Projects() {
+			assert.Equal(t, goradd2.ProjectStatusOpen, project.Status())
+			nums = append(nums, project.Num())
+		}
+		assert.Equal(t, expected, nums)
+		count += len(nums)
+	}
+	assert.NotZero(t, count)
+
+	preloaded, err := goradd2.QueryPeople(ctx).
+		OrderBy(node3.Person().ID()).
+		Preload(query.Related(node3.Person().Projects(), query.RelatedOptions{
+			Condition: op.Equal(node3.Person().Projects().Status(), goradd2.ProjectStatusOpen),
+			OrderBys:  []query.Sorter{node3.Person().Projects().Num()},
+		})).
+		Load()
+	require.NoError(t, err)
+	require.Len(t, preloaded, len(people))
+	for i, p := range preloaded {
+		assert.Equal(t, projectIDs(people[i].Projects()), projectIDs(p.Projects()))
+	}
+}
+
+func TestRelatedManyManyLimit(t *testing.T) {
+	ctx := context.Background()
+
+	for _, preload := range []bool{false, true} {
+		b := goradd2.QueryProjects(ctx).
+			Where(op.Equal(node3.Project().ID(), "1"))
+		n := query.Related(node3.Project().TeamMembers(), query.RelatedOptions{
+			Condition: op.NotEqual(node3.Project().TeamMembers().ID(), "2"),
+			OrderBys: []query.Sorter{
+				node3.Project().TeamMembers().HoursPerWeek().Descending(),
+				node3.Project().TeamMembers().ID(),
+			},
+			Limit: 2,
+		})
+		if preload {
+			b.Preload(n)
+		} else {
+			b.Select(n)
+		}
+		projects, err := b.Load()
+		require.NoError(t, err)
+		require.Len(t, projects, 1)
+		var ids []string
+		for _, link := range projects[0].TeamMemberLinks() {
+			ids = append(ids, link.TeamMember().ID())
+			assert.Equal(t, 40, link.HoursPerWeek())
+		}
+		assert.ElementsMatch(t, []string{"5", "6"}, ids)
+	}
+}
+
+func TestRelatedPanics(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Panics(t, func() {
+		query.Related(node3.Person().Login(), query.RelatedOptions{Limit: 1})
+	})
+	assert.Panics(t, func() {
+		_, _ = goradd2.QueryPeople(ctx).
+			Select(query.Related(node3.Person().ManagerProjects(), query.RelatedOptions{
+				Condition: op.Equal(node3.Person().LastName(), "Wolfe"),
+			})).
+			Load()
+	})
+}
@@ -6,7 +6,13 @@ import (
 	"time"
 
 	"github.com/goradd/gro/ci/tests/gen/goradd_unit"
+	"github.com/goradd/gro/ci/tests/gen/goradd_unit/node"
+	"github.com/goradd/gro/db"
+	"github.com/goradd/gro/db/sql/sqlite"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAutoGeneratedValues(t *testing.T) {
@@ -45,3 +51,214 @@ func TestAutoGeneratedValues(t *testing.T) {
 	assert.NotEqual(t, ts, r.GroTimestamp())
 	assert.EqualValues(t, id, r.ID())
 }
+
+func TestGeometry(t *testing.T) {
+	ctx := context.Background()
+
+	zone := query.NewPolygon([]query.Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 0, Y: 10}, {X: 0, Y: 0}})
+	r := goradd_unit.NewTypeTest()
+	r.SetTestInt64(1)
+	r.SetTestFloat64(1)
+	r.SetTestNumeric("1")
+	r.SetTestUnlimitedString("geometry")
+	r.SetTestLimitedString("geometry")
+	r.SetTestLongstring("geometry")
+	r.SetTestUnlimitedBytes([]byte{1})
+	r.SetTestLimitedBytes([]byte{1})
+	r.SetTypeLongBytes([]byte{1})
+	r.SetTestStringArray(nil)
+	r.SetTestPoint(query.NewPoint(2.5, 3.5))
+	r.SetTestPolygon(zone)
+	require.NoError(t, r.Save(ctx))
+	defer r.Delete(ctx)
+
+	r2, err := goradd_unit.LoadTypeTest(ctx, r.ID())
+	require.NoError(t, err)
+	assert.True(t, r2.TestPoint().Equal(query.NewPoint(2.5, 3.5)))
+	assert.True(t, r2.TestPolygon().Equal(zone))
+
+	b, err := r2.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"testPoint":{"type":"Point","coordinates":[2.5,3.5]}`)
+
+	if _, ok := db.GetDatabase("goradd_unit").(*sqlite.DB); ok {
+		// SQLite has no spatial functions
+		assert.Panics(t, func() {
+			_, _ = goradd_unit.QueryTypeTests(ctx).Where(op.Within(node.TypeTest().TestPoint(), zone)).Load()
+		})
+		return
+	}
+
+	tests, err := goradd_unit.QueryTypeTests(ctx).
+		Where(op.And(
+			op.Equal(node.TypeTest().ID(), r.ID()),
+			op.Within(node.TypeTest().TestPoint(), zone),
+			op.Intersects(node.TypeTest().TestPolygon(), query.NewPoint(5, 5)),
+			op.DistanceLess(node.TypeTest().TestPoint(), query.NewPoint(2.5, 4.5), 1.5),
+		)).
+		Load()
+	assert.NoError(t, err)
+	assert.Len(t, tests, 1)
+
+	tests, err = goradd_unit.QueryTypeTests(ctx).
+		Where(op.And(
+			op.Equal(node.TypeTest().ID(), r.ID()),
+			op.DistanceLess(node.TypeTest().TestPoint(), query.NewPoint(20, 20), 1),
+		)).
+		Load()
+	assert.NoError(t, err)
+	assert.Len(t, tests, 0)
+}
+
+func TestDuration(t *testing.T) {
+	ctx := context.Background()
+
+	r := goradd_unit.NewTypeTest()
+	assert.Equal(t, 90*time.Minute, r.TestDuration())
+	r.SetTestInt64(1)
+	r.SetTestFloat64(1)
+	r.SetTestNumeric("1")
+	r.SetTestUnlimitedString("duration")
+	r.SetTestLimitedString("duration")
+	r.SetTestLongstring("duration")
+	r.SetTestUnlimitedBytes([]byte{1})
+	r.SetTestLimitedBytes([]byte{1})
+	r.SetTypeLongBytes([]byte{1})
+	r.SetTestStringArray(nil)
+	r.SetDateTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	d := 2*time.Hour + 3*time.Minute + 4*time.Second + 5*time.Microsecond
+	r.SetTestDuration(d)
+	require.NoError(t, r.Save(ctx))
+	defer r.Delete(ctx)
+
+	r2, err := goradd_unit.LoadTypeTest(ctx, r.ID())
+	require.NoError(t, err)
+	assert.Equal(t, d, r2.TestDuration())
+
+	b, err := r2.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"testDuration":"2h3m4.000005s"`)
+
+	r3 := goradd_unit.NewTypeTest()
+	assert.NoError(t, r3.UnmarshalJSON([]byte(`{"testDuration":"-1m30s"}`)))
+	assert.Equal(t, -90*time.Second, r3.TestDuration())
+
+	tests, err := goradd_unit.QueryTypeTests(ctx).
+		Where(op.And(
+			op.Equal(node.TypeTest().ID(), r.ID()),
+			op.GreaterThan(node.TypeTest().TestDuration(), 2*time.Hour),
+			op.Equal(op.Hour(op.DateAdd(node.TypeTest().DateTime(), node.TypeTest().TestDuration())), 2),
+			op.Equal(op.Year(op.DateAdd(node.TypeTest().DateTime(), -time.Hour)), 2019),
+		)).
+		Load()
+	assert.NoError(t, err)
+	assert.Len(t, tests, 1)
+}
+
+func TestArrays(t *testing.T) {
+	ctx := context.Background()
+
+	r := goradd_unit.NewTypeTest()
+	r.SetTestInt64(1)
+	r.SetTestFloat64(1)
+	r.SetTestNumeric("1")
+	r.SetTestUnlimitedString("arrays")
+	r.SetTestLimitedString("arrays")
+	r.SetTestLongstring("arrays")
+	r.SetTestUnlimitedBytes([]byte{1})
+	r.SetTestLimitedBytes([]byte{1})
+	r.SetTypeLongBytes([]byte{1})
+	tags := []string{"go", "orm", "has \"quotes\", and commas"}
+	r.SetTestStringArray(tags)
+	tags[0] = "changed" // the setter should have made a copy
+	r.SetTestIntArray([]int{3, -1, 4})
+	require.NoError(t, r.Save(ctx))
+	defer r.Delete(ctx)
+
+	r2, err := goradd_unit.LoadTypeTest(ctx, r.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "orm", "has \"quotes\", and commas"}, r2.TestStringArray())
+	assert.Equal(t, []int{3, -1, 4}, r2.TestIntArray())
+
+	b, err := r2.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"testIntArray":[3,-1,4]`)
+	r3 := goradd_unit.NewTypeTest()
+	assert.NoError(t, r3.UnmarshalJSON([]byte(`{"testStringArray":["a","b"],"testIntArray":[5]}`)))
+	assert.Equal(t, []string{"a", "b"}, r3.TestStringArray())
+	assert.Equal(t, []int{5}, r3.TestIntArray())
+
+	tests, err := goradd_unit.QueryTypeTests(ctx).
+		Where(op.And(
+			op.Equal(node.TypeTest().ID(), r.ID()),
+			op.ArrayContains(node.TypeTest().TestStringArray(), "orm"),
+			op.ArrayOverlaps(node.TypeTest().TestIntArray(), []int{7, 4}),
+		)).
+		Load()
+	assert.NoError(t, err)
+	assert.Len(t, tests, 1)
+
+	tests, err = goradd_unit.QueryTypeTests(ctx).
+		Where(op.And(
+			op.Equal(node.TypeTest().ID(), r.ID()),
+			op.ArrayOverlaps(node.TypeTest().TestStringArray(), []string{"changed", "sql"}),
+		)).
+		Load()
+	assert.NoError(t, err)
+	assert.Len(t, tests, 0)
+
+	assert.Panics(t, func() {
+		op.ArrayOverlaps(node.TypeTest().TestIntArray(), []int{})
+	})
+
+	// an empty array is saved as an empty array and not a NULL
+	r2.SetTestStringArray(nil)
+	r2.SetTestIntArray([]int{})
+	require.NoError(t, r2.Save(ctx))
+	r2, err = goradd_unit.LoadTypeTest(ctx, r.ID())
+	require.NoError(t, err)
+	assert.Empty(t, r2.TestStringArray())
+	assert.False(t, r2.TestIntArrayIsNull())
+	assert.Empty(t, r2.TestIntArray())
+}
+
+func TestJsonOperations(t *testing.T) {
+	ctx := context.Background()
+
+	r := goradd_unit.NewTypeTest()
+	r.SetTestInt64(1)
+	r.SetTestFloat64(1)
+	r.SetTestNumeric("1")
+	r.SetTestUnlimitedString("json")
+	r.SetTestLimitedString("json")
+	r.SetTestLongstring("json")
+	r.SetTestUnlimitedBytes([]byte{1})
+	r.SetTestLimitedBytes([]byte{1})
+	r.SetTypeLongBytes([]byte{1})
+	r.SetTestStringArray([]string{})
+	r.SetTestJSON(`{"name": "gro", "version": 2, "tags": ["go", "orm"], "meta": {"a": true}}`)
+	require.NoError(t, r.Save(ctx))
+	defer r.Delete(ctx)
+
+	count := func(n query.Node) int {
+		c, err := goradd_unit.QueryTypeTests(ctx).
+			Where(op.And(op.Equal(node.TypeTest().ID(), r.ID()), n)).
+			Count()
+		require.NoError(t, err)
+		return c
+	}
+	col := node.TypeTest().TestJSON()
+
+	assert.Equal(t, 1, count(op.Equal(op.JsonExtract(col, "$.name"), "gro")))
+	assert.Equal(t, 0, count(op.Equal(op.JsonExtract(col, "$.name"), "sql")))
+
+	assert.Equal(t, 1, count(op.JsonContains(col, `{"name": "gro"}`)))
+	assert.Equal(t, 1, count(op.JsonContains(col, `{"version": 2, "tags": ["go", "orm"]}`)))
+	assert.Equal(t, 1, count(op.JsonContains(col, `{}`)))
+	assert.Equal(t, 0, count(op.JsonContains(col, `{"name": "sql"}`)))
+	assert.Equal(t, 0, count(op.JsonContains(col, `{"version": "2"}`)))
+	assert.Equal(t, 0, count(op.JsonContains(col, `{"missing": "gro"}`)))
+
+	assert.Equal(t, 1, count(op.JsonHasKey(col, "$.meta.a")))
+	assert.Equal(t, 0, count(op.JsonHasKey(col, "$.missing")))
+}
@@ -35,6 +35,22 @@ func TestUniqueValue(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestUniqueExpressionIndex(t *testing.T) {
+	ctx := context.Background()
+	login, err := goradd2.LoadLoginByUsernameIgnoreCase(ctx, "SyStEm")
+	require.NoError(t, err)
+	require.NotNil(t, login)
+	assert.Equal(t, "system", login.Username())
+
+	h, err := goradd2.HasLoginByUsernameIgnoreCase(ctx, "JDOE")
+	assert.NoError(t, err)
+	assert.True(t, h)
+
+	login, err = goradd2.LoadLoginByUsername(ctx, "SYSTEM")
+	assert.NoError(t, err)
+	assert.Nil(t, login, "the plain index is case-sensitive")
+}
+
 /*
 func TestAlot(t *testing.T) {
 	for i := 0; i < 1000; i++ {
@@ -0,0 +1,45 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	goradd2 "github.com/goradd/gro/ci/tests/gen/goradd"
+	"github.com/goradd/gro/ci/tests/gen/goradd/node"
+	"github.com/goradd/gro/query/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestView(t *testing.T) {
+	ctx := context.Background()
+
+	summary, err := goradd2.LoadProjectSummary(ctx, "1", node.ProjectSummary().Manager())
+	require.NoError(t, err)
+	require.NotNil(t, summary)
+
+	project, err := goradd2.LoadProject(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, project.Name(), summary.Name())
+	assert.EqualValues(t, 5, summary.MemberCount())
+	assert.Equal(t, project.ManagerID(), summary.Manager().ID())
+
+	summaries, err := goradd2.QueryProjectSummaries(ctx).
+		Where(op.GreaterOrEqual(node.ProjectSummary().MemberCount(), 5)).
+		OrderBy(node.ProjectSummary().ID()).
+		Load()
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, summaries) {
+		assert.Equal(t, "1", summaries[0].ID())
+	}
+
+	count, err := goradd2.CountProjectSummaries(ctx)
+	assert.NoError(t, err)
+	projectCount, err := goradd2.CountProjects(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, projectCount, count)
+
+	b, err := summary.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Error(t, goradd2.NewProjectSummary().UnmarshalJSON(b), "views are read-only")
+}
@@ -40,7 +40,7 @@ func Generate(schemaPath string, outdir string) (err error) {
 	}
 
 	var schemaDB *schema.Database
-	schemaDB, err = schema.ReadJsonFile(schemaPath)
+	schemaDB, err = schema.ReadFile(schemaPath)
 	if err != nil {
 		err = fmt.Errorf("error opening or reading schema file %s: %w", schemaPath, err)
 		return
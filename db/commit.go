@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"sync"
+)
+
+type afterCommitKey string
+
+// afterCommits holds the functions given to AfterCommit during a transaction.
+type afterCommits struct {
+	sync.Mutex
+	funcs []func()
+}
+
+// AfterCommit calls f after the transaction of the database with key dbKey that is active in ctx is committed.
+// If ctx is not in a transaction of that database, f is called immediately.
+// f is not called if the transaction is rolled back.
+func AfterCommit(ctx context.Context, dbKey string, f func()) {
+	a, ok := ctx.Value(afterCommitKey(dbKey)).(*afterCommits)
+	if !ok {
+		f()
+		return
+	}
+	a.Lock()
+	a.funcs = append(a.funcs, f)
+	a.Unlock()
+}
+
+// TransactionContext is used by database drivers when starting a transaction of the database with key dbKey.
+// It returns the context that the transaction should be run in, and a function that the driver must call after
+// the transaction is committed, which calls the functions given to AfterCommit during the transaction.
+func TransactionContext(ctx context.Context, dbKey string) (context.Context, func()) {
+	a := new(afterCommits)
+	ctx = context.WithValue(ctx, afterCommitKey(dbKey), a)
+	return ctx, func() {
+		a.Lock()
+		funcs := a.funcs
+		a.funcs = nil
+		a.Unlock()
+		for _, f := range funcs {
+			f()
+		}
+	}
+}
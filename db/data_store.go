@@ -3,7 +3,9 @@ package db
 import (
 	"context"
 	"errors"
+	"fmt"
 	"iter"
+	"slices"
 
 	. "github.com/goradd/gro/query"
 	"github.com/goradd/gro/schema"
@@ -141,6 +143,8 @@ func WithConstraintsOff(ctx context.Context, d DatabaseI, f func(ctx context.Con
 // The relatedColumnName is the name of the column in the association table that points to the destination table's primary key.
 // with relatedPks having all the primary keys of objects that should be associated with the object with
 // primary key pk.
+// If the association table has extra columns, values can hold the values of those columns for each link,
+// keyed by column name. values[i] is written with relatedPks[i]. A missing or nil entry writes only the keys.
 // All previous associations with the source object are deleted.
 func AssociateOnly[J, K any](ctx context.Context,
 	d DatabaseI,
@@ -148,7 +152,8 @@ func AssociateOnly[J, K any](ctx context.Context,
 	srcColumnName string,
 	pk J,
 	relatedColumnName string,
-	relatedPks []K) error {
+	relatedPks []K,
+	values ...map[string]any) error {
 	err := WithTransaction(ctx, d, func(ctx context.Context) error {
 		if err := d.DeleteWhere(ctx, assnTable, map[string]any{srcColumnName: pk}); err != nil {
 			var rErr *RecordNotFoundError
@@ -156,8 +161,12 @@ func AssociateOnly[J, K any](ctx context.Context,
 				return err
 			}
 		}
-		for _, relatedPk := range relatedPks {
-			if err := Associate(ctx, d, assnTable, srcColumnName, pk, relatedColumnName, relatedPk); err != nil {
+		for i, relatedPk := range relatedPks {
+			var v map[string]any
+			if i < len(values) {
+				v = values[i]
+			}
+			if err := Associate(ctx, d, assnTable, srcColumnName, pk, relatedColumnName, relatedPk, v); err != nil {
 				return err
 			}
 		}
@@ -167,13 +176,180 @@ func AssociateOnly[J, K any](ctx context.Context,
 }
 
 // Associate adds a record to the assnTable table.
+// If the association table has extra columns, their values can be given in values, keyed by column name.
 func Associate[J, K any](ctx context.Context,
 	d DatabaseI,
 	assnTable string,
 	srcColumnName string,
 	pk J,
 	relatedColumnName string,
-	relatedPk K) error {
-	err := d.Insert(ctx, assnTable, map[string]any{srcColumnName: pk, relatedColumnName: relatedPk}, "")
+	relatedPk K,
+	values ...map[string]any) error {
+	fields := map[string]any{srcColumnName: pk, relatedColumnName: relatedPk}
+	for _, v := range values {
+		for k, v2 := range v {
+			if k != srcColumnName && k != relatedColumnName {
+				fields[k] = v2
+			}
+		}
+	}
+	err := d.Insert(ctx, assnTable, fields, "")
 	return err
 }
+
+// AssociateOnlyAppending is like AssociateOnly, but is used when the related objects keep their lists of
+// source objects in order using the orderColumnName column of the association table.
+// Associations that already exist keep their positions in those lists, and new associations are
+// added to the end of them. relatedType is the type of the values in the relatedColumnName column.
+func AssociateOnlyAppending[J any, K comparable](ctx context.Context,
+	d DatabaseI,
+	assnTable string,
+	srcColumnName string,
+	pk J,
+	relatedColumnName string,
+	relatedPks []K,
+	relatedType ReceiverType,
+	orderColumnName string,
+	values ...map[string]any) error {
+	err := WithTransaction(ctx, d, func(ctx context.Context) error {
+		recs, err := queryRecords(ctx, d, assnTable,
+			map[string]ReceiverType{relatedColumnName: relatedType, orderColumnName: ColTypeInteger},
+			map[string]any{srcColumnName: pk},
+			nil)
+		if err != nil {
+			return err
+		}
+		positions := make(map[K]int)
+		for _, rec := range recs {
+			if k, ok := rec[relatedColumnName].(K); ok {
+				positions[k], _ = rec[orderColumnName].(int)
+			}
+		}
+
+		if err = d.DeleteWhere(ctx, assnTable, map[string]any{srcColumnName: pk}); err != nil {
+			var rErr *RecordNotFoundError
+			if !errors.As(err, &rErr) { // ignore record not found errors
+				return err
+			}
+		}
+		for i, relatedPk := range relatedPks {
+			v := make(map[string]any)
+			if i < len(values) {
+				for k, v2 := range values[i] {
+					v[k] = v2
+				}
+			}
+			if p, ok := positions[relatedPk]; ok {
+				v[orderColumnName] = p
+			} else if v[orderColumnName], err = nextAssociationPosition(ctx, d, assnTable, relatedColumnName, relatedPk, orderColumnName); err != nil {
+				return err
+			}
+			if err = Associate(ctx, d, assnTable, srcColumnName, pk, relatedColumnName, relatedPk, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// AssociateAppending adds a record to the assnTable table, putting the source object at the end of the
+// list of source objects of the related object, as ordered by the orderColumnName column.
+func AssociateAppending[J, K any](ctx context.Context,
+	d DatabaseI,
+	assnTable string,
+	srcColumnName string,
+	pk J,
+	relatedColumnName string,
+	relatedPk K,
+	orderColumnName string,
+	values ...map[string]any) error {
+	err := WithTransaction(ctx, d, func(ctx context.Context) error {
+		p, err := nextAssociationPosition(ctx, d, assnTable, relatedColumnName, relatedPk, orderColumnName)
+		if err != nil {
+			return err
+		}
+		return Associate(ctx, d, assnTable, srcColumnName, pk, relatedColumnName, relatedPk, append(values, map[string]any{orderColumnName: p})...)
+	})
+	return err
+}
+
+// MoveAssociation moves the related object at position from in the ordered list of objects associated with
+// the source object to position to, shifting the objects in between.
+// The positions are kept in the orderColumnName column of the association table, and are rewritten in one transaction.
+// relatedType is the type of the values in the relatedColumnName column.
+func MoveAssociation[J any](ctx context.Context,
+	d DatabaseI,
+	assnTable string,
+	srcColumnName string,
+	pk J,
+	relatedColumnName string,
+	relatedType ReceiverType,
+	orderColumnName string,
+	from, to int) error {
+	err := WithTransaction(ctx, d, func(ctx context.Context) error {
+		recs, err := queryRecords(ctx, d, assnTable,
+			map[string]ReceiverType{relatedColumnName: relatedType, orderColumnName: ColTypeInteger},
+			map[string]any{srcColumnName: pk},
+			[]string{orderColumnName, relatedColumnName})
+		if err != nil {
+			return err
+		}
+		if from < 0 || from >= len(recs) || to < 0 || to >= len(recs) {
+			return fmt.Errorf("cannot move position %d to %d in a list of %d items", from, to, len(recs))
+		}
+		rec := recs[from]
+		recs = slices.Insert(slices.Delete(recs, from, from+1), to, rec)
+		for i, rec := range recs {
+			if p, _ := rec[orderColumnName].(int); p == i {
+				continue
+			}
+			if err = d.Update(ctx, assnTable,
+				map[string]any{srcColumnName: pk, relatedColumnName: rec[relatedColumnName]},
+				map[string]any{orderColumnName: i},
+				"", 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// nextAssociationPosition returns the position after the last one in the list of objects associated with
+// the object with primary key pk in the pkColumnName column.
+func nextAssociationPosition[K any](ctx context.Context, d DatabaseI, assnTable string, pkColumnName string, pk K, orderColumnName string) (int, error) {
+	recs, err := queryRecords(ctx, d, assnTable,
+		map[string]ReceiverType{orderColumnName: ColTypeInteger},
+		map[string]any{pkColumnName: pk},
+		nil)
+	if err != nil {
+		return 0, err
+	}
+	next := 0
+	for _, rec := range recs {
+		if p, _ := rec[orderColumnName].(int); p >= next {
+			next = p + 1
+		}
+	}
+	return next, nil
+}
+
+// queryRecords runs a Query on d and returns all the records found.
+func queryRecords(ctx context.Context, d DatabaseI, table string, fields map[string]ReceiverType, where map[string]any, orderBy []string) (recs []map[string]any, err error) {
+	cursor, err := d.Query(ctx, table, fields, where, orderBy)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+	for {
+		rec, err2 := cursor.Next()
+		if err2 != nil {
+			return nil, err2
+		}
+		if rec == nil {
+			return recs, nil
+		}
+		recs = append(recs, rec)
+	}
+}
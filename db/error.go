@@ -3,6 +3,9 @@ package db
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 )
 
 // OptimisticLockError reports errors related to optimistic locking. i.e. when the same record was changed by a different user
@@ -77,10 +80,58 @@ func (e *UniqueValueError) Unwrap() error {
 	return e.Err
 }
 
+// CheckConstraintError indicates a record failed to save because a value in that
+// record violated a check constraint of the table. See schema.Check.
+// Constraint is the name of the constraint if the database reported it.
+type CheckConstraintError struct {
+	Table      string
+	Constraint string
+	Err        error
+}
+
+func (e *CheckConstraintError) Error() string {
+	return fmt.Sprintf("check constraint %s failed in table %s: %s", e.Constraint, e.Table, e.Err.Error())
+}
+
+// NewCheckConstraintError returns a new error stating that a record could not be saved
+// because it violated the named check constraint.
+func NewCheckConstraintError(table string, constraint string, err error) error {
+	return &CheckConstraintError{table, constraint, err}
+}
+
+func (e *CheckConstraintError) Unwrap() error {
+	return e.Err
+}
+
+// ReferenceRestrictedError indicates a record could not be deleted because other records refer to it
+// through references whose delete action is restrict. See schema.OnDeleteRestrict.
+// Children lists the primary keys of the blocking records by the name of their table.
+type ReferenceRestrictedError struct {
+	Table    string
+	PkValue  any
+	Children map[string][]any
+}
+
+func (e *ReferenceRestrictedError) Error() string {
+	tables := slices.Sorted(maps.Keys(e.Children))
+	var parts []string
+	for _, t := range tables {
+		parts = append(parts, fmt.Sprintf("%s %v", t, e.Children[t]))
+	}
+	return fmt.Sprintf("delete restricted: table = %s, pk = %v, referred to by %s", e.Table, e.PkValue, strings.Join(parts, ", "))
+}
+
+// NewReferenceRestrictedError returns a new error stating that the record with the given primary key
+// could not be deleted because the records in children refer to it.
+func NewReferenceRestrictedError(table string, pkValue any, children map[string][]any) error {
+	return &ReferenceRestrictedError{table, pkValue, children}
+}
+
 // QueryError indicates an error occurred while querying a database.
 // This could mean a syntax error with the query, a problem with the database,
 // a problem with the connection to the database, etc.
-// Unique value collisions will be returned as a UniqueValueError.
+// Unique value collisions will be returned as a UniqueValueError, and check constraint
+// violations as a CheckConstraintError.
 type QueryError struct {
 	// Operation is the call into the database, or database function that returned the error
 	Operation string
@@ -19,6 +19,20 @@ type Element struct {
 	Alias           string                  // computed or assigned alias
 	Calculations    map[string]query.Node   // calculations attached to this node by alias
 	IsPK            bool
+	JoinCondition   *JoinCondition        // extra condition on the join of a polymorphic reference, or nil
+	Related         *query.RelatedOptions // options that restrict the objects of a selected array relationship, or nil
+}
+
+// JoinCondition is an extra condition on the join of a table element, used by polymorphic references.
+// The join only matches rows whose type column holds the given value.
+type JoinCondition struct {
+	// OnParent is true if the type column is in the parent table of the join, which is the case for forward
+	// references. Otherwise, it is in the joined table.
+	OnParent bool
+	// Column is the query name of the type column.
+	Column string
+	// Value is the value the type column must have.
+	Value string
 }
 
 func newElement(node query.Node) *Element {
@@ -26,8 +40,17 @@ func newElement(node query.Node) *Element {
 	e.QueryNode = node
 
 	// cache some things from the node
-	if c, ok := node.(*query.ColumnNode); ok {
-		e.IsPK = c.IsPrimaryKey
+	switch n := node.(type) {
+	case *query.ColumnNode:
+		e.IsPK = n.IsPrimaryKey
+	case query.ReverseNodeI: // must come before ReferenceNodeI, which it also satisfies
+		if col, v := n.TypeCondition(); col != "" {
+			e.JoinCondition = &JoinCondition{Column: col, Value: v}
+		}
+	case query.ReferenceNodeI:
+		if col, v := n.TypeCondition(); col != "" {
+			e.JoinCondition = &JoinCondition{OnParent: true, Column: col, Value: v}
+		}
 	}
 	return e
 }
@@ -147,3 +170,14 @@ func (j *Element) SelectedReferences() (refs []*Element) {
 	}
 	return
 }
+
+// relatedOrderBys returns the sorts of the related options of the element and the elements it references.
+func (j *Element) relatedOrderBys() (sorters []query.Sorter) {
+	if j.Related != nil {
+		sorters = append(sorters, j.Related.OrderBys...)
+	}
+	for _, r := range j.References {
+		sorters = append(sorters, r.relatedOrderBys()...)
+	}
+	return
+}
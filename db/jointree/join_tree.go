@@ -16,6 +16,10 @@ import (
 const columnAliasPrefix = "c_"
 const tableAliasPrefix = "t_"
 
+// PreloadKeyAlias is the alias of the column in the query of a preloaded relationship that holds the
+// key of the parent object that each row belongs to.
+const PreloadKeyAlias = "k_"
+
 // JoinTree is used by various goradd-orm database drivers to convert a query.Builder object into a query understandable
 // by the database.
 // Developers do not normally need to call code here unless they are making a custom database driver.
@@ -31,6 +35,7 @@ type JoinTree struct {
 	isSubquery         bool
 	Command            query.BuilderCommand
 	Limits             query.LimitParams
+	Locks              query.LockParams
 	Condition          query.Node
 	GroupBys           []query.Node
 	OrderBys           []query.Sorter
@@ -38,6 +43,10 @@ type JoinTree struct {
 	hasSelects         bool
 	hasCalcs           bool
 	hasAggregate       bool
+
+	// Preloads are the trees of the array relationships that are loaded by separate queries after the main query,
+	// in the order they should be loaded. See query.Builder.Preload.
+	Preloads []*JoinTree
 }
 
 // NewJoinTree analyzes b and turns it into a JoinTree.
@@ -48,6 +57,7 @@ func NewJoinTree(b query.BuilderI) *JoinTree {
 		IsDistinct: builder.IsDistinct,
 		Command:    builder.Command,
 		Limits:     builder.Limits,
+		Locks:      builder.Locks,
 		GroupBys:   builder.GroupBys,
 		OrderBys:   builder.OrderBys,
 		Having:     builder.HavingNode,
@@ -88,15 +98,22 @@ func (t *JoinTree) buildCommand(b *query.Builder) {
 	case query.BuilderCommandLoad:
 		t.addCalculations(b)
 		t.addSelectedColumns(b)
+		t.addRelatedOptions(b)
+		t.splitPreloads(b)
+		t.addRelatedOrderBys()
+		t.checkLocks()
 		t.assignSelectAliases()
 	case query.BuilderCommandLoadCursor:
 		t.checkCursor(b)
 		t.addCalculations(b)
 		t.addSelectedColumns(b)
+		t.checkLocks()
 		t.assignSelectAliases()
 	case query.BuilderCommandCount:
 		t.addCalculations(b)
 		t.addSelectedColumns(b)
+		t.addRelatedOptions(b)
+		t.checkLocks()
 		t.assignSelectAliases()
 
 	default:
@@ -144,6 +161,13 @@ func (t *JoinTree) addNode(node query.Node) (top *Element) {
 func (t *JoinTree) findNode(node query.Node) (e *Element, rn *reverseNode, found bool) {
 	e = t.Root
 	rn = reverse(node)
+	// The root of the tree of a preloaded relationship is below the root of the query, so skip to its level
+	for p := e.Parent; p != nil; p = p.Parent {
+		if rn.child == nil {
+			return nil, nil, false
+		}
+		rn = rn.child
+	}
 	if !nodeMatch(e.QueryNode, rn.node) {
 		return nil, nil, false // root nodes do not match
 	}
@@ -417,6 +441,128 @@ func (t *JoinTree) assignSelectAliases() {
 		t.selectAliasCounter++
 		ec.Alias = columnAliasPrefix + t.SubPrefix + strconv.Itoa(t.selectAliasCounter)
 	}
+	for _, p := range t.Preloads {
+		for ec := range p.SelectsIter() {
+			t.selectAliasCounter++
+			ec.Alias = columnAliasPrefix + t.SubPrefix + strconv.Itoa(t.selectAliasCounter)
+		}
+	}
+}
+
+// addRelatedOptions attaches the options given to query.Related to the elements of the selected relationships.
+func (t *JoinTree) addRelatedOptions(b *query.Builder) {
+	for _, n := range b.Selects {
+		o := query.NodeRelatedOptions(n)
+		if o == nil {
+			continue
+		}
+		e := t.FindElement(n)
+		if e == nil {
+			panic("prior node was not found in the tree") // this would be a bug in the ORM
+		}
+		if e.Related != nil && e.Related != o {
+			panic("a relationship can only be given related options once in a query")
+		}
+		nodes := make([]query.Node, 0, len(o.OrderBys)+1)
+		if o.Condition != nil {
+			nodes = append(nodes, o.Condition)
+		}
+		for _, s := range o.OrderBys {
+			nodes = append(nodes, s)
+		}
+		for _, n2 := range nodes {
+			contained := query.ContainedNodes(n2)
+			if contained == nil {
+				contained = []query.Node{n2}
+			}
+			for _, cn := range contained {
+				switch cn.NodeType_() {
+				case query.ValueNodeType, query.OperationNodeType:
+				case query.ColumnNodeType:
+					if c := t.FindElement(cn); c == nil || c.Parent != e {
+						panic("the condition and sorts of related options can only use the columns of the related table")
+					}
+				default:
+					panic("the condition and sorts of related options can only use the columns of the related table")
+				}
+			}
+		}
+		e.Related = o
+	}
+}
+
+// addRelatedOrderBys adds the sorts of the related options to the sorts of the query and of the preloaded
+// relationships, so that the related objects are loaded in order.
+func (t *JoinTree) addRelatedOrderBys() {
+	t.OrderBys = slices.Concat(t.OrderBys, t.Root.relatedOrderBys())
+	for _, p := range t.Preloads {
+		p.OrderBys = p.Root.relatedOrderBys()
+	}
+}
+
+// splitPreloads removes the array relationships that will be preloaded from the tree and puts them in
+// their own trees in Preloads.
+// These are the relationships given to Builder.Preload, and in a limited query, the selected array relationships
+// that are not used elsewhere in the query.
+func (t *JoinTree) splitPreloads(b *query.Builder) {
+	if len(b.Preloads) == 0 && !t.Limits.AreSet() {
+		return
+	}
+	canPreload := len(b.GroupBys) == 0 && !t.IsDistinct && !t.isSubquery
+
+	// Mark the elements used by the conditions, sorts and calculations of the query
+	used := make(map[*Element]bool)
+	for _, n := range b.FilterNodes() {
+		for e := t.FindElement(n); e != nil; e = e.Parent {
+			used[e] = true
+		}
+	}
+
+	var preloads []*Element
+	for _, n := range b.Preloads {
+		e := t.FindElement(n)
+		if e == nil {
+			panic("prior node was not found in the tree") // this would be a bug in the ORM
+		}
+		if !canPreload {
+			panic("preloaded relationships cannot be used in distinct or group by queries")
+		}
+		if used[e] {
+			panic("a preloaded relationship cannot also be used in the conditions, sorts or calculations of the query")
+		}
+		preloads = append(preloads, e)
+	}
+	t.collectPreloads(t.Root, t.Limits.AreSet() && canPreload, preloads, used)
+}
+
+// collectPreloads walks the references of e, moving the ones to preload into Preloads.
+// If auto is true, the selected array relationships that are not used are preloaded as well.
+func (t *JoinTree) collectPreloads(e *Element, auto bool, preloads []*Element, used map[*Element]bool) {
+	var refs []*Element
+	for _, r := range e.References {
+		if slices.Contains(preloads, r) ||
+			auto && r.IsArray() && !used[r] && (r.SelectedColumns.Len() > 0 || len(r.SelectedReferences()) > 0) {
+			// r keeps its parent so that the loaded objects can be attached to the parent objects
+			t.Preloads = append(t.Preloads, &JoinTree{
+				Root:    r,
+				Command: t.Command,
+				Locks:   t.Locks,
+			})
+			// The query of a preloaded relationship is not limited, so only explicit preloads apply below it
+			t.collectPreloads(r, false, preloads, used)
+		} else {
+			refs = append(refs, r)
+			t.collectPreloads(r, auto, preloads, used)
+		}
+	}
+	e.References = refs
+}
+
+// IsPreload returns true if the tree loads the objects of a preloaded relationship.
+// The root of the tree is then the element of the relationship, and its parent is the element of the
+// objects that the loaded objects will be attached to.
+func (t *JoinTree) IsPreload() bool {
+	return t.Root.Parent != nil
 }
 
 func (t *JoinTree) SelectsIter() iter.Seq[*Element] {
@@ -440,6 +586,23 @@ func (t *JoinTree) checkCursor(builder *query.Builder) {
 	}
 }
 
+// checkLocks panics if the query locks the rows it selects in a way that databases do not allow.
+// Postgres cannot lock the rows of grouped, aggregated or distinct results. Joined tables are allowed,
+// since drivers that cannot lock the nullable side of an outer join lock only the rows of the root table.
+func (t *JoinTree) checkLocks() {
+	if !t.Locks.AreSet() {
+		return
+	}
+	switch {
+	case t.IsDistinct:
+		panic("a query that locks its rows cannot be distinct")
+	case len(t.GroupBys) > 0:
+		panic("a query that locks its rows cannot have group bys")
+	case t.hasAggregate || t.Command == query.BuilderCommandCount:
+		panic("a query that locks its rows cannot be counted or have aggregate calculations")
+	}
+}
+
 func (t *JoinTree) HasAggregates() bool {
 	return t.hasAggregate
 }
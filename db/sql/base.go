@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"slices"
 	"strings"
 	"time"
@@ -263,7 +264,62 @@ func (h *Base) joinTreeLoad(ctx context.Context, joinTree *jointree.JoinTree) ([
 		columnTypes = append(columnTypes, ColTypeBytes) // These will be unpacked when they are retrieved
 	}
 
-	return ReceiveRows(rows, columnTypes, names, joinTree, s, args)
+	values, err := ReceiveRows(rows, columnTypes, names, joinTree, s, args)
+	if err != nil {
+		return nil, err
+	}
+	for _, pt := range joinTree.Preloads {
+		if err = h.preload(ctx, pt, values); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// preloadBatchSize is the maximum number of parent keys given to one query of a preloaded relationship.
+const preloadBatchSize = 1000
+
+// preload loads the objects of the preloaded relationship in pt and attaches them to their parent objects in values.
+func (h *Base) preload(ctx context.Context, pt *jointree.JoinTree, values []map[string]any) error {
+	parents := preloadParents(pt, values)
+	pk := pt.Root.Parent.PrimaryKey()
+	pkKey := NodeQueryKey(pk.QueryNode)
+	var keys []any
+	found := make(map[string]bool)
+	for _, p := range parents {
+		if v := p[pkKey]; v != nil && !found[fmt.Sprint(v)] {
+			found[fmt.Sprint(v)] = true
+			keys = append(keys, v)
+		}
+	}
+
+	// The key column is first, followed by the selected columns
+	columnTypes := []ReceiverType{pk.QueryNode.(*ColumnNode).ReceiverType}
+	for sel := range pt.SelectsIter() {
+		columnTypes = append(columnTypes, sel.QueryNode.(*ColumnNode).ReceiverType)
+	}
+
+	children := make(map[string][]map[string]any)
+	for batch := range slices.Chunk(keys, preloadBatchSize) {
+		g := newSqlGenerator(pt, h.dbi)
+		s, args := g.generatePreloadSql(batch)
+		rows, err := h.dbi.SqlQuery(ctx, s, args...)
+		if err != nil {
+			return db.NewQueryError("SqlQuery", s, args, err)
+		}
+		names, err := rows.Columns()
+		if err != nil {
+			RowClose(rows)
+			return db.NewQueryError("Columns", s, args, err)
+		}
+		rowValues, err := ReceiveRows(rows, columnTypes, names, nil, s, args)
+		if err != nil {
+			return err
+		}
+		unpackPreload(pt, rowValues, children)
+	}
+	attachPreload(pt, parents, pkKey, children)
+	return nil
 }
 
 // The cursor returned must be closed by the caller.
@@ -318,6 +374,9 @@ func (h *Base) CreateSchema(ctx context.Context, s schema.Database) error {
 	if err := h.buildAssociations(ctx, &s, s.AssociationTables); err != nil {
 		return err
 	}
+	if err := h.buildViews(ctx, &s, s.Views); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -326,7 +385,7 @@ func (h *Base) buildTables(ctx context.Context, d *schema.Database, tables []*sc
 	for _, table := range tables {
 		statements := h.tableSql(d, table)
 		if statements == nil {
-			continue // already reported error
+			return fmt.Errorf("error in table `%s`", table.QualifiedName())
 		}
 		for _, s := range statements {
 			_, err = h.dbi.SqlExec(ctx, s)
@@ -341,12 +400,22 @@ func (h *Base) buildTables(ctx context.Context, d *schema.Database, tables []*sc
 	return nil
 }
 
+// enumTypeSqler is an optional interface for drivers that create a named database type for enum tables
+// that use schema.EnumStorageNative.
+type enumTypeSqler interface {
+	// EnumTypeSql returns the sql statements that create the native type for the enum table.
+	EnumTypeSql(et *schema.EnumTable) []string
+}
+
 func (h *Base) buildEnums(ctx context.Context, d *schema.Database, tables []*schema.EnumTable) (err error) {
 	for _, table := range tables {
 		statements := h.enumTableSql(d, table)
 		if statements == nil {
 			return fmt.Errorf("error in table `%s`", table.Name)
 		}
+		if ts, ok := h.dbi.(enumTypeSqler); ok && table.Storage == schema.EnumStorageNative {
+			statements = append(statements, ts.EnumTypeSql(table)...)
+		}
 		for _, s := range statements {
 			if _, err = h.dbi.SqlExec(ctx, s); err != nil {
 				slog.Error("SQL error",
@@ -358,15 +427,24 @@ func (h *Base) buildEnums(ctx context.Context, d *schema.Database, tables []*sch
 		}
 
 		fieldKeys := []string{schema.ValueKey, schema.NameKey}
+		if table.Storage != schema.EnumStorageInteger {
+			fieldKeys = append(fieldKeys, schema.KeyKey)
+		}
 		fieldKeys = append(fieldKeys, table.FieldKeys()...)
 		fields := map[string]schema.EnumField{
 			schema.ValueKey: {Type: schema.ColTypeInt},
 			schema.NameKey:  {Type: schema.ColTypeString},
+			schema.KeyKey:   {Type: schema.ColTypeString},
 		}
 		for k, v := range table.Fields {
 			fields[k] = v
 		}
-		for _, v := range table.Values {
+		keys := table.Keys()
+		for i, v := range table.Values {
+			if _, ok := v[schema.KeyKey]; !ok && table.Storage != schema.EnumStorageInteger {
+				v = maps.Clone(v)
+				v[schema.KeyKey] = keys[i]
+			}
 			s, args := h.enumValueSql(table.Name, fieldKeys, fields, v)
 			if _, err = h.dbi.SqlExec(ctx, s, args...); err != nil {
 				slog.Error("SQL error",
@@ -400,6 +478,25 @@ func (h *Base) buildAssociations(ctx context.Context, d *schema.Database, table
 	return err
 }
 
+// buildViews creates the views. The views must be sorted so that views are created after the views they depend on.
+func (h *Base) buildViews(ctx context.Context, d *schema.Database, views []*schema.View) (err error) {
+	for _, view := range views {
+		s := h.dbi.ViewDefinitionSql(d, view)
+		if s == "" {
+			slog.Warn("View skipped, no sql was given for this database.",
+				slog.String(db.LogTable, view.QualifiedName()))
+			continue
+		}
+		if _, err = h.dbi.SqlExec(ctx, s); err != nil {
+			slog.Error("SQL error in buildViews.",
+				slog.String("sql", s),
+				slog.Any("error", err))
+			return err
+		}
+	}
+	return nil
+}
+
 // tableSql returns sql statements to create a table.
 // Multiple statements are returned because some drivers do not allow the execution of
 // multiple statements by default.
@@ -440,6 +537,18 @@ func (h *Base) enumTableSql(d *schema.Database, et *schema.EnumTable) (s []strin
 		Type: schema.ColTypeString,
 		Size: size,
 	})
+	if et.Storage != schema.EnumStorageInteger {
+		// Columns that store the key refer to this column
+		table.Columns = append(table.Columns, &schema.Column{
+			Name: schema.KeyKey,
+			Type: schema.ColTypeString,
+			Size: et.MaxKeyLength(),
+		})
+		table.Indexes = append(table.Indexes, &schema.Index{
+			IndexLevel: schema.IndexLevelUnique,
+			Columns:    []string{schema.KeyKey},
+		})
+	}
 	for _, k := range et.FieldKeys() {
 		size = 0
 		for _, vMap := range et.Values {
@@ -531,6 +640,18 @@ func (h *Base) associationSql(d *schema.Database, at *schema.AssociationTable) [
 	}
 	table.References = append(table.References, ref)
 
+	for _, c := range at.Columns {
+		c2 := *c
+		table.Columns = append(table.Columns, &c2)
+	}
+	if oc := at.OrderColumn(); oc != "" {
+		table.Columns = append(table.Columns, &schema.Column{
+			Name:         oc,
+			Type:         schema.ColTypeInt,
+			DefaultValue: 0,
+		})
+	}
+
 	// composite index for uniqueness and row id
 	table.Indexes = []*schema.Index{
 		{
@@ -550,6 +671,18 @@ func (h *Base) associationSql(d *schema.Database, at *schema.AssociationTable) [
 // This operation is not reversible.
 // Circular references on certain databases may fail if the data is not destroyed first.
 func (h *Base) DestroySchema(ctx context.Context, s schema.Database) error {
+	// views depend on tables, so drop them first
+	for _, view := range slices.Backward(s.Views) {
+		_, err := h.SqlExec(ctx, `DROP VIEW IF EXISTS `+h.dbi.QuoteIdentifier(view.QualifiedName()))
+		if err != nil {
+			slog.Error("failed to drop view",
+				slog.String(db.LogTable, view.QualifiedName()),
+				slog.Any(db.LogError, err),
+			)
+			return err
+		}
+	}
+
 	// gather table names to delete
 	var tables []string
 
@@ -659,7 +792,8 @@ func (h *Base) getTransaction(ctx context.Context) *sql.Tx {
 // it will not apply this timeout to a transaction. It is up to you to pass a context that
 // has a timeout to prevent the overall transaction from hanging.
 // Nested calls will operate within the same transaction, and the outermost call will determine
-// when the transaction is finally committed.
+// when the transaction is finally committed. The functions given to db.AfterCommit during the transaction
+// are called after it is committed.
 func (h *Base) WithTransaction(ctx context.Context, f func(ctx context.Context) error) (err error) {
 	tx := h.getTransaction(ctx)
 	if tx != nil {
@@ -695,6 +829,7 @@ func (h *Base) WithTransaction(ctx context.Context, f func(ctx context.Context)
 		}
 	}()
 	ctx = context.WithValue(ctx, h.transactionKey(), tx)
+	ctx, committed := db.TransactionContext(ctx, h.dbKey)
 	err = f(ctx)
 	if err != nil {
 		return
@@ -702,6 +837,8 @@ func (h *Base) WithTransaction(ctx context.Context, f func(ctx context.Context)
 	if h.profiling {
 		slog.Info("Commit TX")
 	}
-	err = tx.Commit()
+	if err = tx.Commit(); err == nil {
+		committed()
+	}
 	return
 }
@@ -34,8 +34,12 @@ type DbI interface {
 	FormatArgument(n int) string
 	// SupportsForUpdate will return true if it supports SELECT ... FOR UPDATE clauses for row level locking in a transaction
 	SupportsForUpdate() bool
-	// TableDefinitionSql returns the sql that will create table.
+	// TableDefinitionSql returns the sql that will create table, or an empty tableSql if the table cannot be created
+	// in the database. The reason will have been logged.
 	TableDefinitionSql(d *schema.Database, table *schema.Table) (tableSql string, extraSql []string)
+	// ViewDefinitionSql returns the sql that will create view, or an empty string if the view
+	// is not defined for the database.
+	ViewDefinitionSql(d *schema.Database, view *schema.View) string
 }
 
 func RowClose(c io.Closer) {
@@ -1,12 +1,17 @@
 package sql
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/goradd/anyutil"
 	"github.com/goradd/gro/db/jointree"
 	. "github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
 	"github.com/goradd/iter"
 )
 
@@ -18,10 +23,50 @@ type deleteUsesAliaser interface {
 	DeleteUsesAlias() bool
 }
 
-type forUpdater interface {
-	ForUpdate() bool
+// lockSqler is an optional interface for drivers that need a non-standard clause to lock the rows of a query.
+// table is the quoted alias of the queried table.
+type lockSqler interface {
+	LockSql(locks LockParams, table string) string
 }
 
+// geometrySqler is an optional interface for drivers that store geometry values in a format other than
+// Well Known Binary.
+type geometrySqler interface {
+	// GeometrySelectSql returns sql that selects the geometry column in columnSql as Well Known Binary.
+	GeometrySelectSql(columnSql string) string
+	// GeometryArg converts g to a value that the database will accept as a geometry.
+	GeometryArg(g Geometry) any
+}
+
+// durationSqler is an optional interface for drivers that store duration values in a format other than
+// an integer number of microseconds.
+type durationSqler interface {
+	// DurationSelectSql returns sql that selects the duration column in columnSql as an integer number of microseconds.
+	DurationSelectSql(columnSql string) string
+	// DurationArg converts d to a value that the database will accept as a duration.
+	DurationArg(d time.Duration) any
+}
+
+// arraySqler is an optional interface for drivers that have native array types. Drivers that do not implement it
+// store arrays as JSON encoded text.
+type arraySqler interface {
+	// ArraySelectSql returns sql that selects the array column in columnSql as a JSON array.
+	ArraySelectSql(columnSql string) string
+	// ArrayArg converts a, which is a []string or []int, to a value that the database will accept as an array.
+	ArrayArg(a any) any
+}
+
+// lateralJoiner is an optional interface for drivers that support lateral joins. Drivers that implement it
+// load the objects of a relationship with a limit on the number of related objects using a lateral join, and the others
+// use a derived table numbered with a window function.
+type lateralJoiner interface {
+	SupportsLateralJoin() bool
+}
+
+// rowNumberAlias is the alias of the column that numbers the related objects of each parent object in a
+// derived table generated by generateWindowSql.
+const rowNumberAlias = "rn_"
+
 // sqlGenerator is an aid to generating various sql statements.
 // SQL dialects are similar, but have small variations. This object
 // attempts to handle the major issues, while allowing individual
@@ -62,16 +107,261 @@ func (g *sqlGenerator) generateSelectSql() (sql string, args []any) {
 	sb.WriteString(g.generateHaving())
 	sb.WriteString(g.generateOrderBySql())
 	sb.WriteString(g.generateLimitSql())
+	sb.WriteString(g.generateLockSql())
+
+	return sb.String(), g.argList
+}
+
+// generatePreloadSql generates the sql that loads the objects of a preloaded relationship that belong to
+// the parent objects with the given keys. The key of the parent object of each row is selected as
+// jointree.PreloadKeyAlias.
+func (g *sqlGenerator) generatePreloadSql(keys []any) (sql string, args []any) {
+	var sb strings.Builder
+
+	j := g.jt.Root
+	keyAlias, keySql, pk := g.relatedKeySql(j)
+
+	sb.WriteString("SELECT\n")
+	sb.WriteString(keySql)
+	sb.WriteString(" AS ")
+	sb.WriteString(g.iq(jointree.PreloadKeyAlias))
+	sb.WriteString(",\n")
+	sb.WriteString(g.generateColumnListWithAliases())
+	sb.WriteString("FROM\n")
+
+	if j.Related == nil || j.Related.Limit == 0 {
+		sb.WriteString(g.generateRelatedFromSql(j))
+		sb.WriteString("\n")
+		for _, child := range j.References {
+			sb.WriteString(g.generateJoinSql(child))
+		}
+		sb.WriteString(g.generateRelatedWhereSql(j, keySql+" IN "+g.generateInSql(keys)))
+	} else if g.useLateralJoin() {
+		// The lateral join needs a parent table to refer to, which takes the alias of the parent in the main query
+		parentKeySql := g.iq(j.Parent.Alias) + "." + g.iq(pk)
+		sb.WriteString(g.iq(j.Parent.QueryNode.TableName_()))
+		sb.WriteString(" AS ")
+		sb.WriteString(g.iq(j.Parent.Alias))
+		sb.WriteString("\nCROSS JOIN ")
+		sb.WriteString(g.generateLateralSql(j, parentKeySql))
+		sb.WriteString("\n")
+		sb.WriteString(g.generateRelatedChildJoinSql(j, "INNER JOIN"))
+		for _, child := range j.References {
+			sb.WriteString(g.generateJoinSql(child))
+		}
+		sb.WriteString("WHERE ")
+		sb.WriteString(parentKeySql)
+		sb.WriteString(" IN ")
+		sb.WriteString(g.generateInSql(keys))
+	} else {
+		sb.WriteString(g.generateWindowSql(j, keys))
+		sb.WriteString("\n")
+		sb.WriteString(g.generateRelatedChildJoinSql(j, "INNER JOIN"))
+		for _, child := range j.References {
+			sb.WriteString(g.generateJoinSql(child))
+		}
+		sb.WriteString(fmt.Sprintf("WHERE %s.%s <= %d", g.iq(keyAlias), g.iq(rowNumberAlias), j.Related.Limit))
+	}
+	sb.WriteString("\n")
+	sb.WriteString(g.generateOrderBySql())
+	sb.WriteString(g.generateLockSql())
 
 	return sb.String(), g.argList
 }
 
+// useLateralJoin returns true if the database supports lateral joins.
+func (g *sqlGenerator) useLateralJoin() bool {
+	l, ok := g.dbi.(lateralJoiner)
+	return ok && l.SupportsLateralJoin()
+}
+
+// relatedKeySql returns the alias of the table that holds the foreign key pointing to the parent of the
+// array relationship in j, the sql of that foreign key, and the query name of the primary key in the parent
+// that it points to.
+func (g *sqlGenerator) relatedKeySql(j *jointree.Element) (keyAlias string, keySql string, pk string) {
+	switch n := j.QueryNode.(type) {
+	case ReverseNodeI:
+		fk, pk := n.ColumnNames()
+		return j.Alias, g.iq(j.Alias) + "." + g.iq(fk), pk
+	case ManyManyNodeI:
+		fkp, pkp := n.ParentColumnNames()
+		return j.Alias + "a", g.iq(j.Alias+"a") + "." + g.iq(fkp), pkp
+	default:
+		panic("only reverse and many-many relationships can be loaded separately or limited")
+	}
+}
+
+// generateInSql generates the list of values of an IN clause.
+func (g *sqlGenerator) generateInSql(values []any) string {
+	var sb strings.Builder
+	sb.WriteString("(")
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(g.addArg(v))
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// generateRelatedFromSql generates the tables of the array relationship in j, for use in a query that
+// selects the related objects apart from their parents.
+func (g *sqlGenerator) generateRelatedFromSql(j *jointree.Element) string {
+	tn := j.QueryNode.(TableNodeI)
+	sql := g.iq(tn.TableName_()) + " AS " + g.iq(j.Alias)
+	if mm, ok := tn.(ManyManyNodeI); ok {
+		fkr, pkr := mm.RefColumnNames()
+		sql = g.iq(mm.AssnTableName()) + " AS " + g.iq(j.Alias+"a") + "\n" +
+			"INNER JOIN " + sql + " ON " +
+			g.iq(j.Alias+"a") + "." + g.iq(fkr) + " = " + g.iq(j.Alias) + "." + g.iq(pkr)
+	}
+	return sql
+}
+
+// generateRelatedChildJoinSql generates the join of the related table of the many-many relationship in j to a
+// derived table that takes the place of the association table. It returns an empty string for other relationships,
+// since their derived table holds the related table itself.
+func (g *sqlGenerator) generateRelatedChildJoinSql(j *jointree.Element, join string) string {
+	mm, ok := j.QueryNode.(ManyManyNodeI)
+	if !ok {
+		return ""
+	}
+	fkr, pkr := mm.RefColumnNames()
+	return join + " " + g.iq(mm.TableName_()) + " AS " + g.iq(j.Alias) + " ON " +
+		g.iq(j.Alias+"a") + "." + g.iq(fkr) + " = " + g.iq(j.Alias) + "." + g.iq(pkr) + "\n"
+}
+
+// generateRelatedWhereSql generates the WHERE clause that selects the related objects of the relationship in j.
+// It includes keyCondition, if not empty, the type condition of a polymorphic relationship and the condition of the
+// related options.
+func (g *sqlGenerator) generateRelatedWhereSql(j *jointree.Element, keyCondition string) string {
+	var conditions []string
+	if keyCondition != "" {
+		conditions = append(conditions, keyCondition)
+	}
+	if c := j.JoinCondition; c != nil {
+		conditions = append(conditions, g.iq(j.Alias)+"."+g.iq(c.Column)+" = "+g.addArg(c.Value))
+	}
+	if j.Related != nil && j.Related.Condition != nil {
+		conditions = append(conditions, "("+g.generateNodeSql(j.Related.Condition, false)+")")
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(conditions, " AND ")
+}
+
+// generateRelatedOrderSql generates the sort of the related objects of the relationship in j when they are limited.
+// It ends with the primary key of the related table so that the same objects are always selected.
+func (g *sqlGenerator) generateRelatedOrderSql(j *jointree.Element) string {
+	var items []string
+	for _, n := range j.Related.OrderBys {
+		item := g.generateNodeSql(n, false)
+		if n.IsDescending() {
+			item += " DESC"
+		}
+		items = append(items, item)
+	}
+	for _, pk := range j.QueryNode.(PrimaryKeyer).PrimaryKeys() {
+		if item := g.generateColumnNodeSql(j.Alias, pk); !slices.Contains(items, item) {
+			items = append(items, item)
+		}
+	}
+	return strings.Join(items, ",")
+}
+
+// generateLateralSql generates a lateral derived table of the limited rows of the relationship in j that belong to
+// the parent object whose primary key is parentKeySql.
+// The derived table holds the rows of the table with the foreign key and takes the alias of that table.
+func (g *sqlGenerator) generateLateralSql(j *jointree.Element, parentKeySql string) string {
+	var sb strings.Builder
+	keyAlias, keySql, _ := g.relatedKeySql(j)
+
+	sb.WriteString("LATERAL (SELECT ")
+	sb.WriteString(g.iq(keyAlias))
+	sb.WriteString(".* FROM ")
+	sb.WriteString(g.generateRelatedFromSql(j))
+	sb.WriteString(" ")
+	sb.WriteString(g.generateRelatedWhereSql(j, keySql+" = "+parentKeySql))
+	sb.WriteString(" ORDER BY ")
+	sb.WriteString(g.generateRelatedOrderSql(j))
+	sb.WriteString(fmt.Sprintf(" LIMIT %d) AS ", j.Related.Limit))
+	sb.WriteString(g.iq(keyAlias))
+	return sb.String()
+}
+
+// generateWindowSql generates a derived table of the rows of the relationship in j, numbered for each parent
+// object in the rowNumberAlias column by a window function.
+// If keys is not nil, the rows are limited to those of the parent objects with the given keys.
+// The derived table holds the rows of the table with the foreign key and takes the alias of that table.
+func (g *sqlGenerator) generateWindowSql(j *jointree.Element, keys []any) string {
+	var sb strings.Builder
+	keyAlias, keySql, _ := g.relatedKeySql(j)
+
+	// Generate in the order of the text, so that the arguments are in order
+	sb.WriteString("(SELECT ")
+	sb.WriteString(g.iq(keyAlias))
+	sb.WriteString(".*, ROW_NUMBER() OVER (PARTITION BY ")
+	sb.WriteString(keySql)
+	sb.WriteString(" ORDER BY ")
+	sb.WriteString(g.generateRelatedOrderSql(j))
+	sb.WriteString(") AS ")
+	sb.WriteString(g.iq(rowNumberAlias))
+	sb.WriteString(" FROM ")
+	sb.WriteString(g.generateRelatedFromSql(j))
+	sb.WriteString(" ")
+	var keyCondition string
+	if keys != nil {
+		keyCondition = keySql + " IN " + g.generateInSql(keys)
+	}
+	sb.WriteString(g.generateRelatedWhereSql(j, keyCondition))
+	sb.WriteString(") AS ")
+	sb.WriteString(g.iq(keyAlias))
+	return sb.String()
+}
+
+// generateLimitedJoinSql generates the join of the array relationship in j when its related options limit the
+// number of related objects of each parent object.
+func (g *sqlGenerator) generateLimitedJoinSql(j *jointree.Element) string {
+	var sb strings.Builder
+	keyAlias, keySql, pk := g.relatedKeySql(j)
+	parentKeySql := g.iq(j.Parent.Alias) + "." + g.iq(pk)
+
+	sb.WriteString("LEFT JOIN ")
+	if g.useLateralJoin() {
+		sb.WriteString(g.generateLateralSql(j, parentKeySql))
+		sb.WriteString(" ON TRUE\n")
+	} else {
+		sb.WriteString(g.generateWindowSql(j, nil))
+		sb.WriteString(fmt.Sprintf(" ON %s = %s AND %s.%s <= %d\n",
+			parentKeySql, keySql, g.iq(keyAlias), g.iq(rowNumberAlias), j.Related.Limit))
+	}
+	sb.WriteString(g.generateRelatedChildJoinSql(j, "LEFT JOIN"))
+	return sb.String()
+}
+
 func (g *sqlGenerator) generateColumnListWithAliases() (sql string) {
 	var sb strings.Builder
 
 	// Iterate over root selects and append to the string builder
 	for e := range g.jt.SelectsIter() {
-		sb.WriteString(g.generateColumnNodeSql(e.Parent.Alias, e.QueryNode))
+		s := g.generateColumnNodeSql(e.Parent.Alias, e.QueryNode)
+		switch e.QueryNode.(*ColumnNode).SchemaType {
+		case schema.ColTypeGeometry:
+			if gs, ok := g.dbi.(geometrySqler); ok {
+				s = gs.GeometrySelectSql(s)
+			}
+		case schema.ColTypeDuration:
+			if ds, ok := g.dbi.(durationSqler); ok {
+				s = ds.DurationSelectSql(s)
+			}
+		case schema.ColTypeStringArray, schema.ColTypeIntArray, schema.ColTypeEnumArray:
+			if as, ok := g.dbi.(arraySqler); ok {
+				s = as.ArraySelectSql(s)
+			}
+		}
+		sb.WriteString(s)
 		sb.WriteString(" AS ")
 		sb.WriteString(g.iq(e.Alias))
 		sb.WriteString(",\n")
@@ -96,6 +386,9 @@ func (g *sqlGenerator) generateColumnListWithAliases() (sql string) {
 func (g *sqlGenerator) generateColumnNodeSql(parentAlias string, node Node) (sql string) {
 	var sb strings.Builder
 
+	if node.(*ColumnNode).IsAssociation {
+		parentAlias += "a" // the alias of the association table, see generateJoinSql
+	}
 	sb.WriteString(g.iq(parentAlias))
 	sb.WriteString(".")
 	sb.WriteString(g.iq(ColumnNodeQueryName(node)))
@@ -187,6 +480,20 @@ func (g *sqlGenerator) generateOperationSql(n *OperationNode, useAlias bool) (sq
 		}
 		sb.WriteString(") ")
 
+	case OpCase:
+		sb.WriteString("(CASE")
+		for i := 0; i+1 < len(operandStrings); i += 2 {
+			sb.WriteString(" WHEN ")
+			sb.WriteString(operandStrings[i])
+			sb.WriteString(" THEN ")
+			sb.WriteString(operandStrings[i+1])
+		}
+		if len(operandStrings)%2 == 1 {
+			sb.WriteString(" ELSE ")
+			sb.WriteString(operandStrings[len(operandStrings)-1])
+		}
+		sb.WriteString(" END) ")
+
 	case OpNull, OpNotNull:
 		s := operandStrings[0]
 		sb.WriteString("(")
@@ -244,6 +551,12 @@ func (g *sqlGenerator) generateOperationSql(n *OperationNode, useAlias bool) (sq
 	case OpDateAddSeconds:
 		panic("DateAddSeconds is not implemented in this database")
 
+	case OpJsonExtract, OpJsonContains, OpJsonHasKey, OpMatch, OpMatchScore,
+		OpDateTruncYear, OpDateTruncMonth, OpDateTruncWeek, OpDateTruncDay,
+		OpDateYear, OpDateMonth, OpDateDay, OpDateDayOfWeek, OpDateHour, OpDateDiffSeconds, OpNow, OpDateAdd,
+		OpWithin, OpIntersects, OpDistance, OpArrayContains, OpArrayOverlaps:
+		panic(operator.String() + " is not implemented in this database")
+
 	case OpXor:
 		s := operandStrings[0]
 		s2 := operandStrings[1]
@@ -311,6 +624,17 @@ func (g *sqlGenerator) generateJoinSql(j *jointree.Element) (sql string) {
 		panic("cannot generate join code for a non-table node")
 	}
 
+	if j.Related != nil && j.Related.Limit > 0 {
+		if g.jt.Limits.AreSet() {
+			panic("We do not currently support limited queries with an array join.")
+		}
+		sb.WriteString(g.generateLimitedJoinSql(j))
+		for _, cj := range j.References {
+			sb.WriteString(g.generateJoinSql(cj))
+		}
+		return sb.String()
+	}
+
 	switch tn.NodeType_() {
 	case ReferenceNodeType:
 		ref := tn.(ReferenceNodeI)
@@ -386,6 +710,24 @@ func (g *sqlGenerator) generateJoinSql(j *jointree.Element) (sql string) {
 		return
 	}
 
+	if c := j.JoinCondition; c != nil {
+		alias := j.Alias
+		if c.OnParent {
+			alias = j.Parent.Alias
+		}
+		sb.WriteString(" AND ")
+		sb.WriteString(g.iq(alias))
+		sb.WriteString(".")
+		sb.WriteString(g.iq(c.Column))
+		sb.WriteString(" = ")
+		sb.WriteString(g.addArg(c.Value))
+	}
+	if j.Related != nil && j.Related.Condition != nil {
+		sb.WriteString(" AND (")
+		sb.WriteString(g.generateNodeSql(j.Related.Condition, false))
+		sb.WriteString(")")
+	}
+
 	sb.WriteString("\n")
 	for _, cj := range j.References {
 		sb.WriteString(g.generateJoinSql(cj))
@@ -450,12 +792,39 @@ func (g *sqlGenerator) generateLimitSql() (sql string) {
 	return sb.String()
 }
 
+func (g *sqlGenerator) generateLockSql() (sql string) {
+	if !g.jt.Locks.AreSet() || !g.dbi.SupportsForUpdate() {
+		return
+	}
+	if l, ok := g.dbi.(lockSqler); ok {
+		if sql = l.LockSql(g.jt.Locks, g.iq(g.jt.Root.Alias)); sql != "" {
+			return
+		}
+	}
+
+	var sb strings.Builder
+	if g.jt.Locks.Mode == LockModeShare {
+		sb.WriteString("FOR SHARE")
+	} else {
+		sb.WriteString("FOR UPDATE")
+	}
+	if g.jt.Locks.SkipLocked {
+		sb.WriteString(" SKIP LOCKED")
+	} else if g.jt.Locks.NoWait {
+		sb.WriteString(" NOWAIT")
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
 func (g *sqlGenerator) generateOrderBySql() (sql string) {
 	if len(g.jt.OrderBys) > 0 {
 		var sb strings.Builder
 		sb.WriteString("ORDER BY ")
 		for _, n := range g.jt.OrderBys {
-			sb.WriteString(g.generateNodeSql(n, true))
+			// Some databases do not allow select aliases inside of an expression, so expand operations fully
+			useAlias := n.NodeType_() != OperationNodeType
+			sb.WriteString(g.generateNodeSql(n, useAlias))
 			if n.IsDescending() {
 				sb.WriteString(" DESC")
 			}
@@ -660,12 +1029,53 @@ func generateWhereClause(db DbI, where map[string]any, connectWithOr bool, argsI
 // sqlArg converts a non-standard SQL type into a standard SQL type for use as an argument in
 // a SQL query. Standard types are returned unchanged.
 func sqlArg(db DbI, v any) any {
-	return v
-	/*
-		switch v2 := v.(type) {
-		default:
-			return v
+	switch v2 := v.(type) {
+	case Geometry:
+		if gs, ok := db.(geometrySqler); ok {
+			return gs.GeometryArg(v2)
+		}
+		return v2.WKB()
+	case time.Duration:
+		if ds, ok := db.(durationSqler); ok {
+			return ds.DurationArg(v2)
 		}
+		return v2.Microseconds()
+	case []string:
+		return arrayArg(db, v2)
+	default:
+		if a, ok := intSlice(v); ok {
+			return arrayArg(db, a)
+		}
+		return v
+	}
+}
 
-	*/
+// intSlice converts a slice of any integer type, including enum types, to a []int.
+func intSlice(v any) ([]int, bool) {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Slice {
+		return nil, false
+	}
+	switch val.Type().Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+	default:
+		return nil, false
+	}
+	a := make([]int, val.Len())
+	for i := range a {
+		a[i] = int(val.Index(i).Int())
+	}
+	return a, true
+}
+
+// arrayArg converts a []string or []int to a value for an array column.
+func arrayArg(db DbI, a any) any {
+	if as, ok := db.(arraySqler); ok {
+		return as.ArrayArg(a)
+	}
+	if reflect.ValueOf(a).Len() == 0 {
+		return "[]" // rather than null
+	}
+	b, _ := json.Marshal(a)
+	return string(b)
 }
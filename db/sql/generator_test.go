@@ -0,0 +1,154 @@
+package sql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/goradd/gro/ci/tests/gen/goradd/node"
+	"github.com/goradd/gro/db/jointree"
+	. "github.com/goradd/gro/query"
+	"github.com/goradd/gro/query/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDb is a DbI that generates SQL in the standard dialect without a database connection.
+type testDb struct {
+	DbI
+	forUpdate bool
+	lateral   bool
+}
+
+func (d testDb) QuoteIdentifier(v string) string {
+	return `"` + v + `"`
+}
+
+func (d testDb) FormatArgument(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (d testDb) SupportsForUpdate() bool {
+	return d.forUpdate
+}
+
+func (d testDb) SupportsLateralJoin() bool {
+	return d.lateral
+}
+
+// lockTestDb is a testDb with its own locking clause.
+type lockTestDb struct {
+	testDb
+}
+
+func (d lockTestDb) LockSql(_ LockParams, table string) string {
+	return "LOCK " + table + "\n"
+}
+
+func TestGenerateLockSql(t *testing.T) {
+	tests := []struct {
+		name  string
+		locks LockParams
+		want  string
+	}{
+		{"none", LockParams{}, ""},
+		{"update", LockParams{Mode: LockModeUpdate}, "FOR UPDATE\n"},
+		{"share", LockParams{Mode: LockModeShare}, "FOR SHARE\n"},
+		{"skip locked", LockParams{Mode: LockModeUpdate, SkipLocked: true}, "FOR UPDATE SKIP LOCKED\n"},
+		{"nowait", LockParams{Mode: LockModeShare, NoWait: true}, "FOR SHARE NOWAIT\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jt := &jointree.JoinTree{Root: &jointree.Element{Alias: "t0"}, Locks: tt.locks}
+			g := newSqlGenerator(jt, testDb{forUpdate: true})
+			assert.Equal(t, tt.want, g.generateLockSql())
+		})
+	}
+
+	// Databases without row level locks do not lock
+	jt := &jointree.JoinTree{Root: &jointree.Element{Alias: "t0"}, Locks: LockParams{Mode: LockModeUpdate}}
+	assert.Empty(t, newSqlGenerator(jt, testDb{}).generateLockSql())
+
+	// The clause of the driver is given the quoted alias of the root table
+	assert.Equal(t, "LOCK \"t0\"\n", newSqlGenerator(jt, lockTestDb{testDb{forUpdate: true}}).generateLockSql())
+}
+
+func TestGenerateVersionLock(t *testing.T) {
+	s, args := GenerateVersionLock(testDb{forUpdate: true}, "project", "id", 1, "version", true)
+	assert.Equal(t, "SELECT \"version\"\nFROM \"project\"\nWHERE \"id\" = $1 FOR UPDATE", s)
+	assert.Equal(t, []any{1}, args)
+
+	s, _ = GenerateVersionLock(testDb{forUpdate: true}, "project", "id", 1, "version", false)
+	assert.NotContains(t, s, "FOR UPDATE")
+	s, _ = GenerateVersionLock(testDb{}, "project", "id", 1, "version", true)
+	assert.NotContains(t, s, "FOR UPDATE")
+}
+
+func TestLateralJoinSql(t *testing.T) {
+	dbi := testDb{lateral: true}
+	milestones := func() Node {
+		return Related(node.Project().Milestones(), RelatedOptions{
+			Condition: op.NotEqual(node.Project().Milestones().Name(), "x"),
+			OrderBys:  []Sorter{node.Project().Milestones().Name().Descending()},
+			Limit:     2,
+		})
+	}
+	teamMembers := func() Node {
+		return Related(node.Project().TeamMembers(), RelatedOptions{
+			Condition: op.NotEqual(node.Project().TeamMembers().LastName(), "x"),
+			OrderBys:  []Sorter{node.Project().TeamMembers().LastName()},
+			Limit:     2,
+		})
+	}
+	const milestonesLateral = `LATERAL (SELECT "t_2".* FROM "milestone" AS "t_2" ` +
+		`WHERE "t_2"."project_id" = "t_1"."id" AND ( ("t_2"."name" <> $1) ) ` +
+		`ORDER BY "t_2"."name" DESC,"t_2"."id" LIMIT 2) AS "t_2"`
+	// The many-many derived table holds the association table rows, and the related table is joined to it again
+	const teamMembersLateral = `LATERAL (SELECT "t_2a".* FROM "team_member_project_assn" AS "t_2a"` + "\n" +
+		`INNER JOIN "person" AS "t_2" ON "t_2a"."team_member_id" = "t_2"."id" ` +
+		`WHERE "t_2a"."project_id" = "t_1"."id" AND ( ("t_2"."last_name" <> $1) ) ` +
+		`ORDER BY "t_2"."last_name","t_2"."id" LIMIT 2) AS "t_2a"`
+	const teamMembersJoin = `"person" AS "t_2" ON "t_2a"."team_member_id" = "t_2"."id"` + "\n"
+
+	t.Run("reverse join", func(t *testing.T) {
+		b := NewBuilder(node.Project())
+		b.Command = BuilderCommandLoad
+		b.Select(milestones())
+		s, args := newSqlGenerator(jointree.NewJoinTree(b), dbi).generateSelectSql()
+		assert.Contains(t, s, "FROM\n\"project\" AS \"t_1\"\nLEFT JOIN "+milestonesLateral+" ON TRUE\n")
+		assert.Equal(t, []any{"x"}, args)
+	})
+	t.Run("many-many join", func(t *testing.T) {
+		b := NewBuilder(node.Project())
+		b.Command = BuilderCommandLoad
+		b.Select(teamMembers())
+		s, args := newSqlGenerator(jointree.NewJoinTree(b), dbi).generateSelectSql()
+		assert.Contains(t, s, "LEFT JOIN "+teamMembersLateral+" ON TRUE\nLEFT JOIN "+teamMembersJoin)
+		assert.Contains(t, s, `"t_2a"."role" AS`)
+		assert.Equal(t, []any{"x"}, args)
+	})
+	t.Run("reverse preload", func(t *testing.T) {
+		b := NewBuilder(node.Project())
+		b.Command = BuilderCommandLoad
+		b.Preload(milestones())
+		jt := jointree.NewJoinTree(b)
+		require.Len(t, jt.Preloads, 1)
+		s, args := newSqlGenerator(jt.Preloads[0], dbi).generatePreloadSql([]any{"1", "2"})
+		assert.Contains(t, s, "SELECT\n\"t_2\".\"project_id\" AS \"k_\",\n")
+		assert.Contains(t, s, "FROM\n\"project\" AS \"t_1\"\nCROSS JOIN "+milestonesLateral+"\n"+
+			"WHERE \"t_1\".\"id\" IN ($2,$3)\n")
+		assert.Equal(t, []any{"x", "1", "2"}, args)
+	})
+	t.Run("many-many preload", func(t *testing.T) {
+		b := NewBuilder(node.Project())
+		b.Command = BuilderCommandLoad
+		b.Preload(teamMembers())
+		jt := jointree.NewJoinTree(b)
+		require.Len(t, jt.Preloads, 1)
+		s, args := newSqlGenerator(jt.Preloads[0], dbi).generatePreloadSql([]any{"1", "2"})
+		assert.Contains(t, s, "SELECT\n\"t_2a\".\"project_id\" AS \"k_\",\n")
+		assert.Contains(t, s, "FROM\n\"project\" AS \"t_1\"\nCROSS JOIN "+teamMembersLateral+"\n"+
+			"INNER JOIN "+teamMembersJoin+
+			"WHERE \"t_1\".\"id\" IN ($2,$3)\n")
+		assert.Equal(t, []any{"x", "1", "2"}, args)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/goradd/anyutil"
 	"github.com/goradd/gro/db"
@@ -22,7 +23,16 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 	var tableClauses []string
 
 	for _, col := range table.Columns {
-		cc, tc, xc := m.buildColumnDef(col, false)
+		if col.IsGenerated && col.GeneratedExpression(db.DriverTypeMysql) == "" {
+			slog.Error("Table skipped, generated column has no expression for this database.",
+				slog.String(db.LogTable, table.QualifiedName()),
+				slog.String(db.LogColumn, col.Name))
+			return "", nil
+		}
+	}
+
+	for _, col := range table.Columns {
+		cc, tc, xc := m.buildColumnDef(d, col, false)
 		if cc == "" {
 			continue // error, already reported
 		}
@@ -33,6 +43,13 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 
 	// build the foreign keys
 	for _, ref := range table.References {
+		if ref.IsPolymorphic() {
+			cc, tc, xc := m.buildPolymorphicDefs(d, ref)
+			columnDefs = append(columnDefs, cc...)
+			tableClauses = append(tableClauses, tc...)
+			extraClauses = append(extraClauses, xc...)
+			continue
+		}
 		cc, tc, xc := m.buildReferenceDef(d, table, ref)
 		if cc == "" {
 			continue // error, already reported
@@ -43,9 +60,17 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 	}
 
 	for _, mci := range table.Indexes {
-		def := m.indexSql(mci)
-		tableClauses = append(tableClauses, def)
+		if def := m.indexSql(mci); def != "" {
+			tableClauses = append(tableClauses, def)
+		}
+	}
+
+	for _, chk := range table.Checks {
+		if c := m.checkSql(chk); c != "" {
+			tableClauses = append(tableClauses, c)
+		}
 	}
+
 	columnDefs = append(columnDefs, tableClauses...)
 
 	tableName := table.QualifiedName()
@@ -63,7 +88,7 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 // columnClause is the column definition.
 // tableClauses will be included within the Create Table definition after the column clauses.
 // extraClauses will be executed outside the table definition after all tables and their columns have been created.
-func (m *DB) buildColumnDef(col *schema.Column, isFkToAuto bool) (columnClause string, tableClauses []string, extraClauses []string) {
+func (m *DB) buildColumnDef(d *schema.Database, col *schema.Column, isFkToAuto bool) (columnClause string, tableClauses []string, extraClauses []string) {
 	var colType string
 	var collation string
 	var defaultStr string
@@ -89,13 +114,35 @@ func (m *DB) buildColumnDef(col *schema.Column, isFkToAuto bool) (columnClause s
 			return
 		}
 
-		// foreign key will automatically index the column
-		fk := fmt.Sprintf(" FOREIGN KEY (%s) REFERENCES %s(%s)",
-			m.QuoteIdentifier(col.Name),
-			m.QuoteIdentifier(col.EnumTable),
-			m.QuoteIdentifier(schema.ValueKey))
-		tableClauses = append(tableClauses, fk)
-		colType = "INT"
+		et := d.FindEnumTable(col.EnumTable)
+		switch {
+		case et != nil && et.Storage == schema.EnumStorageNative:
+			// The native type enforces the values, so no foreign key is needed
+			var values []string
+			for _, k := range et.Keys() {
+				values = append(values, "'"+strings.ReplaceAll(k, "'", "''")+"'")
+			}
+			colType = fmt.Sprintf("ENUM(%s)", strings.Join(values, ","))
+		case et != nil && et.Storage == schema.EnumStorageKey:
+			// foreign key will automatically index the column
+			fk := fmt.Sprintf(" FOREIGN KEY (%s) REFERENCES %s(%s)",
+				m.QuoteIdentifier(col.Name),
+				m.QuoteIdentifier(col.EnumTable),
+				m.QuoteIdentifier(schema.KeyKey))
+			tableClauses = append(tableClauses, fk)
+			colType = sqlType(schema.ColTypeString, et.MaxKeyLength(), schema.ColSubTypeNone)
+		default:
+			// foreign key will automatically index the column
+			fk := fmt.Sprintf(" FOREIGN KEY (%s) REFERENCES %s(%s)",
+				m.QuoteIdentifier(col.Name),
+				m.QuoteIdentifier(col.EnumTable),
+				m.QuoteIdentifier(schema.ValueKey))
+			tableClauses = append(tableClauses, fk)
+			colType = "INT"
+		}
+		if i, ok := col.DefaultValue.(int); ok && et != nil && et.Storage != schema.EnumStorageInteger && defaultStr == "" {
+			defaultStr = fmt.Sprintf("DEFAULT '%s'", et.KeyOf(i))
+		}
 	} else {
 		colType = sqlType(col.Type, col.Size, col.SubType)
 		if col.Type == schema.ColTypeAutoPrimaryKey {
@@ -103,6 +150,10 @@ func (m *DB) buildColumnDef(col *schema.Column, isFkToAuto bool) (columnClause s
 		}
 	}
 
+	if col.IsGenerated {
+		colType += fmt.Sprintf(" GENERATED ALWAYS AS (%s) STORED", col.GeneratedExpression(db.DriverTypeMysql))
+	}
+
 	if !col.IsNullable {
 		colType += " NOT NULL "
 	}
@@ -119,6 +170,9 @@ func (m *DB) buildColumnDef(col *schema.Column, isFkToAuto bool) (columnClause s
 				} else {
 					defaultStr = fmt.Sprintf("DEFAULT '%s'", val)
 				}
+			} else if col.Type == schema.ColTypeDuration {
+				d, _ := time.ParseDuration(val)
+				defaultStr = fmt.Sprintf("DEFAULT %d", d.Microseconds())
 			} else {
 				defaultStr = fmt.Sprintf("DEFAULT '%s'", val)
 			}
@@ -137,6 +191,17 @@ func (m *DB) buildColumnDef(col *schema.Column, isFkToAuto bool) (columnClause s
 }
 
 func (m *DB) indexSql(idx *schema.Index) string {
+	if idx.WhereFor(db.DriverTypeMysql) != "" {
+		slog.Warn("Index skipped, partial indexes are not supported.",
+			slog.String("index", idx.Name))
+		return ""
+	}
+	if idx.Function != "" && m.isMariaDB {
+		slog.Warn("Index skipped, expression indexes are not supported.",
+			slog.String("index", idx.Name))
+		return ""
+	}
+
 	var idxType string
 	switch idx.IndexLevel {
 	case schema.IndexLevelPrimaryKey:
@@ -145,10 +210,16 @@ func (m *DB) indexSql(idx *schema.Index) string {
 		idxType = "UNIQUE"
 	case schema.IndexLevelIndexed:
 		idxType = "INDEX"
+	case schema.IndexLevelFullText:
+		idxType = "FULLTEXT"
 	default:
 		return ""
 	}
 	cols := anyutil.MapSliceFunc(idx.Columns, func(s string) string {
+		if idx.Function != "" {
+			// functional key parts must be enclosed in parentheses
+			return "(" + idx.Function + "(" + m.QuoteIdentifier(s) + "))"
+		}
 		return m.QuoteIdentifier(s)
 	})
 	idxCols := strings.Join(cols, ", ")
@@ -164,7 +235,7 @@ func (m *DB) buildReferenceDef(db *schema.Database, table *schema.Table, ref *sc
 		fk.Size = 32
 	}
 
-	columnClause, tableClauses, extraClauses = m.buildColumnDef(fk, fk.Type == schema.ColTypeAutoPrimaryKey)
+	columnClause, tableClauses, extraClauses = m.buildColumnDef(db, fk, fk.Type == schema.ColTypeAutoPrimaryKey)
 	if columnClause == "" {
 		return // error, already logged
 	}
@@ -179,6 +250,9 @@ func (m *DB) buildReferenceDef(db *schema.Database, table *schema.Table, ref *sc
 		m.QuoteIdentifier(fk.Name),
 		m.QuoteIdentifier(ref.Table),
 		m.QuoteIdentifier(pk.Name))
+	if a := ref.OnDelete.Sql(); a != "" {
+		s += " ON DELETE " + a
+	}
 	extraClauses = append(extraClauses, s)
 	return
 }
@@ -192,7 +266,7 @@ func sqlType(colType schema.ColumnType, size uint64, subType schema.ColumnSubTyp
 		typ := intType(size)
 		return typ
 	case schema.ColTypeString:
-		if subType == schema.ColSubTypeNumeric {
+		if subType.IsNumeric() {
 			precision := size & 0x0000FFFF
 			scale := size >> 16
 			if precision != 0 && scale != 0 {
@@ -243,6 +317,22 @@ func sqlType(colType schema.ColumnType, size uint64, subType schema.ColumnSubTyp
 		return "DATETIME"
 	case schema.ColTypeEnum:
 		return "INT"
+	case schema.ColTypeStringArray, schema.ColTypeIntArray, schema.ColTypeEnumArray:
+		return "JSON" // JSON encoded array
+	case schema.ColTypeDuration:
+		return "BIGINT" // microseconds
+	case schema.ColTypeEnumSet:
+		return "BIGINT" // bit flags
+	case schema.ColTypeGeometry:
+		switch subType {
+		case schema.ColSubTypePoint:
+			return "POINT"
+		case schema.ColSubTypeLineString:
+			return "LINESTRING"
+		case schema.ColSubTypePolygon:
+			return "POLYGON"
+		}
+		return "GEOMETRY"
 	case schema.ColTypeJSON:
 		fallthrough
 	default:
@@ -266,3 +356,44 @@ func intType(size uint64) string {
 	}
 	return t
 }
+
+// checkSql returns the table clause that creates the check constraint.
+func (m *DB) checkSql(chk *schema.Check) string {
+	expr := chk.ExpressionFor(db.DriverTypeMysql)
+	if expr == "" {
+		slog.Warn("Check skipped, no expression was given for this database.",
+			slog.String("check", chk.Name))
+		return ""
+	}
+	return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", m.QuoteIdentifier(chk.Name), expr)
+}
+
+// ViewDefinitionSql returns the sql that will create the view.
+func (m *DB) ViewDefinitionSql(_ *schema.Database, view *schema.View) string {
+	s := view.SqlFor(db.DriverTypeMysql)
+	if s == "" {
+		return ""
+	}
+	return fmt.Sprintf("CREATE VIEW %s AS %s", m.QuoteIdentifier(view.QualifiedName()), s)
+}
+
+// buildPolymorphicDefs returns the definitions of the columns of a polymorphic reference.
+// Polymorphic references cannot be enforced with foreign keys, so no constraint is created.
+func (m *DB) buildPolymorphicDefs(db *schema.Database, ref *schema.Reference) (columnClauses, tableClauses, extraClauses []string) {
+	typeCol, fk := ref.PolymorphicColumns(db)
+	if fk.Type == schema.ColTypeAutoPrimaryKey {
+		fk.Type = schema.ColTypeInt // auto columns internally are integers
+		fk.Size = 32
+	}
+
+	for _, col := range []*schema.Column{typeCol, fk} {
+		cc, tc, xc := m.buildColumnDef(db, col, false)
+		if cc == "" {
+			continue // error, already reported
+		}
+		columnClauses = append(columnClauses, cc)
+		tableClauses = append(tableClauses, tc...)
+		extraClauses = append(extraClauses, xc...)
+	}
+	return
+}
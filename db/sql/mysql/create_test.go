@@ -266,3 +266,12 @@ func sampleSchemaTypes() schema.Database {
 	}
 	return db
 }
+
+func TestNativeEnumValues(t *testing.T) {
+	values, ok := nativeEnumValues("enum('red','o''clock','blue')")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"red", "o'clock", "blue"}, values)
+
+	_, ok = nativeEnumValues("varchar(20)")
+	assert.False(t, ok)
+}
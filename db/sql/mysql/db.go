@@ -224,6 +224,56 @@ func (m *DB) OperationSql(op Operator, operands []Node, operandStrings []string)
 		s := operandStrings[0]
 		s2 := operandStrings[1]
 		sql = fmt.Sprintf(`DATE_ADD(%s, INTERVAL (%s) SECOND)`, s, s2)
+	case OpDateTruncYear:
+		sql = fmt.Sprintf(`CAST(DATE_FORMAT(%s, '%%Y-01-01') AS DATETIME)`, operandStrings[0])
+	case OpDateTruncMonth:
+		sql = fmt.Sprintf(`CAST(DATE_FORMAT(%s, '%%Y-%%m-01') AS DATETIME)`, operandStrings[0])
+	case OpDateTruncWeek:
+		// Finds the Monday of the ISO week without repeating the operand
+		sql = fmt.Sprintf(`CAST(STR_TO_DATE(CONCAT(YEARWEEK(%s, 3), ' Monday'), '%%x%%v %%W') AS DATETIME)`, operandStrings[0])
+	case OpDateTruncDay:
+		sql = fmt.Sprintf(`CAST(DATE(%s) AS DATETIME)`, operandStrings[0])
+	case OpDateYear:
+		sql = fmt.Sprintf(`YEAR(%s)`, operandStrings[0])
+	case OpDateMonth:
+		sql = fmt.Sprintf(`MONTH(%s)`, operandStrings[0])
+	case OpDateDay:
+		sql = fmt.Sprintf(`DAYOFMONTH(%s)`, operandStrings[0])
+	case OpDateDayOfWeek:
+		sql = fmt.Sprintf(`(DAYOFWEEK(%s) - 1)`, operandStrings[0])
+	case OpDateHour:
+		sql = fmt.Sprintf(`HOUR(%s)`, operandStrings[0])
+	case OpDateDiffSeconds:
+		// Negated so that the operands stay in the same order as their placeholders
+		sql = fmt.Sprintf(`(-TIMESTAMPDIFF(SECOND, %s, %s))`, operandStrings[0], operandStrings[1])
+	case OpNow:
+		sql = `UTC_TIMESTAMP()`
+	case OpDateAdd:
+		// Durations are stored as microseconds
+		sql = fmt.Sprintf(`DATE_ADD(%s, INTERVAL (%s) MICROSECOND)`, operandStrings[0], operandStrings[1])
+	case OpArrayContains:
+		// Arrays are stored as JSON
+		sql = fmt.Sprintf(`JSON_CONTAINS(%s, JSON_ARRAY(%s))`, operandStrings[0], operandStrings[1])
+	case OpArrayOverlaps:
+		sql = fmt.Sprintf(`JSON_OVERLAPS(%s, JSON_ARRAY(%s))`, operandStrings[0], operandStrings[1])
+	case OpJsonExtract:
+		// JSON_UNQUOTE returns the found value as text, the same as the ->> operator does
+		sql = fmt.Sprintf(`JSON_UNQUOTE(JSON_EXTRACT(%s, %s))`, operandStrings[0], operandStrings[1])
+	case OpJsonContains:
+		sql = fmt.Sprintf(`JSON_CONTAINS(%s, %s)`, operandStrings[0], operandStrings[1])
+	case OpJsonHasKey:
+		sql = fmt.Sprintf(`JSON_CONTAINS_PATH(%s, 'one', %s)`, operandStrings[0], operandStrings[1])
+	case OpMatch:
+		sql = fmt.Sprintf(`(MATCH (%s) AGAINST (%s IN NATURAL LANGUAGE MODE))`, operandStrings[0], operandStrings[1])
+	case OpMatchScore:
+		// MATCH returns the relevance when not used as a condition
+		sql = fmt.Sprintf(`MATCH (%s) AGAINST (%s IN NATURAL LANGUAGE MODE)`, operandStrings[0], operandStrings[1])
+	case OpWithin:
+		sql = fmt.Sprintf(`ST_Within(%s, %s)`, operandStrings[0], operandStrings[1])
+	case OpIntersects:
+		sql = fmt.Sprintf(`ST_Intersects(%s, %s)`, operandStrings[0], operandStrings[1])
+	case OpDistance:
+		sql = fmt.Sprintf(`ST_Distance(%s, %s)`, operandStrings[0], operandStrings[1])
 	case OpXor:
 		sOp := " " + op.String() + " "
 		sql = " (" + strings.Join(operandStrings, sOp) + ") "
@@ -231,22 +281,71 @@ func (m *DB) OperationSql(op Operator, operands []Node, operandStrings []string)
 	return
 }
 
+// GeometrySelectSql converts a spatial column from the MySQL internal format to Well Known Binary.
+func (m *DB) GeometrySelectSql(columnSql string) string {
+	return fmt.Sprintf(`ST_AsBinary(%s)`, columnSql)
+}
+
+// GeometryArg converts g to the MySQL internal geometry format, which is Well Known Binary
+// preceded by a 4-byte SRID.
+func (m *DB) GeometryArg(g Geometry) any {
+	return append([]byte{0, 0, 0, 0}, g.WKB()...)
+}
+
 func (m *DB) SupportsForUpdate() bool {
 	return true
 }
 
+// SupportsLateralJoin returns true if the database supports lateral joins, which MySQL 8 does and MariaDB does not.
+func (m *DB) SupportsLateralJoin() bool {
+	return !m.isMariaDB
+}
+
+// LockSql returns the clause that locks the rows of a query. MariaDB does not support FOR SHARE.
+func (m *DB) LockSql(locks LockParams, _ string) string {
+	if !m.isMariaDB || locks.Mode != LockModeShare {
+		return ""
+	}
+	s := "LOCK IN SHARE MODE"
+	if locks.SkipLocked {
+		s += " SKIP LOCKED"
+	} else if locks.NoWait {
+		s += " NOWAIT"
+	}
+	return s + "\n"
+}
+
+// constraintError returns the database agnostic error for a constraint violation reported by mysql,
+// or nil if err is not a constraint violation. These are expected error situations to report to the developer.
+func constraintError(table string, err error) error {
+	if me, ok := anyutil.As[*mysql.MySQLError](err); ok {
+		switch me.Number {
+		case 1062:
+			// Since it is not possible to completely prevent a unique constraint error, except by implementing a separate
+			// service to track and lock unique values that are in use (which is beyond the scope of the ORM), we need
+			// to see if this is that kind of error and return it.
+			return db.NewUniqueValueError(table, nil, err)
+		case 3819, // mysql: Check constraint 'name' is violated.
+			4025: // mariadb: CONSTRAINT `name` failed for `db`.`table`
+			var name string
+			if i := strings.IndexAny(me.Message, "'`"); i >= 0 {
+				name = me.Message[i+1:]
+				if j := strings.IndexAny(name, "'`"); j >= 0 {
+					name = name[:j]
+				}
+			}
+			return db.NewCheckConstraintError(table, name, err)
+		}
+	}
+	return nil
+}
+
 // Insert inserts the given data as a new record in the database.
 func (m *DB) Insert(ctx context.Context, table string, fields map[string]any, autoPkKey string) error {
 	s, args := sql2.GenerateInsert(m, table, fields)
 	if r, err := m.SqlExec(ctx, s, args...); err != nil {
-		if me, ok := anyutil.As[*mysql.MySQLError](err); ok {
-			// expected error situation to report to developer
-			if me.Number == 1062 {
-				// Since it is not possible to completely prevent a unique constraint error, except by implementing a separate
-				// service to track and lock unique values that are in use (which is beyond the scope of the ORM), we need
-				// to see if this is that kind of error and return it.
-				return db.NewUniqueValueError(table, nil, err)
-			}
+		if err2 := constraintError(table, err); err2 != nil {
+			return err2
 		}
 		return db.NewQueryError("SqlExec", s, args, err)
 	} else {
@@ -295,14 +394,8 @@ func (m *DB) Update(ctx context.Context,
 	var result sqldb.Result
 	result, err = m.SqlExec(ctx, s, args...)
 	if err != nil {
-		if me, ok := anyutil.As[*mysql.MySQLError](err); ok {
-			// expected error situation to report to developer. Unique value constraint was violated.
-			if me.Number == 1062 {
-				// Since it is not possible to completely prevent a unique constraint error, except by implementing a separate
-				// service to track and lock unique values that are in use (which is beyond the scope of the ORM), we need
-				// to see if this is that kind of error and return it, rather than panicking.
-				return db.NewUniqueValueError(table, nil, err)
-			}
+		if err2 := constraintError(table, err); err2 != nil {
+			return err2
 		}
 		return db.NewQueryError("SqlExec", s, args, err)
 	}
@@ -0,0 +1,30 @@
+package mysql
+
+import (
+	"testing"
+
+	. "github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDB_LockSql(t *testing.T) {
+	tests := []struct {
+		name    string
+		mariaDB bool
+		locks   LockParams
+		want    string
+	}{
+		{"mysql update", false, LockParams{Mode: LockModeUpdate}, ""},
+		{"mysql share", false, LockParams{Mode: LockModeShare}, ""},
+		{"mariadb update", true, LockParams{Mode: LockModeUpdate}, ""},
+		{"mariadb share", true, LockParams{Mode: LockModeShare}, "LOCK IN SHARE MODE\n"},
+		{"mariadb skip locked", true, LockParams{Mode: LockModeShare, SkipLocked: true}, "LOCK IN SHARE MODE SKIP LOCKED\n"},
+		{"mariadb nowait", true, LockParams{Mode: LockModeShare, NoWait: true}, "LOCK IN SHARE MODE NOWAIT\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &DB{isMariaDB: tt.mariaDB}
+			assert.Equal(t, tt.want, m.LockSql(tt.locks, "`t0`"))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -26,6 +27,7 @@ type mysqlTable struct {
 	columns             []mysqlColumn
 	indexes             []mysqlIndex
 	fkMap               map[string][]mysqlForeignKey
+	checks              []*schema.Check
 	comment             string
 	supportsForeignKeys bool
 }
@@ -42,13 +44,15 @@ type mysqlColumn struct {
 	key             string
 	extra           string
 	comment         string
+	generationExpr  sql.NullString
 }
 
 type mysqlIndex struct {
 	name       string
 	nonUnique  bool
 	tableName  string
-	columnName string
+	columnName sql.NullString
+	expression sql.NullString
 }
 
 type mysqlForeignKey struct {
@@ -88,6 +92,11 @@ func (m *DB) getRawTables() map[string]mysqlTable {
 		return nil
 	}
 
+	checks, err := m.getChecks()
+	if err != nil {
+		return nil
+	}
+
 	tables := m.getTables()
 
 	for _, table := range tables {
@@ -113,6 +122,7 @@ func (m *DB) getRawTables() map[string]mysqlTable {
 		}
 
 		table.indexes = indexes[table.name]
+		table.checks = checks[table.name]
 		table.columns = columns
 		tableMap[table.name] = table
 	}
@@ -190,7 +200,8 @@ func (m *DB) getColumns(table string) (columns []mysqlColumn, err error) {
 	column_key,
 	extra,
 	column_comment,
-	collation_name
+	collation_name,
+	generation_expression
 	FROM
 	information_schema.columns
 	WHERE
@@ -208,7 +219,7 @@ func (m *DB) getColumns(table string) (columns []mysqlColumn, err error) {
 
 	for rows.Next() {
 		col = mysqlColumn{}
-		err = rows.Scan(&col.name, &col.defaultValue.R, &col.isNullable, &col.dataType, &col.characterMaxLen, &col.columnType, &col.key, &col.extra, &col.comment, &col.collation)
+		err = rows.Scan(&col.name, &col.defaultValue.R, &col.isNullable, &col.dataType, &col.characterMaxLen, &col.columnType, &col.key, &col.extra, &col.comment, &col.collation, &col.generationExpr)
 		if err != nil {
 			panic(err)
 		}
@@ -228,19 +239,26 @@ func (m *DB) getIndexes() (indexes map[string][]mysqlIndex, err error) {
 	dbName := m.databaseName
 	indexes = make(map[string][]mysqlIndex)
 
+	// MariaDB does not support functional indexes
+	expression := "expression"
+	if m.isMariaDB {
+		expression = "NULL"
+	}
+
 	rows, err := m.SqlDb().Query(fmt.Sprintf(`
 	SELECT
 	index_name,
 	non_unique,
 	table_name,
-	column_name
+	column_name,
+	%s
 	FROM
 	information_schema.statistics
 	WHERE
 	table_schema = '%s'
 	ORDER BY
 	seq_in_index
-	`, dbName))
+	`, expression, dbName))
 
 	if err != nil {
 		panic(err)
@@ -250,7 +268,7 @@ func (m *DB) getIndexes() (indexes map[string][]mysqlIndex, err error) {
 
 	for rows.Next() {
 		index = mysqlIndex{}
-		err = rows.Scan(&index.name, &index.nonUnique, &index.tableName, &index.columnName)
+		err = rows.Scan(&index.name, &index.nonUnique, &index.tableName, &index.columnName, &index.expression)
 		if err != nil {
 			panic(err)
 		}
@@ -266,6 +284,18 @@ func (m *DB) getIndexes() (indexes map[string][]mysqlIndex, err error) {
 	return indexes, err
 }
 
+// indexExpressionRegex matches a functional key part that applies a function to a column, like lower(`email`).
+var indexExpressionRegex = regexp.MustCompile("^(\\w+)\\(`?(\\w+)`?\\)$")
+
+// parseIndexExpression returns the function and the column of a functional key part
+// if it is an expression that applies a function to a single column.
+func parseIndexExpression(expr string) (function, column string) {
+	if m := indexExpressionRegex.FindStringSubmatch(expr); m != nil {
+		return m[1], m[2]
+	}
+	return
+}
+
 // getForeignKeys gets information on the foreign keys.
 //
 // Note that querying the information_schema database is SLOW, so we want to do it as few times as possible.
@@ -314,6 +344,56 @@ ORDER BY
 	return foreignKeys, err
 }
 
+// getChecks gets the check constraints, keyed by table name.
+func (m *DB) getChecks() (checks map[string][]*schema.Check, err error) {
+	dbName := m.databaseName
+	checks = make(map[string][]*schema.Check)
+
+	rows, err := m.SqlDb().Query(fmt.Sprintf(`
+SELECT
+    tc.TABLE_NAME,
+    cc.CONSTRAINT_NAME,
+    cc.CHECK_CLAUSE
+FROM
+    information_schema.TABLE_CONSTRAINTS tc
+JOIN
+    information_schema.CHECK_CONSTRAINTS cc
+    ON tc.CONSTRAINT_NAME = cc.CONSTRAINT_NAME
+    AND tc.CONSTRAINT_SCHEMA = cc.CONSTRAINT_SCHEMA
+WHERE
+    tc.CONSTRAINT_TYPE = 'CHECK' AND
+    tc.TABLE_SCHEMA = '%s'
+ORDER BY
+    cc.CONSTRAINT_NAME
+`, dbName))
+
+	defer sql2.RowClose(rows)
+	if err != nil {
+		panic(err)
+	}
+
+	for rows.Next() {
+		var tableName, name, clause string
+		err = rows.Scan(&tableName, &name, &clause)
+		if err != nil {
+			panic(err)
+		}
+		if strings.HasPrefix(clause, "json_valid(") {
+			// MariaDB creates these automatically for JSON columns
+			continue
+		}
+		checks[tableName] = append(checks[tableName], &schema.Check{
+			Name: name,
+			Sql:  map[string]string{db.DriverTypeMysql: clause},
+		})
+	}
+	err = rows.Err()
+	if err != nil {
+		panic(err)
+	}
+	return checks, err
+}
+
 // Convert the database native type to a more generic sql type, and a go table type.
 func (m *DB) processTypeInfo(column mysqlColumn) (
 	typ schema.ColumnType,
@@ -435,8 +515,8 @@ func (m *DB) processTypeInfo(column mysqlColumn) (
 		}
 
 	case "decimal":
-		// No native equivalent in Go.
-		// See the shopspring/decimal or math/big packages for possible support.
+		// Extracted as a numeric string. Change the sub type to decimal in the schema
+		// to represent the value in Go as a query.Decimal instead.
 		typ = schema.ColTypeString
 		// pack the two length values to be unpacked in Go
 		maxLength = uint64(dataLen) + uint64(dataSubLen<<16)
@@ -465,6 +545,18 @@ func (m *DB) processTypeInfo(column mysqlColumn) (
 	case "json":
 		typ = schema.ColTypeJSON
 
+	case "point":
+		typ = schema.ColTypeGeometry
+		subType = schema.ColSubTypePoint
+	case "linestring":
+		typ = schema.ColTypeGeometry
+		subType = schema.ColSubTypeLineString
+	case "polygon":
+		typ = schema.ColTypeGeometry
+		subType = schema.ColSubTypePolygon
+	case "geometry":
+		typ = schema.ColTypeGeometry
+
 	default:
 		typ = schema.ColTypeUnknown
 		extra = map[string]interface{}{"type": column.columnType}
@@ -537,9 +629,54 @@ func (m *DB) schemaFromRawTables(rawTables map[string]mysqlTable, options map[st
 			dd.Tables = append(dd.Tables, &t)
 		}
 	}
+	linkNativeEnumColumns(&dd)
 	return dd
 }
 
+// linkNativeEnumColumns finds the enum tables of the columns that use the native ENUM type.
+// These columns have no foreign key to their enum table, so the enum table is the one whose keys match the
+// values of the ENUM type. The enum tables found are given native storage.
+func linkNativeEnumColumns(dd *schema.Database) {
+	for _, t := range dd.Tables {
+		for _, col := range t.Columns {
+			def := col.DatabaseDefinition[db.DriverTypeMysql]
+			colType, _ := def["type"].(string)
+			values, ok := nativeEnumValues(colType)
+			if col.Type != schema.ColTypeUnknown || !ok {
+				continue
+			}
+			for _, et := range dd.EnumTables {
+				if slices.Equal(et.Keys(), values) {
+					col.Type = schema.ColTypeEnum
+					col.Size = 0
+					col.EnumTable = et.Name
+					et.Storage = schema.EnumStorageNative
+					delete(def, "type")
+					delete(def, "collation")
+					if len(def) == 0 {
+						delete(col.DatabaseDefinition, db.DriverTypeMysql)
+					}
+					break
+				}
+			}
+		}
+	}
+}
+
+// nativeEnumValues returns the values of a column type like "enum('a','b')".
+func nativeEnumValues(colType string) (values []string, ok bool) {
+	list, ok := strings.CutPrefix(colType, "enum(")
+	if !ok {
+		return nil, false
+	}
+	list = strings.TrimSuffix(list, ")")
+	for _, v := range strings.Split(list, "','") {
+		v = strings.TrimPrefix(strings.TrimSuffix(v, "'"), "'")
+		values = append(values, strings.ReplaceAll(v, "''", "'"))
+	}
+	return values, true
+}
+
 func (m *DB) getTableSchema(t mysqlTable, enumTableSuffix string) schema.Table {
 	var columnSchemas []*schema.Column
 	var referenceSchemas []*schema.Reference
@@ -550,9 +687,20 @@ func (m *DB) getTableSchema(t mysqlTable, enumTableSuffix string) schema.Table {
 	indexes := make(map[string]*schema.Index)
 
 	for _, idx := range t.indexes {
+		columnName := idx.columnName.String
+		var function string
+		if idx.expression.Valid {
+			function, columnName = parseIndexExpression(idx.expression.String)
+			if function == "" {
+				slog.Warn("Index skipped, the expression is not supported.",
+					slog.String("index", idx.name),
+					slog.String("expression", idx.expression.String))
+				continue
+			}
+		}
 		if i, ok := indexes[idx.name]; ok {
 			// add a column to the previously found index
-			i.Columns = append(i.Columns, idx.columnName)
+			i.Columns = append(i.Columns, columnName)
 		} else {
 			// create a new index
 			var level schema.IndexLevel
@@ -564,9 +712,10 @@ func (m *DB) getTableSchema(t mysqlTable, enumTableSuffix string) schema.Table {
 				level = schema.IndexLevelUnique
 			}
 			mci := &schema.Index{
-				Columns:    []string{idx.columnName},
+				Columns:    []string{columnName},
 				IndexLevel: level,
 				Name:       idx.name,
+				Function:   function,
 			}
 			indexes[idx.name] = mci
 		}
@@ -579,6 +728,9 @@ func (m *DB) getTableSchema(t mysqlTable, enumTableSuffix string) schema.Table {
 	singleIndexes := make(map[string]*schema.Index) // mapped by column name
 
 	for _, idx := range indexes {
+		if idx.Function != "" {
+			continue // expression indexes cannot be described by the column, and will be added to the table
+		}
 		if len(idx.Columns) == 1 {
 			if singleIdx, ok := singleIndexes[idx.Columns[0]]; ok {
 				if idx.IndexLevel > singleIdx.IndexLevel {
@@ -632,6 +784,7 @@ func (m *DB) getTableSchema(t mysqlTable, enumTableSuffix string) schema.Table {
 		Columns:    columnSchemas,
 		Comment:    t.comment,
 		References: referenceSchemas,
+		Checks:     t.checks,
 	}
 
 	// Create the index array
@@ -745,6 +898,11 @@ ORDER BY
 	ed.Comment = t.comment
 	delete(ed.Fields, schema.ValueKey)
 	delete(ed.Fields, schema.NameKey)
+	if _, ok := ed.Fields[schema.KeyKey]; ok {
+		// A key column is only created when columns refer to the enum by key
+		ed.Storage = schema.EnumStorageKey
+		delete(ed.Fields, schema.KeyKey)
+	}
 	if len(ed.Fields) == 0 {
 		ed.Fields = nil
 	}
@@ -767,6 +925,18 @@ func (m *DB) getColumnSchema(table mysqlTable,
 		}
 		columnSchema.DatabaseDefinition[db.DriverTypeMysql] = extra
 	}
+	if column.generationExpr.String != "" {
+		// Generated columns are computed by the database, so remember the expression so the column can be recreated.
+		columnSchema.IsGenerated = true
+		columnSchema.DefaultValue = nil
+		if columnSchema.DatabaseDefinition == nil {
+			columnSchema.DatabaseDefinition = make(map[string]map[string]interface{})
+		}
+		if columnSchema.DatabaseDefinition[db.DriverTypeMysql] == nil {
+			columnSchema.DatabaseDefinition[db.DriverTypeMysql] = make(map[string]interface{})
+		}
+		columnSchema.DatabaseDefinition[db.DriverTypeMysql]["generated"] = column.generationExpr.String
+	}
 
 	isAuto := strings.Contains(column.extra, "auto_increment")
 	if isAuto {
@@ -834,6 +1004,7 @@ func (m *DB) getAssociationSchema(t mysqlTable, enumTableSuffix string) (mm sche
 	mm.Ref1.Column = td.References[0].Column
 	mm.Ref2.Table = td.References[1].Table
 	mm.Ref2.Column = td.References[1].Column
+	mm.Columns = td.Columns
 	mm.Comment = t.comment
 	return
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/goradd/anyutil"
 	"github.com/goradd/gro/db"
@@ -58,7 +59,16 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 	var tableClauses []string
 
 	for _, col := range table.Columns {
-		colDef, tc, xc := m.buildColumnDef(col)
+		if col.IsGenerated && col.GeneratedExpression(db.DriverTypePostgres) == "" {
+			slog.Error("Table skipped, generated column has no expression for this database.",
+				slog.String(db.LogTable, table.QualifiedName()),
+				slog.String(db.LogColumn, col.Name))
+			return "", nil
+		}
+	}
+
+	for _, col := range table.Columns {
+		colDef, tc, xc := m.buildColumnDef(d, col)
 		if colDef == "" {
 			continue // error, already reported
 		}
@@ -76,6 +86,13 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 
 	// build the foreign keys
 	for _, ref := range table.References {
+		if ref.IsPolymorphic() {
+			cc, tc, xc := m.buildPolymorphicDefs(d, ref)
+			columnDefs = append(columnDefs, cc...)
+			tableClauses = append(tableClauses, tc...)
+			extraClauses = append(extraClauses, xc...)
+			continue
+		}
 		cc, tc, xc := m.buildReferenceDef(d, table, ref)
 		if cc == "" {
 			continue // error, already reported
@@ -95,6 +112,12 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 		}
 	}
 
+	for _, chk := range table.Checks {
+		if c := m.checkSql(chk); c != "" {
+			tableClauses = append(tableClauses, c)
+		}
+	}
+
 	columnDefs = append(columnDefs, tableClauses...)
 	if table.Comment != "" {
 		cmt := fmt.Sprintf("COMMENT ON TABLE %s IS '%s'", quotedTableName, table.Comment)
@@ -116,7 +139,7 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 // ColumnDefinitionSql returns the sql that will create the column col.
 // This will include single-column foreign key references.
 // This will not include a primary key designation.
-func (m *DB) buildColumnDef(col *schema.Column) (columnClause string, tableClauses []string, extraClauses []string) {
+func (m *DB) buildColumnDef(d *schema.Database, col *schema.Column) (columnClause string, tableClauses []string, extraClauses []string) {
 	var colType string
 	var collation string
 	var defaultStr string
@@ -141,12 +164,29 @@ func (m *DB) buildColumnDef(col *schema.Column) (columnClause string, tableClaus
 			return
 		}
 
-		fk := fmt.Sprintf(" FOREIGN KEY (%s) REFERENCES %s(%s)",
-			m.QuoteIdentifier(col.Name),
-			m.QuoteIdentifier(col.EnumTable),
-			m.QuoteIdentifier(schema.ValueKey))
-		tableClauses = append(tableClauses, fk)
-		colType = "INT"
+		et := d.FindEnumTable(col.EnumTable)
+		switch {
+		case et != nil && et.Storage == schema.EnumStorageNative:
+			// The native type enforces the values, so no foreign key is needed
+			colType = m.enumTypeName(et)
+		case et != nil && et.Storage == schema.EnumStorageKey:
+			fk := fmt.Sprintf(" FOREIGN KEY (%s) REFERENCES %s(%s)",
+				m.QuoteIdentifier(col.Name),
+				m.QuoteIdentifier(col.EnumTable),
+				m.QuoteIdentifier(schema.KeyKey))
+			tableClauses = append(tableClauses, fk)
+			colType = sqlType(schema.ColTypeString, et.MaxKeyLength(), schema.ColSubTypeNone)
+		default:
+			fk := fmt.Sprintf(" FOREIGN KEY (%s) REFERENCES %s(%s)",
+				m.QuoteIdentifier(col.Name),
+				m.QuoteIdentifier(col.EnumTable),
+				m.QuoteIdentifier(schema.ValueKey))
+			tableClauses = append(tableClauses, fk)
+			colType = "INT"
+		}
+		if i, ok := col.DefaultValue.(int); ok && et != nil && et.Storage != schema.EnumStorageInteger && defaultStr == "" {
+			defaultStr = fmt.Sprintf("DEFAULT '%s'", et.KeyOf(i))
+		}
 	} else {
 		colType = sqlType(col.Type, col.Size, col.SubType)
 		if col.Type == schema.ColTypeAutoPrimaryKey {
@@ -154,6 +194,10 @@ func (m *DB) buildColumnDef(col *schema.Column) (columnClause string, tableClaus
 		}
 	}
 
+	if col.IsGenerated {
+		colType += fmt.Sprintf(" GENERATED ALWAYS AS (%s) STORED", col.GeneratedExpression(db.DriverTypePostgres))
+	}
+
 	if !col.IsNullable {
 		colType += " NOT NULL"
 	}
@@ -169,6 +213,9 @@ func (m *DB) buildColumnDef(col *schema.Column) (columnClause string, tableClaus
 				} else {
 					defaultStr = fmt.Sprintf("DEFAULT '%s'", val)
 				}
+			} else if col.Type == schema.ColTypeDuration {
+				d, _ := time.ParseDuration(val)
+				defaultStr = fmt.Sprintf("DEFAULT '%d microseconds'", d.Microseconds())
 			} else {
 				defaultStr = fmt.Sprintf("DEFAULT '%s'", val)
 			}
@@ -186,6 +233,24 @@ func (m *DB) buildColumnDef(col *schema.Column) (columnClause string, tableClaus
 	return
 }
 
+// EnumTypeSql returns the sql that creates the named enum type used by columns that refer to et
+// when it uses schema.EnumStorageNative.
+func (m *DB) EnumTypeSql(et *schema.EnumTable) []string {
+	var values []string
+	for _, k := range et.Keys() {
+		values = append(values, "'"+strings.ReplaceAll(k, "'", "''")+"'")
+	}
+	return []string{fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", m.enumTypeName(et), strings.Join(values, ", "))}
+}
+
+// enumTypeName returns the quoted name of the native type of et, including its schema if applicable.
+func (m *DB) enumTypeName(et *schema.EnumTable) string {
+	if et.Schema != "" && et.Schema != "public" {
+		return m.QuoteIdentifier(et.Schema) + "." + m.QuoteIdentifier(et.NativeTypeName())
+	}
+	return m.QuoteIdentifier(et.NativeTypeName())
+}
+
 // SqlType is used by the builder to return the SQL corresponding to the given colType that will create
 // the column.
 func sqlType(colType schema.ColumnType, size uint64, subType schema.ColumnSubType) string {
@@ -193,7 +258,7 @@ func sqlType(colType schema.ColumnType, size uint64, subType schema.ColumnSubTyp
 	case schema.ColTypeAutoPrimaryKey:
 		return intType(size)
 	case schema.ColTypeString:
-		if subType == schema.ColSubTypeNumeric {
+		if subType.IsNumeric() {
 			precision := size & 0x0000FFFF
 			scale := size >> 16
 			if precision != 0 && scale != 0 {
@@ -236,6 +301,25 @@ func sqlType(colType schema.ColumnType, size uint64, subType schema.ColumnSubTyp
 		return "INT"
 	case schema.ColTypeJSON:
 		return "JSONB"
+	case schema.ColTypeStringArray:
+		return "TEXT[]"
+	case schema.ColTypeIntArray, schema.ColTypeEnumArray:
+		return "INT[]"
+	case schema.ColTypeDuration:
+		return "INTERVAL"
+	case schema.ColTypeEnumSet:
+		return "BIGINT" // bit flags
+	case schema.ColTypeGeometry:
+		// Requires the PostGIS extension
+		switch subType {
+		case schema.ColSubTypePoint:
+			return "geometry(Point)"
+		case schema.ColSubTypeLineString:
+			return "geometry(LineString)"
+		case schema.ColSubTypePolygon:
+			return "geometry(Polygon)"
+		}
+		return "geometry"
 	default:
 		return "TEXT"
 	}
@@ -266,7 +350,7 @@ func (m *DB) buildReferenceDef(db *schema.Database, table *schema.Table, ref *sc
 		fk.Type = schema.ColTypeInt // auto columns internally are integers
 	}
 
-	columnClause, tableClauses, extraClauses = m.buildColumnDef(fk)
+	columnClause, tableClauses, extraClauses = m.buildColumnDef(db, fk)
 	if columnClause == "" {
 		return // error, already logged
 	}
@@ -275,16 +359,24 @@ func (m *DB) buildReferenceDef(db *schema.Database, table *schema.Table, ref *sc
 	constraintName := table.Name + "_" + ref.Column + "_fk"
 
 	// We use alter table after all tables are created in case of cyclic foreign keys.
-	s := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s(%s) DEFERRABLE INITIALLY IMMEDIATE",
+	s := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s(%s)",
 		m.QuoteIdentifier(table.Name),
 		m.QuoteIdentifier(constraintName),
 		m.QuoteIdentifier(fk.Name),
 		m.QuoteIdentifier(ref.Table),
 		m.QuoteIdentifier(pk.Name))
+	if a := ref.OnDelete.Sql(); a != "" {
+		s += " ON DELETE " + a
+	}
+	s += " DEFERRABLE INITIALLY IMMEDIATE"
 	extraClauses = append(extraClauses, s)
 	return
 }
 
+// fullTextConfig is the text search configuration used to create and search full-text indexes.
+// The simple configuration does not do language specific stemming, so it works for text in any language.
+const fullTextConfig = "simple"
+
 // indexSql returns sql to be included after a table definition that will create an
 // index on the columns. table should NOT include the schema, since index names only need to
 // be unique within the schema in postgres.
@@ -293,6 +385,27 @@ func (m *DB) indexSql(table string, i *schema.Index) (tableSql string, extraSql
 		return m.QuoteIdentifier(s)
 	})
 
+	where := i.WhereFor(db.DriverTypePostgres)
+	if i.Function != "" || where != "" {
+		// Expression and partial indexes cannot be table constraints, so are added after the table definition
+		if i.Function != "" {
+			quotedCols = anyutil.MapSliceFunc(quotedCols, func(s string) string {
+				return i.Function + "(" + s + ")"
+			})
+		}
+		idxName := "idx_" + table + "_" + i.Name
+		var unique string
+		if i.IndexLevel == schema.IndexLevelUnique {
+			idxName = i.Name
+			unique = "UNIQUE "
+		}
+		extraSql = fmt.Sprintf("CREATE %sINDEX %s ON %s (%s)", unique, m.QuoteIdentifier(idxName), m.QuoteIdentifier(table), strings.Join(quotedCols, ","))
+		if where != "" {
+			extraSql += " WHERE " + where
+		}
+		return
+	}
+
 	var idxType string
 	switch i.IndexLevel {
 	case schema.IndexLevelPrimaryKey:
@@ -304,6 +417,11 @@ func (m *DB) indexSql(table string, i *schema.Index) (tableSql string, extraSql
 		idx_name := "idx_" + table + "_" + i.Name
 		extraSql = fmt.Sprintf("CREATE INDEX %s ON %s (%s)", m.QuoteIdentifier(idx_name), m.QuoteIdentifier(table), strings.Join(quotedCols, ","))
 		return
+	case schema.IndexLevelFullText:
+		// The expression must match the one used by OpMatch for the index to be used
+		idx_name := "idx_" + table + "_" + i.Name
+		extraSql = fmt.Sprintf("CREATE INDEX %s ON %s USING GIN (to_tsvector('%s', %s))", m.QuoteIdentifier(idx_name), m.QuoteIdentifier(table), fullTextConfig, quotedCols[0])
+		return
 	default:
 		return
 	}
@@ -317,3 +435,43 @@ func (m *DB) indexSql(table string, i *schema.Index) (tableSql string, extraSql
 	tableSql = fmt.Sprintf("%s %s (%s)", name, idxType, strings.Join(quotedCols, ","))
 	return
 }
+
+// checkSql returns the table clause that creates the check constraint.
+func (m *DB) checkSql(chk *schema.Check) string {
+	expr := chk.ExpressionFor(db.DriverTypePostgres)
+	if expr == "" {
+		slog.Warn("Check skipped, no expression was given for this database.",
+			slog.String("check", chk.Name))
+		return ""
+	}
+	return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", m.QuoteIdentifier(chk.Name), expr)
+}
+
+// ViewDefinitionSql returns the sql that will create the view.
+func (m *DB) ViewDefinitionSql(_ *schema.Database, view *schema.View) string {
+	s := view.SqlFor(db.DriverTypePostgres)
+	if s == "" {
+		return ""
+	}
+	return fmt.Sprintf("CREATE VIEW %s AS %s", m.QuoteIdentifier(view.QualifiedName()), s)
+}
+
+// buildPolymorphicDefs returns the definitions of the columns of a polymorphic reference.
+// Polymorphic references cannot be enforced with foreign keys, so no constraint is created.
+func (m *DB) buildPolymorphicDefs(db *schema.Database, ref *schema.Reference) (columnClauses, tableClauses, extraClauses []string) {
+	typeCol, fk := ref.PolymorphicColumns(db)
+	if fk.Type == schema.ColTypeAutoPrimaryKey {
+		fk.Type = schema.ColTypeInt // auto columns internally are integers
+	}
+
+	for _, col := range []*schema.Column{typeCol, fk} {
+		cc, tc, xc := m.buildColumnDef(db, col)
+		if cc == "" {
+			continue // error, already reported
+		}
+		columnClauses = append(columnClauses, "  "+cc)
+		tableClauses = append(tableClauses, tc...)
+		extraClauses = append(extraClauses, xc...)
+	}
+	return
+}
@@ -359,3 +359,10 @@ func sampleSchemaWithSchemaName() schema.Database {
 	//db.fillDefault()
 	return db
 }
+
+func TestNativeEnumTableName(t *testing.T) {
+	assert.Equal(t, "color_enum", nativeEnumTableName(pgColumn{dataType: "USER-DEFINED", udtName: "color_enum_type"}, "_enum"))
+	assert.Equal(t, "", nativeEnumTableName(pgColumn{dataType: "USER-DEFINED", udtName: "color_type"}, "_enum"))
+	assert.Equal(t, "", nativeEnumTableName(pgColumn{dataType: "USER-DEFINED", udtName: "geometry"}, "_enum"))
+	assert.Equal(t, "", nativeEnumTableName(pgColumn{dataType: "character varying", udtName: "varchar"}, "_enum"))
+}
@@ -3,6 +3,7 @@ package pgsql
 import (
 	"context"
 	sqldb "database/sql"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
 	"slices"
@@ -128,10 +129,120 @@ func (m *DB) OperationSql(op Operator, operands []Node, operandStrings []string)
 		s := operandStrings[0]
 		s2 := operandStrings[1]
 		return fmt.Sprintf(`(%s + MAKE_INTERVAL(SECONDS => %s))`, s, s2)
+	case OpDateTruncYear:
+		return fmt.Sprintf(`date_trunc('year', CAST(%s AS TIMESTAMP))`, operandStrings[0])
+	case OpDateTruncMonth:
+		return fmt.Sprintf(`date_trunc('month', CAST(%s AS TIMESTAMP))`, operandStrings[0])
+	case OpDateTruncWeek:
+		return fmt.Sprintf(`date_trunc('week', CAST(%s AS TIMESTAMP))`, operandStrings[0])
+	case OpDateTruncDay:
+		return fmt.Sprintf(`date_trunc('day', CAST(%s AS TIMESTAMP))`, operandStrings[0])
+	case OpDateYear:
+		return fmt.Sprintf(`CAST(EXTRACT(YEAR FROM %s) AS INTEGER)`, operandStrings[0])
+	case OpDateMonth:
+		return fmt.Sprintf(`CAST(EXTRACT(MONTH FROM %s) AS INTEGER)`, operandStrings[0])
+	case OpDateDay:
+		return fmt.Sprintf(`CAST(EXTRACT(DAY FROM %s) AS INTEGER)`, operandStrings[0])
+	case OpDateDayOfWeek:
+		return fmt.Sprintf(`CAST(EXTRACT(DOW FROM %s) AS INTEGER)`, operandStrings[0])
+	case OpDateHour:
+		return fmt.Sprintf(`CAST(EXTRACT(HOUR FROM %s) AS INTEGER)`, operandStrings[0])
+	case OpDateDiffSeconds:
+		// Casting makes date-only columns subtract as intervals rather than as a number of days
+		return fmt.Sprintf(`CAST(EXTRACT(EPOCH FROM (CAST(%s AS TIMESTAMP) - CAST(%s AS TIMESTAMP))) AS BIGINT)`, operandStrings[0], operandStrings[1])
+	case OpNow:
+		return `(NOW() AT TIME ZONE 'UTC')`
+	case OpDateAdd:
+		return fmt.Sprintf(`(CAST(%s AS TIMESTAMP) + CAST(%s AS INTERVAL))`, operandStrings[0], operandStrings[1])
+	case OpArrayContains:
+		return fmt.Sprintf(`(%s @> CAST(ARRAY[%s] AS %s))`, operandStrings[0], operandStrings[1], arrayType(operands[0]))
+	case OpArrayOverlaps:
+		return fmt.Sprintf(`(%s && CAST(ARRAY[%s] AS %s))`, operandStrings[0], operandStrings[1], arrayType(operands[0]))
+	case OpJsonExtract:
+		// #>> '{}' returns the found value as text, the same as ->> does for a single key
+		return fmt.Sprintf(`(jsonb_path_query_first(%s, CAST(%s AS jsonpath)) #>> '{}')`, operandStrings[0], operandStrings[1])
+	case OpJsonContains:
+		return fmt.Sprintf(`(%s @> CAST(%s AS jsonb))`, operandStrings[0], operandStrings[1])
+	case OpJsonHasKey:
+		return fmt.Sprintf(`(%s @? CAST(%s AS jsonpath))`, operandStrings[0], operandStrings[1])
+	case OpMatch:
+		return fmt.Sprintf(`(to_tsvector('%s', %s) @@ plainto_tsquery('%[1]s', %[3]s))`, fullTextConfig, operandStrings[0], operandStrings[1])
+	case OpMatchScore:
+		return fmt.Sprintf(`ts_rank(to_tsvector('%s', %s), plainto_tsquery('%[1]s', %[3]s))`, fullTextConfig, operandStrings[0], operandStrings[1])
+	case OpWithin:
+		// Casting resolves geometry values sent as arguments, which would otherwise have an unknown type
+		return fmt.Sprintf(`ST_Within(CAST(%s AS geometry), CAST(%s AS geometry))`, operandStrings[0], operandStrings[1])
+	case OpIntersects:
+		return fmt.Sprintf(`ST_Intersects(CAST(%s AS geometry), CAST(%s AS geometry))`, operandStrings[0], operandStrings[1])
+	case OpDistance:
+		return fmt.Sprintf(`ST_Distance(CAST(%s AS geometry), CAST(%s AS geometry))`, operandStrings[0], operandStrings[1])
 	}
 	return
 }
 
+// GeometrySelectSql converts a PostGIS geometry column to Well Known Binary.
+func (m *DB) GeometrySelectSql(columnSql string) string {
+	return fmt.Sprintf(`ST_AsBinary(%s)`, columnSql)
+}
+
+// GeometryArg converts g to the hex encoded Well Known Binary text that PostGIS accepts as a geometry.
+func (m *DB) GeometryArg(g Geometry) any {
+	return hex.EncodeToString(g.WKB())
+}
+
+// DurationSelectSql converts an interval column to an integer number of microseconds.
+func (m *DB) DurationSelectSql(columnSql string) string {
+	return fmt.Sprintf(`CAST(EXTRACT(EPOCH FROM %s) * 1000000 AS BIGINT)`, columnSql)
+}
+
+// DurationArg converts d to text that postgres accepts as an interval.
+func (m *DB) DurationArg(d time.Duration) any {
+	return fmt.Sprintf("%d microseconds", d.Microseconds())
+}
+
+// ArraySelectSql converts a native array column to a JSON array.
+func (m *DB) ArraySelectSql(columnSql string) string {
+	return fmt.Sprintf(`CAST(array_to_json(%s) AS TEXT)`, columnSql)
+}
+
+// ArrayArg returns a unchanged, since the pgx driver converts slices to native arrays.
+// A nil slice is converted to an empty array so that it is not saved as a NULL.
+func (m *DB) ArrayArg(a any) any {
+	switch v := a.(type) {
+	case []string:
+		if v == nil {
+			return []string{}
+		}
+	case []int:
+		if v == nil {
+			return []int{}
+		}
+	}
+	return a
+}
+
+// arrayType returns the type of the array column n, which is used to cast the values that are compared to it.
+func arrayType(n Node) string {
+	if c, ok := n.(*ColumnNode); ok && c.SchemaType == schema.ColTypeStringArray {
+		return "TEXT[]"
+	}
+	return "INT[]"
+}
+
+// constraintError returns the database agnostic error for a constraint violation reported by postgres,
+// or nil if err is not a constraint violation.
+func constraintError(table string, err error) error {
+	if pgErr, ok := anyutil.As[*pgconn.PgError](err); ok {
+		switch pgErr.Code {
+		case "23505":
+			return db.NewUniqueValueError(table, nil, err)
+		case "23514":
+			return db.NewCheckConstraintError(table, pgErr.ConstraintName, err)
+		}
+	}
+	return nil
+}
+
 // Insert inserts the given data as a new record in the database.
 // If fields contains a value for an auto-generated primary key, Insert will synchronize postgres to make
 // sure it will not auto generate another key that matches the manually set primary key.
@@ -142,10 +253,8 @@ func (m *DB) Insert(ctx context.Context, table string, fields map[string]any, au
 
 	if autoPkKey == "" || fields[autoPkKey] != nil { // manually set primary key or setting an auto gen primary key to a specific value on insert
 		if _, err := m.SqlExec(ctx, sql, args...); err != nil {
-			if pgErr, ok := anyutil.As[*pgconn.PgError](err); ok {
-				if pgErr.Code == "23505" {
-					return db.NewUniqueValueError(table, nil, err)
-				}
+			if err2 := constraintError(table, err); err2 != nil {
+				return err2
 			}
 			return db.NewQueryError("SqlQuery", sql, args, err)
 		}
@@ -172,10 +281,8 @@ func (m *DB) insertWithReturning(ctx context.Context, table string, pkName strin
 	}
 
 	if err != nil {
-		if pgErr, ok := anyutil.As[*pgconn.PgError](err); ok {
-			if pgErr.Code == "23505" {
-				return 0, db.NewUniqueValueError(table, nil, err)
-			}
+		if err2 := constraintError(table, err); err2 != nil {
+			return 0, err2
 		}
 		return 0, db.NewQueryError("SqlQuery", sql, args, err)
 	} else {
@@ -230,10 +337,8 @@ func (m *DB) Update(ctx context.Context,
 	var result sqldb.Result
 	result, err = m.SqlExec(ctx, s, args...)
 	if err != nil {
-		if pgErr, ok := anyutil.As[*pgconn.PgError](err); ok {
-			if pgErr.Code == "23505" {
-				return db.NewUniqueValueError(table, nil, err)
-			}
+		if err2 := constraintError(table, err); err2 != nil {
+			return err2
 		}
 		return db.NewQueryError("SqlExec", s, args, err)
 	}
@@ -253,6 +358,27 @@ func (m *DB) SupportsForUpdate() bool {
 	return true
 }
 
+// LockSql returns the clause that locks the rows of a query. Postgres cannot lock the nullable side of an
+// outer join, so only the rows of the queried table are locked.
+func (m *DB) LockSql(locks LockParams, table string) string {
+	s := "FOR UPDATE OF "
+	if locks.Mode == LockModeShare {
+		s = "FOR SHARE OF "
+	}
+	s += table
+	if locks.SkipLocked {
+		s += " SKIP LOCKED"
+	} else if locks.NoWait {
+		s += " NOWAIT"
+	}
+	return s + "\n"
+}
+
+// SupportsLateralJoin returns true, since Postgres supports lateral joins.
+func (m *DB) SupportsLateralJoin() bool {
+	return true
+}
+
 // syncIdentity should be called whenever a record is inserted that is manually setting a pk value
 // that is normally generated by the database.
 // Note that table can be of the form "schema.table".
@@ -319,6 +445,20 @@ func (m *DB) WithConstraintsOff(ctx context.Context, f func(ctx context.Context)
 
 // DestroySchema removes all tables and data from the tables found in the given schema s.
 func (m *DB) DestroySchema(ctx context.Context, s schema.Database) error {
+	if len(s.Views) > 0 {
+		var views []string
+		for _, view := range slices.Backward(s.Views) {
+			views = append(views, m.QuoteIdentifier(view.QualifiedName()))
+		}
+		cmd := fmt.Sprintf(`DROP VIEW IF EXISTS %s CASCADE`, strings.Join(views, ","))
+		if _, err := m.SqlExec(ctx, cmd); err != nil {
+			slog.Error("failed to drop views",
+				slog.Any(db.LogError, err),
+			)
+			return err
+		}
+	}
+
 	// gather table names to delete
 	var tables []string
 
@@ -338,6 +478,23 @@ func (m *DB) DestroySchema(ctx context.Context, s schema.Database) error {
 		slog.Error("failed to drop tables",
 			slog.Any(db.LogError, err),
 		)
+		return err
+	}
+
+	// Drop the native enum types after the tables that use them
+	var types []string
+	for _, table := range s.EnumTables {
+		if table.Storage == schema.EnumStorageNative {
+			types = append(types, m.enumTypeName(table))
+		}
+	}
+	if len(types) > 0 {
+		cmd = fmt.Sprintf(`DROP TYPE IF EXISTS %s`, strings.Join(types, ","))
+		if _, err = m.SqlExec(ctx, cmd); err != nil {
+			slog.Error("failed to drop enum types",
+				slog.Any(db.LogError, err),
+			)
+		}
 	}
 	return err
 }
@@ -0,0 +1,27 @@
+package pgsql
+
+import (
+	"testing"
+
+	. "github.com/goradd/gro/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDB_LockSql(t *testing.T) {
+	m := &DB{}
+	tests := []struct {
+		name  string
+		locks LockParams
+		want  string
+	}{
+		{"update", LockParams{Mode: LockModeUpdate}, `FOR UPDATE OF "t0"` + "\n"},
+		{"share", LockParams{Mode: LockModeShare}, `FOR SHARE OF "t0"` + "\n"},
+		{"skip locked", LockParams{Mode: LockModeUpdate, SkipLocked: true}, `FOR UPDATE OF "t0" SKIP LOCKED` + "\n"},
+		{"nowait", LockParams{Mode: LockModeShare, NoWait: true}, `FOR SHARE OF "t0" NOWAIT` + "\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, m.LockSql(tt.locks, m.QuoteIdentifier("t0")))
+		})
+	}
+}
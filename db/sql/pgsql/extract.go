@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"log/slog"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -28,6 +29,7 @@ type pgTable struct {
 	columns []pgColumn
 	indexes []pgIndex
 	fkMap   map[string][]pgForeignKey
+	checks  []*schema.Check
 	comment string
 	options map[string]interface{}
 }
@@ -43,6 +45,8 @@ type pgColumn struct {
 	isIdentity      bool
 	isAutoIncrement bool
 	comment         string
+	generationExpr  sql.NullString
+	udtName         string
 }
 
 type pgIndex struct {
@@ -53,6 +57,8 @@ type pgIndex struct {
 	tableName   string
 	tableSchema string
 	columnName  string
+	columnDef   string
+	where       string
 }
 
 type pgForeignKey struct {
@@ -97,6 +103,8 @@ func (m *DB) getRawTables(options map[string]any) map[string]pgTable {
 
 	foreignKeys := m.getForeignKeys(schemas2, defaultSchemaName)
 
+	checks := m.getChecks(schemas2, defaultSchemaName)
+
 	for _, table := range tables {
 		tableIndex := table.name
 		if table.schema != "" {
@@ -122,6 +130,7 @@ func (m *DB) getRawTables(options map[string]any) map[string]pgTable {
 		columns := m.getColumns(table.name, table.schema)
 
 		table.indexes = indexes[tableIndex]
+		table.checks = checks[tableIndex]
 		table.columns = columns
 		tableMap[tableIndex] = table
 	}
@@ -196,7 +205,9 @@ func (m *DB) getColumns(table string, schema string) (columns []pgColumn) {
 	c.is_identity,
 	c.identity_generation,
 	pgd.description,
-	c.collation_name
+	c.collation_name,
+	c.generation_expression,
+	c.udt_name
 FROM
 	information_schema.columns as c
 JOIN 
@@ -238,6 +249,8 @@ ORDER BY
 			&(identGen.R),
 			&descr,
 			&col.collationName,
+			&col.generationExpr,
+			&col.udtName,
 		)
 		if err != nil {
 			panic(err)
@@ -280,7 +293,9 @@ func (m *DB) getIndexes(schemas []string, defaultSchemaName string) (indexes map
        tnsp.nspname as table_schema,
 	   pgi.indisunique,
 	   pgi.indisprimary,
-	   a.attname as column_name
+	   a.attname as column_name,
+	   pg_get_indexdef(pgi.indexrelid, a.attnum, true) as column_def,
+	   coalesce(pg_get_expr(pgi.indpred, pgi.indrelid, true), '') as index_where
 from pg_index pgi
   join pg_class idx on idx.oid = pgi.indexrelid
   join pg_namespace insp on insp.oid = idx.relnamespace
@@ -303,7 +318,7 @@ order by
 
 	for rows.Next() {
 		index = pgIndex{}
-		err = rows.Scan(&index.name, &index.schema, &index.tableName, &index.tableSchema, &index.unique, &index.primary, &index.columnName)
+		err = rows.Scan(&index.name, &index.schema, &index.tableName, &index.tableSchema, &index.unique, &index.primary, &index.columnName, &index.columnDef, &index.where)
 		if err != nil {
 			panic(err)
 		}
@@ -323,6 +338,70 @@ order by
 	return indexes
 }
 
+// indexExpressionRegex matches an index column that applies a function to a column, like lower((email)::text).
+var indexExpressionRegex = regexp.MustCompile(`^(\w+)\(\(?"?(\w+)"?\)?(?:::[\w ]+)?\)$`)
+
+// parseIndexExpression returns the function and the column of an index column definition
+// if it is an expression that applies a function to a single column.
+func parseIndexExpression(def string) (function, column string) {
+	if m := indexExpressionRegex.FindStringSubmatch(def); m != nil {
+		return m[1], m[2]
+	}
+	return
+}
+
+// getChecks gets the check constraints, keyed by table.
+func (m *DB) getChecks(schemas []string, defaultSchemaName string) (checks map[string][]*schema.Check) {
+	checks = make(map[string][]*schema.Check)
+
+	s := fmt.Sprintf(`
+SELECT
+	con.conname,
+	tbl.relname,
+	nsp.nspname,
+	pg_get_constraintdef(con.oid)
+FROM pg_constraint con
+	JOIN pg_class tbl ON tbl.oid = con.conrelid
+	JOIN pg_namespace nsp ON nsp.oid = tbl.relnamespace
+WHERE
+	con.contype = 'c' AND
+	nsp.nspname IN ('%s')
+ORDER BY
+	con.conname
+`, strings.Join(schemas, "','"))
+
+	rows, err := m.SqlDb().Query(s)
+	if err != nil {
+		panic(err)
+	}
+	defer sql2.RowClose(rows)
+
+	for rows.Next() {
+		var name, tableName, tableSchema, def string
+		if err = rows.Scan(&name, &tableName, &tableSchema, &def); err != nil {
+			panic(err)
+		}
+		// The definition looks like: CHECK ((price >= 0))
+		def = strings.TrimSuffix(def, " NOT VALID")
+		def = strings.TrimPrefix(def, "CHECK ")
+		if strings.HasPrefix(def, "(") && strings.HasSuffix(def, ")") {
+			def = def[1 : len(def)-1]
+		}
+		key := tableName
+		if tableSchema != defaultSchemaName && tableSchema != "" {
+			key = tableSchema + "." + tableName
+		}
+		checks[key] = append(checks[key], &schema.Check{
+			Name: name,
+			Sql:  map[string]string{db.DriverTypePostgres: def},
+		})
+	}
+	if err = rows.Err(); err != nil {
+		panic(err)
+	}
+	return checks
+}
+
 // getForeignKeys gets information on the foreign keys.
 func (m *DB) getForeignKeys(schemas []string, defaultSchemaName string) (foreignKeys map[string][]pgForeignKey) {
 	foreignKeys = make(map[string][]pgForeignKey)
@@ -418,6 +497,9 @@ func processTypeInfo(column pgColumn) (
 		"datetime", "timestamp without time zone", "date":
 		typ = schema.ColTypeTime
 
+	case "interval":
+		typ = schema.ColTypeDuration
+
 	case "boolean":
 		typ = schema.ColTypeBool
 
@@ -516,9 +598,38 @@ func (m *DB) schemaFromRawTables(rawTables map[string]pgTable, options map[strin
 			dd.Tables = append(dd.Tables, &t)
 		}
 	}
+
+	// Enum tables whose values are stored in a native enum type also have a key column, so tell them apart
+	// by the columns that use the type.
+	var nativeEnumTables maps.Set[string]
+	for _, rawTable := range rawTables {
+		for _, col := range rawTable.columns {
+			if name := nativeEnumTableName(col, dd.EnumTableSuffix); name != "" {
+				nativeEnumTables.Add(name)
+			}
+		}
+	}
+	for _, et := range dd.EnumTables {
+		if nativeEnumTables.Has(et.Name) {
+			et.Storage = schema.EnumStorageNative
+		}
+	}
 	return dd
 }
 
+// nativeEnumTableName returns the name of the enum table whose native enum type is the type of column,
+// or an empty string if column does not have such a type. See schema.EnumTable.NativeTypeName.
+func nativeEnumTableName(column pgColumn, enumTableSuffix string) string {
+	if column.dataType != "USER-DEFINED" || enumTableSuffix == "" {
+		return ""
+	}
+	name, ok := strings.CutSuffix(column.udtName, "_type")
+	if !ok || !strings.HasSuffix(name, enumTableSuffix) {
+		return ""
+	}
+	return name
+}
+
 func (m *DB) getTableSchema(t pgTable, enumTableSuffix string) schema.Table {
 	var columnSchemas []*schema.Column
 	var referenceSchemas []*schema.Reference
@@ -529,9 +640,14 @@ func (m *DB) getTableSchema(t pgTable, enumTableSuffix string) schema.Table {
 	singleIndexes := make(map[string]schema.IndexLevel)
 
 	for _, idx := range t.indexes {
+		columnName := idx.columnName
+		function, exprColumn := parseIndexExpression(idx.columnDef)
+		if function != "" {
+			columnName = exprColumn
+		}
 		if i, ok := indexes[idx.name]; ok {
 			// add a column to the previously found index
-			i.Columns = append(i.Columns, idx.columnName)
+			i.Columns = append(i.Columns, columnName)
 		} else {
 			// create a new index
 			var level schema.IndexLevel
@@ -542,7 +658,10 @@ func (m *DB) getTableSchema(t pgTable, enumTableSuffix string) schema.Table {
 			} else {
 				level = schema.IndexLevelIndexed
 			}
-			mci := &schema.Index{Columns: []string{idx.columnName}, IndexLevel: level}
+			mci := &schema.Index{Columns: []string{columnName}, IndexLevel: level, Function: function}
+			if idx.where != "" {
+				mci.WhereSql = map[string]string{db.DriverTypePostgres: idx.where}
+			}
 			indexes[idx.name] = mci
 		}
 	}
@@ -552,6 +671,9 @@ func (m *DB) getTableSchema(t pgTable, enumTableSuffix string) schema.Table {
 	// we prioritize by the value of the index level.
 	// We don't support the esoteric index types yet.
 	for _, idx := range indexes {
+		if idx.Function != "" || idx.IsPartial() {
+			continue // these cannot be described by the column, and will be added to the table
+		}
 		if len(idx.Columns) == 1 {
 			if level, ok := singleIndexes[idx.Columns[0]]; ok {
 				if idx.IndexLevel > level {
@@ -590,12 +712,13 @@ func (m *DB) getTableSchema(t pgTable, enumTableSuffix string) schema.Table {
 		Columns:    columnSchemas,
 		Comment:    t.comment,
 		References: referenceSchemas,
+		Checks:     t.checks,
 	}
 
 	// Create the  index array
 	for _, idx := range indexes {
-		if len(idx.Columns) > 1 {
-			// only do multi-column indexes, since single column indexes should be specified in the column definition
+		if len(idx.Columns) > 1 || idx.Function != "" || idx.IsPartial() {
+			// only do multi-column and expression indexes, since single column indexes should be specified in the column definition
 			td.Indexes = append(td.Indexes, idx)
 		}
 	}
@@ -707,6 +830,11 @@ ORDER BY
 	ed.Comment = t.comment
 	delete(ed.Fields, schema.ValueKey)
 	delete(ed.Fields, schema.NameKey)
+	if _, ok := ed.Fields[schema.KeyKey]; ok {
+		// A key column is only created when columns refer to the enum by key
+		ed.Storage = schema.EnumStorageKey
+		delete(ed.Fields, schema.KeyKey)
+	}
 	if len(ed.Fields) == 0 {
 		ed.Fields = nil
 	}
@@ -734,6 +862,13 @@ func (m *DB) getColumnSchema(table pgTable,
 
 	columnSchema.IsNullable = column.isNullable
 
+	if name := nativeEnumTableName(column, enumTableSuffix); name != "" {
+		// A native enum type takes the place of a foreign key to the enum table
+		columnSchema.Type = schema.ColTypeEnum
+		columnSchema.Size = 0
+		columnSchema.EnumTable = name
+	}
+
 	fkGroup := table.findForeignKeyGroupByColumn(columnSchema.Name)
 	if len(fkGroup) > 1 {
 		slog.Warn("Multi-column foreign keys are not currently supported.",
@@ -770,6 +905,18 @@ func (m *DB) getColumnSchema(table pgTable,
 		if column.collationName.Valid && column.collationName.String != "" {
 			columnSchema.DatabaseDefinition = map[string]map[string]any{db.DriverTypePostgres: {"collation": column.collationName.String}}
 		}
+		if column.generationExpr.String != "" {
+			// Generated columns are computed by the database, so remember the expression so the column can be recreated.
+			columnSchema.IsGenerated = true
+			columnSchema.DefaultValue = nil
+			if columnSchema.DatabaseDefinition == nil {
+				columnSchema.DatabaseDefinition = make(map[string]map[string]any)
+			}
+			if columnSchema.DatabaseDefinition[db.DriverTypePostgres] == nil {
+				columnSchema.DatabaseDefinition[db.DriverTypePostgres] = make(map[string]any)
+			}
+			columnSchema.DatabaseDefinition[db.DriverTypePostgres]["generated"] = column.generationExpr.String
+		}
 	}
 
 	return
@@ -792,6 +939,7 @@ func (m *DB) getAssociationSchema(t pgTable, enumTableSuffix string) (mm schema.
 	mm.Ref1.Column = td.References[0].Column
 	mm.Ref2.Table = td.References[1].Table
 	mm.Ref2.Column = td.References[1].Column
+	mm.Columns = td.Columns
 	mm.Comment = t.comment
 	return
 }
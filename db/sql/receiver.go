@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"log/slog"
@@ -288,6 +289,116 @@ func (r SqlReceiver) doubleI() interface{} {
 		return nil
 	}
 }
+
+// decimalI returns the value as an interface to a Decimal
+func (r SqlReceiver) decimalI() interface{} {
+	if r.R == nil {
+		return nil
+	}
+	var s string
+	switch v := r.R.(type) {
+	case int64:
+		return NewDecimal(v, 0)
+	case float64:
+		return NewDecimalFromFloat(v)
+	case string:
+		s = v
+	case []byte:
+		s = string(v[:])
+		if s == "NULL" {
+			return nil
+		} // MariaDB does this for default values
+	default:
+		s = fmt.Sprint(r.R)
+	}
+	d, err := ParseDecimal(s)
+	if err != nil {
+		slog.Error("Attempting to scan a non-decimal into a Decimal",
+			slog.String(db.LogComponent, "SQL receiver"),
+			slog.Any(db.LogError, err))
+	}
+	return d
+}
+
+// geometryI returns the value as an interface to a Geometry.
+// Geometry columns are selected as Well Known Binary.
+func (r SqlReceiver) geometryI() interface{} {
+	if r.R == nil {
+		return nil
+	}
+	var b []byte
+	switch v := r.R.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		slog.Error("Unknown type returned from sql driver",
+			slog.String(db.LogComponent, "SQL receiver"))
+		return nil
+	}
+	g, err := GeometryFromWKB(b)
+	if err != nil {
+		slog.Error("Attempting to scan a non-geometry into a Geometry",
+			slog.String(db.LogComponent, "SQL receiver"),
+			slog.Any(db.LogError, err))
+	}
+	return g
+}
+
+// durationI returns the value as an interface to a time.Duration.
+// Duration columns are selected as an integer number of microseconds.
+func (r SqlReceiver) durationI() interface{} {
+	i := r.int64I()
+	if i == nil {
+		return nil
+	}
+	return time.Duration(i.(int64)) * time.Microsecond
+}
+
+// stringArrayI returns the value as an interface to a []string.
+// Array columns are selected as a JSON array.
+func (r SqlReceiver) stringArrayI() interface{} {
+	a := []string{}
+	if !r.unmarshalArray(&a) {
+		return nil
+	}
+	return a
+}
+
+// intArrayI returns the value as an interface to a []int.
+// Array columns are selected as a JSON array.
+func (r SqlReceiver) intArrayI() interface{} {
+	a := []int{}
+	if !r.unmarshalArray(&a) {
+		return nil
+	}
+	return a
+}
+
+// unmarshalArray decodes a JSON array into a, returning false if the value is NULL.
+func (r SqlReceiver) unmarshalArray(a any) bool {
+	var b []byte
+	switch v := r.R.(type) {
+	case nil:
+		return false
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		slog.Error("Unknown type returned from sql driver",
+			slog.String(db.LogComponent, "SQL receiver"))
+		return false
+	}
+	if err := json.Unmarshal(b, a); err != nil {
+		slog.Error("Attempting to scan a non-array into an array",
+			slog.String(db.LogComponent, "SQL receiver"),
+			slog.Any(db.LogError, err))
+	}
+	return true
+}
+
 func (r SqlReceiver) autoPK() interface{} {
 	if r.R == nil {
 		return nil
@@ -352,6 +463,16 @@ func (r SqlReceiver) Unpack(typ ReceiverType) interface{} {
 		return r.boolI()
 	case ColTypeAutoPrimaryKey:
 		return r.autoPK()
+	case ColTypeDecimal:
+		return r.decimalI()
+	case ColTypeGeometry:
+		return r.geometryI()
+	case ColTypeDuration:
+		return r.durationI()
+	case ColTypeStringArray:
+		return r.stringArrayI()
+	case ColTypeIntArray:
+		return r.intArrayI()
 	default:
 		return r.R
 	}
@@ -401,6 +522,9 @@ func (r SqlReceiver) UnpackDefaultValue(typ schema.ColumnType, size int) interfa
 		return r.boolI()
 	case schema.ColTypeAutoPrimaryKey:
 		return NewAutoPrimaryKey(r.R)
+	case schema.ColTypeDuration:
+		// Database interval formats do not correspond to Go duration strings, so the default is not imported.
+		return nil
 	default:
 		return r.R
 	}
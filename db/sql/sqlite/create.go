@@ -1,9 +1,12 @@
 package sqlite
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/goradd/anyutil"
 	"github.com/goradd/gro/db"
@@ -19,7 +22,16 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 	var tableClauses []string
 
 	for _, col := range table.Columns {
-		colDef, tc, xc := m.buildColumnDef(col)
+		if col.IsGenerated && col.GeneratedExpression(db.DriverTypeSQLite) == "" {
+			slog.Error("Table skipped, generated column has no expression for this database.",
+				slog.String(db.LogTable, table.QualifiedName()),
+				slog.String(db.LogColumn, col.Name))
+			return "", nil
+		}
+	}
+
+	for _, col := range table.Columns {
+		colDef, tc, xc := m.buildColumnDef(d, col)
 		if colDef == "" {
 			continue // error, already reported
 		}
@@ -30,6 +42,13 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 
 	// build the foreign keys
 	for _, ref := range table.References {
+		if ref.IsPolymorphic() {
+			cc, tc, xc := m.buildPolymorphicDefs(d, ref)
+			columnDefs = append(columnDefs, cc...)
+			tableClauses = append(tableClauses, tc...)
+			extraClauses = append(extraClauses, xc...)
+			continue
+		}
 		cc, tc, xc := m.buildReferenceDef(d, table, ref)
 		if cc == "" {
 			continue // error, already reported
@@ -40,6 +59,10 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 	}
 
 	for _, mci := range table.Indexes {
+		if mci.IndexLevel == schema.IndexLevelFullText {
+			extraClauses = append(extraClauses, m.fullTextSql(table, mci)...)
+			continue
+		}
 		tSql, extraSql := m.indexSql(table, mci)
 		if tSql != "" {
 			tableClauses = append(tableClauses, tSql)
@@ -49,6 +72,12 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 		}
 	}
 
+	for _, chk := range table.Checks {
+		if c := m.checkSql(chk); c != "" {
+			tableClauses = append(tableClauses, c)
+		}
+	}
+
 	tableName := table.QualifiedName()
 	columnDefs = append(columnDefs, tableClauses...)
 	if table.Comment != "" {
@@ -65,7 +94,7 @@ func (m *DB) TableDefinitionSql(d *schema.Database, table *schema.Table) (tableS
 // ColumnDefinitionSql returns the sql that will create the column col.
 // This will include single-column foreign key references.
 // This will not include a primary key designation.
-func (m *DB) buildColumnDef(col *schema.Column) (s string, tableClauses []string, extraClauses []string) {
+func (m *DB) buildColumnDef(d *schema.Database, col *schema.Column) (s string, tableClauses []string, extraClauses []string) {
 	var colType string
 	var collation string
 	var defaultStr string
@@ -87,12 +116,25 @@ func (m *DB) buildColumnDef(col *schema.Column) (s string, tableClauses []string
 			return
 		}
 
-		fk := fmt.Sprintf(" FOREIGN KEY (%s) REFERENCES %s(%s)",
-			m.QuoteIdentifier(col.Name),
-			m.QuoteIdentifier(col.EnumTable),
-			m.QuoteIdentifier(schema.ValueKey))
-		tableClauses = append(tableClauses, fk)
-		colType = "INTEGER" // NOT INT!
+		if et := d.FindEnumTable(col.EnumTable); et != nil && et.Storage != schema.EnumStorageInteger {
+			// SQLite has no native enum type, so native storage also stores the key
+			fk := fmt.Sprintf(" FOREIGN KEY (%s) REFERENCES %s(%s)",
+				m.QuoteIdentifier(col.Name),
+				m.QuoteIdentifier(col.EnumTable),
+				m.QuoteIdentifier(schema.KeyKey))
+			tableClauses = append(tableClauses, fk)
+			colType = "TEXT"
+			if i, ok := col.DefaultValue.(int); ok && defaultStr == "" {
+				defaultStr = fmt.Sprintf("DEFAULT '%s'", et.KeyOf(i))
+			}
+		} else {
+			fk := fmt.Sprintf(" FOREIGN KEY (%s) REFERENCES %s(%s)",
+				m.QuoteIdentifier(col.Name),
+				m.QuoteIdentifier(col.EnumTable),
+				m.QuoteIdentifier(schema.ValueKey))
+			tableClauses = append(tableClauses, fk)
+			colType = "INTEGER" // NOT INT!
+		}
 	} else {
 		colType = sqlType(col.Type, col.Size, col.SubType)
 		if col.Type == schema.ColTypeAutoPrimaryKey {
@@ -100,6 +142,10 @@ func (m *DB) buildColumnDef(col *schema.Column) (s string, tableClauses []string
 		}
 	}
 
+	if col.IsGenerated {
+		colType += fmt.Sprintf(" GENERATED ALWAYS AS (%s) STORED", col.GeneratedExpression(db.DriverTypeSQLite))
+	}
+
 	if !col.IsNullable {
 		colType += " NOT NULL"
 	}
@@ -115,6 +161,9 @@ func (m *DB) buildColumnDef(col *schema.Column) (s string, tableClauses []string
 				} else {
 					defaultStr = fmt.Sprintf("DEFAULT '%s'", val)
 				}
+			} else if col.Type == schema.ColTypeDuration {
+				d, _ := time.ParseDuration(val)
+				defaultStr = fmt.Sprintf("DEFAULT %d", d.Microseconds())
 			} else {
 				defaultStr = fmt.Sprintf("DEFAULT '%s'", val)
 			}
@@ -140,7 +189,7 @@ func sqlType(colType schema.ColumnType, size uint64, subType schema.ColumnSubTyp
 	case schema.ColTypeAutoPrimaryKey:
 		return "INTEGER"
 	case schema.ColTypeString:
-		if subType == schema.ColSubTypeNumeric {
+		if subType.IsNumeric() {
 			return "TEXT" // NUMERIC is not infinite precision, just allows sqlite to convert to int or real as needed.
 		} else {
 			return "TEXT"
@@ -168,6 +217,14 @@ func sqlType(colType schema.ColumnType, size uint64, subType schema.ColumnSubTyp
 		return "INTEGER"
 	case schema.ColTypeEnum:
 		return "INTEGER"
+	case schema.ColTypeStringArray, schema.ColTypeIntArray, schema.ColTypeEnumArray:
+		return "TEXT" // JSON encoded array
+	case schema.ColTypeDuration:
+		return "INTEGER" // microseconds
+	case schema.ColTypeEnumSet:
+		return "INTEGER" // bit flags
+	case schema.ColTypeGeometry:
+		return "BLOB" // Well Known Binary
 	case schema.ColTypeJSON:
 		return "BLOB" // use new jsonb format
 	default:
@@ -183,6 +240,29 @@ func (m *DB) indexSql(table *schema.Table, i *schema.Index) (tableSql string, ex
 		return m.QuoteIdentifier(s)
 	})
 
+	where := i.WhereFor(db.DriverTypeSQLite)
+	if i.Function != "" || where != "" {
+		// Expression and partial indexes cannot be table constraints, so are added after the table definition
+		if i.Function != "" {
+			quotedCols = anyutil.MapSliceFunc(quotedCols, func(s string) string {
+				return i.Function + "(" + s + ")"
+			})
+		}
+		var unique string
+		if i.IndexLevel == schema.IndexLevelUnique {
+			unique = "UNIQUE "
+		}
+		extraSql = fmt.Sprintf("CREATE %sINDEX %s ON %s (%s)",
+			unique,
+			m.QuoteIdentifier(i.Name),
+			m.QuoteIdentifier(table.Name),
+			strings.Join(quotedCols, ","))
+		if where != "" {
+			extraSql += " WHERE " + where
+		}
+		return
+	}
+
 	var idxType string
 	switch i.IndexLevel {
 	case schema.IndexLevelPrimaryKey:
@@ -216,6 +296,74 @@ func (m *DB) indexSql(table *schema.Table, i *schema.Index) (tableSql string, ex
 	return
 }
 
+// fullTextSql returns the sql that creates an FTS5 shadow table for a full-text index, along with
+// the triggers that keep the shadow table synchronized with the table.
+func (m *DB) fullTextSql(table *schema.Table, i *schema.Index) (extraSql []string) {
+	tableName := m.QuoteIdentifier(table.Name)
+	ftsName := m.QuoteIdentifier(fullTextTableName(table.Name, i.Columns[0]))
+	col := m.QuoteIdentifier(i.Columns[0])
+	triggerPrefix := fullTextTableName(table.Name, i.Columns[0])
+
+	extraSql = append(extraSql,
+		fmt.Sprintf("CREATE VIRTUAL TABLE %s USING fts5(%s, content=%s, content_rowid='rowid')",
+			ftsName, col, tableName),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER INSERT ON %s BEGIN INSERT INTO %s(rowid, %s) VALUES (new.rowid, new.%s); END",
+			m.QuoteIdentifier(triggerPrefix+"_ai"), tableName, ftsName, col, col),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER DELETE ON %s BEGIN INSERT INTO %s(%[3]s, rowid, %s) VALUES ('delete', old.rowid, old.%[4]s); END",
+			m.QuoteIdentifier(triggerPrefix+"_ad"), tableName, ftsName, col),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER UPDATE OF %s ON %s BEGIN INSERT INTO %s(%[4]s, rowid, %[2]s) VALUES ('delete', old.rowid, old.%[2]s); INSERT INTO %[4]s(rowid, %[2]s) VALUES (new.rowid, new.%[2]s); END",
+			m.QuoteIdentifier(triggerPrefix+"_au"), col, tableName, ftsName),
+	)
+	return
+}
+
+// fullTextDropSql returns the sql that drops the FTS5 shadow tables of the full-text indexes of table, along with
+// their triggers.
+func (m *DB) fullTextDropSql(table *schema.Table) (dropSql []string) {
+	var columns []string
+	for _, i := range table.Indexes {
+		if i.IndexLevel == schema.IndexLevelFullText && len(i.Columns) == 1 {
+			columns = append(columns, i.Columns[0])
+		}
+	}
+	// The schema might not be cleaned yet, in which case the index is still on the column
+	for _, col := range table.Columns {
+		if col.IndexLevel == schema.IndexLevelFullText && !slices.Contains(columns, col.Name) {
+			columns = append(columns, col.Name)
+		}
+	}
+	for _, col := range columns {
+		ftsName := fullTextTableName(table.Name, col)
+		for _, suffix := range []string{"_ai", "_ad", "_au"} {
+			dropSql = append(dropSql, "DROP TRIGGER IF EXISTS "+m.QuoteIdentifier(ftsName+suffix))
+		}
+		dropSql = append(dropSql, "DROP TABLE IF EXISTS "+m.QuoteIdentifier(ftsName))
+	}
+	return
+}
+
+// DestroySchema removes all tables and data from the tables found in the given schema s,
+// including the shadow tables of full-text indexes.
+func (m *DB) DestroySchema(ctx context.Context, s schema.Database) error {
+	for _, table := range s.Tables {
+		for _, stmt := range m.fullTextDropSql(table) {
+			if _, err := m.SqlExec(ctx, stmt); err != nil {
+				slog.Error("failed to drop full-text index",
+					slog.String(db.LogTable, table.Name),
+					slog.Any(db.LogError, err),
+				)
+				return err
+			}
+		}
+	}
+	return m.Base.DestroySchema(ctx, s)
+}
+
+// fullTextTableName returns the name of the FTS5 shadow table for a full-text index on column.
+func fullTextTableName(table, column string) string {
+	return table + "_" + column + "_fts"
+}
+
 func (m *DB) buildReferenceDef(db *schema.Database, table *schema.Table, ref *schema.Reference) (columnClause string, tableClauses, extraClauses []string) {
 	fk, pk := ref.ReferenceColumns(db, table)
 
@@ -223,7 +371,7 @@ func (m *DB) buildReferenceDef(db *schema.Database, table *schema.Table, ref *sc
 		fk.Type = schema.ColTypeInt // auto columns internally are integers
 	}
 
-	columnClause, tableClauses, extraClauses = m.buildColumnDef(fk)
+	columnClause, tableClauses, extraClauses = m.buildColumnDef(db, fk)
 	if columnClause == "" {
 		return // error, already logged
 	}
@@ -234,6 +382,49 @@ func (m *DB) buildReferenceDef(db *schema.Database, table *schema.Table, ref *sc
 		m.QuoteIdentifier(fk.Name),
 		m.QuoteIdentifier(ref.Table),
 		m.QuoteIdentifier(pk.Name))
+	if a := ref.OnDelete.Sql(); a != "" {
+		s += " ON DELETE " + a
+	}
 	tableClauses = append(tableClauses, s)
 	return
 }
+
+// checkSql returns the table clause that creates the check constraint.
+func (m *DB) checkSql(chk *schema.Check) string {
+	expr := chk.ExpressionFor(db.DriverTypeSQLite)
+	if expr == "" {
+		slog.Warn("Check skipped, no expression was given for this database.",
+			slog.String("check", chk.Name))
+		return ""
+	}
+	return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", m.QuoteIdentifier(chk.Name), expr)
+}
+
+// ViewDefinitionSql returns the sql that will create the view.
+func (m *DB) ViewDefinitionSql(_ *schema.Database, view *schema.View) string {
+	s := view.SqlFor(db.DriverTypeSQLite)
+	if s == "" {
+		return ""
+	}
+	return fmt.Sprintf("CREATE VIEW %s AS %s", m.QuoteIdentifier(view.QualifiedName()), s)
+}
+
+// buildPolymorphicDefs returns the definitions of the columns of a polymorphic reference.
+// Polymorphic references cannot be enforced with foreign keys, so no constraint is created.
+func (m *DB) buildPolymorphicDefs(db *schema.Database, ref *schema.Reference) (columnClauses, tableClauses, extraClauses []string) {
+	typeCol, fk := ref.PolymorphicColumns(db)
+	if fk.Type == schema.ColTypeAutoPrimaryKey {
+		fk.Type = schema.ColTypeInt // auto columns internally are integers
+	}
+
+	for _, col := range []*schema.Column{typeCol, fk} {
+		cc, tc, xc := m.buildColumnDef(db, col)
+		if cc == "" {
+			continue // error, already reported
+		}
+		columnClauses = append(columnClauses, "  "+cc)
+		tableClauses = append(tableClauses, tc...)
+		extraClauses = append(extraClauses, xc...)
+	}
+	return
+}
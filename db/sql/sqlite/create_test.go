@@ -2,8 +2,10 @@ package sqlite
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
+	"github.com/goradd/gro/db"
 	"github.com/goradd/gro/query"
 	"github.com/goradd/gro/schema"
 	"github.com/stretchr/testify/assert"
@@ -249,3 +251,58 @@ func sampleSchemaWithSchemaName() schema.Database {
 	//db.fillDefault()
 	return db
 }
+
+func TestDB_DestroyFullText(t *testing.T) {
+	d, err := NewDB("test", filepath.Join(t.TempDir(), "fts.db"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	s := schema.Database{
+		Key: "test",
+		Tables: []*schema.Table{
+			{
+				Name: "note",
+				Columns: []*schema.Column{
+					{Name: "id", Type: schema.ColTypeAutoPrimaryKey},
+					{Name: "body", Type: schema.ColTypeString, Size: 1000, IndexLevel: schema.IndexLevelFullText},
+				},
+			},
+		},
+	}
+	require.NoError(t, d.CreateSchema(ctx, s))
+	require.NoError(t, d.DestroySchema(ctx, s))
+
+	var count int
+	require.NoError(t, d.SqlDb().QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE name LIKE 'note%'`).Scan(&count))
+	assert.Zero(t, count)
+
+	// The schema can be created again in the same database
+	require.NoError(t, d.CreateSchema(ctx, s))
+	require.NoError(t, d.DestroySchema(ctx, s))
+}
+
+func TestDB_GeneratedWithoutExpression(t *testing.T) {
+	d, err := NewDB("test", "")
+	require.NoError(t, err)
+
+	s := schema.Database{
+		Key: "test",
+		Tables: []*schema.Table{
+			{
+				Name: "person",
+				Columns: []*schema.Column{
+					{Name: "id", Type: schema.ColTypeAutoPrimaryKey},
+					{Name: "name", Type: schema.ColTypeString, Size: 100},
+					{
+						Name:               "upper_name",
+						Type:               schema.ColTypeString,
+						Size:               100,
+						IsGenerated:        true,
+						DatabaseDefinition: map[string]map[string]any{db.DriverTypePostgres: {"generated": "upper(name)"}},
+					},
+				},
+			},
+		},
+	}
+	assert.Error(t, d.CreateSchema(context.Background(), s))
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/goradd/gro/db"
 	sql2 "github.com/goradd/gro/db/sql"
 	. "github.com/goradd/gro/query"
-	"github.com/goradd/gro/schema"
 	"modernc.org/sqlite"
 )
 
@@ -22,6 +21,9 @@ import (
 // INT, REAL, or TEXT at will, and may lose precision in the process. Therefore, these values are
 // stored as TEXT in SQLite, and no numeric operations can be preformed on these values.
 //
+// Geometry values are stored as Well Known Binary in a BLOB, but since SQLite has no built-in spatial functions,
+// spatial operations like op.Within will panic.
+//
 // Cyclic foreign keys cannot be created using the modernc driver, because to do this in SQLite requires
 // executing multiple create table statements at one time, and the modernc driver does not support this.
 // Instead, you will need to execute the CREATE TABLE statements outside the ORM.
@@ -100,10 +102,70 @@ func (m *DB) OperationSql(op Operator, operands []Node, operandStrings []string)
 		}
 		return fmt.Sprintf(`datetime(%s, '%s%s seconds')`, s, modifier, s2)
 
+	case OpDateTruncYear:
+		return fmt.Sprintf(`datetime(%s, 'start of year')`, sqliteTime(operandStrings[0]))
+	case OpDateTruncMonth:
+		return fmt.Sprintf(`datetime(%s, 'start of month')`, sqliteTime(operandStrings[0]))
+	case OpDateTruncWeek:
+		// Move forward to Sunday, then back to the Monday before it
+		return fmt.Sprintf(`datetime(%s, 'weekday 0', '-6 days', 'start of day')`, sqliteTime(operandStrings[0]))
+	case OpDateTruncDay:
+		return fmt.Sprintf(`datetime(%s, 'start of day')`, sqliteTime(operandStrings[0]))
+	case OpDateYear:
+		return fmt.Sprintf(`CAST(strftime('%%Y', %s) AS INTEGER)`, sqliteTime(operandStrings[0]))
+	case OpDateMonth:
+		return fmt.Sprintf(`CAST(strftime('%%m', %s) AS INTEGER)`, sqliteTime(operandStrings[0]))
+	case OpDateDay:
+		return fmt.Sprintf(`CAST(strftime('%%d', %s) AS INTEGER)`, sqliteTime(operandStrings[0]))
+	case OpDateDayOfWeek:
+		return fmt.Sprintf(`CAST(strftime('%%w', %s) AS INTEGER)`, sqliteTime(operandStrings[0]))
+	case OpDateHour:
+		return fmt.Sprintf(`CAST(strftime('%%H', %s) AS INTEGER)`, sqliteTime(operandStrings[0]))
+	case OpDateDiffSeconds:
+		return fmt.Sprintf(`(unixepoch(%s) - unixepoch(%s))`, sqliteTime(operandStrings[0]), sqliteTime(operandStrings[1]))
+	case OpNow:
+		return `datetime('now')`
+	case OpDateAdd:
+		// Durations are stored as microseconds
+		return fmt.Sprintf(`datetime(%s, ((%s) / 1000000.0) || ' seconds')`, sqliteTime(operandStrings[0]), operandStrings[1])
+	case OpArrayContains, OpArrayOverlaps:
+		// Arrays are stored as JSON
+		return fmt.Sprintf(`EXISTS (SELECT 1 FROM json_each(%s) WHERE json_each.value IN (%s))`, operandStrings[0], operandStrings[1])
+
+	case OpJsonExtract:
+		// json_extract returns numbers as numbers, so cast the value to text the way the other databases return it
+		return fmt.Sprintf(`CAST(json_extract(%s, %s) AS TEXT)`, operandStrings[0], operandStrings[1])
+
+	case OpJsonContains:
+		// SQLite has no containment function, so look for each top level value of the document in the column.
+		// Object members must match by key, and array elements by value only. Nested objects and arrays must match exactly.
+		return fmt.Sprintf(`NOT EXISTS (SELECT 1 FROM (SELECT json(%[2]s) AS doc_) AS jd_, json_each(jd_.doc_) AS d_ `+
+			`WHERE NOT EXISTS (SELECT 1 FROM json_each(%[1]s) AS c_ `+
+			`WHERE (json_type(jd_.doc_) <> 'object' OR c_.key = d_.key) AND c_.type = d_.type AND `+
+			`(CASE WHEN c_.type IN ('object', 'array') THEN json(c_.value) = json(d_.value) ELSE c_.value IS d_.value END)))`,
+			operandStrings[0], operandStrings[1])
+
+	case OpJsonHasKey:
+		return fmt.Sprintf(`(json_type(%s, %s) IS NOT NULL)`, operandStrings[0], operandStrings[1])
+
+	case OpMatch, OpMatchScore:
+		// Full-text searches go through the FTS5 shadow table, which shares its rowid with the searched table
+		cn, ok := operands[0].(*ColumnNode)
+		if !ok {
+			panic("the first operand of a full-text search must be a column node")
+		}
+		ftsName := m.QuoteIdentifier(fullTextTableName(cn.TableName_(), cn.QueryName))
+		rowId := strings.TrimSuffix(operandStrings[0], m.QuoteIdentifier(cn.QueryName)) + "rowid"
+		if op == OpMatch {
+			return fmt.Sprintf(`(%s IN (SELECT rowid FROM %s WHERE %[2]s MATCH %s))`, rowId, ftsName, operandStrings[1])
+		}
+		// bm25 returns lower values for better matches, so negate it to make higher values more relevant
+		return fmt.Sprintf(`(SELECT -bm25(%s) FROM %[1]s WHERE %[1]s MATCH %s AND rowid = %s)`, ftsName, operandStrings[1], rowId)
+
 	case OpGreater, OpGreaterEqual, OpLess, OpLessEqual:
 		if o := operands[0]; o.NodeType_() == ColumnNodeType {
 			cn := o.(*ColumnNode)
-			if cn.SchemaSubType == schema.ColSubTypeNumeric {
+			if cn.SchemaSubType.IsNumeric() {
 				panic("SQLite does not support arbitrary precision numbers, and cannot compare them")
 			}
 		}
@@ -111,6 +173,33 @@ func (m *DB) OperationSql(op Operator, operands []Node, operandStrings []string)
 	return
 }
 
+// sqliteTime returns sql that converts a time value into a form that the SQLite date and time functions can read.
+// The driver stores times using the Go time.String format, which begins with the date and time, and
+// times are always stored in UTC.
+func sqliteTime(s string) string {
+	return fmt.Sprintf(`substr(%s, 1, 19)`, s)
+}
+
+// constraintError returns the database agnostic error for a constraint violation reported by sqlite,
+// or nil if err is not a constraint violation.
+func constraintError(table string, err error) error {
+	if sqliteErr, ok := err.(interface{ Code() int }); ok {
+		switch sqliteErr.Code() {
+		case 2067: // SQLITE_CONSTRAINT_UNIQUE
+			return db.NewUniqueValueError(table, nil, err)
+		case 275: // SQLITE_CONSTRAINT_CHECK
+			// The message ends with "CHECK constraint failed: name"
+			var name string
+			msg := err.Error()
+			if i := strings.Index(msg, "CHECK constraint failed: "); i >= 0 {
+				name, _, _ = strings.Cut(msg[i+len("CHECK constraint failed: "):], " ")
+			}
+			return db.NewCheckConstraintError(table, name, err)
+		}
+	}
+	return nil
+}
+
 // Insert inserts the given data as a new record in the database.
 // Table can include a schema name separated with a period.
 func (m *DB) Insert(ctx context.Context, table string, fields map[string]interface{}, autoPkKey string) error {
@@ -118,10 +207,8 @@ func (m *DB) Insert(ctx context.Context, table string, fields map[string]interfa
 
 	if autoPkKey == "" || fields[autoPkKey] != nil { // manually set primary key or setting an auto gen primary key to a specific value on insert
 		if _, err := m.SqlExec(ctx, sql, args...); err != nil {
-			if sqliteErr, ok := err.(interface{ Code() int }); ok {
-				if sqliteErr.Code() == 2067 {
-					return db.NewUniqueValueError(table, nil, err)
-				}
+			if err2 := constraintError(table, err); err2 != nil {
+				return err2
 			}
 			return db.NewQueryError("SqlQuery", sql, args, err)
 		}
@@ -143,10 +230,8 @@ func (m *DB) insertWithReturning(ctx context.Context, table string, pkName strin
 	}
 
 	if err != nil {
-		if sqliteErr, ok := err.(interface{ Code() int }); ok {
-			if sqliteErr.Code() == 2067 {
-				return 0, db.NewUniqueValueError(table, nil, err)
-			}
+		if err2 := constraintError(table, err); err2 != nil {
+			return 0, err2
 		}
 		return 0, db.NewQueryError("SqlQuery", sql, args, err)
 	} else {
@@ -202,10 +287,8 @@ func (m *DB) Update(ctx context.Context,
 	var result sqldb.Result
 	result, err = m.SqlExec(ctx, s, args...)
 	if err != nil {
-		if sqliteErr, ok := err.(interface{ Code() int }); ok {
-			if sqliteErr.Code() == 2067 {
-				return db.NewUniqueValueError(table, nil, err)
-			}
+		if err2 := constraintError(table, err); err2 != nil {
+			return err2
 		}
 		return db.NewQueryError("SqlExec", s, args, err)
 	}
@@ -221,8 +304,10 @@ func (m *DB) Update(ctx context.Context,
 	return
 }
 
+// SupportsForUpdate returns false, since SQLite does not have row level locks. Instead, SQLite locks the entire
+// database during a write transaction.
 func (m *DB) SupportsForUpdate() bool {
-	return true
+	return false
 }
 
 type contextKey string
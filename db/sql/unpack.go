@@ -3,6 +3,7 @@ package sql
 import (
 	"database/sql"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/goradd/gro/db"
@@ -262,3 +263,51 @@ func (u *unpacker) unpackObjectMap(dbMap db.ValueMap) (outMap map[string]any) {
 	}
 	return
 }
+
+// preloadParents returns the unpacked objects in values that are the parents of the objects of the
+// preloaded relationship in pt.
+func preloadParents(pt *jointree.JoinTree, values []map[string]any) []map[string]any {
+	var path []string
+	for e := pt.Root.Parent; e.Parent != nil; e = e.Parent {
+		path = slices.Insert(path, 0, query.NodeQueryKey(e.QueryNode))
+	}
+	objects := values
+	for _, key := range path {
+		var next []map[string]any
+		for _, o := range objects {
+			switch v := o[key].(type) {
+			case map[string]any:
+				next = append(next, v)
+			case []map[string]any:
+				next = append(next, v...)
+			}
+		}
+		objects = next
+	}
+	return objects
+}
+
+// unpackPreload unpacks the rows returned by a query of the preloaded relationship in pt, and adds the objects
+// to children keyed by the key of the parent object they belong to.
+// The rows are grouped by parent first, since an object of a many-many relationship can belong to more than one parent.
+func unpackPreload(pt *jointree.JoinTree, rows []map[string]any, children map[string][]map[string]any) {
+	groups := make(map[string][]map[string]any)
+	for _, row := range rows {
+		k := fmt.Sprint(row[jointree.PreloadKeyAlias])
+		groups[k] = append(groups[k], row)
+	}
+	for k, groupRows := range groups {
+		children[k] = append(children[k], unpack(pt, groupRows)...)
+	}
+}
+
+// attachPreload attaches the objects of the preloaded relationship in pt to their parent objects, which are
+// found by the value of each parent's pkKey.
+func attachPreload(pt *jointree.JoinTree, parents []map[string]any, pkKey string, children map[string][]map[string]any) {
+	key := query.NodeQueryKey(pt.Root.QueryNode)
+	for _, p := range parents {
+		if v := p[pkKey]; v != nil {
+			p[key] = children[fmt.Sprint(v)]
+		}
+	}
+}
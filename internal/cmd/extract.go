@@ -26,7 +26,7 @@ func Extract(dbConfigFile, schemaFile, dbKey string) error {
 				} else {
 					s := e.ExtractSchema(c)
 					s.Sort()
-					return schema.WriteJsonFile(&s, schemaFile)
+					return schema.WriteFile(&s, schemaFile)
 				}
 			}
 		}
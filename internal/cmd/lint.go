@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/goradd/gro/schema"
+)
+
+// Lint checks the schema file and writes the problems it finds to w in the given format,
+// which is either "text" or "json". It returns the number of errors found.
+func Lint(schemaFile, format, referenceSuffix string, w io.Writer) (errCount int, err error) {
+	if format != "text" && format != "json" {
+		return 0, fmt.Errorf("unknown format %q, must be text or json", format)
+	}
+
+	// The problems are reported as diagnostics, so do not also log them.
+	logger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	diags, err := schema.LintFile(schemaFile, schema.LintOptions{ReferenceSuffix: referenceSuffix})
+	slog.SetDefault(logger)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, d := range diags {
+		if d.Severity == schema.SeverityError {
+			errCount++
+		}
+	}
+
+	if format == "json" {
+		if diags == nil {
+			diags = []schema.Diagnostic{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(diags)
+		return
+	}
+	for _, d := range diags {
+		if _, err = fmt.Fprintln(w, d); err != nil {
+			return
+		}
+	}
+	return
+}
@@ -24,7 +24,7 @@ func Rebuild(dbConfigFile, schemaFile, dbKey string) error {
 					return fmt.Errorf("database not found for key %s", dbKey)
 				}
 				if e, ok := db.(db2.SchemaRebuilder); ok {
-					s, err2 := schema.ReadJsonFile(schemaFile)
+					s, err2 := schema.ReadFile(schemaFile)
 					if err2 != nil {
 						return err2
 					}
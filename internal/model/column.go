@@ -56,8 +56,13 @@ type Column struct {
 	Enum *Enum
 	// If this column is a reference, a pointer to the Reference object.
 	Reference *Reference
+	// If this column is one of the columns of a polymorphic reference, a pointer to the reference.
+	PolymorphicReference *PolymorphicReference
 	// Options are the options extracted from the comments string
 	Options map[string]interface{}
+	// IsGenerated is true if the database computes the value of the column.
+	// Generated columns are read-only, and are reloaded after the object is saved.
+	IsGenerated bool
 }
 
 func (c *Column) String() string {
@@ -76,6 +81,8 @@ func (c *Column) DefaultValueAsValue() string {
 			return `query.TempAutoPrimaryKey()`
 		} else if c.IsEnum() {
 			return fmt.Sprintf("%s(0)", c.Enum.Identifier)
+		} else if c.IsEnumArray() || c.IsEnumSet() {
+			return fmt.Sprintf("%s(nil)", c.Type)
 		}
 		return c.ReceiverType.DefaultValueString()
 	}
@@ -96,12 +103,33 @@ func (c *Column) DefaultValueAsValue() string {
 		return fmt.Sprintf("%s(%d)", c.Enum.Identifier, c.DefaultValue) // should be casting an int to an enum type
 	}
 
+	if c.ReceiverType == ColTypeDecimal {
+		return fmt.Sprintf("query.MustParseDecimal(%q)", fmt.Sprint(c.DefaultValue))
+	}
+
+	if c.ReceiverType == ColTypeDuration {
+		d, _ := time.ParseDuration(fmt.Sprint(c.DefaultValue)) // validated by the schema
+		return fmt.Sprintf("time.Duration(%d)", int64(d))
+	}
+
 	return fmt.Sprintf("%#v", c.DefaultValue)
 }
 
 // CompareGen will treat a and b as variables of the same type as the column's schema type, and
 // will generate code that will compare them for equality (if equal), or inequality (if equal is false)
 func (c *Column) CompareGen(a, b string, equal bool) string {
+	if c.ReceiverType == ColTypeDecimal || c.ReceiverType == ColTypeGeometry {
+		if equal {
+			return fmt.Sprintf("%s.Equal(%s)", a, b)
+		}
+		return fmt.Sprintf("!%s.Equal(%s)", a, b)
+	}
+	if c.SchemaType.IsArray() || c.IsEnumSet() {
+		if equal {
+			return fmt.Sprintf("slices.Equal(%s, %s)", a, b)
+		}
+		return fmt.Sprintf("!slices.Equal(%s, %s)", a, b)
+	}
 	switch c.SchemaType {
 	case schema.ColTypeTime, schema.ColTypeAutoPrimaryKey, schema.ColTypeUUID, schema.ColTypeULID:
 		if equal {
@@ -166,10 +194,43 @@ func (c *Column) IsEnum() bool {
 	return c.SchemaType == schema.ColTypeEnum
 }
 
-// IsDecimal returns true if the column is a Decimal (Numeric) string value, meaning
-// is a variable precision decimal number.
+// IsEnumArray returns true if the column contains a slice of a type defined by an enum table.
+func (c *Column) IsEnumArray() bool {
+	return c.SchemaType == schema.ColTypeEnumArray
+}
+
+// IsEnumSet returns true if the column contains a set of the values of an enum table stored as bit flags.
+// The Go type is a slice of the enum type.
+func (c *Column) IsEnumSet() bool {
+	return c.SchemaType == schema.ColTypeEnumSet
+}
+
+// StoredValueGen returns Go code that converts v, a value of the column's Go type, to the value that
+// is written to the database.
+func (c *Column) StoredValueGen(v string) string {
+	if c.IsEnumSet() {
+		return fmt.Sprintf("query.EnumSetBits(%s)", v)
+	}
+	return v
+}
+
+// IsDecimal returns true if the column is a Decimal (Numeric) value, meaning
+// is a variable precision decimal number. The Go type is either a string or a query.Decimal.
 func (c *Column) IsDecimal() bool {
-	return c.SchemaSubType == schema.ColSubTypeNumeric
+	return c.SchemaSubType.IsNumeric()
+}
+
+// GeometryType returns the Go code for the query.GeometryType constant that corresponds to
+// the subtype of a geometry column. Columns that accept any geometry will return query.GeometryPoint.
+func (c *Column) GeometryType() string {
+	switch c.SchemaSubType {
+	case schema.ColSubTypeLineString:
+		return "query.GeometryLineString"
+	case schema.ColSubTypePolygon:
+		return "query.GeometryPolygon"
+	default:
+		return "query.GeometryPoint"
+	}
 }
 
 // HasDefaultValue returns true if the column has a default value.
@@ -199,6 +260,9 @@ func (c *Column) HasSetter() bool {
 		c.SchemaSubType == schema.ColSubTypeLock {
 		return false
 	}
+	if c.IsGenerated || c.Table.IsView {
+		return false
+	}
 	return true
 }
 
@@ -249,7 +313,7 @@ func (c *Column) MaxLength() uint64 {
 
 // DecimalPrecision returns the precision value of a decimal number that is packed into the Size value.
 func (c *Column) DecimalPrecision() uint64 {
-	if c.SchemaSubType == schema.ColSubTypeNumeric {
+	if c.SchemaSubType.IsNumeric() {
 		return c.Size & 0x0000ffff
 	}
 	return 0
@@ -257,7 +321,7 @@ func (c *Column) DecimalPrecision() uint64 {
 
 // DecimalScale returns the scale value of a decimal number when it is packed into the Size value.
 func (c *Column) DecimalScale() uint64 {
-	if c.SchemaSubType == schema.ColSubTypeNumeric {
+	if c.SchemaSubType.IsNumeric() {
 		return c.Size >> 16
 	}
 	return 0
@@ -286,11 +350,22 @@ func (m *Database) importColumn(schemaCol *schema.Column) *Column {
 		Size:          schemaCol.Size,
 		DefaultValue:  schemaCol.DefaultValue,
 		IsNullable:    schemaCol.IsNullable,
+		IsGenerated:   schemaCol.IsGenerated,
+	}
+
+	if schemaCol.SubType == schema.ColSubTypeDecimal {
+		col.ReceiverType = ColTypeDecimal
 	}
 
 	if schemaCol.Type == schema.ColTypeEnum {
 		col.Enum = m.Enum(schemaCol.EnumTable)
 		col.Type = col.Enum.Identifier
+		if col.Enum.IsKeyStored() {
+			col.ReceiverType = ColTypeString
+		}
+	} else if schemaCol.Type == schema.ColTypeEnumArray || schemaCol.Type == schema.ColTypeEnumSet {
+		col.Enum = m.Enum(schemaCol.EnumTable)
+		col.Type = "[]" + col.Enum.Identifier
 	} else {
 		col.Type = col.ReceiverType.GoType()
 	}
@@ -63,6 +63,10 @@ func (m *Database) importSchema(schema *schema.Database) {
 	for _, assn := range schema.AssociationTables {
 		m.importAssociation(assn)
 	}
+
+	for _, view := range schema.Views {
+		m.importTable(view.Table(), m.WriteTimeout, m.ReadTimeout)
+	}
 }
 
 // Analyzes an association table and creates special virtual columns in the corresponding tables it points to.
@@ -86,6 +90,13 @@ func (m *Database) importAssociation(schemaAssn *schema.AssociationTable) {
 	ref2 := makeManyManyRef(schemaAssn.Table, schemaAssn.Ref1.Column, t1, schemaAssn.Ref1.Label, schemaAssn.Ref1.LabelPlural, schemaAssn.Ref1.Identifier, schemaAssn.Ref1.IdentifierPlural)
 	ref1.MM = ref2
 	ref2.MM = ref1
+	ref1.OrderColumn = schemaAssn.Ref2.OrderColumn
+	ref2.OrderColumn = schemaAssn.Ref1.OrderColumn
+	for _, c := range schemaAssn.Columns {
+		col := m.importColumn(c)
+		ref1.Columns = append(ref1.Columns, col)
+		ref2.Columns = append(ref2.Columns, col)
+	}
 	t1.ManyManyReferences = append(t1.ManyManyReferences, ref1)
 	t2.ManyManyReferences = append(t2.ManyManyReferences, ref2)
 }
@@ -208,13 +219,18 @@ func UpperCaseIdentifier(s string) (i string) {
 // keys not existing when an object is eventually saved.
 // Note that it cannot do this for circular references, and so if your database has circular
 // references, including self references, any foreign key checking will need to be turned off while importing the database.
+// Views are not included.
 func (m *Database) MarshalOrder() (tables []*Table) {
 	var unusedTables maps.SliceSet[*Table]
 
 	unusedTables.SetSortFunc(func(a, b *Table) bool {
 		return a.QueryName < b.QueryName
 	})
-	unusedTables.Add(slices.Collect(maps2.Values(m.Tables))...)
+	for t := range maps2.Values(m.Tables) {
+		if !t.IsView { // views cannot be unmarshalled into
+			unusedTables.Add(t)
+		}
+	}
 	// First add the tables that have no forward references
 	for { // repeat until unusedTables is empty
 		var newTables []*Table
@@ -229,6 +245,15 @@ func (m *Database) MarshalOrder() (tables []*Table) {
 				}
 
 			}
+			for _, ref := range t.PolymorphicReferences {
+				for _, t2 := range ref.ReferencedTables {
+					if !slices.Contains(tables, t2) &&
+						!slices.Contains(newTables, t2) &&
+						t != t2 {
+						continue nexttable
+					}
+				}
+			}
 			// This has no forward references we care about
 			newTables = append(newTables, t)
 		}
@@ -48,6 +48,8 @@ type Enum struct {
 	// Constants are the constant identifiers that will be used for each enumerated value.
 	// These are in ascending order by keys.
 	Constants []ConstValue
+	// Storage is how columns that refer to the enum store their values in the database.
+	Storage schema.EnumStorage
 }
 
 func (tt *Enum) FieldQueryName(i int) string {
@@ -70,6 +72,11 @@ func (tt *Enum) FieldReceiverType(i int) ReceiverType {
 	return tt.Fields[i].Type
 }
 
+// IsKeyStored returns true if columns that refer to the enum store the key of the value rather than the integer value.
+func (tt *Enum) IsKeyStored() bool {
+	return tt.Storage != schema.EnumStorageInteger
+}
+
 // FileName returns the default file name corresponding to the enum table.
 func (tt *Enum) FileName() string {
 	return snaker.CamelToSnake(tt.Identifier)
@@ -86,6 +93,7 @@ func newEnumTable(dbKey string, enumSchema *schema.EnumTable) *Enum {
 		Identifier:       enumSchema.Identifier,
 		IdentifierPlural: enumSchema.IdentifierPlural,
 		DecapIdentifier:  strings2.Decap(enumSchema.Identifier),
+		Storage:          enumSchema.Storage,
 	}
 	if len(enumSchema.Values) == 0 {
 		slog.Error("Enum table " + t.QueryName + " has no Values entries. Specify constants by adding entries to this table schema.")
@@ -1,5 +1,7 @@
 package model
 
+import "fmt"
+
 // Index will create accessor functions related to Columns.
 type Index struct {
 	// IsUnique indicates whether the index is unique
@@ -8,4 +10,17 @@ type Index struct {
 	Columns    []*Column
 	Name       string
 	Identifier string
+	// Function is the name of the sql function applied to the columns of an expression index.
+	Function string
+}
+
+// EqualOp returns the Go code of a query operation that compares col in table with the Go value v.
+// If the index is an expression index, the function of the index is applied to both sides of the comparison
+// so that the database can use the index.
+func (i Index) EqualOp(t *Table, col *Column, v string) string {
+	n := "node." + t.Identifier + "()." + col.Identifier + "()"
+	if i.Function == "" {
+		return fmt.Sprintf("op.Equal(%s, %s)", n, v)
+	}
+	return fmt.Sprintf("op.Equal(op.Function(%q, %s), op.Function(%[1]q, %[3]s))", i.Function, n, v)
 }
@@ -3,6 +3,7 @@ package model
 import (
 	"github.com/goradd/gro/query"
 	"github.com/goradd/strings"
+	"github.com/kenshaw/snaker"
 )
 
 // The ManyManyReference structure is used by the templates during the codegen process to describe
@@ -36,6 +37,14 @@ type ManyManyReference struct {
 	// Since this always points to many objects, it will be a plural name.
 	Field string
 
+	// Columns are the extra columns in the association table. They are shared with MM.
+	Columns []*Column
+
+	// OrderColumn is the name of the column in the association table that holds the position of each
+	// referenced object in the list. It is empty if the list is not ordered.
+	// At most one of this and MM.OrderColumn is set.
+	OrderColumn string
+
 	// MM is the many-many reference on the other end of the relationship that points back to this one.
 	MM *ManyManyReference
 }
@@ -91,6 +100,83 @@ func (m *ManyManyReference) QueryKey() string {
 	return m.Field
 }
 
+// HasColumns returns true if the association table has extra columns, in which case a link type is generated
+// to give access to the values of those columns.
+func (m *ManyManyReference) HasColumns() bool {
+	return len(m.Columns) > 0
+}
+
+// SourceTable returns the table that the reference belongs to.
+func (m *ManyManyReference) SourceTable() *Table {
+	return m.MM.ReferencedTable
+}
+
+// LinkType returns the name of the type that represents a link to a single object, along with the values
+// of the extra columns in the association table. For example, ProjectTeamMemberLink.
+func (m *ManyManyReference) LinkType() string {
+	return m.SourceTable().Identifier + m.Identifier + "Link"
+}
+
+// LinkIdentifier returns the name of the accessor of a single link. For example, TeamMemberLink.
+func (m *ManyManyReference) LinkIdentifier() string {
+	return m.Identifier + "Link"
+}
+
+// LinkIdentifierPlural returns the name of the accessor of the links. For example, TeamMemberLinks.
+func (m *ManyManyReference) LinkIdentifierPlural() string {
+	return m.Identifier + "Links"
+}
+
+// LinkField returns the name of the field in the table struct that holds the links keyed by primary key.
+func (m *ManyManyReference) LinkField() string {
+	return strings.Decap(m.Identifier) + "Links"
+}
+
+// ColumnQueryKey returns the key used in query results for the value of col, which is one of the extra columns.
+// The value is returned as part of the linked object's data, so it is prefixed to avoid collisions with
+// the columns of that object.
+func (m *ManyManyReference) ColumnQueryKey(col *Column) string {
+	return "_link" + col.Identifier
+}
+
+// HasNodeInterface returns true if the node of the reference gives access to columns in the association table,
+// in which case NodeInterface and NodeStruct are generated.
+func (m *ManyManyReference) HasNodeInterface() bool {
+	return m.HasColumns() || m.IsOrdered()
+}
+
+// NodeInterface returns the name of the node interface that gives access to the extra columns.
+// For example, ProjectTeamMembersNode.
+func (m *ManyManyReference) NodeInterface() string {
+	return m.SourceTable().Identifier + m.IdentifierPlural + "Node"
+}
+
+// NodeStruct returns the name of the node struct that implements NodeInterface.
+func (m *ManyManyReference) NodeStruct() string {
+	return m.SourceTable().DecapIdentifier + m.IdentifierPlural + "Association"
+}
+
+// IsOrdered returns true if the objects of this reference are kept in order.
+func (m *ManyManyReference) IsOrdered() bool {
+	return m.OrderColumn != ""
+}
+
+// OrderColumnIdentifier returns the Go identifier of the order column, used by the node to refer to it.
+func (m *ManyManyReference) OrderColumnIdentifier() string {
+	return snaker.SnakeToCamelIdentifier(m.OrderColumn)
+}
+
+// OrderColumnQueryKey returns the key used in query results for the value of the order column.
+func (m *ManyManyReference) OrderColumnQueryKey() string {
+	return "_link" + m.OrderColumnIdentifier()
+}
+
+// IsOtherOrdered returns true if the objects on the other side of the relationship are kept in order, in which case
+// associating objects from this side must add them to the end of the lists on the other side.
+func (m *ManyManyReference) IsOtherOrdered() bool {
+	return m.MM.OrderColumn != ""
+}
+
 func makeManyManyRef(assnTable, fk string, refTable *Table, label, labels, id, ids string) *ManyManyReference {
 	pk := refTable.PrimaryKeyColumn()
 
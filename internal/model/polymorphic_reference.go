@@ -0,0 +1,165 @@
+package model
+
+import (
+	"log/slog"
+
+	"github.com/goradd/anyutil"
+	"github.com/goradd/gro/db"
+	"github.com/goradd/gro/query"
+	"github.com/goradd/gro/schema"
+	strings2 "github.com/goradd/strings"
+	"github.com/kenshaw/snaker"
+)
+
+// PolymorphicReference describes a forward relationship to an object in one of several tables.
+// The relationship is stored in two columns of this table: TypeColumn holds the query name of the table of
+// the referenced object, and ForeignKey holds its primary key.
+// The code generated for the reference refers to the object through an interface that the types of all
+// the referenced tables implement. Each referenced table gets reverse accessors to the objects that refer to it.
+type PolymorphicReference struct {
+	// Table is a pointer back to the table this reference is part of.
+	Table *Table
+	// ReferencedTables are the tables that can be pointed to.
+	ReferencedTables []*Table
+	// TypeColumn is the local column that holds the query name of the referenced table.
+	TypeColumn *Column
+	// ForeignKey is the local column that holds the primary key of the referenced object.
+	ForeignKey *Column
+	// Identifier is the go identifier used as an accessor to the referenced object.
+	// Example: Commentable
+	Identifier string
+	// Field is the name used in the struct representing a pointer to the referenced object.
+	// Example: commentable
+	Field string
+	// The human-readable label of the object referred to.
+	Label string
+	// ReverseIdentifier is the name the referenced tables should use to refer to a single related object.
+	ReverseIdentifier string
+	// ReverseIdentifierPlural is the name the referenced tables should use to refer to the related objects.
+	// Example: Comments
+	ReverseIdentifierPlural string
+	// ReverseLabel is the human-readable label of the object of the reverse relationship.
+	ReverseLabel string
+	// ReverseLabelPlural is the plural of ReverseLabel.
+	ReverseLabelPlural string
+	// ReverseField is the name used in the struct of the referenced tables for the reverse objects.
+	ReverseField string
+	// IsNullable is true if the reference is not required when the table is saved.
+	IsNullable bool
+	// OnDelete is what happens to the objects in Table when the referenced object is deleted.
+	// It is never schema.OnDeleteDefault.
+	OnDelete schema.OnDeleteAction
+}
+
+// InterfaceType is the name of the interface implemented by the objects that can be referenced.
+// Example: CommentCommentable
+func (r *PolymorphicReference) InterfaceType() string {
+	return r.Table.Identifier + r.Identifier
+}
+
+// TypeMethod is the name of the unexported method the referenced objects implement to satisfy InterfaceType.
+// The method returns the value that TypeColumn holds when pointing to the object.
+func (r *PolymorphicReference) TypeMethod() string {
+	return LowerCaseIdentifier(r.InterfaceType()) + "Type"
+}
+
+// NodeIdentifier is the name of the node that joins the objects of the referenced table t.
+// Example: CommentableProject
+func (r *PolymorphicReference) NodeIdentifier(t *Table) string {
+	return r.Identifier + t.Identifier
+}
+
+// QueryKey is the key used in query results for an object of the referenced table t.
+func (r *PolymorphicReference) QueryKey(t *Table) string {
+	return r.Field + t.Identifier
+}
+
+// ReverseQueryKey is the key used in query results for the reverse objects.
+func (r *PolymorphicReference) ReverseQueryKey() string {
+	return r.ReverseField
+}
+
+// JsonKey returns the key that will be used for the referenced object in JSON.
+func (r *PolymorphicReference) JsonKey() string {
+	return r.Field
+}
+
+// importPolymorphicReference creates a polymorphic reference from a schemaRef.
+// The referenced tables must already be imported.
+func (m *Database) importPolymorphicReference(table *Table, schemaRef *schema.Reference) *PolymorphicReference {
+	ref := &PolymorphicReference{
+		Table:                   table,
+		Identifier:              schemaRef.ObjectIdentifier,
+		Field:                   strings2.Decap(schemaRef.ObjectIdentifier),
+		Label:                   schemaRef.ObjectLabel,
+		ReverseIdentifier:       schemaRef.ReverseIdentifier,
+		ReverseIdentifierPlural: schemaRef.ReverseIdentifierPlural,
+		ReverseLabel:            schemaRef.ReverseLabel,
+		ReverseLabelPlural:      schemaRef.ReverseLabelPlural,
+		ReverseField:            strings2.Decap(schemaRef.ReverseIdentifierPlural),
+		IsNullable:              schemaRef.IsNullable,
+		OnDelete:                schemaRef.DeleteAction(),
+	}
+
+	for _, name := range schemaRef.Tables {
+		refTable := m.Table(name)
+		if name == table.QueryName {
+			refTable = table
+		}
+		if refTable == nil {
+			slog.Error("Referenced table does not exist",
+				slog.String(db.LogTable, name))
+			return nil
+		}
+		if refTable.PrimaryKeyColumn() == nil {
+			slog.Warn("Referenced table does not have a single primary key column.",
+				slog.String(db.LogTable, name))
+			return nil
+		}
+		ref.ReferencedTables = append(ref.ReferencedTables, refTable)
+	}
+	pk := ref.ReferencedTables[0].PrimaryKeyColumn()
+
+	typeCol := &Column{
+		Table:         table,
+		QueryName:     schemaRef.TypeColumn,
+		Identifier:    snaker.SnakeToCamelIdentifier(schemaRef.TypeColumn),
+		SchemaType:    schema.ColTypeString,
+		SchemaSubType: schema.ColSubTypeNone,
+		ReceiverType:  query.ColTypeString,
+		Size:          schema.PolymorphicTypeSize,
+		IsNullable:    schemaRef.IsNullable,
+		Type:          query.ColTypeString.GoType(),
+	}
+	typeCol.Field = strings2.Decap(typeCol.Identifier)
+	typeCol.FieldPlural = strings2.Plural(typeCol.Field)
+	typeCol.Label = strings2.Title(typeCol.Identifier)
+
+	fk := &Column{
+		Table:      table,
+		QueryName:  schemaRef.Column,
+		Identifier: schemaRef.ColumnIdentifier,
+		Label:      schemaRef.ColumnLabel,
+		SchemaType: anyutil.If(pk.SchemaType == schema.ColTypeAutoPrimaryKey,
+			schema.ColTypeString,
+			pk.SchemaType),
+		SchemaSubType: pk.SchemaSubType,
+		ReceiverType:  pk.ReceiverType,
+		Size:          pk.Size,
+		IsNullable:    schemaRef.IsNullable,
+		Type:          pk.ReceiverType.GoType(),
+		Field:         strings2.Decap(schemaRef.ColumnIdentifier),
+		FieldPlural:   strings2.Plural(strings2.Decap(schemaRef.ColumnIdentifier)),
+	}
+	ref.TypeColumn = typeCol
+	ref.ForeignKey = fk
+	typeCol.PolymorphicReference = ref
+	fk.PolymorphicReference = ref
+
+	if !table.IsView {
+		for _, t := range ref.ReferencedTables {
+			t.ReversePolymorphicReferences = append(t.ReversePolymorphicReferences, ref)
+		}
+	}
+	return ref
+}
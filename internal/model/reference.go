@@ -51,6 +51,12 @@ type Reference struct {
 	// IsNullable is true if the foreign keys are nullable. This also indicates that the
 	// reference is not required when the table is saved.
 	IsNullable bool
+	// OrderColumn is the column in Table that holds the position of each object in the list of reverse objects.
+	// It is nil if the reverse objects are not ordered.
+	OrderColumn *Column
+	// OnDelete is what happens to the objects in Table when the referenced object is deleted.
+	// It is never schema.OnDeleteDefault.
+	OnDelete schema.OnDeleteAction
 }
 
 // JsonKey returns the key that will be used for the referenced object in JSON.
@@ -87,6 +93,32 @@ func (r *Reference) ReverseQueryKey() string {
 
 // importReference creates a reference from a schemaRef.
 func (m *Database) importReference(table *Table, schemaRef *schema.Reference) *Reference {
+	ref := m.newReference(table, schemaRef)
+	if ref == nil {
+		return nil
+	}
+	if !table.IsView {
+		// Views are read-only, so the referenced table does not get accessors back to the view
+		ref.ReferencedTable.ReverseReferences = append(ref.ReferencedTable.ReverseReferences, ref)
+	}
+	ref.ForeignKey.Reference = ref
+	return ref
+}
+
+// importBaseReference creates the reference from a subtype table to its base table.
+// The foreign key of the reference is the primary key of the subtype table, and is treated as a regular column.
+func (m *Database) importBaseReference(table *Table, schemaRef *schema.Reference) *Reference {
+	ref := m.newReference(table, schemaRef)
+	if ref == nil {
+		return nil
+	}
+	table.BaseReference = ref
+	ref.ReferencedTable.SubTypes = append(ref.ReferencedTable.SubTypes, ref)
+	return ref
+}
+
+// newReference creates a reference and its foreign key column from a schemaRef.
+func (m *Database) newReference(table *Table, schemaRef *schema.Reference) *Reference {
 	var refTable *Table
 
 	if schemaRef.Table == table.QueryName {
@@ -145,9 +177,11 @@ func (m *Database) importReference(table *Table, schemaRef *schema.Reference) *R
 		ReverseField:            strings2.Decap(revID),
 		IsUnique:                isUnique,
 		IsNullable:              schemaRef.IsNullable,
+		OnDelete:                schemaRef.DeleteAction(),
+	}
+	if schemaRef.OrderColumn != "" {
+		ref.OrderColumn = table.ColumnByName(schemaRef.OrderColumn)
 	}
 
-	refTable.ReverseReferences = append(refTable.ReverseReferences, ref)
-	col.Reference = ref
 	return ref
 }
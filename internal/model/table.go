@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/goradd/gro/db"
+	. "github.com/goradd/gro/query"
 	"github.com/goradd/gro/schema"
 	strings2 "github.com/goradd/strings"
 	"github.com/kenshaw/snaker"
@@ -22,6 +23,10 @@ type Table struct {
 	ReadTimeout time.Duration
 	// NoTest indicates that the table should NOT have an automated test generated for it.
 	NoTest bool
+	// IsView is true if the table is a database view. Views are read-only.
+	IsView bool
+	// IsLookup is true if a registry is generated that holds the records of the table in memory.
+	IsLookup bool
 	// QueryName is the database's identifier for the table.
 	QueryName string
 	// Label is the name of the object when describing it to the world. Should be lower case.
@@ -45,8 +50,19 @@ type Table struct {
 	// ReverseReferences are the columns from other tables, or even this table,
 	// that point to this table.
 	ReverseReferences []*Reference
+	// PolymorphicReferences are the references that can point to objects in more than one table.
+	// Their columns are included in Columns.
+	PolymorphicReferences []*PolymorphicReference
+	// ReversePolymorphicReferences are the polymorphic references from other tables, or even this table,
+	// that can point to this table.
+	ReversePolymorphicReferences []*PolymorphicReference
 	// ManyManyReferences describe the many-to-many references pointing to this table
 	ManyManyReferences []*ManyManyReference
+	// BaseReference is the reference to the base table if this is a subtype table, or nil if not.
+	// Its foreign key is the primary key of this table, and is included in Columns.
+	BaseReference *Reference
+	// SubTypes are the references from the subtype tables that extend this table.
+	SubTypes []*Reference
 	// The cached optimistic locking column, if one is present
 	LockColumn *Column
 	// columnMap is an internal map of the columns by query name of the column
@@ -135,6 +151,12 @@ func (t *Table) HasGetterName(name string) (hasName bool, desc string) {
 		}
 	}
 
+	for _, ref := range t.PolymorphicReferences {
+		if ref.Identifier == name {
+			return false, "conflicts with polymorphic reference " + ref.Identifier
+		}
+	}
+
 	for _, mm := range t.ManyManyReferences {
 		if mm.Identifier == name {
 			return false, "conflicts with many-many singular name " + mm.Identifier
@@ -162,7 +184,11 @@ func (t *Table) AllColumns() (out []*Column) {
 }
 
 // SettableColumns returns an array of columns that are settable, including foreign keys.
+// Views do not have settable columns.
 func (t *Table) SettableColumns() (out []*Column) {
+	if t.IsView {
+		return nil
+	}
 	for _, c := range t.Columns {
 		if c.HasSetter() {
 			out = append(out, c)
@@ -174,6 +200,31 @@ func (t *Table) SettableColumns() (out []*Column) {
 	return out
 }
 
+// GeneratedColumns returns the columns whose values are computed by the database.
+func (t *Table) GeneratedColumns() (out []*Column) {
+	for _, c := range t.Columns {
+		if c.IsGenerated {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// RegistryIndexes returns the unique indexes that the registry of a lookup table can find records by.
+// These are the single-column unique indexes on non-nullable columns with comparable Go types.
+func (t *Table) RegistryIndexes() (indexes []Index) {
+	for _, idx := range t.Indexes {
+		if !idx.IsUnique || len(idx.Columns) != 1 || idx.Function != "" || idx.Columns[0].IsNullable {
+			continue
+		}
+		switch idx.Columns[0].ReceiverType {
+		case ColTypeString, ColTypeInteger, ColTypeInteger64, ColTypeUUID, ColTypeULID:
+			indexes = append(indexes, idx)
+		}
+	}
+	return
+}
+
 // HasUniqueIndexes returns true if the table has at least one unique index.
 func (t *Table) HasUniqueIndexes() bool {
 	for _, idx := range t.Indexes {
@@ -194,6 +245,30 @@ func (t *Table) HasReverseReferences() bool {
 	return len(t.ReverseReferences) > 0
 }
 
+// HasRestrictedReverseReferences returns true if objects referring to the table can prevent an object
+// in the table from being deleted.
+func (t *Table) HasRestrictedReverseReferences() bool {
+	for _, rev := range t.ReverseReferences {
+		if rev.OnDelete == schema.OnDeleteRestrict {
+			return true
+		}
+	}
+	for _, poly := range t.ReversePolymorphicReferences {
+		if poly.OnDelete == schema.OnDeleteRestrict {
+			return true
+		}
+	}
+	return false
+}
+
+// BaseTable returns the table that this table extends, or nil if this is not a subtype table.
+func (t *Table) BaseTable() *Table {
+	if t.BaseReference == nil {
+		return nil
+	}
+	return t.BaseReference.ReferencedTable
+}
+
 // HasManyManyReferences returns true if the table has at least one many-many reference.
 func (t *Table) HasManyManyReferences() bool {
 	return len(t.ManyManyReferences) > 0
@@ -243,6 +318,8 @@ func (m *Database) importTable(tableSchema *schema.Table,
 		Identifier:       tableSchema.Identifier,
 		IdentifierPlural: tableSchema.IdentifierPlural,
 		NoTest:           tableSchema.NoTest,
+		IsView:           tableSchema.IsView(),
+		IsLookup:         tableSchema.Lookup,
 		columnMap:        make(map[string]*Column),
 	}
 
@@ -272,10 +349,32 @@ func (m *Database) importTable(tableSchema *schema.Table,
 	}
 
 	var selfRefs []*schema.Reference
+	var polymorphicRefs []*schema.Reference
 
 	// The following relies on the order of tables being processed
 	// such that the referenced tables exist with primary keys.
 	for _, schemaRef := range tableSchema.References {
+		if schemaRef == tableSchema.BaseReference() {
+			ref := m.importBaseReference(t, schemaRef)
+			if ref == nil {
+				return
+			}
+			if _, ok := t.columnMap[ref.ForeignKey.QueryName]; ok {
+				slog.Error("Table skipped. Primary key of the base table already exists in the table.",
+					slog.String(db.LogTable, t.QueryName),
+					slog.String(db.LogColumn, ref.ForeignKey.QueryName),
+				)
+				return
+			}
+			t.columnMap[ref.ForeignKey.QueryName] = ref.ForeignKey
+			t.Columns = append([]*Column{ref.ForeignKey}, t.Columns...)
+			continue
+		}
+		if schemaRef.IsPolymorphic() {
+			// Handle polymorphic references after primary key indexes are processed, in case they refer to this table
+			polymorphicRefs = append(polymorphicRefs, schemaRef)
+			continue
+		}
 		if schemaRef.Table == t.QueryName {
 			// Handle self references after primary key indexes are processed
 			selfRefs = append(selfRefs, schemaRef)
@@ -333,9 +432,30 @@ func (m *Database) importTable(tableSchema *schema.Table,
 		t.References = append(t.References, ref)
 	}
 
-	// Process the rest of the indexes
+	// Process polymorphic references, whose columns are treated as regular columns
+	for _, schemaRef := range polymorphicRefs {
+		ref := m.importPolymorphicReference(t, schemaRef)
+		if ref == nil {
+			return
+		}
+		for _, col := range []*Column{ref.TypeColumn, ref.ForeignKey} {
+			if _, ok := t.columnMap[col.QueryName]; ok {
+				slog.Error("Table skipped. Reference column name already exists in the table.",
+					slog.String(db.LogTable, t.QueryName),
+					slog.String(db.LogColumn, col.QueryName),
+				)
+				return
+			}
+			t.columnMap[col.QueryName] = col
+			t.Columns = append(t.Columns, col)
+		}
+		t.PolymorphicReferences = append(t.PolymorphicReferences, ref)
+	}
+
+	// Process the rest of the indexes. Full-text indexes are searched with op.Match and do not get accessors.
 	for _, idx := range tableSchema.Indexes {
-		if idx.IndexLevel != schema.IndexLevelPrimaryKey {
+		if idx.IndexLevel != schema.IndexLevelPrimaryKey &&
+			idx.IndexLevel != schema.IndexLevelFullText {
 			var columns []*Column
 			for _, name := range idx.Columns {
 				col := t.ColumnByName(name)
@@ -350,10 +470,12 @@ func (m *Database) importTable(tableSchema *schema.Table,
 			}
 			t.Indexes = append(t.Indexes,
 				Index{
-					IsUnique:   idx.IndexLevel == schema.IndexLevelUnique,
+					// more than one record can match a unique partial index
+					IsUnique:   idx.IndexLevel == schema.IndexLevelUnique && !idx.IsPartial(),
 					Columns:    columns,
 					Identifier: idx.Identifier,
 					Name:       idx.Name,
+					Function:   idx.Function,
 				})
 		}
 	}
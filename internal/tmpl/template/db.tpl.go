@@ -401,7 +401,36 @@ func JsonEncodeAll(ctx context.Context, writer io.Writer) error {
 				}
 
 				if _, err = io.WriteString(_w, `,
-            },
+`); err != nil {
+					return
+				}
+
+				for _, col := range mm.Columns {
+
+					if _, err = io.WriteString(_w, `                `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, fmt.Sprintf("%#v", col.QueryName)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `: query.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.ReceiverType.String()); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `,
+`); err != nil {
+						return
+					}
+
+				}
+
+				if _, err = io.WriteString(_w, `            },
             nil,
             []string{"`); err != nil {
 					return
@@ -780,37 +809,157 @@ func jsonDecodeTable(ctx context.Context,  decoder *json.Decoder) error {
 			}
 
 			if _, err = io.WriteString(_w, `"`+"`"+`
-        }
+`); err != nil {
+				return
+			}
+
+			for _, col := range mm.Columns {
+
+				if _, err = io.WriteString(_w, `            `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` *`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.ReceiverType.GoType()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` `+"`"+`json:"`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.QueryName); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"`+"`"+`
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `        }
 
 		if err = decoder.Decode(&imp); err != nil {
 			return err
 		}
-		db.Associate(ctx, database, `); err != nil {
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, fmt.Sprintf("%#v", mm.TableQueryName)); err != nil {
-				return
-			}
+			if mm.HasColumns() {
 
-			if _, err = io.WriteString(_w, `, `); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `		values := make(map[string]any)
+`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, fmt.Sprintf("%#v", mm.SourceColumnName())); err != nil {
-				return
-			}
+				for _, col := range mm.Columns {
 
-			if _, err = io.WriteString(_w, `, imp.Src, `); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `		if imp.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` != nil {
+		    values[`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, fmt.Sprintf("%#v", col.QueryName)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `] = *imp.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `
+		}
+`); err != nil {
+						return
+					}
+
+				}
+
+				if _, err = io.WriteString(_w, `		db.Associate(ctx, database, `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, fmt.Sprintf("%#v", mm.TableQueryName)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, fmt.Sprintf("%#v", mm.SourceColumnName())); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, imp.Src, `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, fmt.Sprintf("%#v", mm.ForeignKeyName)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, imp.Dest, values)
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `		db.Associate(ctx, database, `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, fmt.Sprintf("%#v", mm.TableQueryName)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, fmt.Sprintf("%#v", mm.SourceColumnName())); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, imp.Src, `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, fmt.Sprintf("%#v", mm.ForeignKeyName)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, imp.Dest)
+`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, fmt.Sprintf("%#v", mm.ForeignKeyName)); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, `, imp.Dest)
-	}
+			if _, err = io.WriteString(_w, `	}
 
 	// Check if the last token is the end of the array
 	token, err = decoder.Token()
@@ -49,6 +49,9 @@ func (tmpl *EnumTemplate) gen(table *model.Enum, _w io.Writer) (err error) {
 	if err = tmpl.genKey(table, _w); err != nil {
 		return
 	}
+	if err = tmpl.genValuer(table, _w); err != nil {
+		return
+	}
 	if err = tmpl.genPlurals(table, _w); err != nil {
 		return
 	}
@@ -86,7 +89,20 @@ import (
 	"github.com/goradd/maps"
     "sort"
 	"encoding/gob"
-)
+`); err != nil {
+		return
+	}
+
+	if table.IsKeyStored() {
+
+		if _, err = io.WriteString(_w, `	"database/sql/driver"
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `)
 `); err != nil {
 		return
 	}
@@ -562,6 +578,40 @@ func `); err != nil {
 	return
 }
 
+func (tmpl *EnumTemplate) genValuer(table *model.Enum, _w io.Writer) (err error) {
+	if !table.IsKeyStored() {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `// Value returns the key of e, which is how `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` values are stored in the database.
+// It satisfies the driver.Valuer interface.
+func (e `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) Value() (driver.Value, error) {
+    return e.Key(), nil
+}
+
+`); err != nil {
+		return
+	}
+
+	return
+}
+
 func (tmpl *EnumTemplate) genPlurals(table *model.Enum, _w io.Writer) (err error) {
 
 	if _, err = io.WriteString(_w, `// `); err != nil {
@@ -39,9 +39,9 @@ var hasReference bool
 var hasAssociation bool
 
 func (n *NodeTemplate) gen(table *model.Table, _w io.Writer) (err error) {
-	hasReference = len(table.References) > 0
+	hasReference = len(table.References) > 0 || len(table.PolymorphicReferences) > 0 || table.BaseReference != nil
 	hasAssociation = len(table.ManyManyReferences) > 0
-	hasReverse = len(table.ReverseReferences) > 0
+	hasReverse = len(table.ReverseReferences) > 0 || len(table.ReversePolymorphicReferences) > 0 || len(table.SubTypes) > 0
 
 	if err = n.genHeader(table, _w); err != nil {
 		return
@@ -179,6 +179,48 @@ type `); err != nil {
 
 	}
 
+	if ref := table.BaseReference; ref != nil {
+
+		if _, err = io.WriteString(_w, `    // `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` references the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` base object, which shares the primary key.
+    `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Node
+`); err != nil {
+			return
+		}
+
+	}
+
 	for _, ref := range table.References {
 
 		if _, err = io.WriteString(_w, `    // `); err != nil {
@@ -264,6 +306,77 @@ type `); err != nil {
 
 	}
 
+	for _, poly := range table.PolymorphicReferences {
+
+		for _, t := range poly.ReferencedTables {
+
+			if _, err = io.WriteString(_w, `    // `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` references the `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, t.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` object whose primary key is `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+    // when `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` is "`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, t.QueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `".
+    `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `() `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, t.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Node
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
 	for _, mm := range table.ManyManyReferences {
 
 		if _, err = io.WriteString(_w, `    // `); err != nil {
@@ -283,25 +396,56 @@ type `); err != nil {
 		}
 
 		if _, err = io.WriteString(_w, ` objects.
-    `); err != nil {
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
-			return
-		}
+		if mm.HasNodeInterface() {
 
-		if _, err = io.WriteString(_w, `() `); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `    `); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `Node
+			if _, err = io.WriteString(_w, `() `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.NodeInterface()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
 `); err != nil {
-			return
+				return
+			}
+
+		} else {
+
+			if _, err = io.WriteString(_w, `    `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `() `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Node
+`); err != nil {
+				return
+			}
+
 		}
 
 	}
@@ -387,6 +531,99 @@ type `); err != nil {
 
 	}
 
+	for _, sub := range table.SubTypes {
+
+		if _, err = io.WriteString(_w, `    // `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` represents the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` subtype object that extends the object.
+    `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Node
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, poly := range table.ReversePolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    // `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` represents the reverse reference to `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` objects
+    // through the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` polymorphic reference there.
+    `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Node
+`); err != nil {
+			return
+		}
+
+	}
+
 	if _, err = io.WriteString(_w, `}
 
 // `); err != nil {
@@ -542,37 +779,221 @@ type `); err != nil {
 
 	}
 
-	return
-}
-
-func (n *NodeTemplate) genTableNode(table *model.Table, _w io.Writer) (err error) {
+	for _, mm := range table.ManyManyReferences {
 
-	//*** table.tmpl
+		if mm.HasNodeInterface() {
 
-	if _, err = io.WriteString(_w, `// `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `
+// `); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.NodeInterface()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` returns a table node that starts a node chain that begins with the `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, ` is the builder interface to the `); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.QueryName); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` table.
-func `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, ` many-many relationship.
+// Besides the nodes of the `); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` objects, it gives access to the extra columns
+// of the `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` association table.
+type `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.NodeInterface()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` interface {
+    `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Node
+`); err != nil {
+				return
+			}
+
+			for _, col := range mm.Columns {
+
+				if _, err = io.WriteString(_w, `    // `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` represents the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.QueryName); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` column in the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` association table.
+    `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `() *query.ColumnNode
+`); err != nil {
+					return
+				}
+
+			}
+
+			if mm.IsOrdered() {
+
+				if _, err = io.WriteString(_w, `    // `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.OrderColumnIdentifier()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` represents the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.OrderColumn); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` column in the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` association table,
+    // which holds the position of each `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` in the list.
+    `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.OrderColumnIdentifier()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `() *query.ColumnNode
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `}
+
+type `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.NodeStruct()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` struct {
+    `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.ReferencedTable.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Association
+}
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
+	return
+}
+
+func (n *NodeTemplate) genTableNode(table *model.Table, _w io.Writer) (err error) {
+
+	//*** table.tmpl
+
+	if _, err = io.WriteString(_w, `// `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` returns a table node that starts a node chain that begins with the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` table.
+func `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
 
 	if _, err = io.WriteString(_w, `() `); err != nil {
 		return
@@ -1209,6 +1630,12 @@ func (n *NodeTemplate) genColumns(table *model.Table, _w io.Writer) (err error)
 }
 
 func (n *NodeTemplate) genReferences(table *model.Table, _w io.Writer) (err error) {
+	if ref := table.BaseReference; ref != nil {
+		// The foreign key is the primary key, which is generated with the other columns
+		if err = n.genRefNode(table, ref, _w); err != nil {
+			return
+		}
+	}
 	for _, ref := range table.References {
 		if err = n.genColumn(table, ref.ForeignKey, _w); err != nil {
 			return
@@ -1217,6 +1644,13 @@ func (n *NodeTemplate) genReferences(table *model.Table, _w io.Writer) (err erro
 			return
 		}
 	}
+	for _, poly := range table.PolymorphicReferences {
+		for _, t := range poly.ReferencedTables {
+			if err = n.genPolyNode(table, poly, t, _w); err != nil {
+				return
+			}
+		}
+	}
 	return
 }
 
@@ -1473,6 +1907,27 @@ func init() {
 
 	}
 
+	for _, mm := range table.ManyManyReferences {
+
+		if mm.HasNodeInterface() {
+
+			if _, err = io.WriteString(_w, `	gob.Register(new(`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.NodeStruct()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `))
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
 	if _, err = io.WriteString(_w, `}
 `); err != nil {
 		return
@@ -1504,71 +1959,989 @@ func (n *NodeTemplate) genColumnNode(table *model.Table, col *model.Column, _w i
 		return
 	}
 
-	if _, err = io.WriteString(_w, col.Identifier); err != nil {
-		return
-	}
+	if _, err = io.WriteString(_w, col.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `() *query.ColumnNode {
+	cn := query.NewColumnNode(
+		"`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, col.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
+		"`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, col.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
+		query.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, col.ReceiverType.String()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `,
+		schema.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, col.SchemaType.String()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `,
+		schema.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, col.SchemaSubType.String()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `,
+		`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, strconv.FormatBool(col.IsThePrimaryKey())); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `,
+		n,
+	)
+	return cn
+}
+
+`); err != nil {
+		return
+	}
+
+	if hasReverse {
+
+		if _, err = io.WriteString(_w, `func (n *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Reference) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() *query.ColumnNode {
+    cn := n.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Table.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()
+    query.NodeSetParent(cn, n)
+    return cn
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if hasReference {
+
+		if _, err = io.WriteString(_w, `func (n *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Reverse) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() *query.ColumnNode {
+    cn := n.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Table.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()
+    query.NodeSetParent(cn, n)
+    return cn
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if hasAssociation {
+
+		if _, err = io.WriteString(_w, `func (n *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Association) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() *query.ColumnNode {
+    cn := n.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Table.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()
+    query.NodeSetParent(cn, n)
+    return cn
+}
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
+
+	return
+}
+
+//*** reference.tmpl
+
+func (n *NodeTemplate) genRefNode(table *model.Table, ref *model.Reference, _w io.Writer) (err error) {
+
+	if _, err = io.WriteString(_w, `// `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` represents the link to a `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` object.
+func (n `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Table) `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `() `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Node {
+	cn := &`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ReferencedTable.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Reference{
+		ReferenceNode: query.ReferenceNode {
+            ForeignKey:      "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ForeignKey.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
+            PrimaryKey:      "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ReferencedTable.PrimaryKeyColumn().QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
+            Field:           "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
+		},
+	}
+	query.NodeSetParent(cn, n)
+	return cn
+}
+
+`); err != nil {
+		return
+	}
+
+	if hasReverse {
+
+		if _, err = io.WriteString(_w, `func (n *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Reference) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Node {
+    cn := n.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Table.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().(*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ReferencedTable.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Reference)
+    query.NodeSetParent(cn, n)
+    return cn
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if hasReference {
+
+		if _, err = io.WriteString(_w, `func (n *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Reverse) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Node {
+    cn := n.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Table.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().(*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ReferencedTable.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Reference)
+    query.NodeSetParent(cn, n)
+    return cn
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if hasAssociation {
+
+		if _, err = io.WriteString(_w, `func (n *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Association) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Node {
+    cn := n.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Table.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().(*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ReferencedTable.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Reference)
+    query.NodeSetParent(cn, n)
+    return cn
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+//*** assn.tmpl
+
+func (n *NodeTemplate) genAssn(table *model.Table, _w io.Writer) (err error) {
+	for _, mm := range table.ManyManyReferences {
+		if err = n.genAssnTable(table, mm, _w); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (n *NodeTemplate) genAssnTable(table *model.Table, mm *model.ManyManyReference, _w io.Writer) (err error) {
+	var objectType string
+	var nodeType string
+	objectType = mm.ReferencedTable.DecapIdentifier + "Association"
+	nodeType = mm.ReferencedTable.Identifier + "Node"
+	if mm.HasNodeInterface() {
+		objectType = mm.NodeStruct()
+		nodeType = mm.NodeInterface()
+	}
+
+	if _, err = io.WriteString(_w, `// `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` represents the many-to-many relationship formed by the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` table.
+func (n `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Table) `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `() `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, nodeType); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `  {
+`); err != nil {
+		return
+	}
+
+	if mm.HasNodeInterface() {
+
+		if _, err = io.WriteString(_w, `	cn := &`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, objectType); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` {
+		`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.ReferencedTable.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Association: `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.ReferencedTable.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Association{
+            ManyManyNode: query.ManyManyNode{
+                AssnTableQueryName:       "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+                ParentForeignKey:         "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+                ParentPrimaryKey:         "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.SourcePrimaryKeyName()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+                Field:                    "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+                RefForeignKey:            "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+                RefPrimaryKey:            "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.PrimaryKeyColumnName()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+            },
+		},
+	}
+`); err != nil {
+			return
+		}
+
+	} else {
+
+		if _, err = io.WriteString(_w, `	cn := &`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, objectType); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` {
+		ManyManyNode: query.ManyManyNode{
+			AssnTableQueryName:       "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+			ParentForeignKey:         "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+			ParentPrimaryKey:         "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.SourcePrimaryKeyName()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+			Field:                    "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+			RefForeignKey:            "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+			RefPrimaryKey:            "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.PrimaryKeyColumnName()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `",
+		},
+	}
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	query.NodeSetParent(cn, n)
+	return cn
+}
+
+`); err != nil {
+		return
+	}
+
+	if mm.HasColumns() {
+
+		if _, err = io.WriteString(_w, `// ColumnNodes_ returns the column nodes of the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` table, followed by the extra columns of the association table.
+func (n *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, objectType); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `) ColumnNodes_() (nodes []query.Node) {
+    nodes = n.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.ReferencedTable.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Association.ColumnNodes_()
+    for _,cn := range nodes {
+        query.NodeSetParent(cn, n)
+    }
+`); err != nil {
+			return
+		}
+
+		for _, col := range mm.Columns {
+
+			if _, err = io.WriteString(_w, `    nodes = append(nodes, n.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `())
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `    return
+}
+
+`); err != nil {
+			return
+		}
+
+		for _, col := range mm.Columns {
+
+			if _, err = io.WriteString(_w, `// `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` represents the `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` column in the `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` association table.
+func (n *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, objectType); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `) `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `() *query.ColumnNode {
+	return query.NewAssociationColumnNode(
+		"`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `",
+		"`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.ColumnQueryKey(col)); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `",
+		query.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.ReceiverType.String()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `,
+		schema.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.SchemaType.String()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `,
+		schema.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.SchemaSubType.String()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `,
+		n,
+	)
+}
+
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
+	if mm.IsOrdered() {
+
+		if _, err = io.WriteString(_w, `// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.OrderColumnIdentifier()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` represents the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.OrderColumn); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `() *query.ColumnNode {
-	cn := query.NewColumnNode(
-		"`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, ` column in the `); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, col.QueryName); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `",
-		"`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, ` association table,
+// which holds the position of each `); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, col.Field); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `",
-		query.`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, ` in the list.
+func (n *`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, col.ReceiverType.String()); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, objectType); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `,
-		schema.`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `) `); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, col.SchemaType.String()); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, mm.OrderColumnIdentifier()); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `,
-		schema.`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `() *query.ColumnNode {
+	return query.NewAssociationColumnNode(
+		"`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, col.SchemaSubType.String()); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, mm.OrderColumn); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `,
-		`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `",
+		"`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, strconv.FormatBool(col.IsThePrimaryKey())); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, mm.OrderColumnQueryKey()); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `,
+		if _, err = io.WriteString(_w, `",
+		query.ColTypeInteger,
+		schema.ColTypeInt,
+		schema.ColSubTypeNone,
 		n,
 	)
-	return cn
 }
 
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
 `); err != nil {
 		return
 	}
@@ -1587,11 +2960,19 @@ func (n *NodeTemplate) genColumnNode(table *model.Table, col *model.Column, _w i
 			return
 		}
 
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `() *query.ColumnNode {
+		if _, err = io.WriteString(_w, `() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, nodeType); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `  {
     cn := n.`); err != nil {
 			return
 		}
@@ -1604,11 +2985,19 @@ func (n *NodeTemplate) genColumnNode(table *model.Table, col *model.Column, _w i
 			return
 		}
 
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `()
+		if _, err = io.WriteString(_w, `().(*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, objectType); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -1633,11 +3022,19 @@ func (n *NodeTemplate) genColumnNode(table *model.Table, col *model.Column, _w i
 			return
 		}
 
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `() *query.ColumnNode {
+		if _, err = io.WriteString(_w, `() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, nodeType); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `  {
     cn := n.`); err != nil {
 			return
 		}
@@ -1650,11 +3047,19 @@ func (n *NodeTemplate) genColumnNode(table *model.Table, col *model.Column, _w i
 			return
 		}
 
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `()
+		if _, err = io.WriteString(_w, `().(*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, objectType); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -1679,11 +3084,19 @@ func (n *NodeTemplate) genColumnNode(table *model.Table, col *model.Column, _w i
 			return
 		}
 
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `() *query.ColumnNode {
+		if _, err = io.WriteString(_w, `() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, nodeType); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `  {
     cn := n.`); err != nil {
 			return
 		}
@@ -1696,14 +3109,23 @@ func (n *NodeTemplate) genColumnNode(table *model.Table, col *model.Column, _w i
 			return
 		}
 
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `()
+		if _, err = io.WriteString(_w, `().(*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, objectType); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
     query.NodeSetParent(cn, n)
     return cn
 }
+
 `); err != nil {
 			return
 		}
@@ -1718,27 +3140,61 @@ func (n *NodeTemplate) genColumnNode(table *model.Table, col *model.Column, _w i
 	return
 }
 
-//*** reference.tmpl
+//*** reverse.tmpl
 
-func (n *NodeTemplate) genRefNode(table *model.Table, ref *model.Reference, _w io.Writer) (err error) {
+func (n *NodeTemplate) genReverse(table *model.Table, _w io.Writer) (err error) {
+	for _, rev := range table.ReverseReferences {
+		if rev.IsUnique {
+			if err = n.genReverseOne(table, rev, _w); err != nil {
+				return
+			}
+		} else {
+			if err = n.genReverseMany(table, rev, _w); err != nil {
+				return
+			}
+		}
+	}
+	for _, poly := range table.ReversePolymorphicReferences {
+		if err = n.genPolyReverse(table, poly, _w); err != nil {
+			return
+		}
+	}
+	for _, sub := range table.SubTypes {
+		if err = n.genReverseOne(table, sub, _w); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (n *NodeTemplate) genReverseOne(table *model.Table, rev *model.Reference, _w io.Writer) (err error) {
 
 	if _, err = io.WriteString(_w, `// `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+	if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` represents the link to a `); err != nil {
+	if _, err = io.WriteString(_w, ` represents the one-to-one relationship formed by the reverse reference from the
+// `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+	if _, err = io.WriteString(_w, rev.ForeignKey.QueryName); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` object.
+	if _, err = io.WriteString(_w, ` column in the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, rev.Table.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` table.
 func (n `); err != nil {
 		return
 	}
@@ -1751,7 +3207,7 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+	if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
 		return
 	}
 
@@ -1759,48 +3215,49 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+	if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Node {
+	if _, err = io.WriteString(_w, `Node  {
 	cn := &`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.ReferencedTable.DecapIdentifier); err != nil {
+	if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Reference{
-		ReferenceNode: query.ReferenceNode {
-            ForeignKey:      "`); err != nil {
+	if _, err = io.WriteString(_w, `Reverse{
+		ReverseNode: query.ReverseNode{
+			ForeignKey: "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.ForeignKey.QueryName); err != nil {
+	if _, err = io.WriteString(_w, rev.ForeignKey.QueryName); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
-            PrimaryKey:      "`); err != nil {
+			PrimaryKey: "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.ReferencedTable.PrimaryKeyColumn().QueryName); err != nil {
+	if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
-            Field:           "`); err != nil {
+			Field:      "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.Field); err != nil {
+	if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
+			IsUnique:   true,
 		},
 	}
 	query.NodeSetParent(cn, n)
@@ -1825,7 +3282,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
 			return
 		}
 
@@ -1833,11 +3290,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Node {
+		if _, err = io.WriteString(_w, `Node  {
     cn := n.`); err != nil {
 			return
 		}
@@ -1850,7 +3307,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
 			return
 		}
 
@@ -1858,11 +3315,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.ReferencedTable.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Reference)
+		if _, err = io.WriteString(_w, `Reverse)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -1887,7 +3344,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
 			return
 		}
 
@@ -1895,11 +3352,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Node {
+		if _, err = io.WriteString(_w, `Node  {
     cn := n.`); err != nil {
 			return
 		}
@@ -1912,7 +3369,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
 			return
 		}
 
@@ -1920,11 +3377,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.ReferencedTable.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Reference)
+		if _, err = io.WriteString(_w, `Reverse)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -1949,7 +3406,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
 			return
 		}
 
@@ -1957,11 +3414,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Node {
+		if _, err = io.WriteString(_w, `Node  {
     cn := n.`); err != nil {
 			return
 		}
@@ -1974,7 +3431,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
 			return
 		}
 
@@ -1982,11 +3439,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.ReferencedTable.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Reference)
+		if _, err = io.WriteString(_w, `Reverse)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -1997,37 +3454,39 @@ func (n `); err != nil {
 
 	}
 
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
+
 	return
 }
 
-//*** assn.tmpl
+func (n *NodeTemplate) genReverseMany(table *model.Table, rev *model.Reference, _w io.Writer) (err error) {
 
-func (n *NodeTemplate) genAssn(table *model.Table, _w io.Writer) (err error) {
-	for _, mm := range table.ManyManyReferences {
-		if err = n.genAssnTable(table, mm, _w); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `
+// `); err != nil {
+		return
 	}
-	return
-}
 
-func (n *NodeTemplate) genAssnTable(table *model.Table, mm *model.ManyManyReference, _w io.Writer) (err error) {
-	var objectType string
-	objectType = mm.ReferencedTable.DecapIdentifier + "Association"
+	if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+		return
+	}
 
-	if _, err = io.WriteString(_w, `// `); err != nil {
+	if _, err = io.WriteString(_w, ` represents the many-to-one relationship formed by the reverse reference from the
+// `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+	if _, err = io.WriteString(_w, rev.ForeignKey.QueryName); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` represents the many-to-many relationship formed by the `); err != nil {
+	if _, err = io.WriteString(_w, ` column in the `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+	if _, err = io.WriteString(_w, rev.Table.QueryName); err != nil {
 		return
 	}
 
@@ -2044,7 +3503,7 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+	if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
 		return
 	}
 
@@ -2052,7 +3511,7 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+	if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 		return
 	}
 
@@ -2061,66 +3520,40 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, objectType); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, ` {
-		ManyManyNode: query.ManyManyNode{
-			AssnTableQueryName:       "`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `",
-			ParentForeignKey:         "`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `",
-			ParentPrimaryKey:         "`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, mm.SourcePrimaryKeyName()); err != nil {
+	if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `",
-			Field:                    "`); err != nil {
+	if _, err = io.WriteString(_w, `Reverse{
+		ReverseNode: query.ReverseNode{
+			ForeignKey:     "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, mm.Field); err != nil {
+	if _, err = io.WriteString(_w, rev.ForeignKey.QueryName); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
-			RefForeignKey:            "`); err != nil {
+			PrimaryKey:     "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+	if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
-			RefPrimaryKey:            "`); err != nil {
+			Field:          "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, mm.PrimaryKeyColumnName()); err != nil {
+	if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
+			IsUnique:       false,
 		},
 	}
 	query.NodeSetParent(cn, n)
@@ -2145,7 +3578,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2153,11 +3586,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Node  {
+		if _, err = io.WriteString(_w, `Node {
     cn := n.`); err != nil {
 			return
 		}
@@ -2170,7 +3603,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2178,11 +3611,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, objectType); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `)
+		if _, err = io.WriteString(_w, `Reverse)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -2207,7 +3640,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2215,11 +3648,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Node  {
+		if _, err = io.WriteString(_w, `Node {
     cn := n.`); err != nil {
 			return
 		}
@@ -2232,7 +3665,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2240,11 +3673,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, objectType); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `)
+		if _, err = io.WriteString(_w, `Reverse)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -2269,7 +3702,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2277,11 +3710,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Node  {
+		if _, err = io.WriteString(_w, `Node {
     cn := n.`); err != nil {
 			return
 		}
@@ -2294,7 +3727,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2302,11 +3735,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, objectType); err != nil {
+		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `)
+		if _, err = io.WriteString(_w, `Reverse)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -2317,59 +3750,55 @@ func (n `); err != nil {
 
 	}
 
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
-
 	return
 }
 
-//*** reverse.tmpl
+//*** polymorphic.tmpl
 
-func (n *NodeTemplate) genReverse(table *model.Table, _w io.Writer) (err error) {
-	for _, rev := range table.ReverseReferences {
-		if rev.IsUnique {
-			if err = n.genReverseOne(table, rev, _w); err != nil {
-				return
-			}
-		} else {
-			if err = n.genReverseMany(table, rev, _w); err != nil {
-				return
-			}
-		}
+func (n *NodeTemplate) genPolyNode(table *model.Table, poly *model.PolymorphicReference, t *model.Table, _w io.Writer) (err error) {
+
+	if _, err = io.WriteString(_w, `// `); err != nil {
+		return
 	}
-	return
-}
 
-func (n *NodeTemplate) genReverseOne(table *model.Table, rev *model.Reference, _w io.Writer) (err error) {
+	if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
+		return
+	}
 
-	if _, err = io.WriteString(_w, `// `); err != nil {
+	if _, err = io.WriteString(_w, ` represents the link to a `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+	if _, err = io.WriteString(_w, t.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` represents the one-to-one relationship formed by the reverse reference from the
-// `); err != nil {
+	if _, err = io.WriteString(_w, ` object through the `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ForeignKey.QueryName); err != nil {
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` column in the `); err != nil {
+	if _, err = io.WriteString(_w, `
+// polymorphic reference. Only rows whose `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.Table.QueryName); err != nil {
+	if _, err = io.WriteString(_w, poly.TypeColumn.QueryName); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` table.
+	if _, err = io.WriteString(_w, ` column is "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, t.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `" are joined.
 func (n `); err != nil {
 		return
 	}
@@ -2382,7 +3811,7 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+	if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
 		return
 	}
 
@@ -2390,49 +3819,66 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+	if _, err = io.WriteString(_w, t.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Node  {
+	if _, err = io.WriteString(_w, `Node {
 	cn := &`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
+	if _, err = io.WriteString(_w, t.DecapIdentifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Reverse{
-		ReverseNode: query.ReverseNode{
-			ForeignKey: "`); err != nil {
+	if _, err = io.WriteString(_w, `Reference{
+		ReferenceNode: query.ReferenceNode {
+            ForeignKey:      "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ForeignKey.QueryName); err != nil {
+	if _, err = io.WriteString(_w, poly.ForeignKey.QueryName); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
-			PrimaryKey: "`); err != nil {
+            PrimaryKey:      "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
+	if _, err = io.WriteString(_w, t.PrimaryKeyColumn().QueryName); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
-			Field:      "`); err != nil {
+            Field:           "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+	if _, err = io.WriteString(_w, poly.QueryKey(t)); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
+            TypeColumn:      "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.TypeColumn.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
+            TypeValue:       "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, t.QueryName); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
-			IsUnique:   true,
 		},
 	}
 	query.NodeSetParent(cn, n)
@@ -2457,7 +3903,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+		if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
 			return
 		}
 
@@ -2465,11 +3911,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+		if _, err = io.WriteString(_w, t.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Node  {
+		if _, err = io.WriteString(_w, `Node {
     cn := n.`); err != nil {
 			return
 		}
@@ -2482,7 +3928,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+		if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
 			return
 		}
 
@@ -2490,11 +3936,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, t.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Reverse)
+		if _, err = io.WriteString(_w, `Reference)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -2519,7 +3965,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+		if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
 			return
 		}
 
@@ -2527,11 +3973,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+		if _, err = io.WriteString(_w, t.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Node  {
+		if _, err = io.WriteString(_w, `Node {
     cn := n.`); err != nil {
 			return
 		}
@@ -2544,7 +3990,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+		if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
 			return
 		}
 
@@ -2552,11 +3998,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, t.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Reverse)
+		if _, err = io.WriteString(_w, `Reference)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -2581,7 +4027,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+		if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
 			return
 		}
 
@@ -2589,11 +4035,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+		if _, err = io.WriteString(_w, t.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Node  {
+		if _, err = io.WriteString(_w, `Node {
     cn := n.`); err != nil {
 			return
 		}
@@ -2606,7 +4052,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+		if _, err = io.WriteString(_w, poly.NodeIdentifier(t)); err != nil {
 			return
 		}
 
@@ -2614,11 +4060,11 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, t.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Reverse)
+		if _, err = io.WriteString(_w, `Reference)
     query.NodeSetParent(cn, n)
     return cn
 }
@@ -2629,39 +4075,34 @@ func (n `); err != nil {
 
 	}
 
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
-
 	return
 }
 
-func (n *NodeTemplate) genReverseMany(table *model.Table, rev *model.Reference, _w io.Writer) (err error) {
+func (n *NodeTemplate) genPolyReverse(table *model.Table, poly *model.PolymorphicReference, _w io.Writer) (err error) {
 
 	if _, err = io.WriteString(_w, `
 // `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+	if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` represents the many-to-one relationship formed by the reverse reference from the
-// `); err != nil {
+	if _, err = io.WriteString(_w, ` represents the many-to-one relationship formed by the `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ForeignKey.QueryName); err != nil {
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` column in the `); err != nil {
+	if _, err = io.WriteString(_w, `
+// polymorphic reference from the `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.Table.QueryName); err != nil {
+	if _, err = io.WriteString(_w, poly.Table.QueryName); err != nil {
 		return
 	}
 
@@ -2678,7 +4119,7 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+	if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 		return
 	}
 
@@ -2686,7 +4127,7 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+	if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
 		return
 	}
 
@@ -2695,7 +4136,7 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
+	if _, err = io.WriteString(_w, poly.Table.DecapIdentifier); err != nil {
 		return
 	}
 
@@ -2705,7 +4146,7 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ForeignKey.QueryName); err != nil {
+	if _, err = io.WriteString(_w, poly.ForeignKey.QueryName); err != nil {
 		return
 	}
 
@@ -2723,12 +4164,30 @@ func (n `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+	if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
 		return
 	}
 
 	if _, err = io.WriteString(_w, `",
 			IsUnique:       false,
+			TypeColumn:     "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.TypeColumn.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
+			TypeValue:      "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
 		},
 	}
 	query.NodeSetParent(cn, n)
@@ -2753,7 +4212,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2761,7 +4220,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
 			return
 		}
 
@@ -2778,7 +4237,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2786,7 +4245,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, poly.Table.DecapIdentifier); err != nil {
 			return
 		}
 
@@ -2815,7 +4274,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2823,7 +4282,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
 			return
 		}
 
@@ -2840,7 +4299,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2848,7 +4307,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, poly.Table.DecapIdentifier); err != nil {
 			return
 		}
 
@@ -2877,7 +4336,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2885,7 +4344,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
 			return
 		}
 
@@ -2902,7 +4361,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 			return
 		}
 
@@ -2910,7 +4369,7 @@ func (n `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, rev.Table.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, poly.Table.DecapIdentifier); err != nil {
 			return
 		}
 
@@ -287,7 +287,13 @@ func (o *`); err != nil {
 
 	if _, err = io.WriteString(_w, `}
 
-// Save will update or insert the object, depending on the state of the object.
+`); err != nil {
+		return
+	}
+
+	if !table.IsView {
+
+		if _, err = io.WriteString(_w, `// Save will update or insert the object, depending on the state of the object.
 //
 // If it has an auto-generated primary key, it will be updated after an insert.
 // Database errors generally will be handled by a panic and not returned here,
@@ -304,18 +310,23 @@ func (o *`); err != nil {
 // and if optimistic locking is in effect, will also check whether those records have been altered or deleted,
 // returning an OptimisticLockError if so.
 func (o *`); err != nil {
-		return
-	}
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `) Save(ctx context.Context) error {
+		if _, err = io.WriteString(_w, `) Save(ctx context.Context) error {
     return o.save(ctx)
 }
+`); err != nil {
+			return
+		}
 
+	}
 
+	if _, err = io.WriteString(_w, `
 // Query`); err != nil {
 		return
 	}
@@ -419,139 +430,147 @@ func query`); err != nil {
 	if _, err = io.WriteString(_w, `Builder(ctx)
 }
 
-// get`); err != nil {
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+	if !table.IsView {
+
+		if _, err = io.WriteString(_w, `// get`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `InsertFields returns fields and values that will be used for a new record in the database.
+		if _, err = io.WriteString(_w, `InsertFields returns fields and values that will be used for a new record in the database.
 // You can add or modify the fields here before they are sent to the database. If you set a primary key, it will be
 // used instead of a generated primary key.
 func get`); err != nil {
-		return
-	}
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `InsertFields(o *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `InsertFields(o *`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `Base) (fields map[string]interface{}) {
+		if _, err = io.WriteString(_w, `Base) (fields map[string]interface{}) {
     return o.getInsertFields()
 }
 
 // get`); err != nil {
-		return
-	}
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `UpdateFields returns fields and values that will be used to update a current record in
+		if _, err = io.WriteString(_w, `UpdateFields returns fields and values that will be used to update a current record in
 // the database.
 // You can add or modify the fields here before they are sent to the database.
 func get`); err != nil {
-		return
-	}
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `UpdateFields(o *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `UpdateFields(o *`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `Base) (fields map[string]interface{}) {
+		if _, err = io.WriteString(_w, `Base) (fields map[string]interface{}) {
     return o.getUpdateFields()
 }
 
 
 `); err != nil {
-		return
-	}
-
-	if len(table.PrimaryKeyColumns()) > 0 {
-
-		if _, err = io.WriteString(_w, `// Delete`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
-			return
-		}
+		if len(table.PrimaryKeyColumns()) > 0 {
 
-		if _, err = io.WriteString(_w, ` deletes the `); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `// Delete`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.QueryName); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` deletes the `); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, ` record with primary key pk from the database.
+			if _, err = io.WriteString(_w, table.QueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` record with primary key pk from the database.
 // Note that you can also delete loaded `); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, ` objects by calling Delete on them.
+			if _, err = io.WriteString(_w, ` objects by calling Delete on them.
 // doc: type=`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `
+			if _, err = io.WriteString(_w, `
 func Delete`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `(ctx context.Context, pk `); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `(ctx context.Context, pk `); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `) error {
+			if _, err = io.WriteString(_w, `) error {
 	return delete`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `(ctx, pk)
+			if _, err = io.WriteString(_w, `(ctx, pk)
 }
 `); err != nil {
-			return
+				return
+			}
+
 		}
 
 	}
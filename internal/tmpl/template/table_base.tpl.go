@@ -53,8 +53,10 @@ func (tmpl *TableBaseTemplate) gen(table *model.Table, _w io.Writer, importPath
 	if err = tmpl.genInit(table, _w); err != nil {
 		return
 	}
-	if err = tmpl.genCopy(table, _w); err != nil {
-		return
+	if !table.IsView {
+		if err = tmpl.genCopy(table, _w); err != nil {
+			return
+		}
 	}
 	if err = tmpl.genAccessors(table, _w); err != nil {
 		return
@@ -65,26 +67,35 @@ func (tmpl *TableBaseTemplate) gen(table *model.Table, _w io.Writer, importPath
 	if err = tmpl.genReverseRefAccessors(table, _w); err != nil {
 		return
 	}
-	if err = tmpl.genLoad(table, _w); err != nil {
+	if err = tmpl.genSubTypes(table, _w); err != nil {
 		return
 	}
-	if err = tmpl.genBuilder(table, _w); err != nil {
+	if err = tmpl.genLoad(table, _w); err != nil {
 		return
 	}
-	if err = tmpl.genCount(table, _w); err != nil {
+	if err = tmpl.genRegistry(table, _w); err != nil {
 		return
 	}
-	if err = tmpl.genUnpack(table, _w); err != nil {
+	if err = tmpl.genBuilder(table, _w); err != nil {
 		return
 	}
-	if err = tmpl.genSave(table, _w); err != nil {
+	if err = tmpl.genCount(table, _w); err != nil {
 		return
 	}
-	if err = tmpl.genDelete(table, _w); err != nil {
+	if err = tmpl.genUnpack(table, _w); err != nil {
 		return
 	}
-	if err = tmpl.genDirty(table, _w); err != nil {
-		return
+	if !table.IsView {
+		// views are read-only
+		if err = tmpl.genSave(table, _w); err != nil {
+			return
+		}
+		if err = tmpl.genDelete(table, _w); err != nil {
+			return
+		}
+		if err = tmpl.genDirty(table, _w); err != nil {
+			return
+		}
 	}
 	if err = tmpl.genGet(table, _w); err != nil {
 		return
@@ -140,6 +151,8 @@ import (
 	if _, err = io.WriteString(_w, `/node"
     "time"
     "unicode/utf8"
+    "sync"
+    "log/slog"
 )
 
 `); err != nil {
@@ -266,6 +279,25 @@ type `); err != nil {
 
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `
+    // The base object, which shares the primary key of this object
+    *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+	}
+
 	if table.HasReferences() {
 
 		if _, err = io.WriteString(_w, `
@@ -301,6 +333,76 @@ type `); err != nil {
 
 	}
 
+	if len(table.PolymorphicReferences) > 0 {
+
+		if _, err = io.WriteString(_w, `
+    // Polymorphic references
+`); err != nil {
+			return
+		}
+
+		for _, poly := range table.PolymorphicReferences {
+
+			if _, err = io.WriteString(_w, `	`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.InterfaceType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
+	if len(table.SubTypes) > 0 {
+
+		if _, err = io.WriteString(_w, `
+    // Subtype objects
+`); err != nil {
+			return
+		}
+
+		for _, sub := range table.SubTypes {
+
+			if _, err = io.WriteString(_w, `    `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
 	if table.HasReverseReferences() {
 
 		if _, err = io.WriteString(_w, `
@@ -389,6 +491,49 @@ type `); err != nil {
 
 	}
 
+	if len(table.ReversePolymorphicReferences) > 0 {
+
+		if _, err = io.WriteString(_w, `
+    // Reverse polymorphic references
+`); err != nil {
+			return
+		}
+
+		for _, poly := range table.ReversePolymorphicReferences {
+
+			if _, err = io.WriteString(_w, `    `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` maps.SliceMap[`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.Table.PrimaryKeyType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `, *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `]  // Objects in the order they were queried
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
 	if table.HasManyManyReferences() {
 
 		if _, err = io.WriteString(_w, `
@@ -454,6 +599,47 @@ type `); err != nil {
 				return
 			}
 
+			if mm.HasColumns() {
+
+				if _, err = io.WriteString(_w, `    `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` map[`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `]*`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` // Values of the association table, by primary key of the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.Type()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `
+`); err != nil {
+					return
+				}
+
+			}
+
 		}
 
 	}
@@ -619,6 +805,93 @@ const  (
 
 	}
 
+	for _, sub := range table.SubTypes {
+
+		if _, err = io.WriteString(_w, `    `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Field = `+"`"+``); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ``+"`"+`
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, poly := range table.PolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Field = `+"`"+``); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ``+"`"+`
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, poly := range table.ReversePolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Field = `+"`"+``); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ``+"`"+`
+`); err != nil {
+			return
+		}
+
+	}
+
 	for _, mm := range table.ManyManyReferences {
 
 		if _, err = io.WriteString(_w, `    `); err != nil {
@@ -760,16 +1033,64 @@ const  (
 					return
 				}
 
-			}
+			} else if col.ReceiverType == query.ColTypeDecimal {
 
-		}
+				if _, err = io.WriteString(_w, `    const `); err != nil {
+					return
+				}
 
-	}
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `Precision = `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, strconv.FormatUint(uint64(col.DecimalPrecision()), 10)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` // The total number of digits the column can hold
+    const `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `Scale = `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, strconv.FormatUint(uint64(col.DecimalScale()), 10)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` // The number of digits after the decimal point
+`); err != nil {
+					return
+				}
+
+			}
+
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
 
 	return
 }
@@ -927,6 +1248,48 @@ func (tmpl *TableBaseTemplate) genInit(table *model.Table, _w io.Writer) (err er
 
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = New`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, sub := range table.SubTypes {
+
+		if _, err = io.WriteString(_w, `    o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = nil
+`); err != nil {
+			return
+		}
+
+	}
+
 	if table.HasReverseReferences() {
 
 		if _, err = io.WriteString(_w, `
@@ -1002,6 +1365,23 @@ func (tmpl *TableBaseTemplate) genInit(table *model.Table, _w io.Writer) (err er
 
 	}
 
+	for _, poly := range table.ReversePolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Clear()
+`); err != nil {
+			return
+		}
+
+	}
+
 	if table.HasManyManyReferences() {
 
 		if _, err = io.WriteString(_w, `
@@ -1043,6 +1423,23 @@ func (tmpl *TableBaseTemplate) genInit(table *model.Table, _w io.Writer) (err er
 				return
 			}
 
+			if mm.HasColumns() {
+
+				if _, err = io.WriteString(_w, `    o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = nil
+`); err != nil {
+					return
+				}
+
+			}
+
 		}
 
 	}
@@ -1096,6 +1493,23 @@ func (tmpl *TableBaseTemplate) genCopy(table *model.Table, _w io.Writer) (err er
 
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `// The `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` base object is copied too, and the copy gets its primary key from it when saved.
+`); err != nil {
+			return
+		}
+
+	}
+
 	if _, err = io.WriteString(_w, `// Call Save() on the new object to save it into the database.
 // Copy might panic if any fields in the database were set to a size larger than the
 // maximum size through a process that accessed the database outside of the ORM.
@@ -1129,8 +1543,47 @@ func (o *`); err != nil {
 		return
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    if o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` != nil {
+        newObject.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Copy()
+    }
+`); err != nil {
+			return
+		}
+
+	}
+
 	for _, col := range table.SettableColumns() {
 
+		if table.BaseReference != nil && col == table.BaseReference.ForeignKey {
+			continue // the copy gets its primary key from its base object
+		}
+
 		if _, err = io.WriteString(_w, `    if o.`); err != nil {
 			return
 		}
@@ -1204,12 +1657,20 @@ func (tmpl *TableBaseTemplate) genAccessors(table *model.Table, _w io.Writer) (e
 				return
 			}
 		}
+		if col.IsEnumSet() {
+			if err = tmpl.genColEnumSet(table, col, _w); err != nil {
+				return
+			}
+		}
 	}
 
 	for _, ref := range table.References {
 		if err = tmpl.genRefGetter(table, ref, _w); err != nil {
 			return
 		}
+		if table.IsView {
+			continue
+		}
 		if ref.IsNullable {
 			if err = tmpl.genRefNullSetter(table, ref, _w); err != nil {
 				return
@@ -1221,6 +1682,12 @@ func (tmpl *TableBaseTemplate) genAccessors(table *model.Table, _w io.Writer) (e
 		}
 	}
 
+	for _, poly := range table.PolymorphicReferences {
+		if err = tmpl.genPolymorphicAccessors(table, poly, _w); err != nil {
+			return
+		}
+	}
+
 	if err = tmpl.genAliasGetter(table, _w); err != nil {
 		return
 	}
@@ -1936,6 +2403,74 @@ func (tmpl *TableBaseTemplate) genColSetter(table *model.Table, col *model.Colum
 
 		}
 
+		if col.ReceiverType == query.ColTypeDecimal && col.DecimalPrecision() > 0 {
+
+			if _, err = io.WriteString(_w, `    if !v.Fits(`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Precision, `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Scale) {
+        panic("attempted to set `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` to a value that does not fit its precision and scale")
+    }
+`); err != nil {
+				return
+			}
+
+		}
+
+		if col.IsEnumSet() {
+
+			if _, err = io.WriteString(_w, `    v = query.EnumSetFromBits[`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `](query.EnumSetBits(v)) // sort and remove duplicates
+`); err != nil {
+				return
+			}
+
+		}
+
 		if !col.IsAutoPK() {
 
 			if _, err = io.WriteString(_w, `	if o._restored &&
@@ -1997,17 +2532,40 @@ func (tmpl *TableBaseTemplate) genColSetter(table *model.Table, col *model.Colum
 		}
 
 		if _, err = io.WriteString(_w, `IsLoaded = true
-	o.`); err != nil {
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, col.Field); err != nil {
-			return
-		}
+		if col.SchemaType.IsArray() {
 
-		if _, err = io.WriteString(_w, ` = v
+			if _, err = io.WriteString(_w, `	o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = slices.Clone(v)
 `); err != nil {
-			return
+				return
+			}
+
+		} else {
+
+			if _, err = io.WriteString(_w, `	o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = v
+`); err != nil {
+				return
+			}
+
 		}
 
 		if col.HasSetter() {
@@ -2080,17 +2638,107 @@ func (tmpl *TableBaseTemplate) genColSetter(table *model.Table, col *model.Colum
 
 		}
 
-		if _, err = io.WriteString(_w, `}
+		if col.PolymorphicReference != nil {
 
-`); err != nil {
-			return
-		}
+			if col == col.PolymorphicReference.TypeColumn {
 
-	}
-	return
-}
+				if _, err = io.WriteString(_w, `	if o.`); err != nil {
+					return
+				}
 
-func (tmpl *TableBaseTemplate) genColPrimaryKey(table *model.Table, _w io.Writer) (err error) {
+				if _, err = io.WriteString(_w, col.PolymorphicReference.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` != nil &&
+	        o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` != o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.PolymorphicReference.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.PolymorphicReference.TypeMethod()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `() {
+	    o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.PolymorphicReference.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = nil
+	}
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `	if o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.PolymorphicReference.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` != nil &&
+	        `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.CompareGen("o."+col.Field, "o."+col.PolymorphicReference.Field+".PrimaryKey()", false)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` {
+	    o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.PolymorphicReference.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = nil
+	}
+`); err != nil {
+					return
+				}
+
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `}
+
+`); err != nil {
+			return
+		}
+
+	}
+	return
+}
+
+func (tmpl *TableBaseTemplate) genColPrimaryKey(table *model.Table, _w io.Writer) (err error) {
 
 	//*** column_pk.tmpl
 
@@ -2277,91 +2925,103 @@ func (o *`); err != nil {
 
 	if _, err = io.WriteString(_w, `}
 
-// SetPrimaryKey sets the value of the primary key in the object, to be saved later in the database using the Save() function.
 `); err != nil {
 		return
 	}
 
-	if table.HasAutoPK() {
+	if !table.IsView {
 
-		if _, err = io.WriteString(_w, `// Normally you will not need to call this function, since the primary key value is automatically generated by the
-// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
-// merging data.
+		if _, err = io.WriteString(_w, `// SetPrimaryKey sets the value of the primary key in the object, to be saved later in the database using the Save() function.
 `); err != nil {
 			return
 		}
 
-	}
+		if table.HasAutoPK() {
+
+			if _, err = io.WriteString(_w, `// Normally you will not need to call this function, since the primary key value is automatically generated by the
+// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
+// merging data.
+`); err != nil {
+				return
+			}
+
+		}
 
-	if _, err = io.WriteString(_w, `// You cannot change a primary key for a record that has been written to the database. While SQL databases will
+		if _, err = io.WriteString(_w, `// You cannot change a primary key for a record that has been written to the database. While SQL databases will
 // allow it, NoSql databases will not. Save a copy and delete this one instead.
 func (o *`); err != nil {
-		return
-	}
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `Base) SetPrimaryKey(v `); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `Base) SetPrimaryKey(v `); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `) {
+		if _, err = io.WriteString(_w, `) {
 `); err != nil {
-		return
-	}
+			return
+		}
 
-	for _, col := range table.PrimaryKeyColumns() {
+		for _, col := range table.PrimaryKeyColumns() {
 
-		if len(table.PrimaryKeyColumns()) == 1 {
+			if len(table.PrimaryKeyColumns()) == 1 {
 
-			if _, err = io.WriteString(_w, `	o.Set`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `	o.Set`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `(v)
+				if _, err = io.WriteString(_w, `(v)
 `); err != nil {
-				return
-			}
+					return
+				}
 
-		} else {
+			} else {
 
-			if _, err = io.WriteString(_w, `    o.Set`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `    o.Set`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `(v.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `(v.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `)
+				if _, err = io.WriteString(_w, `)
 `); err != nil {
-				return
+					return
+				}
+
 			}
 
 		}
 
-	}
+		if _, err = io.WriteString(_w, `}
+`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `}
+	}
 
+	if _, err = io.WriteString(_w, `
 `); err != nil {
 		return
 	}
@@ -2477,30 +3137,13 @@ func (o *`); err != nil {
 		if _, err = io.WriteString(_w, `IsLoaded
 }
 
-// Set`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, ` sets the value of `); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, ` in the object, to be saved later in the database using the Save() function.
 `); err != nil {
 			return
 		}
 
-		if col.IsAutoPK() {
+		if !table.IsView {
 
-			if _, err = io.WriteString(_w, `// Normally you will not need to call this function, since the `); err != nil {
+			if _, err = io.WriteString(_w, `// Set`); err != nil {
 				return
 			}
 
@@ -2508,117 +3151,146 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` value is automatically generated by the
-// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
-// merging data.
-`); err != nil {
+			if _, err = io.WriteString(_w, ` sets the value of `); err != nil {
 				return
 			}
 
-		}
-
-		if _, err = io.WriteString(_w, `// You cannot change a primary key for a record that has been written to the database. While SQL databases will
-// allow it, NoSql databases will not. Save a copy and delete this one instead.
-func (o *`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `Base) Set`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, ` in the object, to be saved later in the database using the Save() function.
+`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
-			return
-		}
+			if col.IsAutoPK() {
 
-		if _, err = io.WriteString(_w, `(v `); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `// Normally you will not need to call this function, since the `); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, col.Type); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `) {
-    if o._restored {
-        panic ("error: Do not change a primary key for a record that has been saved. Instead, save a copy and delete the original.")
-    }
+				if _, err = io.WriteString(_w, ` value is automatically generated by the
+// database driver. Exceptions might include importing data to a new database, or correcting primary key conflicts when
+// merging data.
 `); err != nil {
-			return
-		}
-
-		if col.Size > 0 && col.ReceiverType == query.ColTypeString {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `    if utf8.RuneCountInString(v) > `); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `// You cannot change a primary key for a record that has been written to the database. While SQL databases will
+// allow it, NoSql databases will not. Save a copy and delete this one instead.
+func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `MaxLength {
-        panic("attempted to set `); err != nil {
+			if _, err = io.WriteString(_w, `Base) Set`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.`); err != nil {
+			if _, err = io.WriteString(_w, `(v `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			if _, err = io.WriteString(_w, col.Type); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` to a value larger than its maximum length in runes")
+			if _, err = io.WriteString(_w, `) {
+    if o._restored {
+        panic ("error: Do not change a primary key for a record that has been saved. Instead, save a copy and delete the original.")
     }
 `); err != nil {
 				return
 			}
 
-		}
+			if col.Size > 0 && col.ReceiverType == query.ColTypeString {
 
-		if _, err = io.WriteString(_w, `	o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `    if utf8.RuneCountInString(v) > `); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, col.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `IsLoaded = true
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `MaxLength {
+        panic("attempted to set `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` to a value larger than its maximum length in runes")
+    }
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `	o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsLoaded = true
 	o.`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, col.Field); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `IsDirty = true
+			if _, err = io.WriteString(_w, `IsDirty = true
 	o.`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, col.Field); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, ` = v
+			if _, err = io.WriteString(_w, ` = v
 }
+`); err != nil {
+				return
+			}
+
+		}
 
+		if _, err = io.WriteString(_w, `
 `); err != nil {
 			return
 		}
@@ -2734,6 +3406,21 @@ func (tmpl *TableBaseTemplate) genColNullSetter(table *model.Table, col *model.C
 			return
 		}
 
+	} else if col.PolymorphicReference != nil {
+
+		if _, err = io.WriteString(_w, `// Will also set the attached o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.PolymorphicReference.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` to nil.
+`); err != nil {
+			return
+		}
+
 	}
 
 	if _, err = io.WriteString(_w, `func (o *`); err != nil {
@@ -2835,6 +3522,21 @@ func (tmpl *TableBaseTemplate) genColNullSetter(table *model.Table, col *model.C
 			return
 		}
 
+	} else if col.PolymorphicReference != nil {
+
+		if _, err = io.WriteString(_w, `	o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.PolymorphicReference.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = nil
+`); err != nil {
+			return
+		}
+
 	}
 
 	if _, err = io.WriteString(_w, `}
@@ -2845,28 +3547,27 @@ func (tmpl *TableBaseTemplate) genColNullSetter(table *model.Table, col *model.C
 	return
 }
 
-func (tmpl *TableBaseTemplate) genRefGetter(table *model.Table, ref *model.Reference, _w io.Writer) (err error) {
+func (tmpl *TableBaseTemplate) genColEnumSet(table *model.Table, col *model.Column, _w io.Writer) (err error) {
 
-	//*** ref_getter.tmpl
+	//*** column_enum_set.tmpl
 
-	if _, err = io.WriteString(_w, `
-// `); err != nil {
+	if _, err = io.WriteString(_w, `// Has`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+	if _, err = io.WriteString(_w, col.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` returns the current value of the loaded `); err != nil {
+	if _, err = io.WriteString(_w, ` returns true if v is in the set of values of `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+	if _, err = io.WriteString(_w, col.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `, and nil if its not loaded.
+	if _, err = io.WriteString(_w, `.
 func (o *`); err != nil {
 		return
 	}
@@ -2875,138 +3576,343 @@ func (o *`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Base) `); err != nil {
+	if _, err = io.WriteString(_w, `Base) Has`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+	if _, err = io.WriteString(_w, col.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `() *`); err != nil {
+	if _, err = io.WriteString(_w, `(v `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+	if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` {
-	return o.`); err != nil {
+	if _, err = io.WriteString(_w, `) bool {
+	return slices.Contains(o.`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.Field); err != nil {
+	if _, err = io.WriteString(_w, col.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `
+	if _, err = io.WriteString(_w, `(), v)
 }
 
-// Load`); err != nil {
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
-		return
-	}
+	if col.HasSetter() {
 
-	if _, err = io.WriteString(_w, ` returns the related `); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `// Add`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `. If it is not already loaded,
-// it will attempt to load it, provided the `); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, ` adds the values to the set of values of `); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.ForeignKey.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` column has been loaded first.
+		if _, err = io.WriteString(_w, `.
 func (o *`); err != nil {
-		return
-	}
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `Base) Load`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `Base) Add`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `(ctx context.Context) (*`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `(v ...`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `, error) {
-	var err error
+		if _, err = io.WriteString(_w, `) {
+	o.Set`); err != nil {
+			return
+		}
 
-	if o.`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.Field); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `(append(slices.Clone(o.`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` == nil {
-	    if !o.`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `()), v...))
+}
 
-	if _, err = io.WriteString(_w, `IsLoaded  {
-    		panic("`); err != nil {
-		return
-	}
+// Remove`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.ForeignKey.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` must be selected in the previous query")
-    	}
-		// Load and cache
-		o.`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, ` removes the values from the set of values of `); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.Field); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `, err = Load`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `.
+func (o *`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `(ctx, o.`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `Base) Remove`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
-		return
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(v ...`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `) {
+	o.Set`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(slices.DeleteFunc(slices.Clone(o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()), func(e `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `) bool {
+		return slices.Contains(v, e)
+	}))
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+func (tmpl *TableBaseTemplate) genRefGetter(table *model.Table, ref *model.Reference, _w io.Writer) (err error) {
+
+	//*** ref_getter.tmpl
+
+	if _, err = io.WriteString(_w, `
+// `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` returns the current value of the loaded `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, and nil if its not loaded.
+func (o *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Base) `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `() *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	return o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `
+}
+
+// Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` returns the related `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `. If it is not already loaded,
+// it will attempt to load it, provided the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ForeignKey.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` column has been loaded first.
+func (o *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Base) Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx context.Context) (*`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, error) {
+	var err error
+
+	if o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` == nil {
+	    if !o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `IsLoaded  {
+    		panic("`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ForeignKey.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` must be selected in the previous query")
+    	}
+		// Load and cache
+		o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, err = Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx, o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
+		return
 	}
 
 	if _, err = io.WriteString(_w, `)
@@ -3410,505 +4316,723 @@ func (o *`); err != nil {
 	return
 }
 
-func (tmpl *TableBaseTemplate) genAliasGetter(table *model.Table, _w io.Writer) (err error) {
+func (tmpl *TableBaseTemplate) genPolymorphicAccessors(table *model.Table, poly *model.PolymorphicReference, _w io.Writer) (err error) {
 
-	//*** alias_getter.tmpl
+	//*** poly_accessor.tmpl
 
 	if _, err = io.WriteString(_w, `
-// GetAlias returns the value for the Alias node aliasKey that was returned in the most
-// recent query.
-func (o *`); err != nil {
+// `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+	if _, err = io.WriteString(_w, poly.InterfaceType()); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Base) GetAlias(aliasKey string) query.AliasValue {
-	if a,ok := o._aliases[aliasKey]; ok {
-		return query.NewAliasValue(a)
-	} else {
-		panic ("Alias " + aliasKey + " not found.")
+	if _, err = io.WriteString(_w, ` is implemented by the objects a `); err != nil {
+		return
 	}
-}
 
-`); err != nil {
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
 		return
 	}
 
-	return
-}
-
-func (tmpl *TableBaseTemplate) genIsNew(table *model.Table, _w io.Writer) (err error) {
-
-	//*** is_new.tmpl
-
-	if _, err = io.WriteString(_w, `
-// IsNew returns true if the object will create a new record when saved.
-func (o *`); err != nil {
+	if _, err = io.WriteString(_w, ` can refer to as its `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Base) IsNew() bool {
-	return !o._restored
-}
-
-`); err != nil {
+	if _, err = io.WriteString(_w, `:
+// `); err != nil {
 		return
 	}
 
-	return
-}
-
-func (tmpl *TableBaseTemplate) genManyManyAccessors(table *model.Table, _w io.Writer) (err error) {
-
-	//*** many_many_accessors.tmpl
-
-	for _, mm := range table.ManyManyReferences {
+	for _i, _j := range poly.ReferencedTables {
+		_ = _j
 
-		if _, err = io.WriteString(_w, `    `); err != nil {
+		if _, err = io.WriteString(_w, `*`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `
-`); err != nil {
+		if _, err = io.WriteString(_w, _j.Identifier); err != nil {
 			return
 		}
 
-		//*** mm_accessor.tmpl
-
-		if _, err = io.WriteString(_w, `
-// `); err != nil {
-			return
+		if _i < len(poly.ReferencedTables)-1 {
+			if _, err = io.WriteString(_w, ", "); err != nil {
+				return
+			}
 		}
+	}
+	if _, err = io.WriteString(_w, `.
+type `); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Identifier); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.InterfaceType()); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` returns a single `); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, ` interface {
+    query.OrmObj
+    PrimaryKey() `); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.ForeignKey.Type); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` object by primary key pk, if one was loaded.
-// Otherwise, it will return nil.
+	if _, err = io.WriteString(_w, `
+    IsDirty() bool
+    Save(ctx context.Context) error
+    MarshalStringMap() map[string]interface{}
+    // `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.TypeMethod()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` returns the value of the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.TypeColumn.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` column that refers to the object.
+    `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.TypeMethod()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `() string
+}
+
+// `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` returns the loaded `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` object, and nil if it is not loaded.
+// Use a type switch to find out which kind of object it is.
 func (o *`); err != nil {
-			return
-		}
+		return
+	}
 
-		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `Base) `); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `Base) `); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Identifier); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `(pk `); err != nil {
+	if _, err = io.WriteString(_w, `() `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.InterfaceType()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	return o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `
+}
+
+// Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` returns the related `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` object. If it is not already loaded,
+// it will attempt to load it from the table named by the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` column, provided
+// the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` and `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` columns have been loaded first.
+`); err != nil {
+		return
+	}
+
+	if poly.IsNullable {
+
+		if _, err = io.WriteString(_w, `// If the reference is null, nil is returned.
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
+	}
+
+	if _, err = io.WriteString(_w, `func (o *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Base) Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx context.Context) (`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.InterfaceType()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, error) {
+	if o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` != nil {
+	    return o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, nil
+	}
+    if !o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.TypeColumn.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `IsLoaded || !o.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.ForeignKey.Field); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `IsLoaded {
+        panic("`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` and `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` must be selected in the previous query")
+    }
+`); err != nil {
+		return
+	}
+
+	if poly.IsNullable {
+
+		if _, err = io.WriteString(_w, `    if o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `) *`); err != nil {
+		if _, err = io.WriteString(_w, poly.TypeColumn.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+		if _, err = io.WriteString(_w, `IsNull || o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` {
-	return o.`); err != nil {
+		if _, err = io.WriteString(_w, poly.ForeignKey.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
+		if _, err = io.WriteString(_w, `IsNull {
+        return nil, nil
+    }
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.Get(pk)
-}
+	}
 
-// `); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `    // Load and cache
+    switch o.`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.TypeColumn.Field); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` returns a slice of `); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, ` {
+`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+	for _, t := range poly.ReferencedTables {
+
+		if _, err = io.WriteString(_w, `    case "`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` objects if loaded. If not loaded, will return nil.
-// The values will be ordered by the latest query or in the order they were assigned.
-func (o *`); err != nil {
+		if _, err = io.WriteString(_w, t.QueryName); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		if _, err = io.WriteString(_w, `":
+        obj, err := Load`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Base) `); err != nil {
+		if _, err = io.WriteString(_w, t.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, `(ctx, o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `() []*`); err != nil {
+		if _, err = io.WriteString(_w, poly.ForeignKey.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+		if _, err = io.WriteString(_w, `)
+        if err != nil || obj == nil {
+            return nil, err
+        }
+        o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` {
-	return o.`); err != nil {
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
+		if _, err = io.WriteString(_w, ` = obj
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.Values()
-}
+	}
 
-// Set`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `    default:
+        return nil, fmt.Errorf("`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` sets the associated objects to the given slice of `); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `.`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` objects
-// in preparation for saving. The associations will not be updated until Save() is called.
-// Objects that are modified or are new will be saved before completing the association.
-func (o *`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, ` has an unknown table name: %q", o.`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.TypeColumn.Field); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `Base) Set`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `)
+    }
+	return o.`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.Field); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `(objs ...*`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `, nil
+}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
-			return
-		}
+// Set`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `) {
-    o.`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, ` sets the `); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `.Clear()
-	o.`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, ` and `); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `IsDirty = true
-	o.`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, ` columns to refer to obj.
+// The referenced object will be saved when `); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` = nil
-    for _,obj := range objs {
-        o.`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, ` is saved.
+`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
-    }
-}
+	if poly.IsNullable {
 
-// Set`); err != nil {
+		if _, err = io.WriteString(_w, `// Pass nil to break the connection.
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
-			return
-		}
+	}
 
-		if _, err = io.WriteString(_w, `By`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `func (o *`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().Identifier); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` prepares to associate `); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `Base) Set`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` objects by
-// the primary keys in `); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `(obj `); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().FieldPlural); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.InterfaceType()); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `.
-// If objects are currently loaded, they will be unloaded.
-// The association does not take place until Save() is called. Calling Load before calling
-// Save will load the items that will be associated in the database after the Save call.
-// After calling Save, the objects will be unloaded, and you must call Load again if you want
-// them loaded.
-func (o *`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `) {
+	if obj == nil {
+`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-			return
-		}
+	if poly.IsNullable {
 
-		if _, err = io.WriteString(_w, `Base) Set`); err != nil {
+		if _, err = io.WriteString(_w, `		o.Set`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `By`); err != nil {
+		if _, err = io.WriteString(_w, `ToNull()
+		o.Set`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().Identifier); err != nil {
+		if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(`); err != nil {
+		if _, err = io.WriteString(_w, `ToNull()
+		o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().FieldPlural); err != nil {
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` ...`); err != nil {
+		if _, err = io.WriteString(_w, ` = nil
+		return
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyType()); err != nil {
-			return
-		}
+	} else {
 
-		if _, err = io.WriteString(_w, `) {
-	o.`); err != nil {
+		if _, err = io.WriteString(_w, `		panic("Cannot set `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.Clear()
-	o.`); err != nil {
+		if _, err = io.WriteString(_w, ` to a nil value since `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+		if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` = `); err != nil {
+		if _, err = io.WriteString(_w, ` is not nullable.")
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().FieldPlural); err != nil {
-			return
-		}
+	}
 
-		if _, err = io.WriteString(_w, `
-	o.`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `	}
+	o.Set`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `IsDirty = true
-}
+	if _, err = io.WriteString(_w, `(obj.`); err != nil {
+		return
+	}
 
-// Load`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.TypeMethod()); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `())
+	o.Set`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` loads the `); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `(obj.PrimaryKey())
+	o.`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, ` objects associated through the `); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, poly.Field); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.Identifier); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, ` = obj
+}
 
-		if _, err = io.WriteString(_w, `-`); err != nil {
-			return
-		}
+`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.MM.Identifier); err != nil {
-			return
-		}
+	return
+}
 
-		if _, err = io.WriteString(_w, ` relationship.
-func (o *`); err != nil {
-			return
-		}
+func (tmpl *TableBaseTemplate) genAliasGetter(table *model.Table, _w io.Writer) (err error) {
 
-		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-			return
-		}
+	//*** alias_getter.tmpl
 
-		if _, err = io.WriteString(_w, `Base) Load`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `
+// GetAlias returns the value for the Alias node aliasKey that was returned in the most
+// recent query.
+func (o *`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `(ctx context.Context) ([]*`); err != nil {
+	if _, err = io.WriteString(_w, `Base) GetAlias(aliasKey string) query.AliasValue {
+	if a,ok := o._aliases[aliasKey]; ok {
+		return query.NewAliasValue(a)
+	} else {
+		panic ("Alias " + aliasKey + " not found.")
+	}
+}
+
+`); err != nil {
+		return
+	}
+
+	return
+}
+
+func (tmpl *TableBaseTemplate) genIsNew(table *model.Table, _w io.Writer) (err error) {
+
+	//*** is_new.tmpl
+
+	if _, err = io.WriteString(_w, `
+// IsNew returns true if the object will create a new record when saved.
+func (o *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Base) IsNew() bool {
+	return !o._restored
+}
+
+`); err != nil {
+		return
+	}
+
+	return
+}
+
+func (tmpl *TableBaseTemplate) genManyManyAccessors(table *model.Table, _w io.Writer) (err error) {
+
+	//*** many_many_accessors.tmpl
+
+	for _, mm := range table.ManyManyReferences {
+
+		if _, err = io.WriteString(_w, `    `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+		if _, err = io.WriteString(_w, `
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `, error) {
-    if o.`); err != nil {
+		//*** mm_accessor.tmpl
+
+		if _, err = io.WriteString(_w, `
+// `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
+		if _, err = io.WriteString(_w, mm.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `IsDirty && o.`); err != nil {
+		if _, err = io.WriteString(_w, ` returns a single `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` == nil {
-        panic("dirty many-many relationships cannot be loaded; call Save() first")
-    }
-    
-    var objs []*`); err != nil {
+		if _, err = io.WriteString(_w, ` object by primary key pk, if one was loaded.
+// Otherwise, it will return nil.
+func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `
-    var err error
-
-    if o.`); err != nil {
+		if _, err = io.WriteString(_w, `Base) `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+		if _, err = io.WriteString(_w, mm.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` != nil {
-        // Load the objects that will be associated after a Save
-        objs, err = Query`); err != nil {
+		if _, err = io.WriteString(_w, `(pk `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.TypePlural()); err != nil {
+		if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(ctx).
-            Where(op.In(node.`); err != nil {
+		if _, err = io.WriteString(_w, `) *`); err != nil {
 			return
 		}
 
@@ -3916,27 +5040,27 @@ func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `().PrimaryKeys()[0], o.`); err != nil {
+		if _, err = io.WriteString(_w, ` {
+	return o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `...)).
-            Load()
-    } else {
-        objs, err = Query`); err != nil {
+		if _, err = io.WriteString(_w, `.Get(pk)
+}
+
+// `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.TypePlural()); err != nil {
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(ctx).
-            Where(op.Equal(node.`); err != nil {
+		if _, err = io.WriteString(_w, ` returns a slice of `); err != nil {
 			return
 		}
 
@@ -3944,41 +5068,33 @@ func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `().`); err != nil {
+		if _, err = io.WriteString(_w, ` objects if loaded. If not loaded, will return nil.
+// The values will be ordered by the latest query or in the order they were assigned.
+func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.MM.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `().PrimaryKey(), o.PrimaryKey())).
-            Load()
-    }
-    if err != nil {
-        return nil, err
-    }
-
-    o.`); err != nil {
+		if _, err = io.WriteString(_w, `Base) `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.Clear()
-	for _,obj := range objs {
-	    o.`); err != nil {
+		if _, err = io.WriteString(_w, `() []*`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
-	}
+		if _, err = io.WriteString(_w, ` {
 	return o.`); err != nil {
 			return
 		}
@@ -3987,10 +5103,10 @@ func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.Values(), err
+		if _, err = io.WriteString(_w, `.Values()
 }
 
-// Count`); err != nil {
+// Set`); err != nil {
 			return
 		}
 
@@ -3998,18 +5114,56 @@ func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` counts the number of associated `); err != nil {
+		if _, err = io.WriteString(_w, ` sets the associated objects to the given slice of `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` objects in the database.
-// Note that this returns what is reflected by the database at that instant, and not what
-// is the count of the loaded objects.
-func (o *`); err != nil {
+		if _, err = io.WriteString(_w, ` objects
+// in preparation for saving. The associations will not be updated until Save() is called.
+// Objects that are modified or are new will be saved before completing the association.
+`); err != nil {
+			return
+		}
+
+		if mm.IsOrdered() {
+
+			if _, err = io.WriteString(_w, `// The order of objs is saved, and Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` will return them in that order.
+`); err != nil {
+				return
+			}
+
+		}
+
+		if mm.HasColumns() {
+
+			if _, err = io.WriteString(_w, `// Objects that were already associated keep the values of their links. Use Set`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` to also set the values.
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `func (o *`); err != nil {
 			return
 		}
 
@@ -4017,7 +5171,7 @@ func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Base) Count`); err != nil {
+		if _, err = io.WriteString(_w, `Base) Set`); err != nil {
 			return
 		}
 
@@ -4025,546 +5179,756 @@ func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(ctx context.Context) (int, error) {
-	return Query`); err != nil {
+		if _, err = io.WriteString(_w, `(objs ...*`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.TypePlural()); err != nil {
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(ctx).
-		Where(op.Equal(node.`); err != nil {
+		if _, err = io.WriteString(_w, `) {
+    o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `().`); err != nil {
+		if _, err = io.WriteString(_w, `.Clear()
+	o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.MM.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(), o.PrimaryKey())).
-		Count()
+		if _, err = io.WriteString(_w, `IsDirty = true
+	o.`); err != nil {
+			return
+		}
 
-}
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+			return
+		}
 
-`); err != nil {
+		if _, err = io.WriteString(_w, ` = nil
+    for _,obj := range objs {
+        o.`); err != nil {
 			return
 		}
 
-	}
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `
+		if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
+    }
 `); err != nil {
-		return
-	}
-
-	return
-}
-
-func (tmpl *TableBaseTemplate) genReverseRefAccessors(table *model.Table, _w io.Writer) (err error) {
-
-	//*** reverse_ref_accessors.tmpl
+			return
+		}
 
-	for _, rev := range table.ReverseReferences {
-		if rev.IsUnique {
+		if mm.HasColumns() {
 
-			//*** rev_accessor_unique.tmpl
+			if _, err = io.WriteString(_w, `    for pk := range o.`); err != nil {
+				return
+			}
 
-			if _, err = io.WriteString(_w, `
-// `); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+			if _, err = io.WriteString(_w, ` {
+        if !o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` returns the connected `); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `.Has(pk) {
+            delete(o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` object, if one was loaded.
-// Otherwise, it will return nil.
-func (o *`); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			if _, err = io.WriteString(_w, `, pk)
+        }
+    }
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `Base) `); err != nil {
-				return
-			}
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `}
 
-			if _, err = io.WriteString(_w, `() *`); err != nil {
-				return
-			}
+// Set`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, ` {
-	if o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `By`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().Identifier); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, ` == nil {
-		return nil
-	}
-	return o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, ` prepares to associate `); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `
-}
+		if _, err = io.WriteString(_w, ` objects by
+// the primary keys in `); err != nil {
+			return
+		}
 
-// Load`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().FieldPlural); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `.
+`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, ` returns the connected `); err != nil {
-				return
-			}
+		if mm.IsOrdered() {
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `// The order of the primary keys is saved.
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` object, if one was loaded.
-// Otherwise, it will load a new one and return it.
+		}
+
+		if _, err = io.WriteString(_w, `// If objects are currently loaded, they will be unloaded.
+// The association does not take place until Save() is called. Calling Load before calling
+// Save will load the items that will be associated in the database after the Save call.
+// After calling Save, the objects will be unloaded, and you must call Load again if you want
+// them loaded.
 func (o *`); err != nil {
-				return
-			}
+			return
+		}
 
-			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `Base) Load`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `Base) Set`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `(ctx context.Context) (*`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `By`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().Identifier); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `, error) {
-    if o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `(`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().FieldPlural); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, ` != nil && o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, ` ...`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyType()); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `.IsDirty() {
-        panic("The `); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `) {
+	o.`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, ` has changed. You must save it first before changing to a different one.")
-    }
-    var err error
-	if o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `.Clear()
+	o.`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, ` == nil {
-	    pk := o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, ` = `); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.Table.PrimaryKeyColumn().Identifier); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().FieldPlural); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `()
-		o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `
+	o.`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `, err = Load`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `IsDirty = true
+`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-				return
-			}
+		if mm.HasColumns() {
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
+			if _, err = io.WriteString(_w, `    for pk := range o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(ctx, pk)
-	}
-	return o.`); err != nil {
+			if _, err = io.WriteString(_w, ` {
+        if !slices.Contains(`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.ReferencedTable.PrimaryKeyColumn().FieldPlural); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `, err
-}
-
-// Set`); err != nil {
+			if _, err = io.WriteString(_w, `, pk) {
+            delete(o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` associates obj with this `); err != nil {
+			if _, err = io.WriteString(_w, `, pk)
+        }
+    }
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
-				return
-			}
+		}
 
-			if _, err = io.WriteString(_w, `
-// through the reverse relationship in `); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-				return
-			}
+// Load`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.Identifier); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, ` loads the `); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `.
-//
-// The association is temporary until you call Save().
-`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+			return
+		}
 
-			if rev.IsNullable {
+		if _, err = io.WriteString(_w, ` objects associated through the `); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `// Since this is a unique relationship, if a different `); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, mm.Identifier); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `-`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, ` object is currently pointing to this `); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, mm.MM.Identifier); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, table.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, ` relationship.
+func (o *`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `,
-// that `); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `Base) Load`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `'s `); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `(ctx context.Context) ([]*`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, ` value will be set to null when Save is called.
-// If you did not use a join to query the attached `); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `, error) {
+    if o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, ` in the first place, used a conditional join,
-// or joined with an expansion, be particularly careful, since you may be inadvertently changing an item
-// that is not currently attached to this `); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, fmt.Sprint(table.Identifier)); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `IsDirty && o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `.
-`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+			return
+		}
 
-			} else {
+		if _, err = io.WriteString(_w, ` == nil {
+        panic("dirty many-many relationships cannot be loaded; call Save() first")
+    }
+    
+    var objs []*`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `// WARNING! Since this is a non-nullable unique relationship,
-// if a different `); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `
+    var err error
 
-				if _, err = io.WriteString(_w, ` object is currently pointing to this `); err != nil {
-					return
-				}
+    if o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, table.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `,
-// it will be deleted. Pass nil to just delete the old object.
+		if _, err = io.WriteString(_w, ` != nil {
+        // Load the objects that will be associated after a Save
+        objs, err = Query`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.TypePlural()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx).
+            Where(op.In(node.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().PrimaryKeys()[0], o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `...)).
+            Load()
 `); err != nil {
-					return
-				}
+			return
+		}
 
-			}
+		if mm.HasColumns() || mm.IsOrdered() {
 
-			if _, err = io.WriteString(_w, `func (o *`); err != nil {
+			if _, err = io.WriteString(_w, `    } else {
+        // Load through the `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `Base) Set`); err != nil {
+			if _, err = io.WriteString(_w, ` so that the association table can be used
+        var obj *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(obj *`); err != nil {
+			if _, err = io.WriteString(_w, `
+        obj, err = Query`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `) {
-    if o.`); err != nil {
+			if _, err = io.WriteString(_w, `(ctx).
+            Where(op.Equal(node.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` != nil && o.`); err != nil {
+			if _, err = io.WriteString(_w, `().PrimaryKey(), o.PrimaryKey())).
+            Select(node.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.IsDirty() {
-        panic("The `); err != nil {
+			if _, err = io.WriteString(_w, `().`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` has changed. You must save it first before changing to a different one.")
-    }
-	o.`); err != nil {
+			if _, err = io.WriteString(_w, `()).
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
+			if mm.IsOrdered() {
+
+				if _, err = io.WriteString(_w, `            OrderBy(node.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.OrderColumnIdentifier()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `()).
+`); err != nil {
+					return
+				}
+
 			}
 
-			if _, err = io.WriteString(_w, ` = obj
-	o.`); err != nil {
+			if _, err = io.WriteString(_w, `            Get()
+        if err != nil {
+            return nil, err
+        }
+        o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `IsDirty = true
-}
-
+			if _, err = io.WriteString(_w, `.Clear()
 `); err != nil {
 				return
 			}
 
-		} else { // Unique
+			if mm.HasColumns() {
 
-			//*** rev_accessor.tmpl
+				if _, err = io.WriteString(_w, `        o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = nil
+`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `// `); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+			if _, err = io.WriteString(_w, `        if obj != nil {
+            o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` returns a single `); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, ` = obj.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` object by primary key, if one was loaded.
-// Otherwise, it will return nil. It will not return `); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` objects that are not saved.
-func (o *`); err != nil {
+			if mm.HasColumns() {
+
+				if _, err = io.WriteString(_w, `            o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = obj.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `        }
+        return o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `Base) `); err != nil {
+			if _, err = io.WriteString(_w, `.Values(), nil
+    }
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+		} else {
+
+			if _, err = io.WriteString(_w, `    } else {
+        objs, err = Query`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(pk `); err != nil {
+			if _, err = io.WriteString(_w, mm.TypePlural()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.PrimaryKeyType()); err != nil {
+			if _, err = io.WriteString(_w, `(ctx).
+            Where(op.Equal(node.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `) *`); err != nil {
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `().`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` {
-	v := o.`); err != nil {
+			if _, err = io.WriteString(_w, mm.MM.IdentifierPlural); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, `().PrimaryKey(), o.PrimaryKey())).
+            Load()
+    }
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Get(pk)
-	return v
+		}
+
+		if _, err = io.WriteString(_w, `    if err != nil {
+        return nil, err
+    }
+
+    o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Clear()
+	for _,obj := range objs {
+	    o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
+	}
+	return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Values(), err
 }
 
-// `); err != nil {
+// Count`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` counts the number of associated `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` objects in the database.
+// Note that this returns what is reflected by the database at that instant, and not what
+// is the count of the loaded objects.
+func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) Count`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx context.Context) (int, error) {
+	return Query`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.TypePlural()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx).
+		Where(op.Equal(node.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Type()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.MM.IdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(), o.PrimaryKey())).
+		Count()
+
+}
+
+`); err != nil {
+			return
+		}
+
+		if mm.HasColumns() || mm.IsOrdered() {
+
+			if _, err = io.WriteString(_w, `// `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` returns a slice of `); err != nil {
+			if _, err = io.WriteString(_w, `AssnValue returns the values to write to the extra columns of the `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` objects if loaded.
+			if _, err = io.WriteString(_w, ` table
+// when associating the `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` with primary key pk at position i.
 func (o *`); err != nil {
 				return
 			}
@@ -4577,47 +5941,102 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `() []*`); err != nil {
+			if _, err = io.WriteString(_w, `AssnValue(i int, pk `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` {
-	return o.`); err != nil {
+			if _, err = io.WriteString(_w, `) map[string]any {
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
+			if mm.HasColumns() && mm.IsOrdered() {
+
+				if _, err = io.WriteString(_w, `    v := o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `[pk].values()
+    if v == nil {
+        v = make(map[string]any)
+    }
+    v["`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.OrderColumn); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"] = i
+    return v
+`); err != nil {
+					return
+				}
+
+			} else if mm.HasColumns() {
+
+				if _, err = io.WriteString(_w, `    return o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `[pk].values()
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `    return map[string]any{"`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.OrderColumn); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `": i}
+`); err != nil {
+					return
+				}
+
 			}
 
-			if _, err = io.WriteString(_w, `.Values()
-}
+			if _, err = io.WriteString(_w, `}
 
-// Load`); err != nil {
+// `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` loads a new slice of `); err != nil {
+			if _, err = io.WriteString(_w, `AssnValues returns the values of `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` objects and returns it.
+			if _, err = io.WriteString(_w, `AssnValue for each of pks, in the form needed by db.AssociateOnly.
 func (o *`); err != nil {
 				return
 			}
@@ -4626,184 +6045,277 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `Base) Load`); err != nil {
+			if _, err = io.WriteString(_w, `Base) `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(ctx context.Context) ([]*`); err != nil {
+			if _, err = io.WriteString(_w, `AssnValues(pks []`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `, error) {
-	if o.IsNew() {
-		return nil, nil
-	}
-	for obj := range o.`); err != nil {
+			if _, err = io.WriteString(_w, `) []map[string]any {
+    values := make([]map[string]any, len(pks))
+    for i, pk := range pks {
+        values[i] = o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.ValuesIter() {
-        if obj.IsDirty() {
-            panic("You cannot load over items that have changed but have not been saved.")
-        }
+			if _, err = io.WriteString(_w, `AssnValue(i, pk)
     }
+    return values
+}
 
-    objs,err := Load`); err != nil {
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+		}
+
+		if mm.IsOrdered() {
+
+			if _, err = io.WriteString(_w, `// Move`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
+			if _, err = io.WriteString(_w, mm.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+			if _, err = io.WriteString(_w, ` moves the `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(ctx, o.PrimaryKey())
-    if err != nil {
-        return nil, err
-    }
-    o.`); err != nil {
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, ` at position from in the `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Clear()
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+				return
+			}
 
-    for _,obj := range objs {
-        pk := obj.`); err != nil {
+			if _, err = io.WriteString(_w, ` list to position to,
+// shifting the ones in between, and saves the new positions in the database in a single transaction.
+// If the `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.PrimaryKeyColumn().Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `()
-        o.`); err != nil {
+			if _, err = io.WriteString(_w, ` are loaded, they are reordered too.
+// An error is returned if the `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Set(pk, obj)
-    }
+			if _, err = io.WriteString(_w, ` have unsaved changes.
+func (o *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Base) Move`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.Identifier); err != nil {
+				return
+			}
 
+			if _, err = io.WriteString(_w, `(ctx context.Context, from, to int) error {
     if o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Len() == 0 {
-        return nil, nil
+			if _, err = io.WriteString(_w, `IsDirty {
+        return fmt.Errorf("the `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` of `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` %v have unsaved changes and cannot be moved; call Save() first", o.PrimaryKey())
     }
-	return o.`); err != nil {
+    if err := db.MoveAssociation(ctx,
+            Database(),
+            "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Values(), nil
-}
+			if _, err = io.WriteString(_w, `",
+            "`); err != nil {
+				return
+			}
 
-// Count`); err != nil {
+			if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, `",
+            o.PrimaryKey(),
+            "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` does a database query and returns the number of `); err != nil {
+			if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `",
+            query.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `
-// objects currently in the database that have a `); err != nil {
+			if _, err = io.WriteString(_w, mm.ForeignKeyReceiverType.String()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `,
+            "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` value that equals this objects primary key.
-func (o *`); err != nil {
+			if _, err = io.WriteString(_w, mm.OrderColumn); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			if _, err = io.WriteString(_w, `",
+            from,
+            to); err != nil {
+        return err
+    }
+    if from < o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `Base) Count`); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, `.Len() && to < o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(ctx context.Context) (int, error) {
-    return Count`); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, `.Len() {
+        k := o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `.Keys()[from]
+        obj := o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(ctx, o.PrimaryKey())
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Get(k)
+        o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Delete(k)
+        o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.SetAt(to, k, obj)
+    }
+    return nil
 }
 
-// Set`); err != nil {
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+		if mm.HasColumns() {
+
+			if _, err = io.WriteString(_w, `    `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` associates the objects in objs with this `); err != nil {
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
+
+			//*** mm_link.tmpl
+
+			if _, err = io.WriteString(_w, `
+// `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` is a link from a `); err != nil {
 				return
 			}
 
@@ -4811,532 +6323,534 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` by setting
-// their `); err != nil {
+			if _, err = io.WriteString(_w, ` to a `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` values to this object's primary key.
-`); err != nil {
+			if _, err = io.WriteString(_w, ` through the `); err != nil {
 				return
 			}
 
-			if rev.IsNullable {
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `// If it has `); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, ` association table,
+// together with the values of the extra columns in that table.
+type `); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, ` already associated with it that will not be associated after a save,
-// the foreign keys for those `); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, ` struct {
+    `); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, ` will be set to null.
-// If you did not use a join to query the items in the first place, used a conditional join,
-// or joined with an expansion, be particularly careful, since you may be changing items
-// that are not currently attached to this `); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, ` *`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, table.Identifier); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `.
+			if _, err = io.WriteString(_w, `
 `); err != nil {
-					return
-				}
+				return
+			}
 
-			} else {
+			for _, col := range mm.Columns {
 
-				if _, err = io.WriteString(_w, `// WARNING! If it has `); err != nil {
+				if _, err = io.WriteString(_w, `    `); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				if _, err = io.WriteString(_w, col.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, ` already associated with it that will not be associated after a save,
-// Save will panic. Be sure to delete those `); err != nil {
+				if _, err = io.WriteString(_w, ` `); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				if _, err = io.WriteString(_w, col.Type); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, ` or otherwise fix those pointers before calling save.
+				if _, err = io.WriteString(_w, `
 `); err != nil {
 					return
 				}
 
+				if col.IsNullable {
+
+					if _, err = io.WriteString(_w, `    `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `IsNull bool
+`); err != nil {
+						return
+					}
+
+				}
+
 			}
 
-			if _, err = io.WriteString(_w, `func (o *`); err != nil {
+			if _, err = io.WriteString(_w, `    isDirty bool
+}
+
+// New`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `Base) Set`); err != nil {
+			if _, err = io.WriteString(_w, ` returns a new link to obj with the extra columns set to their default values.
+// Pass the link to `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(objs ...*`); err != nil {
+			if _, err = io.WriteString(_w, `.Set`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `) {
-    for obj := range o.`); err != nil {
+			if _, err = io.WriteString(_w, ` to associate obj.
+func New`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.ValuesIter() {
-        if obj.IsDirty() {
-            panic("You cannot overwrite items that have changed but have not been saved.")
-        }
-    }
-
-    o.`); err != nil {
+			if _, err = io.WriteString(_w, `(obj *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Clear()
-    for _,obj := range objs {
-        pk := obj.`); err != nil {
+			if _, err = io.WriteString(_w, `) *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.PrimaryKeyColumn().Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `()
-        o.`); err != nil {
+			if _, err = io.WriteString(_w, ` {
+    return &`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Set(pk, obj)
-    }
-	o.`); err != nil {
+			if _, err = io.WriteString(_w, `{
+        `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `IsDirty = true
-}
-
+			if _, err = io.WriteString(_w, `: obj,
 `); err != nil {
 				return
 			}
 
-		}
-	}
-
-	return
-}
-
-func (tmpl *TableBaseTemplate) genLoad(table *model.Table, _w io.Writer) (err error) {
+			for _, col := range mm.Columns {
 
-	//*** load.tmpl
-
-	if _, err = io.WriteString(_w, `// Load`); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, `        `); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, ` returns a `); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, `: `); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, col.DefaultValueAsValue()); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, ` from the database.
-// selectNodes lets you provide nodes for selecting specific fields or additional fields from related tables.
-// See [`); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, `,
+`); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-		return
-	}
+				if col.IsNullable && col.DefaultValue == nil {
 
-	if _, err = io.WriteString(_w, `Builder.Select] for more info.
-func Load`); err != nil {
-		return
-	}
+					if _, err = io.WriteString(_w, `        `); err != nil {
+						return
+					}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
 
-	if _, err = io.WriteString(_w, `(ctx context.Context, pk `); err != nil {
-		return
-	}
+					if _, err = io.WriteString(_w, `IsNull: true,
+`); err != nil {
+						return
+					}
 
-	if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
-		return
-	}
+				}
 
-	if _, err = io.WriteString(_w, `, selectNodes ...query.Node) (*`); err != nil {
-		return
-	}
+			}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `    }
+}
 
-	if _, err = io.WriteString(_w, `, error) {
-	return query`); err != nil {
-		return
-	}
+// `); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.Identifier); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `(ctx).
-`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, ` returns the linked `); err != nil {
+				return
+			}
 
-	for _, col := range table.PrimaryKeyColumns() {
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `	    Where(op.Equal(node.`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, ` object.
+func (l *`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `().`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `) `); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `(), pk`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `() *`); err != nil {
+				return
+			}
 
-		if len(table.PrimaryKeyColumns()) > 1 {
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
+				return
+			}
 
-			if _, err = io.WriteString(_w, `.`); err != nil {
+			if _, err = io.WriteString(_w, ` {
+    return l.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-		}
+			if _, err = io.WriteString(_w, `
+}
 
-		if _, err = io.WriteString(_w, `)).
 `); err != nil {
-			return
-		}
+				return
+			}
 
-	}
+			for _, col := range mm.Columns {
 
-	if _, err = io.WriteString(_w, `	    Select(selectNodes...).
-	    Get()
-}
+				if _, err = io.WriteString(_w, `// `); err != nil {
+					return
+				}
 
-// Has`); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, ` returns the value of the `); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, ` returns true if a `); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, col.QueryName); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, ` column of the link.
+func (l *`); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, ` with the given primary key exists in the database.
-// doc: type=`); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, `) `); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, `
-func Has`); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, `() `); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, `(ctx context.Context, pk `); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, ` {
+    return l.`); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, `) (bool, error) {
-    v, err := query`); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, `
+}
 
-	if _, err = io.WriteString(_w, `(ctx).
 `); err != nil {
-		return
-	}
+					return
+				}
 
-	for _, col := range table.PrimaryKeyColumns() {
+				if col.IsNullable {
 
-		if _, err = io.WriteString(_w, `	    Where(op.Equal(node.`); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, `// `); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, `().`); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, `IsNull returns true if the `); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, col.Identifier); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, col.QueryName); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, `(), pk`); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, ` column of the link is null.
+func (l *`); err != nil {
+						return
+					}
 
-		if len(table.PrimaryKeyColumns()) > 1 {
+					if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `.`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `) `); err != nil {
+						return
+					}
 
-		}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, `)).
-`); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, `IsNull() bool {
+    return l.`); err != nil {
+						return
+					}
 
-	}
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
 
-	if _, err = io.WriteString(_w, `         Count()
-    return v > 0, err
+					if _, err = io.WriteString(_w, `IsNull
 }
 
-`); err != nil {
-		return
-	}
+// Set`); err != nil {
+						return
+					}
 
-	for _, idx := range table.Indexes {
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-		if idx.IsUnique {
+					if _, err = io.WriteString(_w, `ToNull sets the `); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `// Load`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, col.QueryName); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, ` column of the link to null.
+func (l *`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `) Set`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, ` queries for a single `); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `ToNull() {
+    if !l.`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, ` object by the given unique index values.
-// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
-// See [`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `IsNull {
+        l.`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `Builder.Select].
-// If you need a more elaborate query, use Query`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `IsNull = true
+        l.`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `() to start a query builder.
-func Load`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, ` = `); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, col.DefaultValueAsValue()); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `
+        l.isDirty = true
+    }
+}
 
-			if _, err = io.WriteString(_w, ` (ctx context.Context, `); err != nil {
-				return
-			}
+`); err != nil {
+						return
+					}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
+				}
 
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
+				if _, err = io.WriteString(_w, `// Set`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, ` `); err != nil {
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
 					return
 				}
 
-				if _j.IsNullable {
+				if _, err = io.WriteString(_w, ` sets the value of the `); err != nil {
+					return
+				}
 
-					if _, err = io.WriteString(_w, `interface{}`); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, col.QueryName); err != nil {
+					return
+				}
 
-				} else {
+				if _, err = io.WriteString(_w, ` column of the link, to be saved
+// when the `); err != nil {
+					return
+				}
 
-					if _, err = io.WriteString(_w, _j.Type); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
 
+				if _, err = io.WriteString(_w, ` is saved.
+func (l *`); err != nil {
+					return
 				}
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ", "); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+					return
 				}
-			}
-			if _, err = io.WriteString(_w, `, selectNodes ...query.Node) (*`); err != nil {
-				return
-			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `) Set`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `, error) {
-    q := query`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `(v `); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `(ctx)
-`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
 
-			for _, col := range idx.Columns {
+				if _, err = io.WriteString(_w, `) {
+`); err != nil {
+					return
+				}
 
-				if col.IsNullable {
+				if col.ReceiverType == query.ColTypeString && col.Size > 0 {
 
-					if _, err = io.WriteString(_w, `    if `); err != nil {
+					if _, err = io.WriteString(_w, `    if utf8.RuneCountInString(v) > `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Field); err != nil {
+					if _, err = io.WriteString(_w, strconv.FormatUint(uint64(col.Size), 10)); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` == nil {
-        q = q.Where(op.IsNull(node.`); err != nil {
+					if _, err = io.WriteString(_w, ` {
+        panic("attempted to set `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
+					if _, err = io.WriteString(_w, `.`); err != nil {
 						return
 					}
 
@@ -5344,57 +6858,72 @@ func Load`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `()))
-    } else {
-        q = q.Where(op.Equal(node.`); err != nil {
+					if _, err = io.WriteString(_w, ` to a value larger than its maximum length in runes")
+    }
+`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
-						return
-					}
+				}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
+				if col.IsNullable {
+
+					if _, err = io.WriteString(_w, `    if !l.`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					if _, err = io.WriteString(_w, col.Field); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `(), `); err != nil {
+					if _, err = io.WriteString(_w, `IsNull && `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Field); err != nil {
+					if _, err = io.WriteString(_w, col.CompareGen("l."+col.Field, "v", true)); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `))
-    }
+					if _, err = io.WriteString(_w, ` {
 `); err != nil {
 						return
 					}
 
 				} else {
 
-					if _, err = io.WriteString(_w, `    q = q.Where(op.Equal(node.`); err != nil {
+					if _, err = io.WriteString(_w, `    if `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					if _, err = io.WriteString(_w, col.CompareGen("l."+col.Field, "v", true)); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
+					if _, err = io.WriteString(_w, ` {
+`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
-						return
-					}
+				}
+
+				if _, err = io.WriteString(_w, `        return
+    }
+    l.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = v
+`); err != nil {
+					return
+				}
+
+				if col.IsNullable {
 
-					if _, err = io.WriteString(_w, `(), `); err != nil {
+					if _, err = io.WriteString(_w, `    l.`); err != nil {
 						return
 					}
 
@@ -5402,115 +6931,164 @@ func Load`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `))
+					if _, err = io.WriteString(_w, `IsNull = false
 `); err != nil {
 						return
 					}
 
 				}
 
-			}
-
-			if _, err = io.WriteString(_w, `    return q.Select(selectNodes...).Get()
+				if _, err = io.WriteString(_w, `    l.isDirty = true
 }
 
-// Has`); err != nil {
-				return
-			}
+`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
+			if _, err = io.WriteString(_w, `// IsDirty returns true if a value of the link has changed since it was loaded or saved.
+func (l *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` returns true if the
-// given unique index values exist in the database.
-// doc: type=`); err != nil {
-				return
-			}
+			if _, err = io.WriteString(_w, `) IsDirty() bool {
+    return l.isDirty
+}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+// values returns the values of the extra columns keyed by column name, as needed by db.Associate.
+// A nil link returns nil, so that the database defaults are used.
+func (l *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `
-func Has`); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `) values() map[string]any {
+    if l == nil {
+        return nil
+    }
+    m := make(map[string]any)
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
-				return
-			}
+			for _, col := range mm.Columns {
 
-			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
-				return
-			}
+				if col.IsNullable {
 
-			if _, err = io.WriteString(_w, ` (ctx context.Context, `); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `    if l.`); err != nil {
+						return
+					}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, `IsNull {
+        m["`); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, ` `); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.QueryName); err != nil {
+						return
+					}
 
-				if _j.IsNullable {
+					if _, err = io.WriteString(_w, `"] = nil
+    } else {
+        m["`); err != nil {
+						return
+					}
 
-					if _, err = io.WriteString(_w, `interface{}`); err != nil {
+					if _, err = io.WriteString(_w, col.QueryName); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `"] = l.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `
+    }
+`); err != nil {
 						return
 					}
 
 				} else {
 
-					if _, err = io.WriteString(_w, _j.Type); err != nil {
+					if _, err = io.WriteString(_w, `    m["`); err != nil {
 						return
 					}
 
-				}
+					if _, err = io.WriteString(_w, col.QueryName); err != nil {
+						return
+					}
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ", "); err != nil {
+					if _, err = io.WriteString(_w, `"] = l.`); err != nil {
 						return
 					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `
+`); err != nil {
+						return
+					}
+
 				}
+
 			}
-			if _, err = io.WriteString(_w, `) (bool, error) {
-    q := query`); err != nil {
+
+			if _, err = io.WriteString(_w, `    return m
+}
+
+// unpack sets the values of the link from the data of the linked object returned by a query.
+// It returns false if the query did not select the values of the link.
+func (l *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(ctx)
+			if _, err = io.WriteString(_w, `) unpack(m map[string]any) (found bool) {
 `); err != nil {
 				return
 			}
 
-			for _, col := range idx.Columns {
+			for _, col := range mm.Columns {
+
+				if _, err = io.WriteString(_w, `    if v, ok := m["`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.ColumnQueryKey(col)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"]; ok {
+        found = true
+`); err != nil {
+					return
+				}
 
 				if col.IsNullable {
 
-					if _, err = io.WriteString(_w, `    if `); err != nil {
+					if _, err = io.WriteString(_w, `        if v == nil {
+            l.`); err != nil {
 						return
 					}
 
@@ -5518,42 +7096,139 @@ func Has`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` == nil {
-        q = q.Where(op.IsNull(node.`); err != nil {
+					if _, err = io.WriteString(_w, ` = `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					if _, err = io.WriteString(_w, col.DefaultValueAsValue()); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
+					if _, err = io.WriteString(_w, `
+            l.`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					if _, err = io.WriteString(_w, col.Field); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `()))
-    } else {
-        q = q.Where(op.Equal(node.`); err != nil {
+					if _, err = io.WriteString(_w, `IsNull = true
+`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
-						return
+					if col.IsEnum() && col.Enum.IsKeyStored() {
+
+						if _, err = io.WriteString(_w, `        } else if s, ok2 := v.(string); ok2 {
+            l.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Field); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, ` = `); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Type); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `FromKey(s)
+            l.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Field); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `IsNull = false
+`); err != nil {
+							return
+						}
+
+					} else if col.IsEnum() {
+
+						if _, err = io.WriteString(_w, `        } else if i, ok2 := v.(int); ok2 {
+            l.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Field); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, ` = `); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Type); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `(i)
+            l.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Field); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `IsNull = false
+`); err != nil {
+							return
+						}
+
+					} else {
+
+						if _, err = io.WriteString(_w, `        } else if v2, ok2 := v.(`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Type); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `); ok2 {
+            l.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Field); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, ` = v2
+            l.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Field); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `IsNull = false
+`); err != nil {
+							return
+						}
+
 					}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
+					if _, err = io.WriteString(_w, `        } else {
+            panic("Wrong type found for `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `(), `); err != nil {
+					if _, err = io.WriteString(_w, `.`); err != nil {
 						return
 					}
 
@@ -5561,31 +7236,98 @@ func Has`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `))
-    }
+					if _, err = io.WriteString(_w, `.")
+        }
 `); err != nil {
 						return
 					}
 
 				} else {
 
-					if _, err = io.WriteString(_w, `    q = q.Where(op.Equal(node.`); err != nil {
-						return
-					}
+					if col.IsEnum() && col.Enum.IsKeyStored() {
+
+						if _, err = io.WriteString(_w, `        if s, ok2 := v.(string); ok2 {
+            l.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Field); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, ` = `); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Type); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `FromKey(s)
+`); err != nil {
+							return
+						}
+
+					} else if col.IsEnum() {
+
+						if _, err = io.WriteString(_w, `        if i, ok2 := v.(int); ok2 {
+            l.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Field); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, ` = `); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Type); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `(i)
+`); err != nil {
+							return
+						}
+
+					} else {
+
+						if _, err = io.WriteString(_w, `        if v2, ok2 := v.(`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Type); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `); ok2 {
+            l.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Field); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, ` = v2
+`); err != nil {
+							return
+						}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
-						return
 					}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
+					if _, err = io.WriteString(_w, `        } else {
+            panic("Wrong type found for `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `(), `); err != nil {
+					if _, err = io.WriteString(_w, `.`); err != nil {
 						return
 					}
 
@@ -5593,226 +7335,189 @@ func Has`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `))
+					if _, err = io.WriteString(_w, `.")
+        }
 `); err != nil {
 						return
 					}
 
 				}
 
-			}
-
-			if _, err = io.WriteString(_w, `    v, err := q.Count()
-    return v > 0, err
-}
+				if _, err = io.WriteString(_w, `    }
 `); err != nil {
-				return
+					return
+				}
+
 			}
 
-		} else {
+			if _, err = io.WriteString(_w, `    return
+}
 
-			if _, err = io.WriteString(_w, ` `); err != nil {
+// encode writes the values of the link to enc. The linked object is encoded separately.
+func (l *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `
-// Load`); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, `) encode(enc db.Encoder) error {
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
-				return
-			}
+			for _, col := range mm.Columns {
 
-			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `    if err := enc.Encode(l.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, ` queries `); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `); err != nil {
+        return fmt.Errorf("error encoding `); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, ` objects by the given index values.
-// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
-// See [`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `Builder.Select].
-// If you need a more elaborate query, use Query`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `() to start a query builder.
-func Load`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `By`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, ` (ctx context.Context, `); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+					return
+				}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
+				if _, err = io.WriteString(_w, `.`); err != nil {
+					return
+				}
 
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
+				if _, err = io.WriteString(_w, col.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, ` `); err != nil {
+				if _, err = io.WriteString(_w, `: %w", err)
+    }
+`); err != nil {
 					return
 				}
 
-				if _j.IsNullable {
+				if col.IsNullable {
 
-					if _, err = io.WriteString(_w, `interface{}`); err != nil {
+					if _, err = io.WriteString(_w, `    if err := enc.Encode(l.`); err != nil {
 						return
 					}
 
-				} else {
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
 
-					if _, err = io.WriteString(_w, _j.Type); err != nil {
+					if _, err = io.WriteString(_w, `IsNull); err != nil {
+        return fmt.Errorf("error encoding `); err != nil {
 						return
 					}
 
-				}
+					if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+						return
+					}
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ", "); err != nil {
+					if _, err = io.WriteString(_w, `.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `IsNull: %w", err)
+    }
+`); err != nil {
 						return
 					}
+
 				}
+
 			}
-			if _, err = io.WriteString(_w, `, selectNodes ...query.Node) ([]*`); err != nil {
+
+			if _, err = io.WriteString(_w, `    if err := enc.Encode(l.isDirty); err != nil {
+        return fmt.Errorf("error encoding `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `, error) {
-    q := query`); err != nil {
+			if _, err = io.WriteString(_w, `.isDirty: %w", err)
+    }
+    return nil
+}
+
+// decode reads the values of the link written by encode.
+func (l *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(ctx)
+			if _, err = io.WriteString(_w, `) decode(dec db.Decoder) error {
 `); err != nil {
 				return
 			}
 
-			for _, col := range idx.Columns {
-
-				if col.IsNullable {
-
-					if _, err = io.WriteString(_w, `    if `); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, col.Field); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, ` == nil {
-        q = q.Where(op.IsNull(node.`); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, `().`); err != nil {
-						return
-					}
+			for _, col := range mm.Columns {
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, `()))
-    } else {
-        q = q.Where(op.Equal(node.`); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, `    if err := dec.Decode(&l.`); err != nil {
+					return
+				}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, `); err != nil {
+        return fmt.Errorf("error decoding `); err != nil {
+					return
+				}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+					return
+				}
 
-					if _, err = io.WriteString(_w, `(), `); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, `.`); err != nil {
+					return
+				}
 
-					if _, err = io.WriteString(_w, col.Field); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
 
-					if _, err = io.WriteString(_w, `))
+				if _, err = io.WriteString(_w, `: %w", err)
     }
 `); err != nil {
-						return
-					}
+					return
+				}
 
-				} else {
+				if col.IsNullable {
 
-					if _, err = io.WriteString(_w, `    q = q.Where(op.Equal(node.`); err != nil {
+					if _, err = io.WriteString(_w, `    if err := dec.Decode(&l.`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					if _, err = io.WriteString(_w, col.Field); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
+					if _, err = io.WriteString(_w, `IsNull); err != nil {
+        return fmt.Errorf("error decoding `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `(), `); err != nil {
+					if _, err = io.WriteString(_w, `.`); err != nil {
 						return
 					}
 
@@ -5820,7 +7525,8 @@ func Load`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `))
+					if _, err = io.WriteString(_w, `IsNull: %w", err)
+    }
 `); err != nil {
 						return
 					}
@@ -5829,1170 +7535,7783 @@ func Load`); err != nil {
 
 			}
 
-			if _, err = io.WriteString(_w, `    return q.Select(selectNodes...).Load()
+			if _, err = io.WriteString(_w, `    if err := dec.Decode(&l.isDirty); err != nil {
+        return fmt.Errorf("error decoding `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.isDirty: %w", err)
+    }
+    return nil
 }
 
-// Has`); err != nil {
+// `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
+			if _, err = io.WriteString(_w, ` returns the links to the loaded `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` returns true if the
-// given index values exist in the database.
-// doc: type=`); err != nil {
+			if _, err = io.WriteString(_w, ` objects, in the same order as `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `
-func Has`); err != nil {
+			if _, err = io.WriteString(_w, `().
+// Changes to the values of the links will be written when Save() is called. If the `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
+			if _, err = io.WriteString(_w, ` are not loaded, it will return nil.
+func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` (ctx context.Context, `); err != nil {
+			if _, err = io.WriteString(_w, `Base) `); err != nil {
 				return
 			}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `() []*`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, ` `); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
 
-				if _j.IsNullable {
+			if _, err = io.WriteString(_w, ` {
+    if o.`); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `interface{}`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
 
-				} else {
+			if _, err = io.WriteString(_w, `.Len() == 0 {
+        return nil
+    }
+    links := make([]*`); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, _j.Type); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
 
-				}
+			if _, err = io.WriteString(_w, `, 0, o.`); err != nil {
+				return
+			}
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ", "); err != nil {
-						return
-					}
-				}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
 			}
-			if _, err = io.WriteString(_w, `) (bool, error) {
-    q := query`); err != nil {
+
+			if _, err = io.WriteString(_w, `.Len())
+    for obj := range o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(ctx)
-`); err != nil {
+			if _, err = io.WriteString(_w, `.ValuesIter() {
+        links = append(links, o.`); err != nil {
 				return
 			}
 
-			for _, col := range idx.Columns {
+			if _, err = io.WriteString(_w, mm.LinkIdentifier()); err != nil {
+				return
+			}
 
-				if col.IsNullable {
+			if _, err = io.WriteString(_w, `(obj.PrimaryKey()))
+    }
+    return links
+}
 
-					if _, err = io.WriteString(_w, `    if `); err != nil {
-						return
-					}
+// `); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, col.Field); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.LinkIdentifier()); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, ` == nil {
-        q = q.Where(op.IsNull(node.`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, ` returns the link to the loaded `); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, ` object with primary key pk, or nil
+// if that object is not loaded.
+func (o *`); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `()))
-    } else {
-        q = q.Where(op.Equal(node.`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, `Base) `); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.LinkIdentifier()); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, `(pk `); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `(), `); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, `) *`); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, col.Field); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` {
+    obj := o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `))
+			if _, err = io.WriteString(_w, `.Get(pk)
+    if obj == nil {
+        return nil
     }
-`); err != nil {
-						return
-					}
+    l := o.`); err != nil {
+				return
+			}
 
-				} else {
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `    q = q.Where(op.Equal(node.`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, `[pk]
+    if l == nil {
+        l = New`); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, table.Identifier); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, `(obj)
+        if o.`); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `(), `); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, ` == nil {
+            o.`); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, col.Field); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `))
-`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, ` = make(map[`); err != nil {
+				return
+			}
 
-				}
+			if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
+				return
+			}
 
+			if _, err = io.WriteString(_w, `]*`); err != nil {
+				return
 			}
 
-			if _, err = io.WriteString(_w, `    v, err := q.Count()
-    return v > 0, err
-}
-`); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
 				return
 			}
 
-		}
+			if _, err = io.WriteString(_w, `)
+        }
+        o.`); err != nil {
+				return
+			}
 
-	}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-	return
+			if _, err = io.WriteString(_w, `[pk] = l
+    }
+    return l
 }
 
-func (tmpl *TableBaseTemplate) genBuilder(table *model.Table, _w io.Writer) (err error) {
+// Set`); err != nil {
+				return
+			}
 
-	//*** query_builder.tmpl
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
+				return
+			}
 
-	builderStruct := table.Identifier + "Builder"
+			if _, err = io.WriteString(_w, ` associates the objects in the given links, along with the values of the links,
+// in preparation for saving. The associations will not be updated until Save() is called.
+func (o *`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `
-// The `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `Base) Set`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` uses a builder pattern to create a query on the database.
-// Create a `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `(links ...*`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` by calling Query`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `) {
+    o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `, which will select all
-// the `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `.Clear()
+    o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` object in the database. Then filter and arrange those objects
-// by calling Where, Select, etc.
-// End a query by calling either Load, LoadI, LoadCursor, Get, or Count.
-// A `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `IsDirty = true
+    o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` stores the context it will use to perform the query, and thus is
-// meant to be a short-lived object. You should not save it for later use.
-type `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, ` = nil
+    o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` struct {
-	builder *query.Builder
-	ctx context.Context
-}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-func new`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, ` = make(map[`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `Builder(ctx context.Context) *`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `]*`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` {
-	b := `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `, len(links))
+    for _,l := range links {
+        o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `{
-		builder: query.NewBuilder(node.`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `.Set(l.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `()),
-		ctx: ctx,
-	}
-	return &b
-}
+			if _, err = io.WriteString(_w, `.PrimaryKey(), l.`); err != nil {
+				return
+			}
 
-// Load terminates the query builder, performs the query, and returns a slice of `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `)
+        o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` objects.
-// If there are any errors, nil is returned and the specific error is stored in the context.
-// If no results come back from the query, it will return a non-nil empty slice.
-func (b *`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `[l.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `) Load() (`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `.PrimaryKey()] = l
+    }
+}
 
-	if _, err = io.WriteString(_w, ` []*`); err != nil {
-		return
-	}
+// rekey`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `, err error) {
-	b.builder.Command = query.BuilderCommandLoad
-	database := db.GetDatabase("`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, ` moves the link at oldPk to newPk after the linked object gets a new primary key when saved.
+func (o *`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.DbKey); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `")
-	var results any
+			if _, err = io.WriteString(_w, `Base) rekey`); err != nil {
+				return
+			}
 
-    ctx := b.ctx
-`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
+				return
+			}
 
-	if table.ReadTimeout != 0 {
+			if _, err = io.WriteString(_w, `(oldPk, newPk `); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `    var cancel context.CancelFunc
-    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.ReadTimeoutConst()); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `) {
+    if l, ok := o.`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `)
-    defer cancel()
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `[oldPk]; ok {
+        delete(o.`); err != nil {
+				return
+			}
 
-	}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `	results, err = database.BuilderQuery(ctx, b.builder)
-	if results == nil || err != nil {
-		return
-	}
-	for _,item := range results.([]map[string]any) {
-		o := new(`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `, oldPk)
+        o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `)
-		o.unpack(item, o)
-		`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `[newPk] = l
+    }
+}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
-		return
-	}
+// `); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` = append(`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `AreDirty returns true if the values of any of the links have changed.
+func (o *`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `, o)
-	}
-	return
-}
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
 
-// LoadI terminates the query builder, performs the query, and returns a slice of interfaces.
-// This can then satisfy a variety of interfaces that load arrays of objects, including KeyLabeler.
-// If there are any errors, nil is returned and the specific error is stored in the context.
-// If no results come back from the query, it will return a non-nil empty slice.
-func (b *`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `Base) `); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `) LoadI() (`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `AreDirty() bool {
+    for _,l := range o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, ` []query.OrmObj, err error) {
-	b.builder.Command = query.BuilderCommandLoad
-	database := db.GetDatabase("`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, ` {
+        if l.isDirty {
+            return true
+        }
+    }
+    return false
+}
 
-	if _, err = io.WriteString(_w, table.DbKey); err != nil {
-		return
-	}
+`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `")
-	var results any
+		}
 
-    ctx := b.ctx
+	}
+
+	if _, err = io.WriteString(_w, `
 `); err != nil {
 		return
 	}
 
-	if table.ReadTimeout != 0 {
+	return
+}
 
-		if _, err = io.WriteString(_w, `    var cancel context.CancelFunc
-    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
-			return
-		}
+func (tmpl *TableBaseTemplate) genReverseRefAccessors(table *model.Table, _w io.Writer) (err error) {
 
-		if _, err = io.WriteString(_w, table.ReadTimeoutConst()); err != nil {
-			return
-		}
+	//*** reverse_ref_accessors.tmpl
 
-		if _, err = io.WriteString(_w, `)
-    defer cancel()
+	for _, rev := range table.ReverseReferences {
+		if rev.IsUnique {
 
-`); err != nil {
-			return
-		}
+			//*** rev_accessor_unique.tmpl
 
-	}
+			if _, err = io.WriteString(_w, `
+// `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` returns the connected `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` object, if one was loaded.
+// Otherwise, it will return nil.
+func (o *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Base) `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `() *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` {
+	if o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` == nil {
+		return nil
+	}
+	return o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+}
+
+// Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` returns the connected `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` object, if one was loaded.
+// Otherwise, it will load a new one and return it.
+func (o *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Base) Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx context.Context) (*`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `, error) {
+    if o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` != nil && o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.IsDirty() {
+        panic("The `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` has changed. You must save it first before changing to a different one.")
+    }
+    var err error
+	if o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` == nil {
+	    pk := o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.PrimaryKeyColumn().Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `()
+		o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `, err = Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx, pk)
+	}
+	return o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `, err
+}
+
+// Set`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` associates obj with this `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+// through the reverse relationship in `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.
+//
+// The association is temporary until you call Save().
+`); err != nil {
+				return
+			}
+
+			if rev.IsNullable {
+
+				if _, err = io.WriteString(_w, `// Since this is a unique relationship, if a different `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` object is currently pointing to this `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `,
+// that `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `'s `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` value will be set to null when Save is called.
+// If you did not use a join to query the attached `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` in the first place, used a conditional join,
+// or joined with an expansion, be particularly careful, since you may be inadvertently changing an item
+// that is not currently attached to this `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, fmt.Sprint(table.Identifier)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `// WARNING! Since this is a non-nullable unique relationship,
+// if a different `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` object is currently pointing to this `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `,
+// it will be deleted. Pass nil to just delete the old object.
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `func (o *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Base) Set`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(obj *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `) {
+    if o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` != nil && o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.IsDirty() {
+        panic("The `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` has changed. You must save it first before changing to a different one.")
+    }
+	o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = obj
+	o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsDirty = true
+}
+
+`); err != nil {
+				return
+			}
+
+		} else { // Unique
+
+			//*** rev_accessor.tmpl
+
+			if _, err = io.WriteString(_w, `// `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` returns a single `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` object by primary key, if one was loaded.
+// Otherwise, it will return nil. It will not return `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` objects that are not saved.
+func (o *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Base) `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(pk `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.PrimaryKeyType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `) *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` {
+	v := o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Get(pk)
+	return v
+}
+
+// `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` returns a slice of `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` objects if loaded.
+func (o *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Base) `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `() []*`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` {
+	return o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Values()
+}
+
+// Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` loads a new slice of `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` objects and returns it.
+`); err != nil {
+				return
+			}
+
+			if rev.OrderColumn != nil {
+
+				if _, err = io.WriteString(_w, `// The objects are returned in the order of their `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.OrderColumn.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` values.
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `func (o *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Base) Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx context.Context) ([]*`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `, error) {
+	if o.IsNew() {
+		return nil, nil
+	}
+	for obj := range o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.ValuesIter() {
+        if obj.IsDirty() {
+            panic("You cannot load over items that have changed but have not been saved.")
+        }
+    }
+
+`); err != nil {
+				return
+			}
+
+			if rev.OrderColumn != nil {
+
+				if _, err = io.WriteString(_w, `    objs,err := Query`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(ctx).
+        Where(op.Equal(node.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(), o.PrimaryKey())).
+        OrderBy(node.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.OrderColumn.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `()).
+        Load()
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `    objs,err := Load`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `By`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(ctx, o.PrimaryKey())
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `    if err != nil {
+        return nil, err
+    }
+    o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Clear()
+
+    for _,obj := range objs {
+        pk := obj.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.PrimaryKeyColumn().Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `()
+        o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Set(pk, obj)
+    }
+
+    if o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Len() == 0 {
+        return nil, nil
+    }
+	return o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Values(), nil
+}
+
+// Count`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` does a database query and returns the number of `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+// objects currently in the database that have a `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` value that equals this objects primary key.
+func (o *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Base) Count`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx context.Context) (int, error) {
+    return Count`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx, o.PrimaryKey())
+}
+
+// Set`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` associates the objects in objs with this `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` by setting
+// their `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` values to this object's primary key.
+`); err != nil {
+				return
+			}
+
+			if rev.IsNullable {
+
+				if _, err = io.WriteString(_w, `// If it has `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` already associated with it that will not be associated after a save,
+// the foreign keys for those `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` will be set to null.
+// If you did not use a join to query the items in the first place, used a conditional join,
+// or joined with an expansion, be particularly careful, since you may be changing items
+// that are not currently attached to this `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `// WARNING! If it has `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` already associated with it that will not be associated after a save,
+// Save will panic. Be sure to delete those `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` or otherwise fix those pointers before calling save.
+`); err != nil {
+					return
+				}
+
+			}
+
+			if rev.OrderColumn != nil {
+
+				if _, err = io.WriteString(_w, `// The `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.OrderColumn.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` values of objs are set to their positions in objs.
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `func (o *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Base) Set`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(objs ...*`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `) {
+    for obj := range o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.ValuesIter() {
+        if obj.IsDirty() {
+            panic("You cannot overwrite items that have changed but have not been saved.")
+        }
+    }
+
+    o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Clear()
+`); err != nil {
+				return
+			}
+
+			if rev.OrderColumn != nil {
+
+				if _, err = io.WriteString(_w, `    for i,obj := range objs {
+        obj.Set`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.OrderColumn.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.OrderColumn.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(i))
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `    for _,obj := range objs {
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `        pk := obj.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.PrimaryKeyColumn().Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `()
+        o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Set(pk, obj)
+    }
+	o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsDirty = true
+}
+
+`); err != nil {
+				return
+			}
+
+			if rev.OrderColumn != nil {
+
+				if _, err = io.WriteString(_w, `// Move`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` moves the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` at position from in the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` list to position to,
+// shifting the ones in between, and saves their new `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.OrderColumn.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` values in the database in a single transaction.
+// If the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` are loaded, they are reloaded.
+// An error is returned if the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` have unsaved changes.
+func (o *`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `Base) Move`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(ctx context.Context, from, to int) error {
+    if o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `IsDirty {
+        return fmt.Errorf("the `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` of `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` %v have unsaved changes and cannot be moved; call Save() first", o.PrimaryKey())
+    }
+    var objs []*`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `
+    err := db.WithTransaction(ctx, Database(), func(ctx context.Context) (err error) {
+        objs, err = Query`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(ctx).
+            Where(op.Equal(node.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(), o.PrimaryKey())).
+            OrderBy(node.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.OrderColumn.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `()).
+            Load()
+        if err != nil {
+            return err
+        }
+        if from < 0 || from >= len(objs) || to < 0 || to >= len(objs) {
+            return fmt.Errorf("cannot move position %d to %d in a list of %d items", from, to, len(objs))
+        }
+        obj := objs[from]
+        objs = slices.Insert(slices.Delete(objs, from, from+1), to, obj)
+        for i, obj := range objs {
+            obj.Set`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.OrderColumn.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.OrderColumn.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(i))
+            if err = obj.Save(ctx); err != nil {
+                return err
+            }
+        }
+        return nil
+    })
+    if err != nil {
+        return err
+    }
+    if o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.Len() > 0 {
+        o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.Clear()
+        for _,obj := range objs {
+            o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
+        }
+    }
+    return nil
+}
+
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
+
+		}
+	}
+
+	for _, poly := range table.ReversePolymorphicReferences {
+
+		//*** poly_rev_accessor.tmpl
+
+		if _, err = io.WriteString(_w, `// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.TypeMethod()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` returns the value of the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` column
+// of the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.IdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` that refer to a `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, and makes `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+// implement `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.InterfaceType()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.
+func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.TypeMethod()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() string {
+	return "`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.QueryName); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `"
+}
+
+// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` returns a single `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` object by primary key, if one was loaded.
+// Otherwise, it will return nil.
+func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(pk `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.PrimaryKeyType()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `) *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` {
+	return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Get(pk)
+}
+
+// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` returns a slice of the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` objects whose `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+// is this object, if loaded. To change them, call Set`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` on the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` objects.
+func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() []*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` {
+	return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Values()
+}
+
+// Load`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` loads a new slice of the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` objects whose `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+// is this object and returns it.
+func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) Load`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx context.Context) ([]*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, error) {
+	if o.IsNew() {
+		return nil, nil
+	}
+	for obj := range o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.ValuesIter() {
+		if obj.IsDirty() {
+			panic("You cannot load over items that have changed but have not been saved.")
+		}
+	}
+
+	objs, err := Query`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.IdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx).
+		Where(o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Condition()).
+		Load()
+	if err != nil {
+		return nil, err
+	}
+	o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Clear()
+	for _, obj := range objs {
+		o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
+	}
+
+	if o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Len() == 0 {
+		return nil, nil
+	}
+	return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Values(), nil
+}
+
+// Count`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` does a database query and returns the number of `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+// objects currently in the database whose `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` is this object.
+func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) Count`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx context.Context) (int, error) {
+	return Query`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.IdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx).
+		Where(o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Condition()).
+		Count()
+}
+
+// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Condition returns the condition that selects the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` objects
+// whose `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` is this object.
+func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Condition() query.Node {
+	return op.And(
+		op.Equal(node.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(), o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.TypeMethod()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()),
+		op.Equal(node.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(), o.PrimaryKey()),
+	)
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+func (tmpl *TableBaseTemplate) genSubTypes(table *model.Table, _w io.Writer) (err error) {
+
+	//*** subtypes.tmpl
+
+	if len(table.SubTypes) > 0 {
+
+		if _, err = io.WriteString(_w, `
+// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Object is implemented by a *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` and by the objects of the tables that extend it.
+// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Builder.LoadTyped returns these, and a type switch will find the concrete type.
+type `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Object interface {
+    query.OrmObj
+    PrimaryKey() `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+    IsDirty() bool
+    Save(ctx context.Context) error
+    Delete(ctx context.Context) error
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+	for _, sub := range table.SubTypes {
+
+		if _, err = io.WriteString(_w, `
+// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` returns the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` object that extends this object, if it was loaded.
+// Otherwise, it will return nil.
+func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `() *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` {
+	return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+}
+
+// Load`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` returns the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` object that extends this object, if one was loaded.
+// Otherwise, it will load it and return it. It returns nil if the object is not a `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.
+func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) Load`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx context.Context) (*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, error) {
+    var err error
+	if o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` == nil {
+		o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, err = Load`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx, o.PrimaryKey())
+	}
+	return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, err
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+func (tmpl *TableBaseTemplate) genRegistry(table *model.Table, _w io.Writer) (err error) {
+
+	//*** registry.tmpl
+
+	if !table.IsLookup {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `
+// `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry holds the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` objects loaded by Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.
+var `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry = struct {
+	sync.RWMutex
+	loaded bool
+	items  maps.SliceMap[`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `]
+`); err != nil {
+		return
+	}
+
+	for _, idx := range table.RegistryIndexes() {
+
+		if _, err = io.WriteString(_w, `	by`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` map[`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Columns[0].Type); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `]*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `}{}
+
+func init() {
+	broadcast.Listen("`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DbKey); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `", "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `", reload`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry)
+}
+
+// Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry loads all the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` records into the registry,
+// replacing what was there before. Call it when the application starts.
+//
+// Once loaded, the registry is reloaded whenever a change to the table is broadcast. This includes
+// changes made by other processes if the application's broadcaster reports them with broadcast.Notify.
+// The reload happens in the background after the change is committed. Call broadcast.Wait to wait for it.
+func Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry(ctx context.Context) error {
+	objs, err := Query`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx).
+		OrderBy(node.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `().`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `()).
+		Load()
+	if err != nil {
+		return err
+	}
+	var items maps.SliceMap[`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `]
+`); err != nil {
+		return
+	}
+
+	for _, idx := range table.RegistryIndexes() {
+
+		if _, err = io.WriteString(_w, `	by`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` := make(map[`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Columns[0].Type); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `]*`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, len(objs))
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	for _, o := range objs {
+		items.Set(o.PrimaryKey(), o)
+`); err != nil {
+		return
+	}
+
+	for _, idx := range table.RegistryIndexes() {
+
+		if _, err = io.WriteString(_w, `		by`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `[o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Columns[0].Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()] = o
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	}
+
+	`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.Lock()
+	defer `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.Unlock()
+	`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.loaded = true
+	`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.items = items
+`); err != nil {
+		return
+	}
+
+	for _, idx := range table.RegistryIndexes() {
+
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Registry.by`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = by`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	return nil
+}
+
+// reload`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry reloads the registry if it was loaded.
+// It is called after a change to the table is broadcast and committed.
+func reload`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry(ctx context.Context, _ string, _ string) {
+	`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.RLock()
+	loaded := `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.loaded
+	`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.RUnlock()
+	if !loaded {
+		return
+	}
+	if err := Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry(ctx); err != nil {
+		slog.Error("Error reloading the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` registry",
+			slog.Any("error", err))
+	}
+}
+
+// `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `FromRegistry returns the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` with the given primary key from the registry,
+// or nil if it is not there.
+// The object is shared by all callers and should not be modified.
+func `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `FromRegistry(pk `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.RLock()
+	defer `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.RUnlock()
+	return `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.items.Get(pk)
+}
+
+`); err != nil {
+		return
+	}
+
+	for _, idx := range table.RegistryIndexes() {
+
+		if _, err = io.WriteString(_w, `// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `FromRegistryBy`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` returns the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` with the given
+// `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Columns[0].Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` from the registry, or nil if it is not there.
+// The object is shared by all callers and should not be modified.
+func `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `FromRegistryBy`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Columns[0].Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Columns[0].Type); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `) *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` {
+	`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Registry.RLock()
+	defer `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Registry.RUnlock()
+	return `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Registry.by`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `[`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Columns[0].Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `]
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `// `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `FromRegistry returns all the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` objects in the registry,
+// in primary key order.
+// The objects are shared by all callers and should not be modified.
+func `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `FromRegistry() []*`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.RLock()
+	defer `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.RUnlock()
+	return `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Registry.items.Values()
+}
+
+`); err != nil {
+		return
+	}
+
+	return
+}
+
+func (tmpl *TableBaseTemplate) genLoad(table *model.Table, _w io.Writer) (err error) {
+
+	//*** load.tmpl
+
+	if _, err = io.WriteString(_w, `// Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` returns a `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` from the database.
+// selectNodes lets you provide nodes for selecting specific fields or additional fields from related tables.
+// See [`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Builder.Select] for more info.
+func Load`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx context.Context, pk `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, selectNodes ...query.Node) (*`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, error) {
+	return query`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx).
+`); err != nil {
+		return
+	}
+
+	for _, col := range table.PrimaryKeyColumns() {
+
+		if _, err = io.WriteString(_w, `	    Where(op.Equal(node.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(), pk`); err != nil {
+			return
+		}
+
+		if len(table.PrimaryKeyColumns()) > 1 {
+
+			if _, err = io.WriteString(_w, `.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `)).
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	    Select(selectNodes...).
+	    Get()
+}
+
+// Has`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` returns true if a `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` with the given primary key exists in the database.
+// doc: type=`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `
+func Has`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx context.Context, pk `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.PrimaryKeyType()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) (bool, error) {
+    v, err := query`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx).
+`); err != nil {
+		return
+	}
+
+	for _, col := range table.PrimaryKeyColumns() {
+
+		if _, err = io.WriteString(_w, `	    Where(op.Equal(node.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(), pk`); err != nil {
+			return
+		}
+
+		if len(table.PrimaryKeyColumns()) > 1 {
+
+			if _, err = io.WriteString(_w, `.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `)).
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `         Count()
+    return v > 0, err
+}
+
+`); err != nil {
+		return
+	}
+
+	for _, idx := range table.Indexes {
+
+		if idx.IsUnique {
+
+			if _, err = io.WriteString(_w, `// Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` queries for a single `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` object by the given unique index values.
+// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
+// See [`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Builder.Select].
+// If you need a more elaborate query, use Query`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `() to start a query builder.
+func Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` (ctx context.Context, `); err != nil {
+				return
+			}
+
+			for _i, _j := range idx.Columns {
+				_ = _j
+
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` `); err != nil {
+					return
+				}
+
+				if _j.IsNullable {
+
+					if _, err = io.WriteString(_w, `interface{}`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, _j.Type); err != nil {
+						return
+					}
+
+				}
+
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, ", "); err != nil {
+						return
+					}
+				}
+			}
+			if _, err = io.WriteString(_w, `, selectNodes ...query.Node) (*`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `, error) {
+    q := query`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx)
+`); err != nil {
+				return
+			}
+
+			for _, col := range idx.Columns {
+
+				if col.IsNullable {
+
+					if _, err = io.WriteString(_w, `    if `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` == nil {
+        q = q.Where(op.IsNull(node.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, table.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `().`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `()))
+    } else {
+        q = q.Where(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, idx.EqualOp(table, col, col.Field)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `)
+    }
+`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, `    q = q.Where(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, idx.EqualOp(table, col, col.Field)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `)
+`); err != nil {
+						return
+					}
+
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `    return q.Select(selectNodes...).Get()
+}
+
+// Has`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` returns true if the
+// given unique index values exist in the database.
+// doc: type=`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+func Has`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` (ctx context.Context, `); err != nil {
+				return
+			}
+
+			for _i, _j := range idx.Columns {
+				_ = _j
+
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` `); err != nil {
+					return
+				}
+
+				if _j.IsNullable {
+
+					if _, err = io.WriteString(_w, `interface{}`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, _j.Type); err != nil {
+						return
+					}
+
+				}
+
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, ", "); err != nil {
+						return
+					}
+				}
+			}
+			if _, err = io.WriteString(_w, `) (bool, error) {
+    q := query`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx)
+`); err != nil {
+				return
+			}
+
+			for _, col := range idx.Columns {
+
+				if col.IsNullable {
+
+					if _, err = io.WriteString(_w, `    if `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` == nil {
+        q = q.Where(op.IsNull(node.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, table.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `().`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `()))
+    } else {
+        q = q.Where(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, idx.EqualOp(table, col, col.Field)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `)
+    }
+`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, `    q = q.Where(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, idx.EqualOp(table, col, col.Field)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `)
+`); err != nil {
+						return
+					}
+
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `    v, err := q.Count()
+    return v > 0, err
+}
+`); err != nil {
+				return
+			}
+
+		} else {
+
+			if _, err = io.WriteString(_w, ` `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+// Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` queries `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` objects by the given index values.
+// selectNodes optionally let you provide nodes for joining to other tables or selecting specific fields.
+// See [`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Builder.Select].
+// If you need a more elaborate query, use Query`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `() to start a query builder.
+func Load`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` (ctx context.Context, `); err != nil {
+				return
+			}
+
+			for _i, _j := range idx.Columns {
+				_ = _j
+
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` `); err != nil {
+					return
+				}
+
+				if _j.IsNullable {
+
+					if _, err = io.WriteString(_w, `interface{}`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, _j.Type); err != nil {
+						return
+					}
+
+				}
+
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, ", "); err != nil {
+						return
+					}
+				}
+			}
+			if _, err = io.WriteString(_w, `, selectNodes ...query.Node) ([]*`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `, error) {
+    q := query`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx)
+`); err != nil {
+				return
+			}
+
+			for _, col := range idx.Columns {
+
+				if col.IsNullable {
+
+					if _, err = io.WriteString(_w, `    if `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` == nil {
+        q = q.Where(op.IsNull(node.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, table.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `().`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `()))
+    } else {
+        q = q.Where(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, idx.EqualOp(table, col, col.Field)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `)
+    }
+`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, `    q = q.Where(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, idx.EqualOp(table, col, col.Field)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `)
+`); err != nil {
+						return
+					}
+
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `    return q.Select(selectNodes...).Load()
+}
+
+// Has`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` returns true if the
+// given index values exist in the database.
+// doc: type=`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+func Has`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` (ctx context.Context, `); err != nil {
+				return
+			}
+
+			for _i, _j := range idx.Columns {
+				_ = _j
+
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` `); err != nil {
+					return
+				}
+
+				if _j.IsNullable {
+
+					if _, err = io.WriteString(_w, `interface{}`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, _j.Type); err != nil {
+						return
+					}
+
+				}
+
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, ", "); err != nil {
+						return
+					}
+				}
+			}
+			if _, err = io.WriteString(_w, `) (bool, error) {
+    q := query`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx)
+`); err != nil {
+				return
+			}
+
+			for _, col := range idx.Columns {
+
+				if col.IsNullable {
+
+					if _, err = io.WriteString(_w, `    if `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` == nil {
+        q = q.Where(op.IsNull(node.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, table.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `().`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `()))
+    } else {
+        q = q.Where(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, idx.EqualOp(table, col, col.Field)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `)
+    }
+`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, `    q = q.Where(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, idx.EqualOp(table, col, col.Field)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `)
+`); err != nil {
+						return
+					}
+
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `    v, err := q.Count()
+    return v > 0, err
+}
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
+	return
+}
+
+func (tmpl *TableBaseTemplate) genBuilder(table *model.Table, _w io.Writer) (err error) {
+
+	//*** query_builder.tmpl
+
+	builderStruct := table.Identifier + "Builder"
+
+	if _, err = io.WriteString(_w, `
+// The `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` uses a builder pattern to create a query on the database.
+// Create a `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` by calling Query`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, which will select all
+// the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` object in the database. Then filter and arrange those objects
+// by calling Where, Select, etc.
+// End a query by calling either Load, LoadI, LoadCursor, Get, or Count.
+// A `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` stores the context it will use to perform the query, and thus is
+// meant to be a short-lived object. You should not save it for later use.
+type `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` struct {
+	builder *query.Builder
+	ctx context.Context
+}
+
+func new`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Builder(ctx context.Context) *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b := `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `{
+		builder: query.NewBuilder(node.`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `()),
+		ctx: ctx,
+	}
+	return &b
+}
+
+// Load terminates the query builder, performs the query, and returns a slice of `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` objects.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) Load() (`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` []*`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+`); err != nil {
+		return
+	}
+
+	if table.BaseTable() != nil {
+
+		if _, err = io.WriteString(_w, `	b.selectBase()
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	database := db.GetDatabase("`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DbKey); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `")
+	var results any
+
+    ctx := b.ctx
+`); err != nil {
+		return
+	}
+
+	if table.ReadTimeout != 0 {
+
+		if _, err = io.WriteString(_w, `    var cancel context.CancelFunc
+    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.ReadTimeoutConst()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+    defer cancel()
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _,item := range results.([]map[string]any) {
+		o := new(`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)
+		o.unpack(item, o)
+		`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` = append(`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, o)
+	}
+	return
+}
+
+// LoadI terminates the query builder, performs the query, and returns a slice of interfaces.
+// This can then satisfy a variety of interfaces that load arrays of objects, including KeyLabeler.
+// If there are any errors, nil is returned and the specific error is stored in the context.
+// If no results come back from the query, it will return a non-nil empty slice.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) LoadI() (`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` []query.OrmObj, err error) {
+	b.builder.Command = query.BuilderCommandLoad
+`); err != nil {
+		return
+	}
+
+	if table.BaseTable() != nil {
+
+		if _, err = io.WriteString(_w, `	b.selectBase()
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	database := db.GetDatabase("`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DbKey); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `")
+	var results any
+
+    ctx := b.ctx
+`); err != nil {
+		return
+	}
+
+	if table.ReadTimeout != 0 {
+
+		if _, err = io.WriteString(_w, `    var cancel context.CancelFunc
+    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.ReadTimeoutConst()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+    defer cancel()
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	results, err = database.BuilderQuery(ctx, b.builder)
+	if results == nil || err != nil {
+		return
+	}
+	for _,item := range results.([]map[string]any) {
+		o := new(`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)
+		o.unpack(item, o)
+		`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` = append(`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, o)
+	}
+	return
+}
+
+
+// LoadCursor terminates the query builder, performs the query, and returns a cursor to the query.
+//
+// A query cursor is useful for dealing with large amounts of query results. However, there are some
+// limitations to its use. When working with SQL databases, you cannot use a cursor while querying
+// many-to-many or reverse relationships that will create an array of values.
+//
+// Call Next() on the returned cursor object to step through the results. Make sure you call Close
+// on the cursor object when you are done. You should use
+//   defer cursor.Close()
+// to make sure the cursor gets closed.
+//
+`); err != nil {
+		return
+	}
+
+	if table.ReadTimeout != 0 {
+
+		if _, err = io.WriteString(_w, `// LoadCursor is NOT protected by a timeout on the context. You should set your own timeout on the context,
+// but all the rows of the cursor must be read before canceling the cursor.
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) LoadCursor() (`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Cursor, error) {
+	b.builder.Command = query.BuilderCommandLoadCursor
+`); err != nil {
+		return
+	}
+
+	if table.BaseTable() != nil {
+
+		if _, err = io.WriteString(_w, `	b.selectBase()
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	database := db.GetDatabase("`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DbKey); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `")
+	result, err := database.BuilderQuery(b.ctx, b.builder)
+	var cursor query.CursorI
+	if result != nil {
+	    cursor = result.(query.CursorI)
+	}
+	return `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Cursor{cursor}, err
+}
+
+type `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Cursor struct {
+	query.CursorI
+}
+
+// Next returns the current `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` object and moves the cursor to the next one.
+//
+// If there are no more records, it returns nil.
+func (c `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Cursor) Next() (*`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, error) {
+    if c.CursorI == nil {
+        return nil, nil
+    }
+
+	row, err := c.CursorI.Next()
+	if row == nil || err != nil {
+		return nil, err
+	}
+	o := new(`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)
+	o.unpack(row, o)
+	return o, nil
+}
+
+`); err != nil {
+		return
+	}
+
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `// selectBase joins the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.QueryName); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` table, so that the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` object is loaded with each `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.
+// Without the join, only the primary key of the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` object is known.
+// Queries that group or remove duplicate rows are left alone.
+func (b *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, builderStruct); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `) selectBase() {
+	if b.builder.GroupBys == nil && !b.builder.IsDistinct {
+		b.builder.Select(node.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.BaseReference.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `())
+	}
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if len(table.SubTypes) > 0 {
+
+		if _, err = io.WriteString(_w, `// LoadTyped terminates the query builder, performs the query, and returns a slice of `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Object values.
+// A record that is extended by a record in a subtype table is returned as the subtype object, with
+// the loaded `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` object embedded in it. Other records are returned as a *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.
+// Use a type switch to get to the concrete type of each object.
+func (b *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, builderStruct); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `) LoadTyped() (objects []`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Object, err error) {
+`); err != nil {
+			return
+		}
+
+		for _, sub := range table.SubTypes {
+
+			if _, err = io.WriteString(_w, `	b.builder.Select(node.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `().`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, sub.ReverseIdentifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `())
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, err := b.Load()
+	if err != nil {
+		return nil, err
+	}
+	objects = make([]`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Object, 0, len(`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `))
+	for _,o := range `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` {
+`); err != nil {
+			return
+		}
+
+		for _, sub := range table.SubTypes {
+
+			if _, err = io.WriteString(_w, `		if sub := o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `; sub != nil {
+			// The subtype object takes over this object, so that the two do not refer to each other
+			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = nil
+			sub.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = o
+			objects = append(objects, sub)
+			continue
+		}
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `		objects = append(objects, o)
+	}
+	return
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `// Get is a convenience method to return only the first item found in a query.
+// The entire query is performed, so you should generally use this only if you know
+// you are selecting on one or very few items.
+// If an error occurs, or no results are found, a nil is returned.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) Get() (*`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `, error) {
+    results, err := b.Load()
+    if err != nil || len(results) == 0 {
+        return nil, err
+    }
+    return results[0], nil
+}
+
+// Where adds a condition to filter what gets selected.
+// Calling Where multiple times will AND the conditions together.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)  Where(c query.Node) *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.Where(c)
+	return b
+}
+
+// OrderBy specifies how the resulting data should be sorted.
+// By default, the given nodes are sorted in ascending order.
+// Add Descending() to the node to specify that it should be sorted in descending order.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)  OrderBy(nodes... query.Sorter) *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.OrderBy(nodes...)
+	return b
+}
+
+// Limit will return a subset of the data, limited to the offset and number of rows specified.
+// For large data sets and specific types of queries, this can be slow, because it will perform
+// the entire query before computing the limit.
+// Selected arrays of related objects are preloaded with separate queries, so that the limit applies to
+// the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` objects. You cannot otherwise limit a query that has embedded arrays.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)  Limit(maxRowCount int, offset int) *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.Limit(maxRowCount, offset)
+	return b
+}
+
+// Select specifies what specific columns will be loaded with data.
+// By default, all the columns of the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` table will be queried and loaded.
+// If nodes contains columns from the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` table, that will limit the columns queried and loaded to only those columns.
+// If related tables are specified, then all the columns from those tables are queried, selected and joined to the result.
+// If columns in related tables are specified, then only those columns will be queried and loaded.
+// Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
+// will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with query.Related.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)  Select(nodes... query.Node) *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.Select(nodes...)
+	return b
+}
+
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate query
+// that finds the related objects of all the loaded `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` objects at once, rather than joining them to the query.
+// This avoids repeating the data of each `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` object for every related object in the query results.
+// Relationships that are selected in a query with a Limit are preloaded automatically.
+// A preloaded relationship cannot also be used in the Where, OrderBy or Calculation clauses of the query.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)  Preload(nodes... query.Node) *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.Preload(nodes...)
+	return b
+}
+
+// Calculation adds operation as an aliased value onto base.
+// After the query, you can read the data by passing alias to GetAlias on the returned object.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.Calculation(base, alias, operation)
+	return b
+}
+
+// Distinct removes duplicates from the results of the query.
+// Adding a Select() is usually required.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)  Distinct() *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.Distinct()
+	return b
+}
+
+// GroupBy controls how results are grouped when using aggregate functions with Calculation.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)  GroupBy(nodes... query.Node) *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.GroupBy(nodes...)
+	return b
+}
+
+// Having does additional filtering on the results of the query after the query is performed.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)  Having(node query.Node)  *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	 b.builder.Having(node)
+	 return b
+}
+
+// ForUpdate places an exclusive lock on the selected rows until the end of the current transaction.
+// Use it inside of a transaction to prevent other transactions from modifying the rows
+// before the current transaction is done with them.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) ForUpdate() *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.ForUpdate()
+	return b
+}
+
+// ForShare places a shared lock on the selected rows until the end of the current transaction.
+// Other transactions can read the rows, but cannot modify them until the current transaction is done.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) ForShare() *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.ForShare()
+	return b
+}
+
+// SkipLocked skips rows that are locked by other transactions when used with ForUpdate or ForShare.
+// Combine with Limit to let multiple workers each claim a different set of rows from a queue.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) SkipLocked() *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.SkipLocked()
+	return b
+}
+
+// NoWait returns an error right away if a selected row is locked by another transaction
+// when used with ForUpdate or ForShare, rather than waiting for the lock to be released.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) NoWait() *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` {
+	b.builder.NoWait()
+	return b
+}
+
+// Count terminates a query and returns just the number of items in the result.
+// If you have Select or Calculation columns in the query, it will count NULL results as well.
+// To not count NULL values, use Where in the builder with a NotNull operation.
+// To count distinct combinations of items, call Distinct() on the builder.
+func (b *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, builderStruct); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `)  Count() (int, error) {
+	b.builder.Command = query.BuilderCommandCount
+	database := db.GetDatabase("`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DbKey); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `")
+
+    ctx := b.ctx
+`); err != nil {
+		return
+	}
+
+	if table.ReadTimeout != 0 {
+
+		if _, err = io.WriteString(_w, `    var cancel context.CancelFunc
+    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.ReadTimeoutConst()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+    defer cancel()
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `	results, err := database.BuilderQuery(ctx, b.builder)
+    if results == nil || err != nil {
+        return 0, err
+    }
+	return results.(int), nil
+}
+
+`); err != nil {
+		return
+	}
+
+	return
+}
+
+func (tmpl *TableBaseTemplate) genCount(table *model.Table, _w io.Writer) (err error) {
+
+	//*** count.tmpl
+
+	if _, err = io.WriteString(_w, `
+// Count`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` returns the total number of items in the `); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, ` table.
+func Count`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx context.Context) (int, error) {
+	return Query`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `(ctx).Count()
+}
+
+`); err != nil {
+		return
+	}
+
+	for _, idx := range table.Indexes {
+
+		if _, err = io.WriteString(_w, `// Count`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `By`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` queries the database and returns the number of `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` objects that
+// have `); err != nil {
+			return
+		}
+
+		for _i, _j := range idx.Columns {
+			_ = _j
+
+			if _, err = io.WriteString(_w, _j.Field); err != nil {
+				return
+			}
+
+			if _i < len(idx.Columns)-1 {
+				if _, err = io.WriteString(_w, " and "); err != nil {
+					return
+				}
+			}
+		}
+		if _, err = io.WriteString(_w, `.
+// doc: type=`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+func Count`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `By`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx context.Context, `); err != nil {
+			return
+		}
+
+		for _i, _j := range idx.Columns {
+			_ = _j
+
+			if _, err = io.WriteString(_w, _j.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, _j.Type); err != nil {
+				return
+			}
+
+			if _i < len(idx.Columns)-1 {
+				if _, err = io.WriteString(_w, ", "); err != nil {
+					return
+				}
+			}
+		}
+		if _, err = io.WriteString(_w, ` ) (int, error) {
+`); err != nil {
+			return
+		}
+
+		for _, col := range idx.Columns {
+
+			if col.SchemaSubType == schema.ColSubTypeTimeOnly {
+
+				if _, err = io.WriteString(_w, `    v_`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` := `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.Format("15:04:05")
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `    v_`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` := `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `
+`); err != nil {
+					return
+				}
+
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `	return Query`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx).
+`); err != nil {
+			return
+		}
+
+		for _, col := range idx.Columns {
+
+			if _, err = io.WriteString(_w, `	Where(`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, idx.EqualOp(table, col, "v_"+col.Field)); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `).
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `	Count()
+}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
+
+	return
+}
+
+func (tmpl *TableBaseTemplate) genUnpack(table *model.Table, _w io.Writer) (err error) {
+
+	//*** unpack.tmpl
+
+	if _, err = io.WriteString(_w, `// unpack recursively transforms data coming from the database into ORM objects.
+func (o *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Base) unpack (m map[string]interface{}, objThis *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, fmt.Sprint(table.Identifier)); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `) {
+
+`); err != nil {
+		return
+	}
+
+	for _, col := range table.AllColumns() {
+
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
+
+		if col.IsNullable {
+
+			if _, err = io.WriteString(_w, `
+	    `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
+
+			//*** null_column.tmpl
+
+			if _, err = io.WriteString(_w, `
+	if v, ok := m["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.QueryKey()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"]; ok {
+		if v == nil {
+			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.DefaultValueAsValue()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsNull = true
+			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsLoaded = true
+`); err != nil {
+				return
+			}
+
+			if col.HasSetter() {
+
+				if _, err = io.WriteString(_w, `			o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `IsDirty = false
+`); err != nil {
+					return
+				}
+
+			}
+
+			if col.IsEnum() && col.Enum.IsKeyStored() {
+
+				if _, err = io.WriteString(_w, `		} else if s, ok2 := v.(string); ok2 {
+		    o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `FromKey(s)
+`); err != nil {
+					return
+				}
+
+			} else if col.IsEnum() {
+
+				if _, err = io.WriteString(_w, `		} else if i, ok2 := v.(int); ok2 {
+		    o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(i)
+`); err != nil {
+					return
+				}
+
+			} else if col.IsEnumArray() {
+
+				if _, err = io.WriteString(_w, `		} else if a, ok2 := v.([]int); ok2 {
+		    o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = make(`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, len(a))
+		    for i, i2 := range a {
+		        o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `[i] = `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(i2)
+		    }
+`); err != nil {
+					return
+				}
+
+			} else if col.IsEnumSet() {
+
+				if _, err = io.WriteString(_w, `		} else if bits, ok2 := v.(int64); ok2 {
+		    o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = query.EnumSetFromBits[`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `](bits)
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `		} else if o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, ok = v.(`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `); ok {
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsNull = false
+			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsLoaded = true
+`); err != nil {
+				return
+			}
+
+			if col.HasSetter() {
+
+				if _, err = io.WriteString(_w, `			o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `IsDirty = false
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `		} else {
+			panic("Wrong type found for `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.")
+		}
+	} else {
+		o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsLoaded = false
+		o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsNull = true
+		o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.DefaultValueAsValue()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
+
+			if col.HasSetter() {
+
+				if _, err = io.WriteString(_w, `		o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `IsDirty = false
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `	}
+
+`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `	`); err != nil {
+				return
+			}
+
+		} else {
+
+			if _, err = io.WriteString(_w, `
+        `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
+
+			//*** not_null_column.tmpl
+
+			if _, err = io.WriteString(_w, `
+	if v, ok := m["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.QueryKey()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"]; ok && v != nil {
+`); err != nil {
+				return
+			}
+
+			if col.IsEnum() && col.Enum.IsKeyStored() {
+
+				if _, err = io.WriteString(_w, `     	if s, ok2 := v.(string); ok2 {
+           o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `FromKey(s)
+`); err != nil {
+					return
+				}
+
+			} else if col.IsEnum() {
+
+				if _, err = io.WriteString(_w, `     	if i, ok2 := v.(int); ok2 {
+           o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(i)
+`); err != nil {
+					return
+				}
+
+			} else if col.IsEnumArray() {
+
+				if _, err = io.WriteString(_w, `     	if a, ok2 := v.([]int); ok2 {
+           o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = make(`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, len(a))
+           for i, i2 := range a {
+               o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `[i] = `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(i2)
+           }
+`); err != nil {
+					return
+				}
+
+			} else if col.IsEnumSet() {
+
+				if _, err = io.WriteString(_w, `     	if bits, ok2 := v.(int64); ok2 {
+           o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` = query.EnumSetFromBits[`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `](bits)
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `    	if o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, ok = v.(`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Type); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `); ok {
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsLoaded = true
+`); err != nil {
+				return
+			}
+
+			if col.HasSetter() {
+
+				if _, err = io.WriteString(_w, `			o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `IsDirty = false
+`); err != nil {
+					return
+				}
+
+			}
+
+			if col.IsAPrimaryKey() {
+
+				if len(table.PrimaryKeyColumns()) == 1 {
+
+					if _, err = io.WriteString(_w, `            o._originalPK = o.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `
+`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, `            o._originalPK.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` = o.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `
+`); err != nil {
+						return
+					}
+
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `		} else {
+			panic("Wrong type found for `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.")
+		}
+	} else {
+		o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsLoaded = false
+		o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.DefaultValueAsValue()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
+
+			if col.HasSetter() {
+
+				if _, err = io.WriteString(_w, `		o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `IsDirty = false
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `	}
+
+`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `    `); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+	}
+
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
+
+		//*** base.tmpl
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+	if v, ok := m["`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.BaseReference.QueryKey()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `"]; ok {
+		if v2, ok2 := v.(map[string]any); ok2 {
+			o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = new(`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+			o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.unpack(v2, o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+		} else {
+			panic("Wrong type found for `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` object.")
+		}
+	} else {
+		// The base record was not loaded, so only its primary key is known
+		o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = new(`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+		o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.unpack(map[string]any{"`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.PrimaryKeyColumn().Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `": o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.BaseReference.ForeignKey.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `}, o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+	}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, ref := range table.References {
+
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+		//*** reference.tmpl
+
+		if _, err = io.WriteString(_w, `
+	if v, ok := m["`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.QueryKey()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `"]; ok {
+		if `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, ok2 := v.(map[string]any); ok2 {
+			o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = new(`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+			o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.unpack(`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+			// mirror foreign key with loaded object
+			o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.PrimaryKey()
+`); err != nil {
+			return
+		}
+
+		if ref.IsNullable {
+
+			if _, err = io.WriteString(_w, `			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsNull = false
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `			o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `IsLoaded = true
+`); err != nil {
+			return
+		}
+
+		if ref.ForeignKey.HasSetter() {
+
+			if _, err = io.WriteString(_w, `			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsDirty = false
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `		} else {
+			panic("Wrong type found for `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` object.")
+		}
+	} else {
+		o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = nil
+	}
+
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
+
+	for _, poly := range table.PolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
+
+		//*** polymorphic.tmpl
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+		for _, t := range poly.ReferencedTables {
+
+			if _, err = io.WriteString(_w, `	if v, ok := m["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.QueryKey(t)); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"]; ok {
+		if v2, ok2 := v.(map[string]any); ok2 {
+			obj := new(`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, t.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `)
+			obj.unpack(v2, obj)
+			// A left join that did not match the type column returns no primary key
+			if obj.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, t.PrimaryKeyColumn().Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsLoaded {
+				o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = obj
+			}
+		} else {
+			panic("Wrong type found for `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` object.")
+		}
+	}
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
+
+	if len(table.ManyManyReferences) > 0 {
+
+		if _, err = io.WriteString(_w, `// Many-Many references
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, mm := range table.ManyManyReferences {
+
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+		//*** mm.tmpl
+
+		if _, err = io.WriteString(_w, `
+	if v, ok := m["`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.QueryKey()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `"]; ok {
+		if v2, ok2 := v.([]map[string]any); ok2 {
+			o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Clear()
+`); err != nil {
+			return
+		}
+
+		if mm.HasColumns() {
+
+			if _, err = io.WriteString(_w, `			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = nil
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `
+			for _,v3 := range v2 {
+				obj := new(`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+				obj.unpack(v3, obj)
+				o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
+`); err != nil {
+			return
+		}
+
+		if mm.HasColumns() {
+
+			if _, err = io.WriteString(_w, `				if l := New`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(obj); l.unpack(v3) {
+				    if o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` == nil {
+				        o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = make(map[`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `]*`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `)
+				    }
+				    o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `[obj.PrimaryKey()] = l
+				}
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `			}
+			o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = nil
+		} else {
+			panic("Wrong type found for `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` object.")
+		}
+	} else {
+		o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Clear()
+		o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = nil
+`); err != nil {
+			return
+		}
+
+		if mm.HasColumns() {
+
+			if _, err = io.WriteString(_w, `		o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = nil
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `	}
+
+`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `	results, err = database.BuilderQuery(ctx, b.builder)
-	if results == nil || err != nil {
-		return
 	}
-	for _,item := range results.([]map[string]any) {
-		o := new(`); err != nil {
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+	if len(table.ReverseReferences) > 0 {
+
+		if _, err = io.WriteString(_w, `// Reverse references
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, rev := range table.ReverseReferences {
+
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
+
+		if rev.IsUnique {
+
+			if _, err = io.WriteString(_w, `
+	    `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
+
+			//*** reverse_unique.tmpl
+
+			if _, err = io.WriteString(_w, `
+	if v, ok := m["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseQueryKey()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"]; ok {
+		if v2, ok2 := v.(map[string]any); ok2 {
+			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = new(`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `)
+			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.unpack(v2, o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `)
+			o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsDirty = false
+		} else {
+			panic("Wrong type found for `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` object.")
+		}
+	} else {
+		o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = nil
+		o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsDirty = false
+	}
+
+`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `	`); err != nil {
+				return
+			}
+
+		} else {
+
+			if _, err = io.WriteString(_w, ` `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+	    `); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `)
-		o.unpack(item, o)
-		`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
-		return
-	}
+			//*** reverse.tmpl
 
-	if _, err = io.WriteString(_w, ` = append(`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `
+	if v, ok := m["`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `, o)
-	}
-	return
-}
+			if _, err = io.WriteString(_w, rev.ReverseQueryKey()); err != nil {
+				return
+			}
 
+			if _, err = io.WriteString(_w, `"]; ok {
+		switch v2 := v.(type) {
+		case []map[string]any: // array expansion
+		    o.`); err != nil {
+				return
+			}
 
-// LoadCursor terminates the query builder, performs the query, and returns a cursor to the query.
-//
-// A query cursor is useful for dealing with large amounts of query results. However, there are some
-// limitations to its use. When working with SQL databases, you cannot use a cursor while querying
-// many-to-many or reverse relationships that will create an array of values.
-//
-// Call Next() on the returned cursor object to step through the results. Make sure you call Close
-// on the cursor object when you are done. You should use
-//   defer cursor.Close()
-// to make sure the cursor gets closed.
-//
-`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-	if table.ReadTimeout != 0 {
+			if _, err = io.WriteString(_w, `.Clear()
+			o.`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `// LoadCursor is NOT protected by a timeout on the context. You should set your own timeout on the context,
-// but all the rows of the cursor must be read before canceling the cursor.
-`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-	}
+			if _, err = io.WriteString(_w, `IsDirty = false
+			for _,v3 := range v2 {
+				obj := new(`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `func (b *`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `)
+				obj.unpack(v3, obj)
+				o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `) LoadCursor() (`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
+			}
+		default:
+			panic("Wrong type found for `); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `Cursor, error) {
-	b.builder.Command = query.BuilderCommandLoadCursor
-	database := db.GetDatabase("`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.DbKey); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, ` object.")
+		}
+	} else {
+		o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `")
-	result, err := database.BuilderQuery(b.ctx, b.builder)
-	var cursor query.CursorI
-	if result != nil {
-	    cursor = result.(query.CursorI)
-	}
-	return `); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `.Clear()
+		o.`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `Cursor{cursor}, err
-}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-type `); err != nil {
-		return
+			if _, err = io.WriteString(_w, `IsDirty = false
 	}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
-		return
-	}
+`); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `Cursor struct {
-	query.CursorI
-}
+			if _, err = io.WriteString(_w, `	`); err != nil {
+				return
+			}
 
-// Next returns the current `); err != nil {
-		return
-	}
+		}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` object and moves the cursor to the next one.
-//
-// If there are no more records, it returns nil.
-func (c `); err != nil {
-		return
 	}
 
-	if _, err = io.WriteString(_w, table.VariableNamePlural()); err != nil {
+	if _, err = io.WriteString(_w, `
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Cursor) Next() (*`); err != nil {
-		return
-	}
+	if len(table.SubTypes) > 0 {
+
+		if _, err = io.WriteString(_w, `// Subtype objects
+`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
 	}
 
-	if _, err = io.WriteString(_w, `, error) {
-    if c.CursorI == nil {
-        return nil, nil
-    }
+	for _, sub := range table.SubTypes {
 
-	row, err := c.CursorI.Next()
-	if row == nil || err != nil {
-		return nil, err
-	}
-	o := new(`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+		//*** subtype.tmpl
 
-	if _, err = io.WriteString(_w, `)
-	o.unpack(row, o)
-	return o, nil
-}
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
 
-// Get is a convenience method to return only the first item found in a query.
-// The entire query is performed, so you should generally use this only if you know
-// you are selecting on one or very few items.
-// If an error occurs, or no results are found, a nil is returned.
-func (b *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `
+	if v, ok := m["`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, sub.ReverseQueryKey()); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `) Get() (*`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `"]; ok {
+		if v2, ok2 := v.(map[string]any); ok2 {
+			o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = new(`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+			o.`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `, error) {
-    results, err := b.Load()
-    if err != nil || len(results) == 0 {
-        return nil, err
-    }
-    return results[0], nil
-}
+		if _, err = io.WriteString(_w, `.unpack(v2, o.`); err != nil {
+			return
+		}
 
-// Where adds a condition to filter what gets selected.
-// Calling Where multiple times will AND the conditions together.
-func (b *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `)
+		} else {
+			panic("Wrong type found for `); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `)  Where(c query.Node) *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, ` object.")
+		}
+	} else {
+		o.`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` {
-	b.builder.Where(c)
-	return b
-}
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
 
-// OrderBy specifies how the resulting data should be sorted.
-// By default, the given nodes are sorted in ascending order.
-// Add Descending() to the node to specify that it should be sorted in descending order.
-func (b *`); err != nil {
-		return
+		if _, err = io.WriteString(_w, ` = nil
 	}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `)  OrderBy(nodes... query.Sorter) *`); err != nil {
-		return
 	}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
+	if _, err = io.WriteString(_w, `
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` {
-	b.builder.OrderBy(nodes...)
-	return b
-}
+	for _, poly := range table.ReversePolymorphicReferences {
 
-// Limit will return a subset of the data, limited to the offset and number of rows specified.
-// For large data sets and specific types of queries, this can be slow, because it will perform
-// the entire query before computing the limit.
-// You cannot limit a query that has embedded arrays.
-func (b *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `	`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+		//*** polymorphic_reverse.tmpl
 
-	if _, err = io.WriteString(_w, `)  Limit(maxRowCount int, offset int) *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `
+	if v, ok := m["`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` {
-	b.builder.Limit(maxRowCount, offset)
-	return b
-}
+		if _, err = io.WriteString(_w, poly.ReverseQueryKey()); err != nil {
+			return
+		}
 
-// Select specifies what specific columns will be loaded with data.
-// By default, all the columns of the `); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `"]; ok {
+		switch v2 := v.(type) {
+		case []map[string]any: // array expansion
+		    o.`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.QueryName); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` table will be queried and loaded.
-// If nodes contains columns from the `); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `.Clear()
+			for _,v3 := range v2 {
+				obj := new(`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.QueryName); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` table, that will limit the columns queried and loaded to only those columns.
-// If related tables are specified, then all the columns from those tables are queried, selected and joined to the result.
-// If columns in related tables are specified, then only those columns will be queried and loaded.
-// Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
-// will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
-func (b *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `)
+				obj.unpack(v3, obj)
+				o.`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `)  Select(nodes... query.Node) *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
+			}
+		default:
+			panic("Wrong type found for `); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` {
-	b.builder.Select(nodes...)
-	return b
-}
+		if _, err = io.WriteString(_w, ` object.")
+		}
+	} else {
+		o.`); err != nil {
+			return
+		}
 
-// Calculation adds operation as an aliased value onto base.
-// After the query, you can read the data by passing alias to GetAlias on the returned object.
-func (b *`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
+		if _, err = io.WriteString(_w, `.Clear()
 	}
 
-	if _, err = io.WriteString(_w, `) Calculation(base query.TableNodeI, alias string, operation query.OperationNodeI) *`); err != nil {
-		return
+`); err != nil {
+			return
+		}
+
 	}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
+	if _, err = io.WriteString(_w, `
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` {
-	b.builder.Calculation(base, alias, operation)
-	return b
-}
+	//*** extra.tmpl
 
-// Distinct removes duplicates from the results of the query.
-// Adding a Select() is usually required.
-func (b *`); err != nil {
+	if _, err = io.WriteString(_w, `	if v, ok := m["`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
+	if _, err = io.WriteString(_w, query.AliasResults); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `)  Distinct() *`); err != nil {
-		return
+	if _, err = io.WriteString(_w, `"]; ok {
+		o._aliases = v.(map[string]any)
 	}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
+	o._restored = true
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, ` {
-	b.builder.Distinct()
-	return b
+	if _, err = io.WriteString(_w, `
 }
 
-// GroupBy controls how results are grouped when using aggregate functions with Calculation.
-func (b *`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `)  GroupBy(nodes... query.Node) *`); err != nil {
-		return
-	}
+	return
+}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+func (tmpl *TableBaseTemplate) genSave(table *model.Table, _w io.Writer) (err error) {
 
-	if _, err = io.WriteString(_w, ` {
-	b.builder.GroupBy(nodes...)
-	return b
-}
+	//*** save.tmpl
 
-// Having does additional filtering on the results of the query after the query is performed.
-func (b *`); err != nil {
-		return
-	}
+	// TODO: Make sure dirty related objects are saved first
+	// TODO: Make sure id field cannot be set or updated
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
+	if _, err = io.WriteString(_w, `
+// save will update or insert the object, depending on the state of the object.
+func (o *`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `)  Having(node query.Node)  *`); err != nil {
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
+	if _, err = io.WriteString(_w, `Base) save(ctx context.Context) error {
+	if o._restored {
+		return o.update(ctx)
+	} else {
+		return o.insert(ctx)
 	}
-
-	if _, err = io.WriteString(_w, ` {
-	 b.builder.Having(node)
-	 return b
 }
 
-// Count terminates a query and returns just the number of items in the result.
-// If you have Select or Calculation columns in the query, it will count NULL results as well.
-// To not count NULL values, use Where in the builder with a NotNull operation.
-// To count distinct combinations of items, call Distinct() on the builder.
-func (b *`); err != nil {
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, builderStruct); err != nil {
-		return
-	}
+	//*** update.tmpl
 
-	if _, err = io.WriteString(_w, `)  Count() (int, error) {
-	b.builder.Command = query.BuilderCommandCount
-	database := db.GetDatabase("`); err != nil {
+	if _, err = io.WriteString(_w, `// update will update the values in the database, saving any changed values.
+// If the table has auto-generated values, those will be updated automatically.
+func (o *`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, table.DbKey); err != nil {
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `")
+	if _, err = io.WriteString(_w, `Base) update(ctx context.Context) error {
+    if !o._restored {
+        panic ("cannot update a record that was not originally read from the database.")
+    }
+    if !o.IsDirty() {
+        return nil // nothing to save
+    }
 
-    ctx := b.ctx
+    var modifiedFields map[string]interface{}
+
+    d := Database()
 `); err != nil {
 		return
 	}
 
-	if table.ReadTimeout != 0 {
+	if table.WriteTimeout != 0 {
 
 		if _, err = io.WriteString(_w, `    var cancel context.CancelFunc
     ctx, cancel = context.WithTimeout(ctx, `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.ReadTimeoutConst()); err != nil {
+		if _, err = io.WriteString(_w, table.WriteTimeoutConst()); err != nil {
 			return
 		}
 
 		if _, err = io.WriteString(_w, `)
     defer cancel()
-
 `); err != nil {
 			return
 		}
 
 	}
 
-	if _, err = io.WriteString(_w, `	results, err := database.BuilderQuery(ctx, b.builder)
-    if results == nil || err != nil {
-        return 0, err
-    }
-	return results.(int), nil
-}
-
+	if _, err = io.WriteString(_w, `    err := db.WithTransaction(ctx, d, func(ctx context.Context) error {
 `); err != nil {
 		return
 	}
 
-	return
-}
+	//*** update_ref.tmpl
 
-func (tmpl *TableBaseTemplate) genCount(table *model.Table, _w io.Writer) (err error) {
+	if base := table.BaseTable(); base != nil {
 
-	//*** count.tmpl
+		if _, err = io.WriteString(_w, `    // Save the `); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `
-// Count`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, ` object first, since a new one provides the primary key of this object.
+    if err := o.`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` returns the total number of items in the `); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.QueryName); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+        return err
+    }
+    if !o._restored {
+        o.Set`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, ` table.
-func Count`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.BaseReference.ForeignKey.Identifier); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `(o.`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `(ctx context.Context) (int, error) {
-	return Query`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.PrimaryKey())
+    }
+`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
-		return
 	}
 
-	if _, err = io.WriteString(_w, `(ctx).Count()
-}
+	for _, ref := range table.References {
 
-`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `    // Save loaded `); err != nil {
+			return
+		}
 
-	for _, idx := range table.Indexes {
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
 
-		if _, err = io.WriteString(_w, `// Count`); err != nil {
+		if _, err = io.WriteString(_w, ` object to get its new pk and update it here.
+    if o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `By`); err != nil {
+		if _, err = io.WriteString(_w, ` != nil {
+        if err := o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` queries the database and returns the number of `); err != nil {
+		if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+            return err
+        }
+        o.Set`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		if _, err = io.WriteString(_w, ref.ForeignKey.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` objects that
-// have `); err != nil {
+		if _, err = io.WriteString(_w, `(o.`); err != nil {
 			return
 		}
 
-		for _i, _j := range idx.Columns {
-			_ = _j
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, _j.Field); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `.PrimaryKey())
+    }
+`); err != nil {
+			return
+		}
 
-			if _i < len(idx.Columns)-1 {
-				if _, err = io.WriteString(_w, " and "); err != nil {
-					return
-				}
-			}
+	}
+
+	for _, poly := range table.PolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    // Save loaded `); err != nil {
+			return
 		}
-		if _, err = io.WriteString(_w, `.
-// doc: type=`); err != nil {
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		if _, err = io.WriteString(_w, ` object to get its new pk and update it here.
+    if o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `
-func Count`); err != nil {
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, ` != nil {
+        if err := o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `By`); err != nil {
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+		if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+            return err
+        }
+        o.Set`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(ctx context.Context, `); err != nil {
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+    }
+`); err != nil {
 			return
 		}
 
-		for _i, _j := range idx.Columns {
-			_ = _j
+	}
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
+
+	//*** unique_check.tmpl
+
+	for _, idx := range table.Indexes {
+
+		if idx.IsUnique && len(idx.Columns) > 1 {
+
+			if _, err = io.WriteString(_w, `    // Check mult-column unique index
+    if (`); err != nil {
+				return
+			}
+
+			for _i, _j := range idx.Columns {
+				_ = _j
+
+				if _, err = io.WriteString(_w, `o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `IsDirty`); err != nil {
+					return
+				}
+
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, " || "); err != nil {
+						return
+					}
+				}
+			}
+			if _, err = io.WriteString(_w, `) `); err != nil {
+				return
+			}
+
+			for _, col := range idx.Columns {
+
+				if col.IsNullable {
+
+					if _, err = io.WriteString(_w, ` && !o.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `IsNull`); err != nil {
+						return
+					}
+
+				}
+
+			}
 
-			if _, err = io.WriteString(_w, _j.Field); err != nil {
+			if _, err = io.WriteString(_w, ` {
+        if obj, err := Load`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` `); err != nil {
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, _j.Type); err != nil {
+			if _, err = io.WriteString(_w, `By`); err != nil {
 				return
 			}
 
-			if _i < len(idx.Columns)-1 {
-				if _, err = io.WriteString(_w, ", "); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
 			}
-		}
-		if _, err = io.WriteString(_w, ` ) (int, error) {
-`); err != nil {
-			return
-		}
 
-		for _, col := range idx.Columns {
+			if _, err = io.WriteString(_w, `(ctx, `); err != nil {
+				return
+			}
 
-			if col.SchemaSubType == schema.ColSubTypeTimeOnly {
+			for _i, _j := range idx.Columns {
+				_ = _j
 
-				if _, err = io.WriteString(_w, `    v_`); err != nil {
+				if _, err = io.WriteString(_w, `o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, ` := `); err != nil {
-					return
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, ", "); err != nil {
+						return
+					}
 				}
+			}
+			if _, err = io.WriteString(_w, `); err != nil {
+            return err
+        } else if obj != nil {
+            return db.NewUniqueValueError("`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, table.QueryName); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `.Format("15:04:05")
-`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `", map[string]any{ `); err != nil {
+				return
+			}
 
-			} else {
+			for _i, _j := range idx.Columns {
+				_ = _j
 
-				if _, err = io.WriteString(_w, `    v_`); err != nil {
+				if _, err = io.WriteString(_w, `"`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, _j.QueryName); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, ` := `); err != nil {
+				if _, err = io.WriteString(_w, `":o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `
-`); err != nil {
-					return
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, ","); err != nil {
+						return
+					}
 				}
-
+			}
+			if _, err = io.WriteString(_w, ` }, nil)
+        }
+    }
+`); err != nil {
+				return
 			}
 
 		}
 
-		if _, err = io.WriteString(_w, `	return Query`); err != nil {
+	}
+
+	if _, err = io.WriteString(_w, `
+        modifiedFields = get`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `UpdateFields(o)
+        if len(modifiedFields) != 0 {
+            err2 := d.Update(ctx, "`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `",
+                map[string]any{
+`); err != nil {
+		return
+	}
+
+	for _, col := range table.PrimaryKeyColumns() {
+
+		if _, err = io.WriteString(_w, `                    "`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, col.QueryName); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(ctx).
-`); err != nil {
+		if _, err = io.WriteString(_w, `": o._originalPK`); err != nil {
 			return
 		}
 
-		for _, col := range idx.Columns {
-
-			if _, err = io.WriteString(_w, `	Where(op.Equal(node.`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
-				return
-			}
+		if len(table.PrimaryKeyColumns()) > 1 {
 
-			if _, err = io.WriteString(_w, `().`); err != nil {
+			if _, err = io.WriteString(_w, `.`); err != nil {
 				return
 			}
 
@@ -7000,1038 +15319,896 @@ func Count`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(), v_`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `)).
-`); err != nil {
-				return
-			}
-
 		}
 
-		if _, err = io.WriteString(_w, `	Count()
-}
-
+		if _, err = io.WriteString(_w, `,
 `); err != nil {
 			return
 		}
 
 	}
 
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
-
-	return
-}
-
-func (tmpl *TableBaseTemplate) genUnpack(table *model.Table, _w io.Writer) (err error) {
-
-	//*** unpack.tmpl
-
-	if _, err = io.WriteString(_w, `// unpack recursively transforms data coming from the database into ORM objects.
-func (o *`); err != nil {
+	if _, err = io.WriteString(_w, `                },
+                modifiedFields,
+                "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+	if _, err = io.WriteString(_w, table.LockColumnQueryName()); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Base) unpack (m map[string]interface{}, objThis *`); err != nil {
+	if _, err = io.WriteString(_w, `",
+                `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, fmt.Sprint(table.Identifier)); err != nil {
-		return
-	}
+	if s := table.LockColumnIdentifier(); s == "" {
 
-	if _, err = io.WriteString(_w, `) {
+		if _, err = io.WriteString(_w, `0`); err != nil {
+			return
+		}
 
-`); err != nil {
-		return
-	}
+	} else {
 
-	for _, col := range table.AllColumns() {
+		if _, err = io.WriteString(_w, `o.`); err != nil {
+			return
+		}
 
-		if _, err = io.WriteString(_w, `	`); err != nil {
+		if _, err = io.WriteString(_w, s); err != nil {
 			return
 		}
 
-		if col.IsNullable {
+		if _, err = io.WriteString(_w, `()`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `
-	    `); err != nil {
-				return
-			}
+	}
 
-			if _, err = io.WriteString(_w, `
+	if _, err = io.WriteString(_w, `,
+            )
+            if err2 != nil {
+                return err2
+            }
 `); err != nil {
-				return
-			}
+		return
+	}
 
-			//*** null_column.tmpl
+	if len(table.GeneratedColumns()) > 0 {
 
-			if _, err = io.WriteString(_w, `
-	if v, ok := m["`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `            if err2 = o.loadGeneratedColumns(ctx); err2 != nil {
+                return err2
+            }
+`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, col.QueryKey()); err != nil {
-				return
-			}
+	}
 
-			if _, err = io.WriteString(_w, `"]; ok {
-		if v == nil {
-			o.`); err != nil {
-				return
-			}
+	if _, err = io.WriteString(_w, `        }
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+`); err != nil {
+		return
+	}
 
-			if _, err = io.WriteString(_w, ` = `); err != nil {
-				return
-			}
+	//*** update_rev.tmpl
 
-			if _, err = io.WriteString(_w, col.DefaultValueAsValue()); err != nil {
-				return
-			}
+	for _, rev := range table.ReverseReferences {
 
-			if _, err = io.WriteString(_w, `
-			o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `        if o.`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `IsNull = true
-			o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `IsDirty {
+            // relation connection changed
+    `); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+		if rev.IsNullable {
 
-			if _, err = io.WriteString(_w, `IsLoaded = true
-`); err != nil {
+			if _, err = io.WriteString(_w, `
+        `); err != nil {
 				return
 			}
 
-			if col.HasSetter() {
+			if rev.IsUnique {
 
-				if _, err = io.WriteString(_w, `			o.`); err != nil {
+				if _, err = io.WriteString(_w, `
+            `); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, `
+`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `IsDirty = false
-`); err != nil {
+				//*** update_rev_null_unique.tmpl
+
+				if _, err = io.WriteString(_w, `                if obj, err := Query`); err != nil {
 					return
 				}
 
-			}
-
-			if col.IsEnum() {
+				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+					return
+				}
 
-				if _, err = io.WriteString(_w, `		} else if i, ok2 := v.(int); ok2 {
-		    o.`); err != nil {
+				if _, err = io.WriteString(_w, `(ctx).
+                        Where(op.Equal(node.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, ` = `); err != nil {
+				if _, err = io.WriteString(_w, `().`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Type); err != nil {
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `(i)
-`); err != nil {
+				if _, err = io.WriteString(_w, `(), o.`); err != nil {
 					return
 				}
 
-			} else {
+				if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Identifier); err != nil {
+					return
+				}
 
-				if _, err = io.WriteString(_w, `		} else if o.`); err != nil {
+				if _, err = io.WriteString(_w, `())).
+                        Select(node.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `, ok = v.(`); err != nil {
+				if _, err = io.WriteString(_w, `().`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Type); err != nil {
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `); ok {
-`); err != nil {
+				if _, err = io.WriteString(_w, `()).
+ `); err != nil {
 					return
 				}
 
-			}
+				if rev.Table.LockColumn != nil {
 
-			if _, err = io.WriteString(_w, `			o.`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `
+                        Select(node.`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `IsNull = false
-			o.`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `().`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `IsLoaded = true
-`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `()).
+ `); err != nil {
+						return
+					}
 
-			if col.HasSetter() {
+				}
 
-				if _, err = io.WriteString(_w, `			o.`); err != nil {
+				if _, err = io.WriteString(_w, `
+                       Get(); err != nil {
+                    return err
+                } else if obj != nil &&
+                    (o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `IsDirty = false
-`); err != nil {
+				if _, err = io.WriteString(_w, ` == nil ||
+                    obj.PrimaryKey() != o.`); err != nil {
 					return
 				}
 
-			}
-
-			if _, err = io.WriteString(_w, `		} else {
-			panic("Wrong type found for `); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `.")
-		}
-	} else {
-		o.`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `IsLoaded = false
-		o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `.PrimaryKey()) {
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+                    obj.Set`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `IsNull = true
-		o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `ToNull()
+                    if err = obj.Save(ctx); err != nil {
+                        return err
+                    }
+                }
 
-			if _, err = io.WriteString(_w, ` = `); err != nil {
-				return
-			}
+                if o.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, col.DefaultValueAsValue()); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, ` != nil {
+                    o.`); err != nil {
+					return
+				}
 
-			if col.HasSetter() {
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-				if _, err = io.WriteString(_w, `		o.`); err != nil {
+				if _, err = io.WriteString(_w, `.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, rev.ForeignKey.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `IsDirty = false
-`); err != nil {
+				if _, err = io.WriteString(_w, `IsDirty = true // force a change in case data is stale
+                    o.`); err != nil {
 					return
 				}
 
-			}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `	}
+				if _, err = io.WriteString(_w, `.Set`); err != nil {
+					return
+				}
 
-`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `	`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `(o.PrimaryKey())
+                    if err := o.`); err != nil {
+					return
+				}
 
-		} else {
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-        `); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+                        return err
+                    }
+                }
 
-			if _, err = io.WriteString(_w, `
 `); err != nil {
-				return
-			}
+					return
+				}
 
-			//*** not_null_column.tmpl
+				if _, err = io.WriteString(_w, `        `); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-	if v, ok := m["`); err != nil {
-				return
-			}
+			} else {
 
-			if _, err = io.WriteString(_w, col.QueryKey()); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `
+            `); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `"]; ok && v != nil {
+				if _, err = io.WriteString(_w, `
 `); err != nil {
-				return
-			}
-
-			if col.IsEnum() {
-
-				if _, err = io.WriteString(_w, `     	if i, ok2 := v.(int); ok2 {
-           o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				//*** update_rev_null.tmpl
+
+				if _, err = io.WriteString(_w, `                if currentObjs, err := Query`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, ` = `); err != nil {
+				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Type); err != nil {
+				if _, err = io.WriteString(_w, `(ctx).
+                          Where(op.Equal(node.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `(i)
-`); err != nil {
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 					return
 				}
 
-			} else {
-
-				if _, err = io.WriteString(_w, `    	if o.`); err != nil {
+				if _, err = io.WriteString(_w, `().`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `, ok = v.(`); err != nil {
+				if _, err = io.WriteString(_w, `(), o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Type); err != nil {
+				if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Identifier); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `); ok {
-`); err != nil {
+				if _, err = io.WriteString(_w, `())).
+                          Select(node.`); err != nil {
 					return
 				}
 
-			}
-
-			if _, err = io.WriteString(_w, `			o.`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `IsLoaded = true
-`); err != nil {
-				return
-			}
-
-			if col.HasSetter() {
-
-				if _, err = io.WriteString(_w, `			o.`); err != nil {
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, `().`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `IsDirty = false
-`); err != nil {
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
 					return
 				}
 
-			}
-
-			if col.IsAPrimaryKey() {
-
-				if len(table.PrimaryKeyColumns()) == 1 {
-
-					if _, err = io.WriteString(_w, `            o._originalPK = o.`); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, col.Field); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, `
+				if _, err = io.WriteString(_w, `()).
 `); err != nil {
-						return
-					}
+					return
+				}
 
-				} else {
+				if rev.Table.LockColumn != nil {
 
-					if _, err = io.WriteString(_w, `            o._originalPK.`); err != nil {
+					if _, err = io.WriteString(_w, `                          Select(node.`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` = o.`); err != nil {
+					if _, err = io.WriteString(_w, `().`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, col.Field); err != nil {
+					if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `
+					if _, err = io.WriteString(_w, `()).
 `); err != nil {
 						return
 					}
 
 				}
 
-			}
-
-			if _, err = io.WriteString(_w, `		} else {
-			panic("Wrong type found for `); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `.")
-		}
-	} else {
-		o.`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `IsLoaded = false
-		o.`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, ` = `); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.DefaultValueAsValue()); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `
-`); err != nil {
-				return
-			}
-
-			if col.HasSetter() {
-
-				if _, err = io.WriteString(_w, `		o.`); err != nil {
+				if _, err = io.WriteString(_w, `                          Load(); err != nil {
+                    return err
+                } else {
+                    for _,obj := range currentObjs {
+                        if !o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Field); err != nil {
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `IsDirty = false
-`); err != nil {
+				if _, err = io.WriteString(_w, `.Has(obj.PrimaryKey()) {
+                            // The old object is not in the group of new objects
+                            obj.Set`); err != nil {
 					return
 				}
 
-			}
-
-			if _, err = io.WriteString(_w, `	}
-
-`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `    `); err != nil {
-				return
-			}
-
-		}
-
-		if _, err = io.WriteString(_w, `
-`); err != nil {
-			return
-		}
-
-	}
-
-	for _, ref := range table.References {
-
-		if _, err = io.WriteString(_w, `	`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `
-`); err != nil {
-			return
-		}
-
-		//*** reference.tmpl
-
-		if _, err = io.WriteString(_w, `
-	if v, ok := m["`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, ref.QueryKey()); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `"]; ok {
-		if `); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `, ok2 := v.(map[string]any); ok2 {
-			o.`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ` = new(`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `ToNull()
+                            if err = obj.Save(ctx); err != nil {
+                                return err
+                            }
+                        }
+                    }
+                    keys := o.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `)
-			o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `.Keys() // Make a copy of the keys, since we will change the slicemap while iterating
+                    for i, k := range keys {
+                        obj := o.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `.unpack(`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `.Get(k)
+                        obj.Set`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `, o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `(o.PrimaryKey())
+                        obj.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Field); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `)
-			// mirror foreign key with loaded object
-			o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `IsDirty = true // force a change in case data is stale
+                        if err = obj.Save(ctx); err != nil {
+                            return err
+                        }
+                        if obj.PrimaryKey() != k {
+                            // update slice map key without changing order
+                            o.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ` = o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `.Delete(k)
+                            o.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
+                        }
+                    }
+                }
 
-		if _, err = io.WriteString(_w, `.PrimaryKey()
 `); err != nil {
-			return
-		}
+					return
+				}
 
-		if ref.IsNullable {
+				if _, err = io.WriteString(_w, `        `); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `			o.`); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
+			if _, err = io.WriteString(_w, `
+    `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `IsNull = false
-`); err != nil {
+		} else {
+
+			if _, err = io.WriteString(_w, ` `); err != nil {
 				return
 			}
 
-		}
-
-		if _, err = io.WriteString(_w, `			o.`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `
+        `); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
-			return
-		}
+			if rev.IsUnique {
 
-		if _, err = io.WriteString(_w, `IsLoaded = true
-			o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `
+            `); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ref.ForeignKey.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `
+`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `IsDirty = false
-		} else {
-			panic("Wrong type found for `); err != nil {
-			return
-		}
+				//*** update_rev_not_null_unique.tmpl
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `                    // Since the other side of the relationship cannot be null, if there is an object already attached
+                    // that is different than the one we are trying to attach, we will panic to warn the developer.
+                    if oldObj, err := Query`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ` object.")
-		}
-	} else {
-		o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `(ctx).
+                          Where(op.Equal(node.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ` = nil
-	}
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
 
-`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
 
-	}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
+				if _, err = io.WriteString(_w, `(), o.`); err != nil {
+					return
+				}
 
-	if len(table.ManyManyReferences) > 0 {
+				if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Identifier); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `// Many-Many references
-`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `())).
+                          Select(node.`); err != nil {
+					return
+				}
 
-	}
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
 
-	for _, mm := range table.ManyManyReferences {
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `	`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `
+				if _, err = io.WriteString(_w, `()).
 `); err != nil {
-			return
-		}
-
-		//*** mm.tmpl
-
-		if _, err = io.WriteString(_w, `
-	if v, ok := m["`); err != nil {
-			return
-		}
+					return
+				}
 
-		if _, err = io.WriteString(_w, mm.QueryKey()); err != nil {
-			return
-		}
+				if rev.Table.LockColumn != nil {
 
-		if _, err = io.WriteString(_w, `"]; ok {
-		if v2, ok2 := v.([]map[string]any); ok2 {
-			o.`); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, `                          Select(node.`); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, `.Clear()
+					if _, err = io.WriteString(_w, `().`); err != nil {
+						return
+					}
 
-			for _,v3 := range v2 {
-				obj := new(`); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, `()).
+`); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, `)
-				obj.unpack(v3, obj)
-				o.`); err != nil {
-			return
-		}
+				}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `                         Get(); err != nil {
+                        return err
+                    } else if oldObj != nil {
+                        if o.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
-			}
-			o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, ` == nil || oldObj.PrimaryKey() != o.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ` = nil
-		} else {
-			panic("Wrong type found for `); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `.PrimaryKey() {
+                            // Delete the old object
+                            err = oldObj.Delete(ctx)
+                            if err != nil {
+                                return err
+                            }
+                        }
+                    }
+                    // we are moving the attachment from another object to our object, or attaching an object that is already attached.
+                    if o.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ` object.")
-		}
-	} else {
-		o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, ` != nil {
+                        o.`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `.Clear()
-		o.`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `.Set`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, ` = nil
-	}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `(o.PrimaryKey())
+                        if err := o.`); err != nil {
+					return
+				}
 
-	}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, `
+				if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+                            return err
+                        }
+                    }
 `); err != nil {
-		return
-	}
+					return
+				}
 
-	if len(table.ReverseReferences) > 0 {
+				if _, err = io.WriteString(_w, `        `); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `// Reverse references
-`); err != nil {
-			return
-		}
+			} else {
 
-	}
+				if _, err = io.WriteString(_w, `
+            `); err != nil {
+					return
+				}
 
-	for _, rev := range table.ReverseReferences {
+				if _, err = io.WriteString(_w, `
+`); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `	`); err != nil {
-			return
-		}
+				//*** update_rev_not_null.tmpl
 
-		if rev.IsUnique {
+				if _, err = io.WriteString(_w, `                    // Since the other side of the relationship cannot be null, there cannot be objects that will be detached.
+                    if oldObjs, err := Query`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-	    `); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `(ctx).
+                                Where(op.Equal(node.`); err != nil {
+					return
+				}
 
-			//*** reverse_unique.tmpl
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-	if v, ok := m["`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseQueryKey()); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `"]; ok {
-		if v2, ok2 := v.(map[string]any); ok2 {
-			o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `(), o.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, ` = new(`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `())).
+                                Select(node.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `)
-			o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `.unpack(v2, o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `()).
+`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+				if rev.Table.LockColumn != nil {
 
-			if _, err = io.WriteString(_w, `)
-			o.`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `                                Select(node.`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `IsDirty = false
-		} else {
-			panic("Wrong type found for `); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `().`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, ` object.")
-		}
-	} else {
-		o.`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `()).
+`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+				}
 
-			if _, err = io.WriteString(_w, ` = nil
-		o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `                                Load(); err != nil {
+                        return err
+                    } else {
+                        for _,obj := range oldObjs {
+                            if !o.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `IsDirty = false
-	}
+				if _, err = io.WriteString(_w, `.Has(obj.PrimaryKey()) {
+                                err = obj.Delete(ctx) // old object is not in group of new objects, so delete it since it has a non-null reference to o.
+                                if err != nil {
+                                    return err
+                                }
+                            }
+                        }
+                        keys := o.`); err != nil {
+					return
+				}
 
-`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `	`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `.Keys() // Make a copy of the keys, since we will change the slicemap while iterating
+                        for i, k := range keys {
+                            obj := o.`); err != nil {
+					return
+				}
 
-		} else {
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, ` `); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `.Get(k)
+                            if obj == nil {
+                                // object was deleted during save?
+                                continue
+                            }
+                            obj.Set`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-	    `); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `(o.PrimaryKey())
+                            obj.`); err != nil {
+					return
+				}
 
-			//*** reverse.tmpl
+				if _, err = io.WriteString(_w, rev.ForeignKey.Field); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `IsDirty = true // force a change in case data is stale
+                            if err = obj.Save(ctx); err != nil {
+                                return err
+                            }
+                            if obj.PrimaryKey() != k {
+                                // update slice map key without changing order
+                                o.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
-	if v, ok := m["`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseQueryKey()); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `.Delete(k)
+                                o.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `"]; ok {
-		switch v2 := v.(type) {
-		case []map[string]any: // array expansion
-		    o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
+                            }
+                        }
+                    }
+`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `.Clear()
-			o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `        `); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, `IsDirty = false
-			for _,v3 := range v2 {
-				obj := new(`); err != nil {
+			if _, err = io.WriteString(_w, `
+    `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-				return
-			}
+		}
 
-			if _, err = io.WriteString(_w, `)
-				obj.unpack(v3, obj)
-				o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `
+        } else {
+    `); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `.Set(obj.PrimaryKey(), obj)
-			}
-		default:
-			panic("Wrong type found for `); err != nil {
-				return
-			}
+		//*** update_rev_save.tmpl
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+		if rev.IsUnique {
 
-			if _, err = io.WriteString(_w, ` object.")
-		}
-	} else {
-		o.`); err != nil {
+			if _, err = io.WriteString(_w, `            // save related object in case internal values changed
+            if o.`); err != nil {
 				return
 			}
 
@@ -8039,8 +16216,8 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Clear()
-		o.`); err != nil {
+			if _, err = io.WriteString(_w, ` != nil {
+                if err := o.`); err != nil {
 				return
 			}
 
@@ -8048,1280 +16225,1035 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `IsDirty = false
-	}
-
+			if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+                    return err
+                }
+            }
 `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `	`); err != nil {
-				return
-			}
-
-		}
-
-		if _, err = io.WriteString(_w, `
-`); err != nil {
-			return
-		}
-
-	}
-
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
-
-	//*** extra.tmpl
-
-	if _, err = io.WriteString(_w, `	if v, ok := m["`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, query.AliasResults); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `"]; ok {
-		o._aliases = v.(map[string]any)
-	}
-
-	o._restored = true
-`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `
-}
-
-`); err != nil {
-		return
-	}
-
-	return
-}
-
-func (tmpl *TableBaseTemplate) genSave(table *model.Table, _w io.Writer) (err error) {
-
-	//*** save.tmpl
-
-	// TODO: Make sure dirty related objects are saved first
-	// TODO: Make sure id field cannot be set or updated
-
-	if _, err = io.WriteString(_w, `
-// save will update or insert the object, depending on the state of the object.
-func (o *`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `Base) save(ctx context.Context) error {
-	if o._restored {
-		return o.update(ctx)
-	} else {
-		return o.insert(ctx)
-	}
-}
-
-`); err != nil {
-		return
-	}
-
-	//*** update.tmpl
-
-	if _, err = io.WriteString(_w, `// update will update the values in the database, saving any changed values.
-// If the table has auto-generated values, those will be updated automatically.
-func (o *`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `Base) update(ctx context.Context) error {
-    if !o._restored {
-        panic ("cannot update a record that was not originally read from the database.")
-    }
-    if !o.IsDirty() {
-        return nil // nothing to save
-    }
-
-    var modifiedFields map[string]interface{}
+		} else {
 
-    d := Database()
-`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, `            // save related objects in case internal values changed
+            for obj := range o.`); err != nil {
+				return
+			}
 
-	if table.WriteTimeout != 0 {
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `    var cancel context.CancelFunc
-    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `.ValuesIter() {
+                if err := obj.Save(ctx); err != nil {
+                    return err
+                }
+            }
+`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.WriteTimeoutConst()); err != nil {
-			return
 		}
 
-		if _, err = io.WriteString(_w, `)
-    defer cancel()
+		if _, err = io.WriteString(_w, `        }
 `); err != nil {
 			return
 		}
 
 	}
 
-	if _, err = io.WriteString(_w, `    err := db.WithTransaction(ctx, d, func(ctx context.Context) error {
+	//*** update_mm.tmpl
+
+	if _, err = io.WriteString(_w, `
 `); err != nil {
 		return
 	}
 
-	//*** update_ref.tmpl
-
-	for _, ref := range table.References {
+	for _, mm := range table.ManyManyReferences {
 
-		if _, err = io.WriteString(_w, `    // Save loaded `); err != nil {
+		if _, err = io.WriteString(_w, `    `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		//*** update_mm_col.tmpl
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` object to get its new pk and update it here.
-    if o.`); err != nil {
+		if _, err = io.WriteString(_w, `    {
+        keys := o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` != nil {
-        if err := o.`); err != nil {
+		if _, err = io.WriteString(_w, `.Keys() // Make a copy of the keys, since we will change the slicemap while iterating
+        for i, k := range keys {
+            obj := o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
-            return err
-        }
-        o.Set`); err != nil {
+		if _, err = io.WriteString(_w, `.Get(k)
+            if err := obj.Save(ctx); err != nil {
+                return err
+            }
+            if obj.PrimaryKey() != k {
+                // update key in the slice map without changing the order
+                o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.ForeignKey.Identifier); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(o.`); err != nil {
+		if _, err = io.WriteString(_w, `.Delete(k)
+                o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.PrimaryKey())
-    }
+		if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
 `); err != nil {
 			return
 		}
 
-	}
-
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
-
-	//*** unique_check.tmpl
-
-	for _, idx := range table.Indexes {
-
-		if idx.IsUnique && len(idx.Columns) > 1 {
-
-			if _, err = io.WriteString(_w, `    // Check mult-column unique index
-    if (`); err != nil {
-				return
-			}
-
-			for _i, _j := range idx.Columns {
-				_ = _j
-
-				if _, err = io.WriteString(_w, `o.`); err != nil {
-					return
-				}
-
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
-					return
-				}
-
-				if _, err = io.WriteString(_w, `IsDirty`); err != nil {
-					return
-				}
+		if mm.HasColumns() {
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, " || "); err != nil {
-						return
-					}
-				}
-			}
-			if _, err = io.WriteString(_w, `) `); err != nil {
+			if _, err = io.WriteString(_w, `                o.rekey`); err != nil {
 				return
 			}
 
-			for _, col := range idx.Columns {
-
-				if col.IsNullable {
-
-					if _, err = io.WriteString(_w, ` && !o.`); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, col.Field); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, `IsNull`); err != nil {
-						return
-					}
-
-				}
-
-			}
-
-			if _, err = io.WriteString(_w, ` {
-        if obj, err := Load`); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `(k, obj.PrimaryKey())
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
-				return
-			}
+		}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
+		if _, err = io.WriteString(_w, `            }
+        }
+`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, _j.Identifier); err != nil {
-					return
-				}
+		if mm.HasColumns() {
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ""); err != nil {
-						return
-					}
-				}
-			}
-			if _, err = io.WriteString(_w, `(ctx, `); err != nil {
+			if _, err = io.WriteString(_w, `        if o.`); err != nil {
 				return
 			}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
-
-				if _, err = io.WriteString(_w, `o.`); err != nil {
-					return
-				}
-
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
-					return
-				}
-
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ", "); err != nil {
-						return
-					}
-				}
-			}
-			if _, err = io.WriteString(_w, `); err != nil {
-            return err
-        } else if obj != nil {
-            return db.NewUniqueValueError("`); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.QueryName); err != nil {
+			if _, err = io.WriteString(_w, `IsDirty || o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `", map[string]any{ `); err != nil {
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
 				return
 			}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
-
-				if _, err = io.WriteString(_w, `"`); err != nil {
-					return
-				}
-
-				if _, err = io.WriteString(_w, _j.QueryName); err != nil {
-					return
-				}
-
-				if _, err = io.WriteString(_w, `":o.`); err != nil {
-					return
-				}
-
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
-					return
-				}
-
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ","); err != nil {
-						return
-					}
-				}
-			}
-			if _, err = io.WriteString(_w, ` }, nil)
-        }
-    }
+			if _, err = io.WriteString(_w, `AreDirty() {
 `); err != nil {
 				return
 			}
 
-		}
-
-	}
-
-	if _, err = io.WriteString(_w, `
-        modifiedFields = get`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `UpdateFields(o)
-        if len(modifiedFields) != 0 {
-            err2 := d.Update(ctx, "`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, table.QueryName); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `",
-                map[string]any{
-`); err != nil {
-		return
-	}
-
-	for _, col := range table.PrimaryKeyColumns() {
-
-		if _, err = io.WriteString(_w, `                    "`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, col.QueryName); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `": o._originalPK`); err != nil {
-			return
-		}
+		} else {
 
-		if len(table.PrimaryKeyColumns()) > 1 {
+			if _, err = io.WriteString(_w, `        if o.`); err != nil {
+				return
+			}
 
-			if _, err = io.WriteString(_w, `.`); err != nil {
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `IsDirty {
+`); err != nil {
 				return
 			}
 
 		}
 
-		if _, err = io.WriteString(_w, `,
-`); err != nil {
+		if _, err = io.WriteString(_w, `            pks := o.`); err != nil {
 			return
 		}
 
-	}
-
-	if _, err = io.WriteString(_w, `                },
-                modifiedFields,
-                "`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, table.LockColumnQueryName()); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `",
-                `); err != nil {
-		return
-	}
-
-	if s := table.LockColumnIdentifier(); s == "" {
-
-		if _, err = io.WriteString(_w, `0`); err != nil {
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
 			return
 		}
 
-	} else {
-
-		if _, err = io.WriteString(_w, `o.`); err != nil {
+		if _, err = io.WriteString(_w, `
+            if len(pks) == 0 {
+                pks = o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, s); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `()`); err != nil {
+		if _, err = io.WriteString(_w, `.Keys()
+            }
+`); err != nil {
 			return
 		}
 
-	}
+		if mm.IsOtherOrdered() {
 
-	if _, err = io.WriteString(_w, `,
-            )
-            if err2 != nil {
-                return err2
-            }
-        }
+			if _, err = io.WriteString(_w, `            // The `); err != nil {
+				return
+			}
 
-`); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.Type()); err != nil {
+				return
+			}
 
-	//*** update_rev.tmpl
+			if _, err = io.WriteString(_w, ` objects keep their `); err != nil {
+				return
+			}
 
-	for _, rev := range table.ReverseReferences {
+			if _, err = io.WriteString(_w, mm.MM.IdentifierPlural); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `        if o.`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, ` in order, so keep existing positions and add new ones at the end
+            if err := db.AssociateOnlyAppending(ctx,
+                    d,
+                    "`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `IsDirty {
-            // relation connection changed
-    `); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `",
+                    "`); err != nil {
+				return
+			}
 
-		if rev.IsNullable {
+			if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
+				return
+			}
 
-			if _, err = io.WriteString(_w, `
-        `); err != nil {
+			if _, err = io.WriteString(_w, `",
+                    o.PrimaryKey(),
+                    "`); err != nil {
 				return
 			}
 
-			if rev.IsUnique {
+			if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `
-            `); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `",
+                    pks,
+                    query.`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `
+			if _, err = io.WriteString(_w, mm.ForeignKeyReceiverType.String()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `,
 `); err != nil {
-					return
-				}
+				return
+			}
 
-				//*** update_rev_null_unique.tmpl
+			if mm.HasColumns() {
 
-				if _, err = io.WriteString(_w, `                if obj, err := Query`); err != nil {
+				if _, err = io.WriteString(_w, `                    "`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+				if _, err = io.WriteString(_w, mm.MM.OrderColumn); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `(ctx).
-                        Where(op.Equal(node.`); err != nil {
+				if _, err = io.WriteString(_w, `",
+                    o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				if _, err = io.WriteString(_w, mm.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `().`); err != nil {
+				if _, err = io.WriteString(_w, `AssnValues(pks)...); err != nil {
+`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+			} else {
 
-				if _, err = io.WriteString(_w, `(), o.`); err != nil {
+				if _, err = io.WriteString(_w, `                    "`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Identifier); err != nil {
+				if _, err = io.WriteString(_w, mm.MM.OrderColumn); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `())).
-                        Select(node.`); err != nil {
+				if _, err = io.WriteString(_w, `"); err != nil {
+`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
-				}
+			}
 
-				if _, err = io.WriteString(_w, `().`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `                return err
+            }
+`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+		} else {
 
-				if _, err = io.WriteString(_w, `()).
- `); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `            if err := db.AssociateOnly(ctx,
+                    d,
+                    "`); err != nil {
+				return
+			}
 
-				if rev.Table.LockColumn != nil {
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `
-                        Select(node.`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, `",
+                    "`); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, `",
+                    o.PrimaryKey(),
+                    "`); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+				return
+			}
 
-					if _, err = io.WriteString(_w, `()).
- `); err != nil {
-						return
-					}
+			if _, err = io.WriteString(_w, `",
+`); err != nil {
+				return
+			}
 
-				}
+			if mm.HasColumns() || mm.IsOrdered() {
 
-				if _, err = io.WriteString(_w, `
-                       Get(); err != nil {
-                    return err
-                } else if obj != nil &&
-                    (o.`); err != nil {
+				if _, err = io.WriteString(_w, `                    pks,
+                    o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				if _, err = io.WriteString(_w, mm.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, ` == nil ||
-                    obj.PrimaryKey() != o.`); err != nil {
+				if _, err = io.WriteString(_w, `AssnValues(pks)...); err != nil {
+`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			} else {
+
+				if _, err = io.WriteString(_w, `                    pks); err != nil {
+`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `.PrimaryKey()) {
+			}
 
-                    obj.Set`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `                return err
+            }
+`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+		}
 
-				if _, err = io.WriteString(_w, `ToNull()
-                    if err = obj.Save(ctx); err != nil {
-                        return err
-                    }
-                }
+		if _, err = io.WriteString(_w, `        }
+    }
+`); err != nil {
+			return
+		}
 
-                if o.`); err != nil {
-					return
-				}
+	}
+
+	if _, err = io.WriteString(_w, `
+        return nil
+    }) // transaction
+    if err != nil {
+        return err
+    }
+`); err != nil {
+		return
+	}
+
+	for _, col := range table.Columns {
+
+		if col.SchemaSubType == schema.ColSubTypeTimestamp ||
+			col.ReceiverType == query.ColTypeTime && col.DefaultValue == model.ModifiedTime {
+
+			if _, err = io.WriteString(_w, `    // update generated time value
+    if t, ok := modifiedFields["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"]; ok {
+        o.`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, ` != nil {
-                    o.`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, ` = t.(`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.Type); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `.`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `)
+        o.`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Field); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `IsDirty = true // force a change in case data is stale
-                    o.`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `IsLoaded = true
+    }
+`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+		} else if col.SchemaSubType == schema.ColSubTypeLock {
 
-				if _, err = io.WriteString(_w, `.Set`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `    // update generated lock value
+    if l, ok := modifiedFields["`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `(o.PrimaryKey())
-                    if err := o.`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `"]; ok {
+        o.`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
-                        return err
-                    }
-                }
+			if _, err = io.WriteString(_w, ` = l.(int64)
+        o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
 
+			if _, err = io.WriteString(_w, `IsLoaded = true
+    }
 `); err != nil {
-					return
-				}
+				return
+			}
 
-				if _, err = io.WriteString(_w, `        `); err != nil {
-					return
-				}
+		}
 
-			} else {
+	}
 
-				if _, err = io.WriteString(_w, `
-            `); err != nil {
-					return
-				}
+	if _, err = io.WriteString(_w, `
+	o.resetDirtyStatus()
+	if len(modifiedFields) != 0 {
+        broadcast.Update(ctx, "`); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, `
-`); err != nil {
-					return
-				}
+	if _, err = io.WriteString(_w, table.DbKey); err != nil {
+		return
+	}
 
-				//*** update_rev_null.tmpl
+	if _, err = io.WriteString(_w, `", "`); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, `                if currentObjs, err := Query`); err != nil {
-					return
-				}
+	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
-					return
-				}
+	if _, err = io.WriteString(_w, `", o._originalPK, anyutil.SortedKeys(modifiedFields)...)
+	}
 
-				if _, err = io.WriteString(_w, `(ctx).
-                          Where(op.Equal(node.`); err != nil {
-					return
-				}
+	return nil
+}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
-				}
+`); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, `().`); err != nil {
-					return
-				}
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+	//*** insert.tmpl
 
-				if _, err = io.WriteString(_w, `(), o.`); err != nil {
-					return
-				}
+	if _, err = io.WriteString(_w, `// insert will insert the object into the database. Related items will be saved.
+func (o *`); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Identifier); err != nil {
-					return
-				}
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, `())).
-                          Select(node.`); err != nil {
-					return
-				}
+	if _, err = io.WriteString(_w, `Base) insert(ctx context.Context) (err error) {
+    var insertFields map[string]interface{}
+    d := Database()
+`); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
-				}
+	if table.WriteTimeout != 0 {
 
-				if _, err = io.WriteString(_w, `().`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, table.WriteTimeoutConst()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+    defer cancel()
 
-				if _, err = io.WriteString(_w, `()).
 `); err != nil {
-					return
-				}
+			return
+		}
 
-				if rev.Table.LockColumn != nil {
+	}
 
-					if _, err = io.WriteString(_w, `                          Select(node.`); err != nil {
-						return
-					}
+	if _, err = io.WriteString(_w, `	err = db.WithTransaction(ctx, d, func(ctx context.Context) error {
+`); err != nil {
+		return
+	}
 
-					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-						return
-					}
+	//*** update_ref.tmpl
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
-						return
-					}
+	if base := table.BaseTable(); base != nil {
 
-					if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
-						return
-					}
+		if _, err = io.WriteString(_w, `    // Save the `); err != nil {
+			return
+		}
 
-					if _, err = io.WriteString(_w, `()).
-`); err != nil {
-						return
-					}
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
 
-				}
+		if _, err = io.WriteString(_w, ` object first, since a new one provides the primary key of this object.
+    if err := o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `                          Load(); err != nil {
-                    return err
-                } else {
-                    for _,obj := range currentObjs {
-                        if !o.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+        return err
+    }
+    if !o._restored {
+        o.Set`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `.Has(obj.PrimaryKey()) {
-                            // The old object is not in the group of new objects
-                            obj.Set`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, table.BaseReference.ForeignKey.Identifier); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `(o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `ToNull()
-                            if err = obj.Save(ctx); err != nil {
-                                return err
-                            }
-                        }
-                    }
-                    keys := o.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `.PrimaryKey())
+    }
+`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `.Keys() // Make a copy of the keys, since we will change the slicemap while iterating
-                    for i, k := range keys {
-                        obj := o.`); err != nil {
-					return
-				}
+	}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+	for _, ref := range table.References {
 
-				if _, err = io.WriteString(_w, `.Get(k)
-                        obj.Set`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `    // Save loaded `); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `(o.PrimaryKey())
-                        obj.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, ` object to get its new pk and update it here.
+    if o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Field); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `IsDirty = true // force a change in case data is stale
-                        if err = obj.Save(ctx); err != nil {
-                            return err
-                        }
-                        if obj.PrimaryKey() != k {
-                            // update slice map key without changing order
-                            o.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, ` != nil {
+        if err := o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `.Delete(k)
-                            o.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+            return err
+        }
+        o.Set`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, ref.ForeignKey.Identifier); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
-                        }
-                    }
-                }
+		if _, err = io.WriteString(_w, `(o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ref.Field); err != nil {
+			return
+		}
 
+		if _, err = io.WriteString(_w, `.PrimaryKey())
+    }
 `); err != nil {
-					return
-				}
+			return
+		}
 
-				if _, err = io.WriteString(_w, `        `); err != nil {
-					return
-				}
+	}
 
-			}
+	for _, poly := range table.PolymorphicReferences {
 
-			if _, err = io.WriteString(_w, `
-    `); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `    // Save loaded `); err != nil {
+			return
+		}
 
-		} else {
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, ` `); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, ` object to get its new pk and update it here.
+    if o.`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `
-        `); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
+			return
+		}
 
-			if rev.IsUnique {
+		if _, err = io.WriteString(_w, ` != nil {
+        if err := o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `
-            `); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `
-`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+            return err
+        }
+        o.Set`); err != nil {
+			return
+		}
 
-				//*** update_rev_not_null_unique.tmpl
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `                    // Since the other side of the relationship cannot be null, if there is an object already attached
-                    // that is different than the one we are trying to attach, we will panic to warn the developer.
-                    if oldObj, err := Query`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `(o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `(ctx).
-                          Where(op.Equal(node.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `)
+    }
+`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
-				}
+	}
 
-				if _, err = io.WriteString(_w, `().`); err != nil {
-					return
-				}
+	for _, col := range table.SettableColumns() {
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+		if !col.IsAutoPK() && !col.IsNullable {
 
-				if _, err = io.WriteString(_w, `(), o.`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `    if !o.`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Identifier); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `())).
-                          Select(node.`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `IsLoaded {
+        panic("a value for `); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `().`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, ` is required, and there is no default value. Call Set`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `()).
+			if _, err = io.WriteString(_w, `() before inserting the record.")
+    }
 `); err != nil {
-					return
-				}
-
-				if rev.Table.LockColumn != nil {
-
-					if _, err = io.WriteString(_w, `                          Select(node.`); err != nil {
-						return
-					}
+				return
+			}
 
-					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-						return
-					}
+		}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
-						return
-					}
+	}
 
-					if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
-						return
-					}
+	//*** unique_check.tmpl
 
-					if _, err = io.WriteString(_w, `()).
-`); err != nil {
-						return
-					}
+	for _, idx := range table.Indexes {
 
-				}
+		if idx.IsUnique && len(idx.Columns) > 1 {
 
-				if _, err = io.WriteString(_w, `                         Get(); err != nil {
-                        return err
-                    } else if oldObj != nil {
-                        if o.`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `    // Check mult-column unique index
+    if (`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+			for _i, _j := range idx.Columns {
+				_ = _j
 
-				if _, err = io.WriteString(_w, ` == nil || oldObj.PrimaryKey() != o.`); err != nil {
+				if _, err = io.WriteString(_w, `o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `.PrimaryKey() {
-                            // Delete the old object
-                            err = oldObj.Delete(ctx)
-                            if err != nil {
-                                return err
-                            }
-                        }
-                    }
-                    // we are moving the attachment from another object to our object, or attaching an object that is already attached.
-                    if o.`); err != nil {
+				if _, err = io.WriteString(_w, `IsDirty`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, " || "); err != nil {
+						return
+					}
 				}
+			}
+			if _, err = io.WriteString(_w, `) `); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, ` != nil {
-                        o.`); err != nil {
-					return
-				}
+			for _, col := range idx.Columns {
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+				if col.IsNullable {
 
-				if _, err = io.WriteString(_w, `.Set`); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, ` && !o.`); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Field); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(o.PrimaryKey())
-                        if err := o.`); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, `IsNull`); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
 				}
 
-				if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
-                            return err
-                        }
-                    }
-`); err != nil {
-					return
-				}
+			}
 
-				if _, err = io.WriteString(_w, `        `); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, ` {
+        if obj, err := Load`); err != nil {
+				return
+			}
 
-			} else {
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `
-            `); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `By`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `
-`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+				return
+			}
 
-				//*** update_rev_not_null.tmpl
+			if _, err = io.WriteString(_w, `(ctx, `); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `                    // Since the other side of the relationship cannot be null, there cannot be objects that will be detached.
-                    if oldObjs, err := Query`); err != nil {
-					return
-				}
+			for _i, _j := range idx.Columns {
+				_ = _j
 
-				if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+				if _, err = io.WriteString(_w, `o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `(ctx).
-                                Where(op.Equal(node.`); err != nil {
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-					return
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, ", "); err != nil {
+						return
+					}
 				}
+			}
+			if _, err = io.WriteString(_w, `); err != nil {
+            return err
+        } else if obj != nil {
+            return db.NewUniqueValueError("`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `().`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, table.QueryName); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `", map[string]any{ `); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `(), o.`); err != nil {
-					return
-				}
+			for _i, _j := range idx.Columns {
+				_ = _j
 
-				if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Identifier); err != nil {
+				if _, err = io.WriteString(_w, `"`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `())).
-                                Select(node.`); err != nil {
+				if _, err = io.WriteString(_w, _j.QueryName); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+				if _, err = io.WriteString(_w, `":o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `().`); err != nil {
+				if _, err = io.WriteString(_w, _j.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
+				if _i < len(idx.Columns)-1 {
+					if _, err = io.WriteString(_w, ","); err != nil {
+						return
+					}
 				}
-
-				if _, err = io.WriteString(_w, `()).
+			}
+			if _, err = io.WriteString(_w, ` }, nil)
+        }
+    }
 `); err != nil {
-					return
-				}
+				return
+			}
 
-				if rev.Table.LockColumn != nil {
+		}
 
-					if _, err = io.WriteString(_w, `                                Select(node.`); err != nil {
-						return
-					}
+	}
 
-					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-						return
-					}
+	if _, err = io.WriteString(_w, `    insertFields = get`); err != nil {
+		return
+	}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
-						return
-					}
+	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+		return
+	}
 
-					if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
-						return
-					}
+	if _, err = io.WriteString(_w, `InsertFields(o)
+	err = d.Insert(ctx, "`); err != nil {
+		return
+	}
 
-					if _, err = io.WriteString(_w, `()).
-`); err != nil {
-						return
-					}
+	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		return
+	}
 
-				}
+	if _, err = io.WriteString(_w, `", insertFields, `); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, `                                Load(); err != nil {
-                        return err
-                    } else {
-                        for _,obj := range oldObjs {
-                            if !o.`); err != nil {
-					return
-				}
+	if table.HasAutoPK() {
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `"`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `.Has(obj.PrimaryKey()) {
-                                err = obj.Delete(ctx) // old object is not in group of new objects, so delete it since it has a non-null reference to o.
-                                if err != nil {
-                                    return err
-                                }
-                            }
-                        }
-                        keys := o.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `"`); err != nil {
+			return
+		}
+
+	} else {
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `""`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `.Keys() // Make a copy of the keys, since we will change the slicemap while iterating
-                        for i, k := range keys {
-                            obj := o.`); err != nil {
-					return
-				}
+	}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+	if _, err = io.WriteString(_w, `)
+    if err != nil {
+        return err
+    }
+`); err != nil {
+		return
+	}
 
-				if _, err = io.WriteString(_w, `.Get(k)
-                            if obj == nil {
-                                // object was deleted during save?
-                                continue
-                            }
-                            obj.Set`); err != nil {
-					return
-				}
+	if table.HasAutoPK() {
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `	o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `(o.PrimaryKey())
-                            obj.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Field); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ForeignKey.Field); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, ` = insertFields["`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `IsDirty = true // force a change in case data is stale
-                            if err = obj.Save(ctx); err != nil {
-                                return err
-                            }
-                            if obj.PrimaryKey() != k {
-                                // update slice map key without changing order
-                                o.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `"].(query.AutoPrimaryKey)
+	o._originalPK = o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `.Delete(k)
-                                o.`); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Field); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-					return
-				}
+		if _, err = io.WriteString(_w, `
+    o.`); err != nil {
+			return
+		}
 
-				if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
-                            }
-                        }
-                    }
+		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `IsLoaded = true
 `); err != nil {
-					return
-				}
+			return
+		}
 
-				if _, err = io.WriteString(_w, `        `); err != nil {
-					return
-				}
+	} else {
 
-			}
+		if _, err = io.WriteString(_w, `	o._originalPK = o.PrimaryKey()
+`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `
-    `); err != nil {
-				return
-			}
+	}
 
-		}
+	if len(table.GeneratedColumns()) > 0 {
 
-		if _, err = io.WriteString(_w, `
-        } else {
-    `); err != nil {
+		if _, err = io.WriteString(_w, `    if err2 := o.loadGeneratedColumns(ctx); err2 != nil {
+        return err2
+    }
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `
+	}
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
+
+	//*** insert_rev.tmpl
+
+	if _, err = io.WriteString(_w, `
 `); err != nil {
+		return
+	}
+
+	for _, rev := range table.ReverseReferences {
+
+		if _, err = io.WriteString(_w, `    `); err != nil {
 			return
 		}
 
-		//*** update_rev_save.tmpl
-
 		if rev.IsUnique {
 
-			if _, err = io.WriteString(_w, `            // save related object in case internal values changed
-            if o.`); err != nil {
+			if _, err = io.WriteString(_w, `
+
+    if o.`); err != nil {
 				return
 			}
 
@@ -9330,7 +17262,7 @@ func (o *`); err != nil {
 			}
 
 			if _, err = io.WriteString(_w, ` != nil {
-                if err := o.`); err != nil {
+        o.`); err != nil {
 				return
 			}
 
@@ -9338,199 +17270,167 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
-                    return err
-                }
-            }
-`); err != nil {
+			if _, err = io.WriteString(_w, `.Set`); err != nil {
 				return
 			}
 
-		} else {
-
-			if _, err = io.WriteString(_w, `            // save related objects in case internal values changed
-            for obj := range o.`); err != nil {
+			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, `(o.PrimaryKey())
+        if err = o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.ValuesIter() {
-                if err := obj.Save(ctx); err != nil {
-                    return err
-                }
-            }
-`); err != nil {
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
 				return
 			}
 
-		}
-
-		if _, err = io.WriteString(_w, `        }
-`); err != nil {
-			return
-		}
-
-	}
+			if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
+            return err
+        }
+    }
 
-	//*** update_mm.tmpl
+    `); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
+		} else {
 
-	for _, mm := range table.ManyManyReferences {
+			if _, err = io.WriteString(_w, ` `); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `    `); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `
 
-		if _, err = io.WriteString(_w, `
-`); err != nil {
-			return
-		}
+    if o.`); err != nil {
+				return
+			}
 
-		//*** update_mm_col.tmpl
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `    {
+			if _, err = io.WriteString(_w, `.Len() > 0 {
         keys := o.`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `.Keys() // Make a copy of the keys, since we will change the slicemap while iterating
+			if _, err = io.WriteString(_w, `.Keys()
         for i, k := range keys {
             obj := o.`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `.Get(k)
-            if err := obj.Save(ctx); err != nil {
+			if _, err = io.WriteString(_w, `.Get(k)
+            obj.Set`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(o.PrimaryKey())
+            if err = obj.Save(ctx); err != nil {
                 return err
             }
             if obj.PrimaryKey() != k {
-                // update key in the slice map without changing the order
                 o.`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `.Delete(k)
+			if _, err = io.WriteString(_w, `.Delete(k)
                 o.`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
+			if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
             }
         }
-        if o.`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `IsDirty {
-            if (len(o.`); err != nil {
-			return
-		}
+    }
+    `); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
-			return
 		}
 
-		if _, err = io.WriteString(_w, `) != 0) {
-                if err := db.AssociateOnly(ctx,
-                        d,
-                        "`); err != nil {
-			return
-		}
+		if _, err = io.WriteString(_w, `
 
-		if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `",
-                        "`); err != nil {
-			return
-		}
+	}
 
-		if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `",
-                        o.PrimaryKey(),
-                        "`); err != nil {
-			return
-		}
+	//*** insert_mm.tmpl
 
-		if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
-			return
-		}
+	for _, mm := range table.ManyManyReferences {
 
-		if _, err = io.WriteString(_w, `",
-                        o.`); err != nil {
+		if _, err = io.WriteString(_w, `    if o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `); err != nil {
-                    return err
-                }
-            } else {
-                if err := db.AssociateOnly(ctx,
-                        d,
-                        "`); err != nil {
+		if _, err = io.WriteString(_w, `.Len() > 0 {
+        keys := o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `",
-                        "`); err != nil {
+		if _, err = io.WriteString(_w, `.Keys()
+        for i, k := range keys {
+            obj := o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `",
-                        o.PrimaryKey(),
-                        "`); err != nil {
+		if _, err = io.WriteString(_w, `.Get(k)
+            if err = obj.Save(ctx); err != nil {
+                return err
+            }
+            if k != obj.PrimaryKey() {
+                o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `",
-                        o.`); err != nil {
+		if _, err = io.WriteString(_w, `.Delete(k)
+                o.`); err != nil {
 			return
 		}
 
@@ -9538,432 +17438,416 @@ func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.Keys()); err != nil {
-                    return err
-                }
-            }
-        }
-    }
+		if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
 `); err != nil {
 			return
 		}
 
-	}
+		if mm.HasColumns() {
 
-	if _, err = io.WriteString(_w, `
-        return nil
-    }) // transaction
-    if err != nil {
-        return err
-    }
+			if _, err = io.WriteString(_w, `                o.rekey`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkIdentifierPlural()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(k, obj.PrimaryKey())
 `); err != nil {
-		return
-	}
+				return
+			}
 
-	for _, col := range table.Columns {
+		}
 
-		if col.SchemaSubType == schema.ColSubTypeTimestamp ||
-			col.ReceiverType == query.ColTypeTime && col.DefaultValue == model.ModifiedTime {
+		if _, err = io.WriteString(_w, `            }
+`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `    // update generated time value
-    if t, ok := modifiedFields["`); err != nil {
+		if mm.IsOtherOrdered() {
+
+			if _, err = io.WriteString(_w, `            db.AssociateAppending(ctx,
+                d,
+                "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `"]; ok {
-        o.`); err != nil {
+			if _, err = io.WriteString(_w, `",
+                "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
+			if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` = t.(`); err != nil {
+			if _, err = io.WriteString(_w, `",
+                o.PrimaryKey(),
+                "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Type); err != nil {
+			if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `)
-        o.`); err != nil {
+			if _, err = io.WriteString(_w, `",
+                obj.PrimaryKey(),
+                "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
+			if _, err = io.WriteString(_w, mm.MM.OrderColumn); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `IsLoaded = true
-    }
+			if _, err = io.WriteString(_w, `",
 `); err != nil {
 				return
 			}
 
-		} else if col.SchemaSubType == schema.ColSubTypeLock {
+			if mm.HasColumns() {
+
+				if _, err = io.WriteString(_w, `                o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `AssnValue(i, obj.PrimaryKey()),
+`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `    // update generated lock value
-    if l, ok := modifiedFields["`); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+			if _, err = io.WriteString(_w, `            )
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `"]; ok {
-        o.`); err != nil {
+		} else {
+
+			if _, err = io.WriteString(_w, `            db.Associate(ctx,
+                d,
+                "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` = l.(int64)
-        o.`); err != nil {
+			if _, err = io.WriteString(_w, `",
+                "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
+			if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `IsLoaded = true
-    }
-`); err != nil {
+			if _, err = io.WriteString(_w, `",
+                o.PrimaryKey(),
+                "`); err != nil {
 				return
 			}
 
-		}
-
-	}
-
-	if _, err = io.WriteString(_w, `
-	o.resetDirtyStatus()
-	if len(modifiedFields) != 0 {
-        broadcast.Update(ctx, "`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, table.DbKey); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `", "`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, table.QueryName); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `", o._originalPK, anyutil.SortedKeys(modifiedFields)...)
-	}
-
-	return nil
-}
-
-`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
-
-	//*** insert.tmpl
-
-	if _, err = io.WriteString(_w, `// insert will insert the object into the database. Related items will be saved.
-func (o *`); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
+			if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+				return
+			}
 
-	if _, err = io.WriteString(_w, `Base) insert(ctx context.Context) (err error) {
-    var insertFields map[string]interface{}
-    d := Database()
+			if _, err = io.WriteString(_w, `",
+                obj.PrimaryKey(),
 `); err != nil {
-		return
-	}
-
-	if table.WriteTimeout != 0 {
-
-		if _, err = io.WriteString(_w, `
-    var cancel context.CancelFunc
-    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, table.WriteTimeoutConst()); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, `)
-    defer cancel()
+			if mm.HasColumns() || mm.IsOrdered() {
 
-`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, `                o.`); err != nil {
+					return
+				}
 
-	}
+				if _, err = io.WriteString(_w, mm.Field); err != nil {
+					return
+				}
 
-	if _, err = io.WriteString(_w, `	err = db.WithTransaction(ctx, d, func(ctx context.Context) error {
+				if _, err = io.WriteString(_w, `AssnValue(i, obj.PrimaryKey()),
 `); err != nil {
-		return
-	}
+					return
+				}
 
-	//*** update_ref.tmpl
+			}
 
-	for _, ref := range table.References {
+			if _, err = io.WriteString(_w, `            )
+`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `    // Save loaded `); err != nil {
-			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+		if _, err = io.WriteString(_w, `        }
+    } else if len(o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` object to get its new pk and update it here.
-    if o.`); err != nil {
+		if _, err = io.WriteString(_w, mm.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
+		if _, err = io.WriteString(_w, `Pks) > 0 {
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` != nil {
-        if err := o.`); err != nil {
-			return
-		}
+		if mm.HasColumns() || mm.IsOrdered() {
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `        for i, k := range o.`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
-            return err
-        }
-        o.Set`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Pks {
+`); err != nil {
+				return
+			}
+
+		} else {
+
+			if _, err = io.WriteString(_w, `        for _,k := range o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `Pks {
+`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, ref.ForeignKey.Identifier); err != nil {
-			return
 		}
 
-		if _, err = io.WriteString(_w, `(o.`); err != nil {
+		if _, err = io.WriteString(_w, `            obj, err2 := Load`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ref.Field); err != nil {
+		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.PrimaryKey())
-    }
+		if _, err = io.WriteString(_w, `(ctx, k)
+            if err2 != nil {
+                return err2
+            }
+            if (obj != nil) {
 `); err != nil {
 			return
 		}
 
-	}
-
-	for _, col := range table.SettableColumns() {
-
-		if !col.IsAutoPK() && !col.IsNullable {
+		if mm.IsOtherOrdered() {
 
-			if _, err = io.WriteString(_w, `    if !o.`); err != nil {
+			if _, err = io.WriteString(_w, `                db.AssociateAppending(ctx,
+                    d,
+                    "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `IsLoaded {
-        panic("a value for `); err != nil {
+			if _, err = io.WriteString(_w, `",
+                    "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` is required, and there is no default value. Call Set`); err != nil {
+			if _, err = io.WriteString(_w, `",
+                    o.PrimaryKey(),
+                    "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `() before inserting the record.")
-    }
-`); err != nil {
+			if _, err = io.WriteString(_w, `",
+                    k,
+                    "`); err != nil {
 				return
 			}
 
-		}
-
-	}
-
-	//*** unique_check.tmpl
-
-	for _, idx := range table.Indexes {
-
-		if idx.IsUnique && len(idx.Columns) > 1 {
+			if _, err = io.WriteString(_w, mm.MM.OrderColumn); err != nil {
+				return
+			}
 
-			if _, err = io.WriteString(_w, `    // Check mult-column unique index
-    if (`); err != nil {
+			if _, err = io.WriteString(_w, `",
+`); err != nil {
 				return
 			}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
+			if mm.HasColumns() {
 
-				if _, err = io.WriteString(_w, `o.`); err != nil {
+				if _, err = io.WriteString(_w, `                    o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
+				if _, err = io.WriteString(_w, mm.Field); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `IsDirty`); err != nil {
+				if _, err = io.WriteString(_w, `AssnValue(i, k),
+`); err != nil {
 					return
 				}
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, " || "); err != nil {
-						return
-					}
-				}
 			}
-			if _, err = io.WriteString(_w, `) `); err != nil {
+
+			if _, err = io.WriteString(_w, `                )
+`); err != nil {
 				return
 			}
 
-			for _, col := range idx.Columns {
-
-				if col.IsNullable {
-
-					if _, err = io.WriteString(_w, ` && !o.`); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, col.Field); err != nil {
-						return
-					}
-
-					if _, err = io.WriteString(_w, `IsNull`); err != nil {
-						return
-					}
-
-				}
+		} else {
 
+			if _, err = io.WriteString(_w, `                db.Associate(ctx,
+                    d,
+                    "`); err != nil {
+				return
 			}
 
-			if _, err = io.WriteString(_w, ` {
-        if obj, err := Load`); err != nil {
+			if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `",
+                    "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `By`); err != nil {
+			if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
 				return
 			}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
-
-				if _, err = io.WriteString(_w, _j.Identifier); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `",
+                    o.PrimaryKey(),
+                    "`); err != nil {
+				return
+			}
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ""); err != nil {
-						return
-					}
-				}
+			if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+				return
 			}
-			if _, err = io.WriteString(_w, `(ctx, `); err != nil {
+
+			if _, err = io.WriteString(_w, `",
+                    k,
+`); err != nil {
 				return
 			}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
+			if mm.HasColumns() || mm.IsOrdered() {
 
-				if _, err = io.WriteString(_w, `o.`); err != nil {
+				if _, err = io.WriteString(_w, `                    o.`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
+				if _, err = io.WriteString(_w, mm.Field); err != nil {
 					return
 				}
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ", "); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, `AssnValue(i, k),
+`); err != nil {
+					return
 				}
+
 			}
-			if _, err = io.WriteString(_w, `); err != nil {
-            return err
-        } else if obj != nil {
-            return db.NewUniqueValueError("`); err != nil {
+
+			if _, err = io.WriteString(_w, `                )
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.QueryName); err != nil {
+		}
+
+		if _, err = io.WriteString(_w, `            }
+        }
+    }
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
+        return nil
+
+    }) // transaction
+
+    if err != nil {
+        return
+    }
+`); err != nil {
+		return
+	}
+
+	for _, col := range table.Columns {
+
+		if col.ReceiverType == query.ColTypeTime && (col.DefaultValue == model.CreatedTime || col.DefaultValue == model.ModifiedTime) ||
+			col.SchemaSubType == schema.ColSubTypeTimestamp ||
+			col.SchemaSubType == schema.ColSubTypeLock {
+
+			if _, err = io.WriteString(_w, `    if t,ok := insertFields["`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `", map[string]any{ `); err != nil {
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
 				return
 			}
 
-			for _i, _j := range idx.Columns {
-				_ = _j
-
-				if _, err = io.WriteString(_w, `"`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, `"]; ok {
+        o.`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, _j.QueryName); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, `":o.`); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, ` = t.(`); err != nil {
+				return
+			}
 
-				if _, err = io.WriteString(_w, _j.Field); err != nil {
-					return
-				}
+			if _, err = io.WriteString(_w, col.Type); err != nil {
+				return
+			}
 
-				if _i < len(idx.Columns)-1 {
-					if _, err = io.WriteString(_w, ","); err != nil {
-						return
-					}
-				}
+			if _, err = io.WriteString(_w, `)
+        o.`); err != nil {
+				return
 			}
-			if _, err = io.WriteString(_w, ` }, nil)
-        }
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsLoaded = true
     }
 `); err != nil {
 				return
@@ -9973,16 +17857,18 @@ func (o *`); err != nil {
 
 	}
 
-	if _, err = io.WriteString(_w, `    insertFields = get`); err != nil {
+	if _, err = io.WriteString(_w, `
+	o.resetDirtyStatus()
+	o._restored = true
+	broadcast.Insert(ctx, "`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
+	if _, err = io.WriteString(_w, table.DbKey); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `InsertFields(o)
-	err = d.Insert(ctx, "`); err != nil {
+	if _, err = io.WriteString(_w, `", "`); err != nil {
 		return
 	}
 
@@ -9990,432 +17876,470 @@ func (o *`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `", insertFields, `); err != nil {
+	if _, err = io.WriteString(_w, `", o.PrimaryKey())
+	return
+}
+
+`); err != nil {
 		return
 	}
 
-	if table.HasAutoPK() {
-
-		if _, err = io.WriteString(_w, `"`); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
-			return
-		}
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
 
-		if _, err = io.WriteString(_w, `"`); err != nil {
-			return
-		}
+	//*** get_update_fields_func.tmpl
 
-	} else {
+	var hasTimestamp bool
 
-		if _, err = io.WriteString(_w, `""`); err != nil {
-			return
+	for _, col := range table.AllColumns() {
+		if col.ReceiverType == query.ColTypeTime && col.DefaultValue == model.ModifiedTime ||
+			col.SchemaSubType == schema.ColSubTypeTimestamp {
+			hasTimestamp = true
 		}
+	}
 
+	if _, err = io.WriteString(_w, `// getUpdateFields returns the database columns that will be sent to the update process.
+// This will include timestamp fields only if some other column has changed.
+func (o *`); err != nil {
+		return
 	}
 
-	if _, err = io.WriteString(_w, `)
-    if err != nil {
-        return err
-    }
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Base) getUpdateFields() (fields map[string]interface{}) {
+	fields = map[string]interface{}{}
 `); err != nil {
 		return
 	}
 
-	if table.HasAutoPK() {
+	for _, col := range table.SettableColumns() {
 
-		if _, err = io.WriteString(_w, `	o.`); err != nil {
+		if _, err = io.WriteString(_w, `	if o.`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Field); err != nil {
+		if _, err = io.WriteString(_w, col.Field); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, ` = insertFields["`); err != nil {
+		if _, err = io.WriteString(_w, `IsDirty {
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
-			return
-		}
+		if col.IsNullable {
 
-		if _, err = io.WriteString(_w, `"].(query.AutoPrimaryKey)
-	o._originalPK = o.`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `        if 	o.`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Field); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsNull {
+            fields["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"] = nil
+        } else {
+  		    fields["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"] = `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.StoredValueGen("o."+col.Field)); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+        }
+`); err != nil {
+				return
+			}
+
+		} else {
+
+			if _, err = io.WriteString(_w, `        fields["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"] = `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.StoredValueGen("o."+col.Field)); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `
-    o.`); err != nil {
-			return
 		}
 
-		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().Field); err != nil {
+		if _, err = io.WriteString(_w, `	}
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `IsLoaded = true
+	}
+
+	if hasTimestamp {
+
+		if _, err = io.WriteString(_w, `    if len(fields) > 0 {
 `); err != nil {
 			return
 		}
 
-	} else {
+		for _, col := range table.Columns {
 
-		if _, err = io.WriteString(_w, `	o._originalPK = o.PrimaryKey()
+			if col.ReceiverType == query.ColTypeTime && col.DefaultValue == model.ModifiedTime {
+
+				if _, err = io.WriteString(_w, `        fields["`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.QueryName); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"] = time.Now().UTC()
+`); err != nil {
+					return
+				}
+
+			} else if col.SchemaSubType == schema.ColSubTypeTimestamp {
+
+				if _, err = io.WriteString(_w, `        fields["`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.QueryName); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"] = time.Now().UnixMicro()
+`); err != nil {
+					return
+				}
+
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `    }
 `); err != nil {
 			return
 		}
 
 	}
 
-	if _, err = io.WriteString(_w, `
+	if _, err = io.WriteString(_w, `	return
+}
+
 `); err != nil {
 		return
 	}
 
-	//*** insert_rev.tmpl
+	//*** get_insert_fields_func.tmpl
 
-	if _, err = io.WriteString(_w, `
+	if _, err = io.WriteString(_w, `// getInsertFields returns the fields that will be specified in an insert operation.
+// Optional fields that have not been set and have no default will be returned as nil.
+// NoSql databases should interpret this as no value. Sql databases should interpret this as
+// explicitly setting a NULL value, which would override any database specific default value.
+// Auto-generated fields will be returned here with their generated values, except AutoPK fields, which are returned
+// as a new AutoPrimaryKey to indicate that the driver will fill this in after the insert.
+func (o *`); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(_w, `Base) getInsertFields() (fields map[string]interface{}) {
+	fields = map[string]interface{}{}
 `); err != nil {
 		return
 	}
 
-	for _, rev := range table.ReverseReferences {
+	for _, col := range table.AllColumns() {
 
-		if _, err = io.WriteString(_w, `    `); err != nil {
-			return
-		}
+		if col.IsGenerated {
 
-		if rev.IsUnique {
+		} else if col.IsAutoPK() {
+
+			if _, err = io.WriteString(_w, `    if o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `IsDirty {
+        fields["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"] = o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Field); err != nil {
+				return
+			}
 
 			if _, err = io.WriteString(_w, `
+    }
+`); err != nil {
+				return
+			}
 
-    if o.`); err != nil {
+		} else if col.ReceiverType == query.ColTypeTime && (col.DefaultValue == model.CreatedTime || col.DefaultValue == model.ModifiedTime) {
+
+			if _, err = io.WriteString(_w, `    fields["`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` != nil {
-        o.`); err != nil {
+			if _, err = io.WriteString(_w, `"] = time.Now().UTC()
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+		} else if col.SchemaSubType == schema.ColSubTypeTimestamp {
+
+			if _, err = io.WriteString(_w, `    fields["`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Set`); err != nil {
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `"] = time.Now().UnixMicro()
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(o.PrimaryKey())
-        if err = o.`); err != nil {
+		} else if col.SchemaSubType == schema.ColSubTypeLock {
+
+			if _, err = io.WriteString(_w, `    fields["`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Save(ctx); err != nil {
-            return err
-        }
-    }
-
-    `); err != nil {
+			if _, err = io.WriteString(_w, `"] = db.RecordVersion(0)
+`); err != nil {
 				return
 			}
 
-		} else {
+		} else if col.IsNullable {
 
-			if _, err = io.WriteString(_w, ` `); err != nil {
+			if _, err = io.WriteString(_w, `    if o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `
-
-    if o.`); err != nil {
+			if _, err = io.WriteString(_w, col.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, `IsNull {
+        fields["`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Len() > 0 {
-        keys := o.`); err != nil {
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, `"] = nil
+    } else {
+        fields["`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Keys()
-        for i, k := range keys {
-            obj := o.`); err != nil {
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, `"] = `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Get(k)
-            obj.Set`); err != nil {
+			if _, err = io.WriteString(_w, col.StoredValueGen("o."+col.Field)); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ForeignKey.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `
+    }
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `(o.PrimaryKey())
-            if err = obj.Save(ctx); err != nil {
-                return err
-            }
-            if obj.PrimaryKey() != k {
-                o.`); err != nil {
+		} else {
+
+			if _, err = io.WriteString(_w, ` `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, `
+    fields["`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Delete(k)
-                o.`); err != nil {
+			if _, err = io.WriteString(_w, col.QueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+			if _, err = io.WriteString(_w, `"] = `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
-            }
-        }
-    }
-    `); err != nil {
+			if _, err = io.WriteString(_w, col.StoredValueGen("o."+col.Field)); err != nil {
 				return
 			}
 
-		}
-
-		if _, err = io.WriteString(_w, `
-
+			if _, err = io.WriteString(_w, `
 `); err != nil {
-			return
+				return
+			}
+
 		}
 
 	}
 
-	if _, err = io.WriteString(_w, `
+	if _, err = io.WriteString(_w, `	return
+}
+
 `); err != nil {
 		return
 	}
 
-	//*** insert_mm.tmpl
-
-	for _, mm := range table.ManyManyReferences {
-
-		if _, err = io.WriteString(_w, `    if o.`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `.Len() > 0 {
-        keys := o.`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `.Keys()
-        for i, k := range keys {
-            obj := o.`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `.Get(k)
-            if err = obj.Save(ctx); err != nil {
-                return err
-            }
-            if k != obj.PrimaryKey() {
-                o.`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `.Delete(k)
-                o.`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `.SetAt(i, obj.PrimaryKey(), obj)
-            }
-            db.Associate(ctx,
-                d,
-                "`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `",
-                "`); err != nil {
-			return
-		}
+	//*** load_generated.tmpl
 
-		if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
-			return
-		}
+	if len(table.GeneratedColumns()) > 0 {
 
-		if _, err = io.WriteString(_w, `",
-                o.PrimaryKey(),
-                "`); err != nil {
+		if _, err = io.WriteString(_w, `// loadGeneratedColumns reads the values that the database computed for the generated columns
+// after an insert or update.
+func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `",
-                obj.PrimaryKey(),
-            )
-        }
-    } else if len(o.`); err != nil {
+		if _, err = io.WriteString(_w, `Base) loadGeneratedColumns(ctx context.Context) error {
+    obj, err := Load`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `Pks) > 0 {
-        for _,k := range o.`); err != nil {
+		if _, err = io.WriteString(_w, `(ctx, o.PrimaryKey(), `); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.Field); err != nil {
-			return
-		}
+		for _i, _j := range table.GeneratedColumns() {
+			_ = _j
 
-		if _, err = io.WriteString(_w, `Pks {
-            obj, err2 := Load`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `node.`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `(ctx, k)
-            if err2 != nil {
-                return err2
-            }
-            if (obj != nil) {
-                db.Associate(ctx,
-                    d,
-                    "`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `().`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.TableQueryName); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, _j.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `",
-                    "`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `()`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.SourceColumnName()); err != nil {
-			return
+			if _i < len(table.GeneratedColumns())-1 {
+				if _, err = io.WriteString(_w, ", "); err != nil {
+					return
+				}
+			}
 		}
-
-		if _, err = io.WriteString(_w, `",
-                    o.PrimaryKey(),
-                    "`); err != nil {
+		if _, err = io.WriteString(_w, `)
+    if err != nil {
+        return err
+    }
+    if obj == nil {
+        return db.NewRecordNotFoundError("`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, mm.ForeignKeyName); err != nil {
+		if _, err = io.WriteString(_w, table.QueryName); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `",
-                    k,
-                )
-            }
-        }
+		if _, err = io.WriteString(_w, `", o.PrimaryKey())
     }
 `); err != nil {
 			return
 		}
 
-	}
-
-	if _, err = io.WriteString(_w, `
-        return nil
-
-    }) // transaction
-
-    if err != nil {
-        return
-    }
-`); err != nil {
-		return
-	}
-
-	for _, col := range table.Columns {
-
-		if col.ReceiverType == query.ColTypeTime && (col.DefaultValue == model.CreatedTime || col.DefaultValue == model.ModifiedTime) ||
-			col.SchemaSubType == schema.ColSubTypeTimestamp ||
-			col.SchemaSubType == schema.ColSubTypeLock {
+		for _, col := range table.GeneratedColumns() {
 
-			if _, err = io.WriteString(_w, `    if t,ok := insertFields["`); err != nil {
+			if _, err = io.WriteString(_w, `    o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+			if _, err = io.WriteString(_w, col.Field); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `"]; ok {
-        o.`); err != nil {
+			if _, err = io.WriteString(_w, ` = obj.`); err != nil {
 				return
 			}
 
@@ -10423,16 +18347,37 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, ` = t.(`); err != nil {
+			if _, err = io.WriteString(_w, `
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Type); err != nil {
-				return
+			if col.IsNullable {
+
+				if _, err = io.WriteString(_w, `    o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `IsNull = obj.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `IsNull
+`); err != nil {
+					return
+				}
+
 			}
 
-			if _, err = io.WriteString(_w, `)
-        o.`); err != nil {
+			if _, err = io.WriteString(_w, `    o.`); err != nil {
 				return
 			}
 
@@ -10441,204 +18386,261 @@ func (o *`); err != nil {
 			}
 
 			if _, err = io.WriteString(_w, `IsLoaded = true
-    }
 `); err != nil {
 				return
 			}
 
 		}
 
-	}
-
-	if _, err = io.WriteString(_w, `
-	o.resetDirtyStatus()
-	o._restored = true
-	broadcast.Insert(ctx, "`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, `    return nil
+}
 
-	if _, err = io.WriteString(_w, table.DbKey); err != nil {
-		return
-	}
+`); err != nil {
+			return
+		}
 
-	if _, err = io.WriteString(_w, `", "`); err != nil {
-		return
 	}
 
-	if _, err = io.WriteString(_w, table.QueryName); err != nil {
+	if _, err = io.WriteString(_w, `
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `", o.PrimaryKey())
 	return
 }
 
+func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err error) {
+
+	//*** delete.tmpl
+
+	if _, err = io.WriteString(_w, `// Delete deletes the record from the database.
 `); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `
+	if len(table.ReverseReferences) > 0 || len(table.ReversePolymorphicReferences) > 0 {
+
+		if _, err = io.WriteString(_w, `//
 `); err != nil {
-		return
-	}
+			return
+		}
 
-	if _, err = io.WriteString(_w, `
+		for _, rev := range table.ReverseReferences {
+
+			if rev.IsUnique {
+
+				if rev.OnDelete == schema.OnDeleteCascade {
+
+					if _, err = io.WriteString(_w, `// An associated `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` will also be deleted.
 `); err != nil {
-		return
-	}
+						return
+					}
 
-	//*** get_update_fields_func.tmpl
+				} else if rev.OnDelete == schema.OnDeleteSetNull {
 
-	var hasTimestamp bool
+					if _, err = io.WriteString(_w, `// An associated `); err != nil {
+						return
+					}
 
-	for _, col := range table.AllColumns() {
-		if col.ReceiverType == query.ColTypeTime && col.DefaultValue == model.ModifiedTime ||
-			col.SchemaSubType == schema.ColSubTypeTimestamp {
-			hasTimestamp = true
-		}
-	}
+					if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+						return
+					}
 
-	if _, err = io.WriteString(_w, `// getUpdateFields returns the database columns that will be sent to the update process.
-// This will include timestamp fields only if some other column has changed.
-func (o *`); err != nil {
-		return
-	}
+					if _, err = io.WriteString(_w, ` will have its `); err != nil {
+						return
+					}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
+					if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+						return
+					}
 
-	if _, err = io.WriteString(_w, `Base) getUpdateFields() (fields map[string]interface{}) {
-	fields = map[string]interface{}{}
+					if _, err = io.WriteString(_w, ` field set to NULL.
 `); err != nil {
-		return
-	}
+						return
+					}
 
-	for _, col := range table.SettableColumns() {
+				} else if rev.OnDelete == schema.OnDeleteRestrict {
 
-		if _, err = io.WriteString(_w, `	if o.`); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, `// If a `); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, col.Field); err != nil {
-			return
-		}
+					if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+						return
+					}
 
-		if _, err = io.WriteString(_w, `IsDirty {
+					if _, err = io.WriteString(_w, ` refers to the object, nothing is deleted and a *db.ReferenceRestrictedError is returned.
 `); err != nil {
-			return
-		}
+						return
+					}
 
-		if col.IsNullable {
+				} else {
 
-			if _, err = io.WriteString(_w, `        if 	o.`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `// An associated `); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `IsNull {
-            fields["`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, ` is not changed, and the database may return an error if there is one.
+`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
-				return
-			}
+				}
 
-			if _, err = io.WriteString(_w, `"] = nil
-        } else {
-  		    fields["`); err != nil {
-				return
-			}
+			} else {
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
-				return
-			}
+				if rev.OnDelete == schema.OnDeleteCascade {
 
-			if _, err = io.WriteString(_w, `"] = o.`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `// Associated `); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `
-        }
+					if _, err = io.WriteString(_w, ` will also be deleted.
 `); err != nil {
-				return
-			}
+						return
+					}
 
-		} else {
+				} else if rev.OnDelete == schema.OnDeleteSetNull {
 
-			if _, err = io.WriteString(_w, `        fields["`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `// Associated `); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `"] = o.`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, ` will have their `); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `
+					if _, err = io.WriteString(_w, ` field set to NULL.
 `); err != nil {
-				return
+						return
+					}
+
+				} else if rev.OnDelete == schema.OnDeleteRestrict {
+
+					if _, err = io.WriteString(_w, `// If any `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` refer to the object, nothing is deleted and a *db.ReferenceRestrictedError is returned.
+`); err != nil {
+						return
+					}
+
+				} else {
+
+					if _, err = io.WriteString(_w, `// Associated `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` are not changed, and the database may return an error if there are any.
+`); err != nil {
+						return
+					}
+
+				}
+
 			}
 
 		}
 
-		if _, err = io.WriteString(_w, `	}
+		for _, poly := range table.ReversePolymorphicReferences {
+
+			if poly.OnDelete == schema.OnDeleteCascade {
+
+				if _, err = io.WriteString(_w, `// Associated `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` will also be deleted.
 `); err != nil {
-			return
-		}
+					return
+				}
+
+			} else if poly.OnDelete == schema.OnDeleteSetNull {
+
+				if _, err = io.WriteString(_w, `// Associated `); err != nil {
+					return
+				}
 
-	}
+				if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+					return
+				}
 
-	if hasTimestamp {
+				if _, err = io.WriteString(_w, ` will have their `); err != nil {
+					return
+				}
 
-		if _, err = io.WriteString(_w, `    if len(fields) > 0 {
-`); err != nil {
-			return
-		}
+				if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+					return
+				}
 
-		for _, col := range table.Columns {
+				if _, err = io.WriteString(_w, ` set to NULL.
+`); err != nil {
+					return
+				}
 
-			if col.ReceiverType == query.ColTypeTime && col.DefaultValue == model.ModifiedTime {
+			} else if poly.OnDelete == schema.OnDeleteRestrict {
 
-				if _, err = io.WriteString(_w, `        fields["`); err != nil {
+				if _, err = io.WriteString(_w, `// If any `); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `"] = time.Now().UTC()
+				if _, err = io.WriteString(_w, ` refer to the object, nothing is deleted and a *db.ReferenceRestrictedError is returned.
 `); err != nil {
 					return
 				}
 
-			} else if col.SchemaSubType == schema.ColSubTypeTimestamp {
+			} else {
 
-				if _, err = io.WriteString(_w, `        fields["`); err != nil {
+				if _, err = io.WriteString(_w, `// Associated `); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.QueryName); err != nil {
+				if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `"] = time.Now().UnixMicro()
+				if _, err = io.WriteString(_w, ` are not changed.
 `); err != nil {
 					return
 				}
@@ -10647,29 +18649,53 @@ func (o *`); err != nil {
 
 		}
 
-		if _, err = io.WriteString(_w, `    }
+	}
+
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `//
+// The `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` base object is deleted too.
 `); err != nil {
 			return
 		}
 
 	}
 
-	if _, err = io.WriteString(_w, `	return
-}
+	for _, sub := range table.SubTypes {
+
+		if _, err = io.WriteString(_w, `//
+// If the object is extended by a `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `, the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
 
+		if _, err = io.WriteString(_w, ` is deleted instead, which also deletes this object.
 `); err != nil {
-		return
-	}
+			return
+		}
 
-	//*** get_insert_fields_func.tmpl
+	}
 
-	if _, err = io.WriteString(_w, `// getInsertFields returns the fields that will be specified in an insert operation.
-// Optional fields that have not been set and have no default will be returned as nil.
-// NoSql databases should interpret this as no value. Sql databases should interpret this as
-// explicitly setting a NULL value, which would override any database specific default value.
-// Auto-generated fields will be returned here with their generated values, except AutoPK fields, which are returned
-// as a new AutoPrimaryKey to indicate that the driver will fill this in after the insert.
-func (o *`); err != nil {
+	if _, err = io.WriteString(_w, `func (o *`); err != nil {
 		return
 	}
 
@@ -10677,80 +18703,77 @@ func (o *`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, `Base) getInsertFields() (fields map[string]interface{}) {
-	fields = map[string]interface{}{}
+	if _, err = io.WriteString(_w, `Base) Delete(ctx context.Context) (err error) {
+    if o == nil {
+        return // allow deleting of a nil object to be a noop
+    }
+	if !o._restored {
+		panic ("Cannot delete a record that has no primary key value.")
+	}
+	d := Database()
 `); err != nil {
 		return
 	}
 
-	for _, col := range table.AllColumns() {
-
-		if col.IsAutoPK() {
+	for _, sub := range table.SubTypes {
 
-			if _, err = io.WriteString(_w, `    if o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `    if obj, err2 := Query`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, sub.Table.IdentifierPlural); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `IsDirty {
-        fields["`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `(ctx).
+            Where(op.Equal(node.`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, sub.Table.Identifier); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `"] = o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `().`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, sub.ForeignKey.Identifier); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `
+		if _, err = io.WriteString(_w, `(), o._originalPK)).
+            Get(); err2 != nil {
+        return err2
+    } else if obj != nil {
+        return obj.Delete(ctx)
     }
 `); err != nil {
-				return
-			}
-
-		} else if col.ReceiverType == query.ColTypeTime && (col.DefaultValue == model.CreatedTime || col.DefaultValue == model.ModifiedTime) {
-
-			if _, err = io.WriteString(_w, `    fields["`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
-				return
-			}
+			return
+		}
 
-			if _, err = io.WriteString(_w, `"] = time.Now().UTC()
-`); err != nil {
-				return
-			}
+	}
 
-		} else if col.SchemaSubType == schema.ColSubTypeTimestamp {
+	if len(table.ReverseReferences) == 0 && len(table.ReversePolymorphicReferences) == 0 && len(table.ManyManyReferences) == 0 &&
+		table.BaseReference == nil && len(table.SubTypes) == 0 {
 
-			if _, err = io.WriteString(_w, `    fields["`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `    err = d.Delete(ctx, "`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, fmt.Sprint(table.QueryName)); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `"] = time.Now().UnixMicro()
+		if _, err = io.WriteString(_w, `",
+        map[string]any {
 `); err != nil {
-				return
-			}
+			return
+		}
 
-		} else if col.SchemaSubType == schema.ColSubTypeLock {
+		for _, col := range table.PrimaryKeyColumns() {
 
-			if _, err = io.WriteString(_w, `    fields["`); err != nil {
+			if _, err = io.WriteString(_w, `            "`); err != nil {
 				return
 			}
 
@@ -10758,132 +18781,136 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `"] = db.RecordVersion(0)
-`); err != nil {
+			if _, err = io.WriteString(_w, `": o._originalPK`); err != nil {
 				return
 			}
 
-		} else if col.IsNullable {
+			if len(table.PrimaryKeyColumns()) > 1 {
 
-			if _, err = io.WriteString(_w, `    if o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `IsNull {
-        fields["`); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+			if _, err = io.WriteString(_w, `,
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `"] = nil
-    } else {
-        fields["`); err != nil {
-				return
-			}
+		}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `        },
+        "`); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `"] = o.`); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, table.LockColumnQueryName()); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, `",
+        `); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `
-    }
-`); err != nil {
+		if s := table.LockColumnIdentifier(); s == "" {
+
+			if _, err = io.WriteString(_w, `0`); err != nil {
 				return
 			}
 
 		} else {
 
-			if _, err = io.WriteString(_w, ` `); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `
-    fields["`); err != nil {
+			if _, err = io.WriteString(_w, `o.`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
+			if _, err = io.WriteString(_w, s); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `"] = o.`); err != nil {
+			if _, err = io.WriteString(_w, `()`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+		}
 
-			if _, err = io.WriteString(_w, `
+		if _, err = io.WriteString(_w, `,
+    )
 `); err != nil {
-				return
-			}
-
+			return
 		}
 
-	}
-
-	if _, err = io.WriteString(_w, `	return
-}
-
-`); err != nil {
-		return
-	}
+	} else {
 
-	if _, err = io.WriteString(_w, `
-`); err != nil {
-		return
-	}
+		if table.WriteTimeout != 0 {
 
-	return
-}
+			if _, err = io.WriteString(_w, `
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
+				return
+			}
 
-func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err error) {
+			if _, err = io.WriteString(_w, table.WriteTimeoutConst()); err != nil {
+				return
+			}
 
-	//*** delete.tmpl
+			if _, err = io.WriteString(_w, `)
+    defer cancel()
 
-	if _, err = io.WriteString(_w, `// Delete deletes the record from the database.
 `); err != nil {
-		return
-	}
+				return
+			}
 
-	if len(table.ReverseReferences) > 0 {
+		}
 
-		if _, err = io.WriteString(_w, `//
+		if _, err = io.WriteString(_w, `    err = db.WithTransaction(ctx, d, func(ctx context.Context) error {
 `); err != nil {
 			return
 		}
 
-		for _, rev := range table.ReverseReferences {
+		if table.HasRestrictedReverseReferences() {
 
-			if rev.IsUnique {
+			if _, err = io.WriteString(_w, `        // Check for objects that prevent the delete before changing anything
+        restricted := make(map[string][]any)
+    `); err != nil {
+				return
+			}
 
-				if rev.IsNullable {
+			for _, rev := range table.ReverseReferences {
 
-					if _, err = io.WriteString(_w, `// An associated `); err != nil {
+				if _, err = io.WriteString(_w, `
+        `); err != nil {
+					return
+				}
+
+				if rev.OnDelete == schema.OnDeleteRestrict {
+
+					if _, err = io.WriteString(_w, `
+            {
+                objs, err := Query`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+					if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` will have its `); err != nil {
+					if _, err = io.WriteString(_w, `(ctx).
+                          Where(op.Equal(node.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `().`); err != nil {
 						return
 					}
 
@@ -10891,219 +18918,220 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` field set to NULL.
+					if _, err = io.WriteString(_w, `().PrimaryKey(), o._originalPK)).
 `); err != nil {
 						return
 					}
 
-				} else {
+					for _, col := range rev.Table.PrimaryKeyColumns() {
 
-					if _, err = io.WriteString(_w, `// An associated `); err != nil {
+						if _, err = io.WriteString(_w, `                          Select(node.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `().`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, col.Identifier); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `()).
+`); err != nil {
+							return
+						}
+
+					}
+
+					if _, err = io.WriteString(_w, `                          Load()
+                if err != nil {
+                    return err
+                }
+                for _,obj := range objs {
+                    restricted["`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `{= rev.ReverseIdentifier `); err != nil {
+					if _, err = io.WriteString(_w, rev.Table.QueryName); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` will also be deleted since its `); err != nil {
+					if _, err = io.WriteString(_w, `"] = append(restricted["`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+					if _, err = io.WriteString(_w, rev.Table.QueryName); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` field is not nullable.
-`); err != nil {
+					if _, err = io.WriteString(_w, `"], obj.PrimaryKey())
+                }
+            }
+        `); err != nil {
 						return
 					}
 
 				}
 
-			} else {
+				if _, err = io.WriteString(_w, `
+    `); err != nil {
+					return
+				}
 
-				if rev.IsNullable {
+			}
 
-					if _, err = io.WriteString(_w, `// Associated `); err != nil {
+			if _, err = io.WriteString(_w, `
+    `); err != nil {
+				return
+			}
+
+			for _, poly := range table.ReversePolymorphicReferences {
+
+				if _, err = io.WriteString(_w, `
+        `); err != nil {
+					return
+				}
+
+				if poly.OnDelete == schema.OnDeleteRestrict {
+
+					if _, err = io.WriteString(_w, `
+            {
+                objs, err := Query`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+					if _, err = io.WriteString(_w, poly.Table.IdentifierPlural); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` will have their `); err != nil {
+					if _, err = io.WriteString(_w, `(ctx).
+                          Where(op.And(
+                              op.Equal(node.`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+					if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` field set to NULL.
-`); err != nil {
+					if _, err = io.WriteString(_w, `().`); err != nil {
 						return
 					}
 
-				} else {
-
-					if _, err = io.WriteString(_w, `// Associated `); err != nil {
+					if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+					if _, err = io.WriteString(_w, `(), "`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` will also be deleted since their `); err != nil {
+					if _, err = io.WriteString(_w, table.QueryName); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+					if _, err = io.WriteString(_w, `"),
+                              op.Equal(node.`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` fields are not nullable.
-`); err != nil {
+					if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
 						return
 					}
 
-				}
-
-			}
-
-		}
+					if _, err = io.WriteString(_w, `().`); err != nil {
+						return
+					}
 
-	}
+					if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
+						return
+					}
 
-	if _, err = io.WriteString(_w, `func (o *`); err != nil {
-		return
-	}
+					if _, err = io.WriteString(_w, `(), o._originalPK),
+                          )).
+`); err != nil {
+						return
+					}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
+					for _, col := range poly.Table.PrimaryKeyColumns() {
 
-	if _, err = io.WriteString(_w, `Base) Delete(ctx context.Context) (err error) {
-    if o == nil {
-        return // allow deleting of a nil object to be a noop
-    }
-	if !o._restored {
-		panic ("Cannot delete a record that has no primary key value.")
-	}
-	d := Database()
-`); err != nil {
-		return
-	}
+						if _, err = io.WriteString(_w, `                          Select(node.`); err != nil {
+							return
+						}
 
-	if len(table.ReverseReferences) == 0 && len(table.ManyManyReferences) == 0 {
+						if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+							return
+						}
 
-		if _, err = io.WriteString(_w, `    err = d.Delete(ctx, "`); err != nil {
-			return
-		}
+						if _, err = io.WriteString(_w, `().`); err != nil {
+							return
+						}
 
-		if _, err = io.WriteString(_w, fmt.Sprint(table.QueryName)); err != nil {
-			return
-		}
+						if _, err = io.WriteString(_w, col.Identifier); err != nil {
+							return
+						}
 
-		if _, err = io.WriteString(_w, `",
-        map[string]any {
+						if _, err = io.WriteString(_w, `()).
 `); err != nil {
-			return
-		}
+							return
+						}
 
-		for _, col := range table.PrimaryKeyColumns() {
+					}
 
-			if _, err = io.WriteString(_w, `            "`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `                          Load()
+                if err != nil {
+                    return err
+                }
+                for _,obj := range objs {
+                    restricted["`); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, col.QueryName); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, poly.Table.QueryName); err != nil {
+						return
+					}
 
-			if _, err = io.WriteString(_w, `": o._originalPK`); err != nil {
-				return
-			}
+					if _, err = io.WriteString(_w, `"] = append(restricted["`); err != nil {
+						return
+					}
 
-			if len(table.PrimaryKeyColumns()) > 1 {
+					if _, err = io.WriteString(_w, poly.Table.QueryName); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `"], obj.PrimaryKey())
+                }
+            }
+        `); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `.`); err != nil {
-					return
 				}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				if _, err = io.WriteString(_w, `
+    `); err != nil {
 					return
 				}
 
-			}
-
-			if _, err = io.WriteString(_w, `,
-`); err != nil {
-				return
-			}
-
-		}
-
-		if _, err = io.WriteString(_w, `        },
-        "`); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, table.LockColumnQueryName()); err != nil {
-			return
-		}
-
-		if _, err = io.WriteString(_w, `",
-        `); err != nil {
-			return
-		}
-
-		if s := table.LockColumnIdentifier(); s == "" {
-
-			if _, err = io.WriteString(_w, `0`); err != nil {
-				return
-			}
-
-		} else {
-
-			if _, err = io.WriteString(_w, `o.`); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, s); err != nil {
-				return
-			}
-
-			if _, err = io.WriteString(_w, `()`); err != nil {
-				return
-			}
-
-		}
-
-		if _, err = io.WriteString(_w, `,
-    )
-`); err != nil {
-			return
-		}
-
-	} else {
-
-		if table.WriteTimeout != 0 {
+			}
 
 			if _, err = io.WriteString(_w, `
-    var cancel context.CancelFunc
-    ctx, cancel = context.WithTimeout(ctx, `); err != nil {
+        if len(restricted) > 0 {
+            return db.NewReferenceRestrictedError("`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, table.WriteTimeoutConst()); err != nil {
+			if _, err = io.WriteString(_w, table.QueryName); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `)
-    defer cancel()
+			if _, err = io.WriteString(_w, `", o._originalPK, restricted)
+        }
 
 `); err != nil {
 				return
@@ -11111,8 +19139,7 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 
 		}
 
-		if _, err = io.WriteString(_w, `    err = db.WithTransaction(ctx, d, func(ctx context.Context) error {
-	`); err != nil {
+		if _, err = io.WriteString(_w, `	`); err != nil {
 			return
 		}
 
@@ -11123,14 +19150,14 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 				return
 			}
 
-			if rev.IsUnique {
+			if rev.OnDelete == schema.OnDeleteSetNull {
 
 				if _, err = io.WriteString(_w, `
             `); err != nil {
 					return
 				}
 
-				if rev.IsNullable {
+				if rev.IsUnique {
 
 					if _, err = io.WriteString(_w, `
             {
@@ -11242,9 +19269,13 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 
 				} else {
 
+					if _, err = io.WriteString(_w, ` `); err != nil {
+						return
+					}
+
 					if _, err = io.WriteString(_w, `
             {
-                 obj, err := Query`); err != nil {
+                objs, err := Query`); err != nil {
 						return
 					}
 
@@ -11253,7 +19284,7 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 					}
 
 					if _, err = io.WriteString(_w, `(ctx).
-                         Where(op.Equal(node.`); err != nil {
+                          Where(op.Equal(node.`); err != nil {
 						return
 					}
 
@@ -11270,17 +19301,71 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 					}
 
 					if _, err = io.WriteString(_w, `().PrimaryKey(), o._originalPK)).
-                         Get()
-                 if err != nil {
-                     return err
-                 }
-                 if obj != nil {
-                     if err = obj.Delete(ctx); err != nil {
-                         return err
-                     }
-                 }
-                 // Set this object's pointer to the reverse object to nil to mark that we broke the link
-                 o.`); err != nil {
+                          Select(node.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `().`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `()).
+`); err != nil {
+						return
+					}
+
+					if rev.Table.LockColumn != nil {
+
+						if _, err = io.WriteString(_w, `                          Select(node.`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `().`); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
+							return
+						}
+
+						if _, err = io.WriteString(_w, `()).
+`); err != nil {
+							return
+						}
+
+					}
+
+					if _, err = io.WriteString(_w, `                          Load()
+                if err != nil {
+                    return err
+                }
+                for _,obj := range objs {
+                    obj.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(nil)
+                    if err = obj.Save(ctx); err != nil {
+                        return err
+                    }
+                }
+                o.`); err != nil {
 						return
 					}
 
@@ -11288,7 +19373,7 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 						return
 					}
 
-					if _, err = io.WriteString(_w, ` = nil
+					if _, err = io.WriteString(_w, `.Clear()
             }
             `); err != nil {
 						return
@@ -11301,22 +19386,18 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 					return
 				}
 
-			} else {
-
-				if _, err = io.WriteString(_w, ` `); err != nil {
-					return
-				}
+			} else if rev.OnDelete == schema.OnDeleteCascade {
 
 				if _, err = io.WriteString(_w, `
             `); err != nil {
 					return
 				}
 
-				if rev.IsNullable {
+				if rev.IsUnique {
 
 					if _, err = io.WriteString(_w, `
             {
-                objs, err := Query`); err != nil {
+                 obj, err := Query`); err != nil {
 						return
 					}
 
@@ -11325,7 +19406,7 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 					}
 
 					if _, err = io.WriteString(_w, `(ctx).
-                          Where(op.Equal(node.`); err != nil {
+                         Where(op.Equal(node.`); err != nil {
 						return
 					}
 
@@ -11342,58 +19423,56 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 					}
 
 					if _, err = io.WriteString(_w, `().PrimaryKey(), o._originalPK)).
-                          Select(node.`); err != nil {
+                         Get()
+                 if err != nil {
+                     return err
+                 }
+                 if obj != nil {
+                     if err = obj.Delete(ctx); err != nil {
+                         return err
+                     }
+                 }
+                 // Set this object's pointer to the reverse object to nil to mark that we broke the link
+                 o.`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
+					if _, err = io.WriteString(_w, ` = nil
+            }
+            `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.Identifier); err != nil {
+				} else {
+
+					if _, err = io.WriteString(_w, ` `); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `()).
-`); err != nil {
+					if _, err = io.WriteString(_w, `
+            {
+                objs, err := Query`); err != nil {
 						return
 					}
 
-					if rev.Table.LockColumn != nil {
-
-						if _, err = io.WriteString(_w, `                          Select(node.`); err != nil {
-							return
-						}
-
-						if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-							return
-						}
-
-						if _, err = io.WriteString(_w, `().`); err != nil {
-							return
-						}
-
-						if _, err = io.WriteString(_w, rev.Table.LockColumn.Identifier); err != nil {
-							return
-						}
+					if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
+						return
+					}
 
-						if _, err = io.WriteString(_w, `()).
-`); err != nil {
-							return
-						}
+					if _, err = io.WriteString(_w, `(ctx).
+                          Where(op.Equal(node.`); err != nil {
+						return
+					}
 
+					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+						return
 					}
 
-					if _, err = io.WriteString(_w, `                          Load()
-                if err != nil {
-                    return err
-                }
-                for _,obj := range objs {
-                    obj.Set`); err != nil {
+					if _, err = io.WriteString(_w, `().`); err != nil {
 						return
 					}
 
@@ -11401,8 +19480,13 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 						return
 					}
 
-					if _, err = io.WriteString(_w, `(nil)
-                    if err = obj.Save(ctx); err != nil {
+					if _, err = io.WriteString(_w, `().PrimaryKey(), o._originalPK)).
+                          Load()
+                if err != nil {
+                    return err
+                }
+                for _,obj := range objs {
+                    if err = obj.Delete(ctx); err != nil {
                         return err
                     }
                 }
@@ -11420,62 +19504,141 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 						return
 					}
 
-				} else {
+				}
 
-					if _, err = io.WriteString(_w, `
+				if _, err = io.WriteString(_w, `
+        `); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `
+    `); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `
+
+    `); err != nil {
+			return
+		}
+
+		for _, poly := range table.ReversePolymorphicReferences {
+
+			if _, err = io.WriteString(_w, `
+        `); err != nil {
+				return
+			}
+
+			if poly.OnDelete == schema.OnDeleteSetNull || poly.OnDelete == schema.OnDeleteCascade {
+
+				if _, err = io.WriteString(_w, `
             {
                 objs, err := Query`); err != nil {
-						return
-					}
+					return
+				}
+
+				if _, err = io.WriteString(_w, poly.Table.IdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(ctx).
+                          Where(op.And(
+                              op.Equal(node.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, poly.TypeColumn.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(), "`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, table.QueryName); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"),
+                              op.Equal(node.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, poly.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `().`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, poly.ForeignKey.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(), o._originalPK),
+                          )).
+                          Load()
+                if err != nil {
+                    return err
+                }
+                for _,obj := range objs {
+`); err != nil {
+					return
+				}
 
-					if _, err = io.WriteString(_w, rev.Table.IdentifierPlural); err != nil {
-						return
-					}
+				if poly.OnDelete == schema.OnDeleteSetNull {
 
-					if _, err = io.WriteString(_w, `(ctx).
-                          Where(op.Equal(node.`); err != nil {
+					if _, err = io.WriteString(_w, `                    obj.Set`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					if _, err = io.WriteString(_w, poly.Identifier); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, `().`); err != nil {
+					if _, err = io.WriteString(_w, `(nil)
+                    if err = obj.Save(ctx); err != nil {
+                        return err
+                    }
+`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.Identifier); err != nil {
-						return
-					}
+				} else {
 
-					if _, err = io.WriteString(_w, `().PrimaryKey(), o._originalPK)).
-                          Load()
-                if err != nil {
-                    return err
-                }
-                for _,obj := range objs {
-                    if err = obj.Delete(ctx); err != nil {
+					if _, err = io.WriteString(_w, `                    if err = obj.Delete(ctx); err != nil {
                         return err
                     }
-                }
-                o.`); err != nil {
+`); err != nil {
 						return
 					}
 
-					if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-						return
-					}
+				}
 
-					if _, err = io.WriteString(_w, `.Clear()
-            }
-            `); err != nil {
-						return
-					}
+				if _, err = io.WriteString(_w, `                }
+                o.`); err != nil {
+					return
+				}
 
+				if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+					return
 				}
 
-				if _, err = io.WriteString(_w, `
+				if _, err = io.WriteString(_w, `.Clear()
+            }
         `); err != nil {
 					return
 				}
@@ -11547,62 +19710,146 @@ func (tmpl *TableBaseTemplate) genDelete(table *model.Table, _w io.Writer) (err
 		}
 
 		if _, err = io.WriteString(_w, `
-	    return d.Delete(ctx, "`); err != nil {
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, fmt.Sprint(table.QueryName)); err != nil {
-			return
-		}
+		if base := table.BaseTable(); base != nil {
 
-		if _, err = io.WriteString(_w, `",
+			if _, err = io.WriteString(_w, `	    if err := d.Delete(ctx, "`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, fmt.Sprint(table.QueryName)); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `",
 	        map[string]any{"`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `": o._originalPK},
+			if _, err = io.WriteString(_w, `": o._originalPK},
             "`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, table.LockColumnQueryName()); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, table.LockColumnQueryName()); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `",
+			if _, err = io.WriteString(_w, `",
             `); err != nil {
-			return
-		}
+				return
+			}
 
-		if s := table.LockColumnIdentifier(); s == "" {
+			if s := table.LockColumnIdentifier(); s == "" {
 
-			if _, err = io.WriteString(_w, `0`); err != nil {
+				if _, err = io.WriteString(_w, `0`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, s); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `()`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `,
+        ); err != nil {
+            return err
+        }
+        return o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, base.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Delete(ctx)
+`); err != nil {
 				return
 			}
 
 		} else {
 
-			if _, err = io.WriteString(_w, `o.`); err != nil {
+			if _, err = io.WriteString(_w, `	    return d.Delete(ctx, "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, s); err != nil {
+			if _, err = io.WriteString(_w, fmt.Sprint(table.QueryName)); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `()`); err != nil {
+			if _, err = io.WriteString(_w, `",
+	        map[string]any{"`); err != nil {
 				return
 			}
 
-		}
+			if _, err = io.WriteString(_w, table.PrimaryKeyColumn().QueryName); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `,
+			if _, err = io.WriteString(_w, `": o._originalPK},
+            "`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.LockColumnQueryName()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `",
+            `); err != nil {
+				return
+			}
+
+			if s := table.LockColumnIdentifier(); s == "" {
+
+				if _, err = io.WriteString(_w, `0`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, s); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `()`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `,
         )
-	})
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `	})
 
 `); err != nil {
 			return
@@ -11681,7 +19928,8 @@ func delete`); err != nil {
 		return
 	}
 
-	if len(table.ReverseReferences) == 0 && len(table.ManyManyReferences) == 0 {
+	if len(table.ReverseReferences) == 0 && len(table.ReversePolymorphicReferences) == 0 && len(table.ManyManyReferences) == 0 &&
+		table.BaseReference == nil && len(table.SubTypes) == 0 {
 
 		if _, err = io.WriteString(_w, `    err := d.Delete(ctx, "`); err != nil {
 			return
@@ -11955,6 +20203,25 @@ func (o *`); err != nil {
 			return
 		}
 
+		if mm.HasColumns() {
+
+			if _, err = io.WriteString(_w, `	for _,l := range o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` {
+	    l.isDirty = false
+	}
+`); err != nil {
+				return
+			}
+
+		}
+
 	}
 
 	if _, err = io.WriteString(_w, `
@@ -12049,6 +20316,66 @@ func (o *`); err != nil {
 		return
 	}
 
+	for _, poly := range table.PolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    dirty = dirty || (o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` != nil && o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.IsDirty())
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
+
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    dirty = dirty || (o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` != nil && o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.IsDirty())
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
+`); err != nil {
+		return
+	}
+
 	if table.HasReverseReferences() {
 
 		if _, err = io.WriteString(_w, `	dirty = dirty ||
@@ -12184,7 +20511,28 @@ func (o *`); err != nil {
 			if _, err = io.WriteString(_w, `.ValuesIter() {
         dirty = dirty || obj.IsDirty()
     }
+`); err != nil {
+				return
+			}
+
+			if mm.HasColumns() {
 
+				if _, err = io.WriteString(_w, `    dirty = dirty || o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `AreDirty()
+`); err != nil {
+					return
+				}
+
+			}
+
+			if _, err = io.WriteString(_w, `
     `); err != nil {
 				return
 			}
@@ -12216,7 +20564,28 @@ func (tmpl *TableBaseTemplate) genGet(table *model.Table, _w io.Writer) (err err
 // It will also get related objects if they are loaded.
 // Invalid fields and objects are returned as nil.
 // Get can be used to retrieve a value by using the Field() of a node.
-func (o *`); err != nil {
+`); err != nil {
+		return
+	}
+
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `// Fields of the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` base object can be retrieved too.
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `func (o *`); err != nil {
 		return
 	}
 
@@ -12354,6 +20723,96 @@ func (o *`); err != nil {
 
 	}
 
+	for _, sub := range table.SubTypes {
+
+		if _, err = io.WriteString(_w, `    case `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Field:
+        return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, poly := range table.PolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    case `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Field:
+        return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, poly := range table.ReversePolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    case `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseIdentifierPlural); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Field:
+        return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Values()
+`); err != nil {
+			return
+		}
+
+	}
+
 	for _, mm := range table.ManyManyReferences {
 
 		if _, err = io.WriteString(_w, `    case `); err != nil {
@@ -12385,8 +20844,36 @@ func (o *`); err != nil {
 	}
 
 	if _, err = io.WriteString(_w, `    }
-    return nil
-}
+`); err != nil {
+		return
+	}
+
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    // Fields that are not in this object might be in the base object
+    return o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Get(key)
+`); err != nil {
+			return
+		}
+
+	} else {
+
+		if _, err = io.WriteString(_w, `    return nil
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `}
 `); err != nil {
 		return
 	}
@@ -12631,6 +21118,110 @@ func (o *`); err != nil {
 
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    if o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` == nil {
+        if err := enc.Encode(false); err != nil {
+            return err
+        }
+    } else {
+        if err := enc.Encode(true); err != nil {
+            return err
+        }
+        if err := enc.Encode(o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `); err != nil {
+            return fmt.Errorf("error encoding `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `: %w", err)
+        }
+    }
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, sub := range table.SubTypes {
+
+		if _, err = io.WriteString(_w, `    if o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` == nil {
+        if err := enc.Encode(false); err != nil {
+            return err
+        }
+    } else {
+        if err := enc.Encode(true); err != nil {
+            return err
+        }
+        if err := enc.Encode(o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `); err != nil {
+            return fmt.Errorf("error encoding `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `: %w", err)
+        }
+    }
+`); err != nil {
+			return
+		}
+
+	}
+
 	for _, rev := range table.ReverseReferences {
 		if rev.IsUnique {
 
@@ -12843,22 +21434,93 @@ func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, table.Identifier); err != nil {
-			return
-		}
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `: %w", err)
+        }
+    }
+`); err != nil {
+			return
+		}
+
+		if mm.HasColumns() {
+
+			if _, err = io.WriteString(_w, `    if err := enc.Encode(len(o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `)); err != nil {
+        return fmt.Errorf("error encoding `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` length: %w", err)
+    }
+    for k,l := range o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` {
+        if err := enc.Encode(k); err != nil {
+            return fmt.Errorf("error encoding `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `.`); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, `.`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `: %w", err)
+			if _, err = io.WriteString(_w, ` key: %w", err)
+        }
+        if err := l.encode(enc); err != nil {
+            return err
         }
     }
+`); err != nil {
+				return
+			}
+
+		}
 
+		if _, err = io.WriteString(_w, `
 `); err != nil {
 			return
 		}
@@ -13164,6 +21826,136 @@ func (o *`); err != nil {
 
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    if err = dec.Decode(&isPtr); err != nil {
+        return fmt.Errorf("error decoding `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` isPtr: %w", err)
+    }
+    o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = nil
+    if isPtr {
+        if err = dec.Decode(&o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `); err != nil {
+            return fmt.Errorf("error decoding `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `: %w", err)
+        }
+    }
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, sub := range table.SubTypes {
+
+		if _, err = io.WriteString(_w, `    if err = dec.Decode(&isPtr); err != nil {
+        return fmt.Errorf("error decoding `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` isPtr: %w", err)
+    }
+    o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = nil
+    if isPtr {
+        if err = dec.Decode(&o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `); err != nil {
+            return fmt.Errorf("error decoding `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `: %w", err)
+        }
+    }
+`); err != nil {
+			return
+		}
+
+	}
+
 	for _, rev := range table.ReverseReferences {
 		if rev.IsUnique {
 
@@ -13417,19 +22209,160 @@ func (o *`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `.`); err != nil {
-			return
-		}
+		if _, err = io.WriteString(_w, `.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `: %w", err)
+        }
+    }
+`); err != nil {
+			return
+		}
+
+		if mm.HasColumns() {
+
+			if _, err = io.WriteString(_w, `    {
+        var n int
+        if err = dec.Decode(&n); err != nil {
+            return fmt.Errorf("error decoding `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` length: %w", err)
+        }
+        o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = nil
+        for range n {
+            var k `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `
+            if err = dec.Decode(&k); err != nil {
+                return fmt.Errorf("error decoding `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, table.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` key: %w", err)
+            }
+            l := &`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `{ `); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `: o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.Get(k) }
+            if err = l.decode(dec); err != nil {
+                return err
+            }
+            if o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` == nil {
+                o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = make(map[`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.PrimaryKeyType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `]*`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.LinkType()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `)
+            }
+            o.`); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.PkField()); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.LinkField()); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `: %w", err)
+			if _, err = io.WriteString(_w, `[k] = l
         }
     }
 `); err != nil {
-			return
+				return
+			}
+
 		}
 
 	}
@@ -13520,7 +22453,28 @@ func (o *`); err != nil {
 // MarshalStringMap serializes the object into a string map of interfaces.
 // Only valid data will be serialized, meaning, you can control what gets serialized by using Select to
 // select only the fields you want when you query for the object. The keys are the same as the json keys.
-func (o *`); err != nil {
+`); err != nil {
+		return
+	}
+
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `// The fields of the `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` base object are included.
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `func (o *`); err != nil {
 		return
 	}
 
@@ -13529,11 +22483,47 @@ func (o *`); err != nil {
 	}
 
 	if _, err = io.WriteString(_w, `Base) MarshalStringMap() (map[string]interface{}) {
-    v := make(map[string]interface{})
 `); err != nil {
 		return
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    var v map[string]interface{}
+    if o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` != nil {
+        v = o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.MarshalStringMap()
+    } else {
+        v = make(map[string]interface{})
+    }
+`); err != nil {
+			return
+		}
+
+	} else {
+
+		if _, err = io.WriteString(_w, `    v := make(map[string]interface{})
+`); err != nil {
+			return
+		}
+
+	}
+
 	for _, col := range table.AllColumns() {
 
 		//*** marshal_stringmap_col.tmpl
@@ -13573,49 +22563,111 @@ func (o *`); err != nil {
 
 			if _, err = io.WriteString(_w, `"] = nil
         } else {
-            v["`); err != nil {
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.JsonKey()); err != nil {
-				return
-			}
+			if col.ReceiverType == query.ColTypeDuration {
 
-			if _, err = io.WriteString(_w, `"] = o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `            v["`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.JsonKey()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"] = o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.String()
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `            v["`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.JsonKey()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"] = o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `
+`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, `
-        }
+			if _, err = io.WriteString(_w, `        }
 `); err != nil {
 				return
 			}
 
 		} else {
 
-			if _, err = io.WriteString(_w, `        v["`); err != nil {
-				return
-			}
+			if col.ReceiverType == query.ColTypeDuration {
 
-			if _, err = io.WriteString(_w, col.JsonKey()); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `        v["`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `"] = o.`); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, col.JsonKey()); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, col.Field); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `"] = o.`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `.String()
 `); err != nil {
-				return
+					return
+				}
+
+			} else {
+
+				if _, err = io.WriteString(_w, `        v["`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.JsonKey()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"] = o.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Field); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `
+`); err != nil {
+					return
+				}
+
 			}
 
 		}
@@ -13697,33 +22749,139 @@ func (o *`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `.Len() != 0 {
+			if _, err = io.WriteString(_w, `.Len() != 0 {
+        var vals []map[string]interface{}
+        for obj := range o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `.ValuesIter() {
+            vals = append(vals, obj.MarshalStringMap())
+        }
+        v["`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, rev.ReverseJsonKey()); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `"] = vals
+    }
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
+	for _, sub := range table.SubTypes {
+
+		if _, err = io.WriteString(_w, `    if o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` != nil {
+        v["`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseJsonKey()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `"] = o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, sub.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.MarshalStringMap()
+    }
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, poly := range table.PolymorphicReferences {
+
+		//*** marshal_stringmap_poly.tmpl
+
+		if _, err = io.WriteString(_w, `
+    if val := o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Field); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `; val != nil {
+        v["`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.JsonKey()); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `"] = val.MarshalStringMap()
+    }
+`); err != nil {
+			return
+		}
+
+	}
+
+	for _, poly := range table.ReversePolymorphicReferences {
+
+		//*** marshal_stringmap_poly_rev.tmpl
+
+		if _, err = io.WriteString(_w, `    if o.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `.Len() != 0 {
         var vals []map[string]interface{}
         for obj := range o.`); err != nil {
-				return
-			}
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseField); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `.ValuesIter() {
+		if _, err = io.WriteString(_w, `.ValuesIter() {
             vals = append(vals, obj.MarshalStringMap())
         }
         v["`); err != nil {
-				return
-			}
+			return
+		}
 
-			if _, err = io.WriteString(_w, rev.ReverseJsonKey()); err != nil {
-				return
-			}
+		if _, err = io.WriteString(_w, poly.ReverseField); err != nil {
+			return
+		}
 
-			if _, err = io.WriteString(_w, `"] = vals
+		if _, err = io.WriteString(_w, `"] = vals
     }
 `); err != nil {
-				return
-			}
-
+			return
 		}
 
 	}
@@ -13871,157 +23029,187 @@ func (o *`); err != nil {
 	return o.UnmarshalStringMap(v)
 }
 
-// UnmarshalStringMap will load the values from the stringmap into the object.
-//
-// Override this in `); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, table.Identifier); err != nil {
-		return
-	}
-
-	if _, err = io.WriteString(_w, ` to modify the json before sending it here.
-func (o *`); err != nil {
+`); err != nil {
 		return
 	}
 
-	if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
-		return
-	}
+	if table.IsView {
 
-	if _, err = io.WriteString(_w, `Base) UnmarshalStringMap(m map[string]interface{}) (err error) {
-    for k,v := range m {
-        switch k {
+		if _, err = io.WriteString(_w, `// UnmarshalStringMap returns an error, since `); err != nil {
+			return
+		}
 
-`); err != nil {
-		return
-	}
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
 
-	for _, col := range table.SettableColumns() {
+		if _, err = io.WriteString(_w, ` is a read-only view.
+func (o *`); err != nil {
+			return
+		}
 
-		//*** unmarshal_stringmap_col.tmpl
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
 
-		if _, err = io.WriteString(_w, `        case "`); err != nil {
+		if _, err = io.WriteString(_w, `Base) UnmarshalStringMap(m map[string]interface{}) (err error) {
+    return fmt.Errorf("`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, col.JsonKey()); err != nil {
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `":
-        {
+		if _, err = io.WriteString(_w, ` is a view and cannot be unmarshalled")
+}
+
 `); err != nil {
 			return
 		}
 
-		if !col.IsNullable {
+	} else {
 
-			if _, err = io.WriteString(_w, `            if v == nil {
-                return fmt.Errorf("field %s cannot be null", k)
-            }
+		if _, err = io.WriteString(_w, `// UnmarshalStringMap will load the values from the stringmap into the object.
+//
+// Override this in `); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` to modify the json before sending it here.
 `); err != nil {
-				return
-			}
+			return
+		}
 
-		} else {
+		if base := table.BaseTable(); base != nil {
 
-			if _, err = io.WriteString(_w, `            if v == nil {
-                o.Set`); err != nil {
+			if _, err = io.WriteString(_w, `// The fields of the `); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+			if _, err = io.WriteString(_w, base.Identifier); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `ToNull()
-                continue
-            }
+			if _, err = io.WriteString(_w, ` base object are loaded into the base object.
 `); err != nil {
 				return
 			}
 
 		}
 
-		if _, err = io.WriteString(_w, `
+		if _, err = io.WriteString(_w, `func (o *`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, table.DecapIdentifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `Base) UnmarshalStringMap(m map[string]interface{}) (err error) {
+    for k,v := range m {
+        switch k {
+
 `); err != nil {
 			return
 		}
 
-		if col.IsReference() {
+		for _, col := range table.SettableColumns() {
+			if table.BaseReference != nil && col == table.BaseReference.ForeignKey {
+				continue // the primary key comes from the base object
+			}
+
+			//*** unmarshal_stringmap_col.tmpl
 
-			if _, err = io.WriteString(_w, `            if _,ok := m["`); err != nil {
+			if _, err = io.WriteString(_w, `        case "`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Reference.JsonKey()); err != nil {
+			if _, err = io.WriteString(_w, col.JsonKey()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `"]; ok {
-                continue // importing the foreign key will remove the object
-            }
+			if _, err = io.WriteString(_w, `":
+        {
 `); err != nil {
 				return
 			}
 
-		}
+			if !col.IsNullable {
 
-		if _, err = io.WriteString(_w, `
+				if _, err = io.WriteString(_w, `            if v == nil {
+                return fmt.Errorf("field %s cannot be null", k)
+            }
 `); err != nil {
-			return
-		}
+					return
+				}
 
-		if col.IsEnum() {
+			} else {
 
-			if _, err = io.WriteString(_w, `            v2, err := `); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, `            if v == nil {
+                o.Set`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `ToNull()
+                continue
+            }
+`); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, col.Type); err != nil {
-				return
 			}
 
-			if _, err = io.WriteString(_w, `FromInterface(v)
-            if err != nil {return err}
-            o.Set`); err != nil {
+			if _, err = io.WriteString(_w, `
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, col.Identifier); err != nil {
-				return
+			if col.IsReference() {
+
+				if _, err = io.WriteString(_w, `            if _,ok := m["`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Reference.JsonKey()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `"]; ok {
+                continue // importing the foreign key will remove the object
+            }
+`); err != nil {
+					return
+				}
+
 			}
 
-			if _, err = io.WriteString(_w, `(v2)
+			if _, err = io.WriteString(_w, `
 `); err != nil {
 				return
 			}
 
-		} else {
-
-			switch col.ReceiverType {
-			case query.ColTypeAutoPrimaryKey:
+			if col.IsEnum() {
 
-				if _, err = io.WriteString(_w, `            if u,ok := Database().(db.AutoPrimaryKeyJsonUnmarshaller); ok {
-                u.AutoPrimaryKeyJsonUnmarshal(v)
-            } else {
-                switch n := v.(type) {
-                case json.Number:
-                    n2,err := n.Int64()
-                    if err != nil {return err}
-                    o.Set`); err != nil {
+				if _, err = io.WriteString(_w, `            v2, err := `); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				if _, err = io.WriteString(_w, col.Type); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `(query.NewAutoPrimaryKey(n2))
-                default:
-                    o.Set`); err != nil {
+				if _, err = io.WriteString(_w, `FromInterface(v)
+            if err != nil {return err}
+            o.Set`); err != nil {
 					return
 				}
 
@@ -14029,40 +23217,40 @@ func (o *`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `(query.NewAutoPrimaryKey(v))
-                }
-            }
+				if _, err = io.WriteString(_w, `(v2)
 `); err != nil {
 					return
 				}
 
-			case query.ColTypeInteger:
+			} else if col.IsEnumArray() || col.IsEnumSet() {
 
-				if _, err = io.WriteString(_w, `            switch n := v.(type) {
-            case json.Number:
-                n2,err := n.Int64()
-                if err != nil {return err}
-                o.Set`); err != nil {
+				if _, err = io.WriteString(_w, `            a, ok := v.([]any)
+            if !ok {
+                return fmt.Errorf("json field %s must be an array", k)
+            }
+            v2 := make(`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				if _, err = io.WriteString(_w, col.Type); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `(int(n2))
-            case int:
-                o.Set`); err != nil {
+				if _, err = io.WriteString(_w, `, len(a))
+            for i, i2 := range a {
+                e, err := `); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				if _, err = io.WriteString(_w, col.Enum.Identifier); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `(n)
-            case float64:
-                o.Set`); err != nil {
+				if _, err = io.WriteString(_w, `FromInterface(i2)
+                if err != nil {return err}
+                v2[i] = e
+            }
+            o.Set`); err != nil {
 					return
 				}
 
@@ -14070,148 +23258,224 @@ func (o *`); err != nil {
 					return
 				}
 
-				if _, err = io.WriteString(_w, `(int(n))
+				if _, err = io.WriteString(_w, `(v2)
+`); err != nil {
+					return
+				}
+
+			} else {
+
+				switch col.ReceiverType {
+				case query.ColTypeAutoPrimaryKey:
+
+					if _, err = io.WriteString(_w, `            if u,ok := Database().(db.AutoPrimaryKeyJsonUnmarshaller); ok {
+                u.AutoPrimaryKeyJsonUnmarshal(v)
+            } else {
+                switch n := v.(type) {
+                case json.Number:
+                    n2,err := n.Int64()
+                    if err != nil {return err}
+                    o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(query.NewAutoPrimaryKey(n2))
+                default:
+                    o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(query.NewAutoPrimaryKey(v))
+                }
+            }
+`); err != nil {
+						return
+					}
+
+				case query.ColTypeInteger:
+
+					if _, err = io.WriteString(_w, `            switch n := v.(type) {
+            case json.Number:
+                n2,err := n.Int64()
+                if err != nil {return err}
+                o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(int(n2))
+            case int:
+                o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(n)
+            case float64:
+                o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(int(n))
             default:
                 return fmt.Errorf("field %s must be a number", k)
             }
 `); err != nil {
-					return
-				}
+						return
+					}
 
-			case query.ColTypeInteger64:
+				case query.ColTypeInteger64:
 
-				if _, err = io.WriteString(_w, `            switch n := v.(type) {
+					if _, err = io.WriteString(_w, `            switch n := v.(type) {
             case json.Number:
                 n2,err := n.Int64()
                 if err != nil {return err}
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(n2)
+					if _, err = io.WriteString(_w, `(n2)
             case int:
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(int64(n))
+					if _, err = io.WriteString(_w, `(int64(n))
             case float64:
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(int64(n))
+					if _, err = io.WriteString(_w, `(int64(n))
             default:
                 return fmt.Errorf("field %s must be a number", k)
             }
 `); err != nil {
-					return
-				}
+						return
+					}
 
-			case query.ColTypeFloat32:
+				case query.ColTypeFloat32:
 
-				if _, err = io.WriteString(_w, `            switch n := v.(type) {
+					if _, err = io.WriteString(_w, `            switch n := v.(type) {
             case json.Number:
                 n2,err := n.Float64()
                 if err != nil {return err}
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(float32(n2))
+					if _, err = io.WriteString(_w, `(float32(n2))
             case float64:
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(float32(n))
+					if _, err = io.WriteString(_w, `(float32(n))
             case float32:
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(n)
+					if _, err = io.WriteString(_w, `(n)
             default:
                 return fmt.Errorf("field %s must be a number", k)
             }
 `); err != nil {
-					return
-				}
+						return
+					}
 
-			case query.ColTypeFloat64:
+				case query.ColTypeFloat64:
 
-				if _, err = io.WriteString(_w, `            switch n := v.(type) {
+					if _, err = io.WriteString(_w, `            switch n := v.(type) {
             case json.Number:
                 n2,err := n.Float64()
                 if err != nil {return err}
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(n2)
+					if _, err = io.WriteString(_w, `(n2)
             case float64:
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(n)
+					if _, err = io.WriteString(_w, `(n)
             default:
                 return fmt.Errorf("field %s must be a number", k)
             }
 `); err != nil {
-					return
-				}
+						return
+					}
 
-			case query.ColTypeBytes:
-				fallthrough
-			case query.ColTypeUnknown:
+				case query.ColTypeBytes:
+					fallthrough
+				case query.ColTypeUnknown:
 
-				if _, err = io.WriteString(_w, `            switch d := v.(type) {
+					if _, err = io.WriteString(_w, `            switch d := v.(type) {
             case string:
             {
                 // A base 64 encoded string
                 if b,err2 := base64.StdEncoding.DecodeString(d); err2 == nil {
                     o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(b)
+					if _, err = io.WriteString(_w, `(b)
                 } else {
                     return fmt.Errorf("json field %s must be either a Base64 encoded string or an array of byte values", k)
                 }
@@ -14228,88 +23492,248 @@ func (o *`); err != nil {
                     }
                 }
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(b)
+					if _, err = io.WriteString(_w, `(b)
             }
             default:
                 return fmt.Errorf("json field %s must be either a Base64 encoded string or an array of byte values", k)
             }
 
 `); err != nil {
-					return
-				}
+						return
+					}
 
-			case query.ColTypeString:
+				case query.ColTypeString:
 
-				if _, err = io.WriteString(_w, `            if s,ok := v.(string); !ok {
+					if _, err = io.WriteString(_w, `            if s,ok := v.(string); !ok {
                 return fmt.Errorf("json field %s must be a string", k)
             } else {
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(s)
+					if _, err = io.WriteString(_w, `(s)
             }
 `); err != nil {
-					return
-				}
+						return
+					}
+
+				case query.ColTypeStringArray:
+
+					if _, err = io.WriteString(_w, `            a, ok := v.([]any)
+            if !ok {
+                return fmt.Errorf("json field %s must be an array of strings", k)
+            }
+            v2 := make([]string, len(a))
+            for i, i2 := range a {
+                if v2[i], ok = i2.(string); !ok {
+                    return fmt.Errorf("json field %s must be an array of strings", k)
+                }
+            }
+            o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(v2)
+`); err != nil {
+						return
+					}
+
+				case query.ColTypeIntArray:
+
+					if _, err = io.WriteString(_w, `            a, ok := v.([]any)
+            if !ok {
+                return fmt.Errorf("json field %s must be an array of integers", k)
+            }
+            v2 := make([]int, len(a))
+            for i, i2 := range a {
+                switch n := i2.(type) {
+                case json.Number:
+                    n2, err2 := n.Int64()
+                    if err2 != nil {
+                        return fmt.Errorf("json field %s must be an array of integers: %w", k, err2)
+                    }
+                    v2[i] = int(n2)
+                case float64:
+                    v2[i] = int(n)
+                default:
+                    return fmt.Errorf("json field %s must be an array of integers", k)
+                }
+            }
+            o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(v2)
+`); err != nil {
+						return
+					}
+
+				case query.ColTypeDecimal:
+
+					if _, err = io.WriteString(_w, `            var d query.Decimal
+            switch n := v.(type) {
+            case string:
+                d,err = query.ParseDecimal(n)
+            case json.Number:
+                d,err = query.ParseDecimal(n.String())
+            case float64:
+                d = query.NewDecimalFromFloat(n)
+            default:
+                return fmt.Errorf("json field %s must be a number or a string", k)
+            }
+            if err != nil {
+                return fmt.Errorf("json field %s must be a decimal number: %w", k, err)
+            }
+            o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(d)
+`); err != nil {
+						return
+					}
+
+				case query.ColTypeGeometry:
+
+					if _, err = io.WriteString(_w, `            // a GeoJSON geometry object
+            var g query.Geometry
+            if b,err2 := json.Marshal(v); err2 != nil {
+                return err2
+            } else if err2 = g.UnmarshalJSON(b); err2 != nil {
+                return fmt.Errorf("json field %s must be a GeoJSON geometry: %w", k, err2)
+            }
+            o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(g)
+`); err != nil {
+						return
+					}
+
+				case query.ColTypeDuration:
+
+					if _, err = io.WriteString(_w, `            switch d := v.(type) {
+            case string:
+                // a Go duration string, as in "1h30m"
+                d2, err2 := time.ParseDuration(d)
+                if err2 != nil {
+                    return fmt.Errorf("json field %s must be a duration: %w", k, err2)
+                }
+                o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `(d2)
+            case json.Number:
+                // a numeric value, which is a number of nanoseconds, the same as a time.Duration
+                n2, err2 := d.Int64()
+                if err2 != nil {
+                    return fmt.Errorf("json field %s must be a duration: %w", k, err2)
+                }
+                o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-			case query.ColTypeBool:
+					if _, err = io.WriteString(_w, `(time.Duration(n2))
+            case float64:
+                o.Set`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `            if b,ok := v.(bool); !ok {
+					if _, err = io.WriteString(_w, `(time.Duration(d))
+            default:
+                return fmt.Errorf("json field %s must be a number or a string", k)
+            }
+`); err != nil {
+						return
+					}
+
+				case query.ColTypeBool:
+
+					if _, err = io.WriteString(_w, `            if b,ok := v.(bool); !ok {
                 return fmt.Errorf("json field %s must be a boolean", k)
             } else {
                 o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(b)
+					if _, err = io.WriteString(_w, `(b)
             }
 `); err != nil {
-					return
-				}
+						return
+					}
 
-			case query.ColTypeTime:
+				case query.ColTypeTime:
 
-				if _, err = io.WriteString(_w, `             switch d := v.(type) {
+					if _, err = io.WriteString(_w, `             switch d := v.(type) {
              case json.Number:
                 // a numeric value, which for JSON, means milliseconds since epoc
                  n2,err := d.Int64()
                  if err != nil {return err}
-                 o.Set`); err != nil {
-					return
-				}
+                 o.Set`); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(time.UnixMilli(n2).UTC())
+					if _, err = io.WriteString(_w, `(time.UnixMilli(n2).UTC())
              case float64:
                  // a numeric value, which for JSON, means milliseconds since epoc
                  o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(time.UnixMilli(int64(d)).UTC())
+					if _, err = io.WriteString(_w, `(time.UnixMilli(int64(d)).UTC())
              case string:
                  // an ISO8601 string (hopefully)
                  var t time.Time
@@ -14319,55 +23743,55 @@ func (o *`); err != nil {
                  }
                  t = t.UTC()
                  o.Set`); err != nil {
-					return
-				}
+						return
+					}
 
-				if _, err = io.WriteString(_w, col.Identifier); err != nil {
-					return
-				}
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
 
-				if _, err = io.WriteString(_w, `(t)
+					if _, err = io.WriteString(_w, `(t)
              default:
                  return fmt.Errorf("json field %s must be a number or a string", k)
              }
 `); err != nil {
-					return
+						return
+					}
+
 				}
 
 			}
 
-		}
-
-		if _, err = io.WriteString(_w, `            }
+			if _, err = io.WriteString(_w, `            }
 `); err != nil {
-			return
-		}
+				return
+			}
 
-	}
+		}
 
-	for _, ref := range table.References {
+		for _, ref := range table.References {
 
-		//*** unmarshal_stringmap_ref.tmpl
+			//*** unmarshal_stringmap_ref.tmpl
 
-		if _, err = io.WriteString(_w, `
+			if _, err = io.WriteString(_w, `
             case "`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, ref.JsonKey()); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, ref.JsonKey()); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `":
+			if _, err = io.WriteString(_w, `":
                 v2 := New`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, ref.ReferencedTable.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `()
+			if _, err = io.WriteString(_w, `()
                 m2,ok := v.(map[string]any)
                 if !ok {
                     return fmt.Errorf("json field %s must be a map", k)
@@ -14375,49 +23799,49 @@ func (o *`); err != nil {
                 err = v2.UnmarshalStringMap(m2)
                 if err != nil {return}
                 o.Set`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, ref.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, ref.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `(v2)
+			if _, err = io.WriteString(_w, `(v2)
 
 `); err != nil {
-			return
-		}
-
-	}
-
-	for _, rev := range table.ReverseReferences {
-
-		//*** unmarshal_stringmap_rev.tmpl
+				return
+			}
 
-		if _, err = io.WriteString(_w, `        case "`); err != nil {
-			return
 		}
 
-		if _, err = io.WriteString(_w, rev.ReverseJsonKey()); err != nil {
-			return
-		}
+		for _, rev := range table.ReverseReferences {
 
-		if _, err = io.WriteString(_w, `":
-`); err != nil {
-			return
-		}
+			//*** unmarshal_stringmap_rev.tmpl
 
-		if rev.IsUnique {
+			if _, err = io.WriteString(_w, `        case "`); err != nil {
+				return
+			}
 
-			if _, err = io.WriteString(_w, `            v2 := New`); err != nil {
+			if _, err = io.WriteString(_w, rev.ReverseJsonKey()); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+			if _, err = io.WriteString(_w, `":
+`); err != nil {
 				return
 			}
 
-			if _, err = io.WriteString(_w, `()
+			if rev.IsUnique {
+
+				if _, err = io.WriteString(_w, `            v2 := New`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `()
             m2,ok := v.(map[string]any)
             if !ok {
                 return fmt.Errorf("json field %s must be a map", k)
@@ -14425,142 +23849,171 @@ func (o *`); err != nil {
             err = v2.UnmarshalStringMap(m2)
             if err != nil {return}
             o.Set`); err != nil {
-				return
-			}
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ReverseIdentifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `(v2)
+				if _, err = io.WriteString(_w, `(v2)
 `); err != nil {
-				return
-			}
+					return
+				}
 
-		} else {
+			} else {
 
-			if _, err = io.WriteString(_w, `            v2,ok := v.([]any)
+				if _, err = io.WriteString(_w, `            v2,ok := v.([]any)
             if !ok {
                 return fmt.Errorf("json field %s must be an array of maps", k)
             }
             var s []*`); err != nil {
-				return
-			}
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `
+				if _, err = io.WriteString(_w, `
             for _,i2 := range v2 {
                 m2,ok := i2.(map[string]any)
                 if !ok {
                     return fmt.Errorf("json field %s must be an array of maps", k)
                 }
                 v3 := New`); err != nil {
-				return
-			}
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.Table.Identifier); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `()
+				if _, err = io.WriteString(_w, `()
                 err = v3.UnmarshalStringMap(m2)
                 if err != nil {return}
                 s = append(s, v3)
             }
             o.Set`); err != nil {
-				return
-			}
+					return
+				}
 
-			if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
-				return
-			}
+				if _, err = io.WriteString(_w, rev.ReverseIdentifierPlural); err != nil {
+					return
+				}
 
-			if _, err = io.WriteString(_w, `(s...)
+				if _, err = io.WriteString(_w, `(s...)
 `); err != nil {
-				return
-			}
+					return
+				}
 
-		}
+			}
 
-		if _, err = io.WriteString(_w, `
+			if _, err = io.WriteString(_w, `
 
 `); err != nil {
-			return
-		}
+				return
+			}
 
-	}
+		}
 
-	for _, mm := range table.ManyManyReferences {
+		for _, mm := range table.ManyManyReferences {
 
-		//*** unmarshal_stringmap_mm.tmpl
+			//*** unmarshal_stringmap_mm.tmpl
 
-		if _, err = io.WriteString(_w, `
+			if _, err = io.WriteString(_w, `
         case "`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.JsonKey()); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.JsonKey()); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `":
+			if _, err = io.WriteString(_w, `":
             v2,ok := v.([]any)
             if !ok {
                 return fmt.Errorf("json field %s must be an array of maps", k)
             }
             var s []*`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `
+			if _, err = io.WriteString(_w, `
             for _,i2 := range v2 {
                 m2,ok := i2.(map[string]any)
                 if !ok {
                     return fmt.Errorf("json field %s must be an array of maps", k)
                 }
                 v3 := New`); err != nil {
-			return
-		}
+				return
+			}
 
-		if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
-			return
-		}
+			if _, err = io.WriteString(_w, mm.ReferencedTable.Identifier); err != nil {
+				return
+			}
 
-		if _, err = io.WriteString(_w, `()
+			if _, err = io.WriteString(_w, `()
                 err = v3.UnmarshalStringMap(m2)
                 if err != nil {return}
                 s = append(s, v3)
             }
             o.Set`); err != nil {
-			return
+				return
+			}
+
+			if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(s...)
+
+`); err != nil {
+				return
+			}
+
 		}
 
-		if _, err = io.WriteString(_w, mm.IdentifierPlural); err != nil {
+		if _, err = io.WriteString(_w, `        }
+    }
+`); err != nil {
 			return
 		}
 
-		if _, err = io.WriteString(_w, `(s...)
+		if base := table.BaseTable(); base != nil {
+
+			if _, err = io.WriteString(_w, `    return o.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, base.Identifier); err != nil {
+				return
+			}
 
+			if _, err = io.WriteString(_w, `.UnmarshalStringMap(m)
 `); err != nil {
-			return
-		}
+				return
+			}
 
-	}
+		} else {
 
-	if _, err = io.WriteString(_w, `        }
-    }
-    return
-}
+			if _, err = io.WriteString(_w, `    return
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `}
 
 `); err != nil {
-		return
+			return
+		}
+
 	}
 
 	return
@@ -119,14 +119,43 @@ func createMinimalSample`); err != nil {
 	}
 
 	if _, err = io.WriteString(_w, `(obj)
+`); err != nil {
+		return
+	}
+
+	if base := table.BaseTable(); base != nil {
 
+		if _, err = io.WriteString(_w, `    obj.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = createMinimalSample`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()
+`); err != nil {
+			return
+		}
+
+	}
+
+	if _, err = io.WriteString(_w, `
 `); err != nil {
 		return
 	}
 
 	for _, col := range table.Columns {
 
-		if col.IsAPrimaryKey() && !col.IsAutoPK() {
+		if col.IsAPrimaryKey() && !col.IsAutoPK() && table.BaseReference == nil {
 
 			if _, err = io.WriteString(_w, `    obj.Set`); err != nil {
 				return
@@ -198,6 +227,35 @@ func createMinimalSample`); err != nil {
 
 	}
 
+	for _, poly := range table.PolymorphicReferences {
+
+		if !poly.IsNullable {
+
+			if _, err = io.WriteString(_w, `        obj.Set`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(createMinimalSample`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.ReferencedTables[0].Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `())
+`); err != nil {
+				return
+			}
+
+		}
+
+	}
+
 	if _, err = io.WriteString(_w, `
     return obj
 }
@@ -232,6 +290,31 @@ func updateMinimalSample`); err != nil {
 		return
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    updateMinimalSample`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(obj.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+`); err != nil {
+			return
+		}
+
+	}
+
 	for _, col := range table.SettableColumns() {
 
 		if col.ReceiverType == query.ColTypeUnknown {
@@ -240,6 +323,9 @@ func updateMinimalSample`); err != nil {
 		if col.IsReference() {
 			continue
 		} // references must point to objects
+		if col.PolymorphicReference != nil {
+			continue
+		}
 		if col.IsAPrimaryKey() {
 			continue
 		} // cannot change a primary key
@@ -273,9 +359,101 @@ func updateMinimalSample`); err != nil {
 				return
 			}
 
+		} else if col.IsEnumArray() || col.IsEnumSet() {
+
+			if _, err = io.WriteString(_w, `     obj.Set`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(test.RandomEnumSlice(`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Enum.IdentifierPlural); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `()))
+`); err != nil {
+				return
+			}
+
 		} else {
 
-			if col.IsDecimal() {
+			if col.ReceiverType == query.ColTypeGeometry {
+
+				if _, err = io.WriteString(_w, `    obj.Set`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(test.RandomGeometry(`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.GeometryType()); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `))
+`); err != nil {
+					return
+				}
+
+			} else if col.ReceiverType == query.ColTypeDecimal {
+
+				if _, err = io.WriteString(_w, `    obj.Set`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(query.MustParseDecimal(test.RandomDecimal(`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, strconv.FormatUint(uint64(col.DecimalPrecision()), 10)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `, `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, strconv.FormatUint(uint64(col.DecimalScale()), 10)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` )))
+`); err != nil {
+					return
+				}
+
+			} else if col.SchemaType == schema.ColTypeJSON {
+
+				if _, err = io.WriteString(_w, `    obj.Set`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(test.RandomJSON())
+`); err != nil {
+					return
+				}
+
+			} else if col.IsDecimal() {
 
 				if _, err = io.WriteString(_w, `    obj.Set`); err != nil {
 					return
@@ -400,7 +578,7 @@ func createMaximalSample`); err != nil {
 
 	for _, col := range table.Columns {
 
-		if col.IsAPrimaryKey() && !col.IsAutoPK() {
+		if col.IsAPrimaryKey() && !col.IsAutoPK() && table.BaseReference == nil {
 
 			if _, err = io.WriteString(_w, `    obj.Set`); err != nil {
 				return
@@ -435,6 +613,31 @@ func createMaximalSample`); err != nil {
 
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    obj.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, ` = createMinimalSample`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `()
+`); err != nil {
+			return
+		}
+
+	}
+
 	if _, err = io.WriteString(_w, `    updateMaximalSample`); err != nil {
 		return
 	}
@@ -512,6 +715,31 @@ func updateMaximalSample`); err != nil {
 
 	}
 
+	for _, poly := range table.PolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    obj.Set`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(createMinimalSample`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.ReferencedTables[0].Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `())
+`); err != nil {
+			return
+		}
+
+	}
+
 	if _, err = io.WriteString(_w, `
 `); err != nil {
 		return
@@ -736,6 +964,31 @@ func deleteSample`); err != nil {
 		return
 	}
 
+	if base := table.BaseTable(); base != nil {
+
+		if _, err = io.WriteString(_w, `    deleteSample`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `(ctx, obj.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, base.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `)
+`); err != nil {
+			return
+		}
+
+	}
+
 	for _, ref := range table.References {
 
 		if _, err = io.WriteString(_w, `    deleteSample`); err != nil {
@@ -761,6 +1014,54 @@ func deleteSample`); err != nil {
 
 	}
 
+	for _, poly := range table.PolymorphicReferences {
+
+		if _, err = io.WriteString(_w, `    switch v := obj.`); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, poly.Identifier); err != nil {
+			return
+		}
+
+		if _, err = io.WriteString(_w, `().(type) {
+`); err != nil {
+			return
+		}
+
+		for _, t := range poly.ReferencedTables {
+
+			if _, err = io.WriteString(_w, `    case *`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, t.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `:
+        deleteSample`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, t.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `(ctx, v)
+`); err != nil {
+				return
+			}
+
+		}
+
+		if _, err = io.WriteString(_w, `    }
+`); err != nil {
+			return
+		}
+
+	}
+
 	if _, err = io.WriteString(_w, `}
 
 // assertEqualFields`); err != nil {
@@ -839,6 +1140,29 @@ func assertEqualFields`); err != nil {
 				return
 			}
 
+		} else if col.ReceiverType == query.ColTypeDecimal {
+
+			if _, err = io.WriteString(_w, `        assert.True(t, obj1.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `().Equal(obj2.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, col.Identifier); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, `()))
+`); err != nil {
+				return
+			}
+
 		} else if col.IsDecimal() {
 
 			if _, err = io.WriteString(_w, `        assert.True(t, test.EqualDecimals(obj1.`); err != nil {
@@ -988,6 +1312,30 @@ func assertEqualFields`); err != nil {
 					return
 				}
 
+			} else if col.IsEnumArray() || col.IsEnumSet() {
+
+				if _, err = io.WriteString(_w, `    val := test.RandomEnumSlice(`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Enum.IdentifierPlural); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `())
+    obj.Set`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(val)
+`); err != nil {
+					return
+				}
+
 			} else {
 
 				if col.IsAutoPK() {
@@ -997,6 +1345,51 @@ func assertEqualFields`); err != nil {
 						return
 					}
 
+				} else if col.ReceiverType == query.ColTypeGeometry {
+
+					if _, err = io.WriteString(_w, `    val := 	test.RandomGeometry(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.GeometryType()); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `)
+`); err != nil {
+						return
+					}
+
+				} else if col.ReceiverType == query.ColTypeDecimal {
+
+					if _, err = io.WriteString(_w, `    val := 	query.MustParseDecimal(test.RandomDecimal(`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, strconv.FormatUint(uint64(col.DecimalPrecision()), 10)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `, `); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, strconv.FormatUint(uint64(col.DecimalScale()), 10)); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, ` ))
+`); err != nil {
+						return
+					}
+
+				} else if col.SchemaType == schema.ColTypeJSON {
+
+					if _, err = io.WriteString(_w, `    val := 	test.RandomJSON()
+`); err != nil {
+						return
+					}
+
 				} else if col.SchemaSubType == schema.ColSubTypeNumeric {
 
 					if _, err = io.WriteString(_w, `    val := 	test.RandomDecimal(`); err != nil {
@@ -1288,6 +1681,55 @@ func assertEqualFields`); err != nil {
 
 			}
 
+			if col.ReceiverType == query.ColTypeDecimal && col.DecimalPrecision() > 0 {
+
+				if _, err = io.WriteString(_w, `    // test panic on setting value that does not fit the precision and scale
+    val = query.NewDecimal(1, `); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, strconv.FormatUint(uint64(col.DecimalScale()+1), 10)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `)
+    assert.Panics(t, func() {
+        obj.Set`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(val)
+    })
+    val = query.NewDecimal(1, -`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, strconv.FormatUint(uint64(col.DecimalPrecision()-col.DecimalScale()), 10)); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `)
+    assert.Panics(t, func() {
+        obj.Set`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `(val)
+    })
+`); err != nil {
+					return
+				}
+
+			}
+
 			if _, err = io.WriteString(_w, `}
 `); err != nil {
 				return
@@ -1450,7 +1892,30 @@ func Test`); err != nil {
 
 			if col.HasSetter() {
 
-				if col.IsDecimal() {
+				if col.ReceiverType == query.ColTypeDecimal {
+
+					if _, err = io.WriteString(_w, `    assert.True(t, obj.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `().Equal(obj2.`); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, col.Identifier); err != nil {
+						return
+					}
+
+					if _, err = io.WriteString(_w, `()))
+`); err != nil {
+						return
+					}
+
+				} else if col.IsDecimal() {
 
 					if _, err = io.WriteString(_w, `    assert.True(t, test.EqualDecimals(obj.`); err != nil {
 						return
@@ -1594,6 +2059,23 @@ func Test`); err != nil {
 
 		}
 
+		for _, poly := range table.PolymorphicReferences {
+
+			if _, err = io.WriteString(_w, `    obj.`); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, poly.Field); err != nil {
+				return
+			}
+
+			if _, err = io.WriteString(_w, ` = nil
+`); err != nil {
+				return
+			}
+
+		}
+
 		if _, err = io.WriteString(_w, `
 `); err != nil {
 			return
@@ -1601,7 +2083,7 @@ func Test`); err != nil {
 
 		for _, col := range table.SettableColumns() {
 
-			if !col.IsAutoPK() && !col.IsNullable {
+			if !col.IsAutoPK() && !col.IsNullable && (table.BaseReference == nil || col != table.BaseReference.ForeignKey) {
 
 				if _, err = io.WriteString(_w, `    obj.`); err != nil {
 					return
@@ -1725,6 +2207,37 @@ func Test`); err != nil {
 					return
 				}
 
+			} else if col.ReceiverType == query.ColTypeDecimal {
+
+				if _, err = io.WriteString(_w, `    assert.True(t, obj2.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `().Equal(obj.`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, `()), "`); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, col.Identifier); err != nil {
+					return
+				}
+
+				if _, err = io.WriteString(_w, ` did not update")
+`); err != nil {
+					return
+				}
+
 			} else if col.SchemaSubType != schema.ColSubTypeNumeric &&
 				!col.IsReference() {
 
@@ -4319,12 +4832,8 @@ func Test`); err != nil {
 						return
 					}
 
-					for _, col := range idx.Columns {
-
-						if _, err = io.WriteString(_w, col.Identifier); err != nil {
-							return
-						}
-
+					if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+						return
 					}
 
 					if _, err = io.WriteString(_w, ` (ctx `); err != nil {
@@ -4361,12 +4870,8 @@ func Test`); err != nil {
 						return
 					}
 
-					for _, col := range idx.Columns {
-
-						if _, err = io.WriteString(_w, col.Identifier); err != nil {
-							return
-						}
-
+					if _, err = io.WriteString(_w, idx.Identifier); err != nil {
+						return
 					}
 
 					if _, err = io.WriteString(_w, ` (ctx `); err != nil {
@@ -17,6 +17,8 @@ var (
 	schemaPath string
 	key        string
 	outputPath string
+	format     string
+	refSuffix  string
 
 	rootCmd = &cobra.Command{
 		Use:   "gro",
@@ -28,9 +30,10 @@ func main() {
 	initGen()
 	initGet()
 	initPut()
+	initLint()
 
 	// Shared flags for all subcommands
-	rootCmd.PersistentFlags().StringVarP(&schemaPath, "schema", "s", "", "Path to schema file (required)")
+	rootCmd.PersistentFlags().StringVarP(&schemaPath, "schema", "s", "", "Path to schema file, in JSON or YAML format (required)")
 
 	handler := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
 		Level:     slog.LevelInfo,
@@ -116,3 +119,28 @@ func initPut() {
 
 	rootCmd.AddCommand(putCmd)
 }
+
+func initLint() {
+	lintCmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Check the schema for problems",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if schemaPath == "" {
+				return fmt.Errorf("missing required flag: -s/--schema")
+			}
+			errCount, err := cmdpkg.Lint(schemaPath, format, refSuffix, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if errCount > 0 {
+				os.Exit(1)
+			}
+			return nil
+		},
+	}
+
+	lintCmd.Flags().StringVarP(&format, "format", "f", "text", "Output format, text or json")
+	lintCmd.Flags().StringVar(&refSuffix, "reference-suffix", "_id", "Suffix expected at the end of reference column names")
+
+	rootCmd.AddCommand(lintCmd)
+}
@@ -31,6 +31,32 @@ func (l LimitParams) AreSet() bool {
 	return l.MaxRowCount > 0
 }
 
+// LockMode specifies the kind of row level lock a query will place on the rows it selects.
+type LockMode int
+
+const (
+	// LockModeNone does not lock the selected rows.
+	LockModeNone LockMode = iota
+	// LockModeUpdate places an exclusive lock on the selected rows, as in SELECT ... FOR UPDATE.
+	LockModeUpdate
+	// LockModeShare places a shared lock on the selected rows, as in SELECT ... FOR SHARE.
+	LockModeShare
+)
+
+// LockParams is the information needed to lock the rows being requested.
+type LockParams struct {
+	Mode LockMode
+	// SkipLocked will skip rows that are locked by another transaction, rather than waiting for them.
+	SkipLocked bool
+	// NoWait will return an error if a row is locked by another transaction, rather than waiting for it.
+	NoWait bool
+}
+
+// AreSet returns true if the query will lock the rows it selects.
+func (l LockParams) AreSet() bool {
+	return l.Mode != LockModeNone
+}
+
 // BuilderI is the interface to the builder structure. Since the builder is directly interacted with by the developer,
 // passing this interface instead of the Builder object makes it more clear what the developer should use to build queries.
 type BuilderI interface {
@@ -40,8 +66,13 @@ type BuilderI interface {
 	GroupBy(nodes ...Node)
 	Limit(maxRowCount int, offset int)
 	Select(nodes ...Node)
+	Preload(nodes ...Node)
 	Distinct()
 	Calculation(base TableNodeI, alias string, n OperationNodeI)
+	ForUpdate()
+	ForShare()
+	SkipLocked()
+	NoWait()
 }
 
 type calc struct {
@@ -64,7 +95,9 @@ type Builder struct {
 	// Adds a COUNT(*) to the select list
 	GroupBys   []Node
 	Selects    []Node
+	Preloads   []Node
 	Limits     LimitParams
+	Locks      LockParams
 	HavingNode Node
 	IsSubquery bool
 }
@@ -123,8 +156,8 @@ func (b *Builder) OrderBy(nodes ...Sorter) {
 // Limit limits the query to returning maxRowCount rows at most, starting at row offset.
 // In SQL queries, this limits the rows BEFORE assembling many type relationships,
 // which usually is not what is wanted.
-// Therefore, you cannot put limits on queries that have reverse or many-many
-// relationships.
+// Therefore, reverse and many-many relationships that are selected in a limited query are
+// automatically preloaded (see Preload), and you cannot otherwise use them in a limited query.
 // Also note that SQL at least will perform the entire query before finding the offset, which could have performance
 // issues. If paging through a large dataset, consider getting a list of just primary keys of the records you want, saving
 // that list, and then lazy-loading the rest of the information with a separate query.
@@ -150,6 +183,7 @@ func (b *Builder) Limit(maxRowCount int, offset int) {
 // If columns in related tables are specified, then only those columns will be queried and loaded.
 // Depending on the query, additional columns may automatically be added to the query. In particular, primary key columns
 // will be added in most situations. The exception to this would be in distinct queries, group by queries, or subqueries.
+// To filter, sort or limit the objects loaded for a reverse or many-many relationship, wrap its node with Related.
 func (b *Builder) Select(nodes ...Node) {
 	if b.GroupBys != nil {
 		panic("you cannot have Select and GroupBy statements in the same query. The GroupBy columns will automatically be selected")
@@ -170,11 +204,67 @@ func (b *Builder) Select(nodes ...Node) {
 	}
 }
 
+// Preload selects reverse or many-many relationships like Select, but loads each of them with a separate
+// query rather than joining them to the main query.
+// The separate query finds the related objects of all the objects returned by the main query at once, using
+// the primary keys of those objects, as in "WHERE person_id IN (...)".
+// This avoids repeating the data of each object in the rows of the main query for every related object,
+// which can make a big difference when selecting more than one of these relationships.
+//
+// A preloaded relationship cannot be used elsewhere in the query, like in a Where or OrderBy clause, and
+// cannot be used in Distinct or GroupBy queries.
+func (b *Builder) Preload(nodes ...Node) {
+	for _, n := range nodes {
+		if !NodeIsArray(n) {
+			panic("you can only Preload a reverse or many-many relationship node")
+		}
+	}
+	b.Select(nodes...)
+	b.Preloads = append(b.Preloads, nodes...)
+}
+
 // Distinct sets the distinct bit, causing the query to not return duplicates.
 func (b *Builder) Distinct() {
 	b.IsDistinct = true
 }
 
+// ForUpdate will place an exclusive lock on the selected rows until the end of the current transaction.
+// Other transactions will not be able to modify or lock the rows until the lock is released.
+// Since locks are released when the transaction ends, this is only useful inside a transaction.
+// This is ignored by databases that do not support row level locking, like SQLite, which instead
+// lock the entire database during a write transaction.
+//
+// A query that locks its rows cannot be distinct, grouped, counted or have aggregate calculations.
+// Loading such a query will panic. It can join other tables, but on Postgres only the rows of the queried
+// table are locked, and not the rows of the joined tables.
+func (b *Builder) ForUpdate() {
+	b.Locks.Mode = LockModeUpdate
+}
+
+// ForShare will place a shared lock on the selected rows until the end of the current transaction.
+// Other transactions will be able to read the rows, but will not be able to modify them until the lock is released.
+// The same restrictions as ForUpdate apply.
+func (b *Builder) ForShare() {
+	b.Locks.Mode = LockModeShare
+}
+
+// SkipLocked will cause a locking query to skip rows that are already locked by another transaction.
+// Combined with ForUpdate and Limit, this lets multiple workers each claim a different set of rows from a queue.
+func (b *Builder) SkipLocked() {
+	if b.Locks.NoWait {
+		panic("cannot use SkipLocked and NoWait in the same query")
+	}
+	b.Locks.SkipLocked = true
+}
+
+// NoWait will cause a locking query to return an error immediately if a row is already locked by another transaction.
+func (b *Builder) NoWait() {
+	if b.Locks.SkipLocked {
+		panic("cannot use SkipLocked and NoWait in the same query")
+	}
+	b.Locks.NoWait = true
+}
+
 // GroupBy sets the nodes that are grouped.
 // GroupBy only makes sense if only those same columns are selected. Most SQL databases enforce this.
 func (b *Builder) GroupBy(nodes ...Node) {
@@ -199,10 +289,17 @@ func (b *Builder) Subquery() *SubqueryNode {
 
 // Nodes returns all the nodes referred to in the query.
 func (b *Builder) Nodes() (nodes []Node) {
-	// first pass
-	topNodes := b.topNodes()
+	return containedNodes(b.topNodes(true))
+}
 
-	// unpack container nodes
+// FilterNodes returns the nodes referred to in the query other than the ones that are selected.
+// These are the nodes in the conditions, sorts, groupings and calculations of the query.
+func (b *Builder) FilterNodes() (nodes []Node) {
+	return containedNodes(b.topNodes(false))
+}
+
+// containedNodes returns topNodes, with the container nodes replaced by the nodes they contain.
+func containedNodes(topNodes []Node) (nodes []Node) {
 	for _, n := range topNodes {
 		if sn, ok := n.(*SubqueryNode); ok {
 			nodes = append(nodes, n) // Return the subquery node itself, because we need to do some work on it
@@ -221,7 +318,7 @@ func (b *Builder) Nodes() (nodes []Node) {
 // HasAggregate returns true if the builder has an aggregate function in it somwhere.
 func (b *Builder) HasAggregate() bool {
 	// first pass
-	topNodes := b.topNodes()
+	topNodes := b.topNodes(true)
 
 	// unpack container nodes
 	for _, n := range topNodes {
@@ -232,9 +329,10 @@ func (b *Builder) HasAggregate() bool {
 	return false
 }
 
-// topNodes returns all the top level nodes referred to in the query.
+// topNodes returns all the top level nodes referred to in the query, leaving out the selected nodes
+// if withSelects is false.
 // Some of the nodes returned may be container nodes.
-func (b *Builder) topNodes() []Node {
+func (b *Builder) topNodes(withSelects bool) []Node {
 	var nodes []Node
 
 	/*
@@ -264,7 +362,19 @@ func (b *Builder) topNodes() []Node {
 	if b.HavingNode != nil {
 		nodes = append(nodes, b.HavingNode)
 	}
-	nodes = append(nodes, b.Selects...)
+	if withSelects {
+		nodes = append(nodes, b.Selects...)
+		for _, n := range b.Selects {
+			if o := NodeRelatedOptions(n); o != nil {
+				if o.Condition != nil {
+					nodes = append(nodes, o.Condition)
+				}
+				for _, s := range o.OrderBys {
+					nodes = append(nodes, s)
+				}
+			}
+		}
+	}
 
 	for _, n := range b.Calculations {
 		nodes = append(nodes, n.BaseNode)
@@ -29,7 +29,10 @@ type ColumnNode struct {
 	// The schema subtype for the node
 	SchemaSubType schema.ColumnSubType
 	// True if this is the single primary key of its parent table
-	IsPrimaryKey   bool
+	IsPrimaryKey bool
+	// True if the column is in the association table of its parent many-many node,
+	// rather than in the table that the association links to.
+	IsAssociation  bool
 	sortDescending bool
 	nodeLink
 }
@@ -57,6 +60,22 @@ func NewColumnNode(
 	return n
 }
 
+// NewAssociationColumnNode is used by the code generated framework to create a new column node that refers to
+// an extra column in the association table of a many-many relationship. parent is the node of the relationship.
+// You would not normally call this function directly.
+func NewAssociationColumnNode(
+	queryName string,
+	field string,
+	receiverType ReceiverType,
+	schemaType schema.ColumnType,
+	schemaSubType schema.ColumnSubType,
+	parent ManyManyNodeI,
+) *ColumnNode {
+	n := NewColumnNode(queryName, field, receiverType, schemaType, schemaSubType, false, parent)
+	n.IsAssociation = true
+	return n
+}
+
 // NodeType_ is used by the framework to return the type of node this is.
 func (n *ColumnNode) NodeType_() NodeType {
 	return ColumnNodeType
@@ -103,6 +122,9 @@ func (n *ColumnNode) GobEncode() (data []byte, err error) {
 	if err = e.Encode(n.IsPrimaryKey); err != nil {
 		panic(err)
 	}
+	if err = e.Encode(n.IsAssociation); err != nil {
+		panic(err)
+	}
 	if err = e.Encode(n.sortDescending); err != nil {
 		panic(err)
 	}
@@ -128,6 +150,9 @@ func (n *ColumnNode) GobDecode(data []byte) (err error) {
 	if err = dec.Decode(&n.IsPrimaryKey); err != nil {
 		panic(err)
 	}
+	if err = dec.Decode(&n.IsAssociation); err != nil {
+		panic(err)
+	}
 	if err = dec.Decode(&n.sortDescending); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,339 @@
+package query
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var bigTen = big.NewInt(10)
+
+// maxDecimalScale is the largest exponent, and the largest scale in either direction, that ParseDecimal accepts.
+// It is far beyond the 65 digits of the largest database decimal type, and covers every float64 value, while
+// preventing an exponent like the one in "1e2000000000" from allocating a huge number.
+const maxDecimalScale = 400
+
+// Decimal is an exact decimal number, and is the Go representation of columns with a ColSubTypeDecimal subtype.
+//
+// The value is stored as an arbitrary precision integer and a scale, which is the number of digits
+// after the decimal point. A Decimal is immutable, and all of its arithmetic functions return a new value.
+// The zero value is a Decimal with a value of zero.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// NewDecimal returns a Decimal with the value unscaled * 10^-scale.
+// For example, NewDecimal(12345, 2) is 123.45.
+func NewDecimal(unscaled int64, scale int) Decimal {
+	return newDecimal(big.NewInt(unscaled), scale)
+}
+
+// NewDecimalFromBigInt returns a Decimal with the value unscaled * 10^-scale.
+// The value of unscaled is copied.
+func NewDecimalFromBigInt(unscaled *big.Int, scale int) Decimal {
+	return newDecimal(new(big.Int).Set(unscaled), scale)
+}
+
+// NewDecimalFromFloat returns the Decimal closest to f using the fewest digits needed to represent f exactly
+// as a float64. This is a convenience function, since most decimal values cannot be expressed exactly by a float.
+// Panics if f is infinite or not a number.
+func NewDecimalFromFloat(f float64) Decimal {
+	d, err := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// newDecimal returns a Decimal that takes ownership of unscaled.
+// A negative scale is normalized so that the scale of a Decimal is never negative.
+func newDecimal(unscaled *big.Int, scale int) Decimal {
+	if scale < 0 {
+		unscaled.Mul(unscaled, bigPow10(-scale))
+		scale = 0
+	}
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+// ParseDecimal converts a string to a Decimal.
+//
+// The string may have a leading + or - sign, a decimal point, and an exponent, as in "-1.25" or "1.5e3".
+// The scale of the result is the number of digits after the decimal point, adjusted by the exponent.
+// An error is returned if the exponent or the scale is more than 400 in either direction.
+func ParseDecimal(s string) (Decimal, error) {
+	src := s
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		exp, err = strconv.Atoi(s[i+1:])
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal: %q", src)
+		}
+		if exp > maxDecimalScale || exp < -maxDecimalScale {
+			return Decimal{}, fmt.Errorf("decimal exponent is out of range: %q", src)
+		}
+		s = s[:i]
+	}
+	var sign string
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		sign = s[:1]
+		s = s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal: %q", src)
+	}
+	digits := intPart + fracPart
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Decimal{}, fmt.Errorf("invalid decimal: %q", src)
+		}
+	}
+	scale := len(fracPart) - exp
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, fmt.Errorf("decimal scale is out of range: %q", src)
+	}
+	unscaled, _ := new(big.Int).SetString(sign+digits, 10)
+	return newDecimal(unscaled, scale), nil
+}
+
+// MustParseDecimal is like ParseDecimal, but panics if s cannot be parsed.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// bigPow10 returns 10^n.
+func bigPow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// int returns the unscaled value, treating the zero value as zero.
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// rescale returns the unscaled value of d expressed with the given scale, which must be greater than or
+// equal to the scale of d.
+func (d Decimal) rescale(scale int) *big.Int {
+	i := new(big.Int).Set(d.int())
+	if scale > d.scale {
+		i.Mul(i, bigPow10(scale-d.scale))
+	}
+	return i
+}
+
+// String returns the value as a decimal string that includes all the digits of its scale, as in "-123.40".
+func (d Decimal) String() string {
+	s := d.int().String()
+	if d.scale == 0 {
+		return s
+	}
+	var sign string
+	if s[0] == '-' {
+		sign = "-"
+		s = s[1:]
+	}
+	if len(s) <= d.scale {
+		s = strings.Repeat("0", d.scale-len(s)+1) + s
+	}
+	return sign + s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// Precision returns the total number of digits in the value, not counting leading zeros.
+// A zero value has a precision of 1.
+func (d Decimal) Precision() int {
+	i := d.int()
+	if i.Sign() == 0 {
+		return 1
+	}
+	return len(new(big.Int).Abs(i).String())
+}
+
+// Sign returns -1, 0 or 1 depending on whether d is negative, zero or positive.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero returns true if the value is zero.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Add returns d + d2. The scale of the result is the larger of the two scales.
+func (d Decimal) Add(d2 Decimal) Decimal {
+	scale := max(d.scale, d2.scale)
+	return Decimal{unscaled: new(big.Int).Add(d.rescale(scale), d2.rescale(scale)), scale: scale}
+}
+
+// Sub returns d - d2. The scale of the result is the larger of the two scales.
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	scale := max(d.scale, d2.scale)
+	return Decimal{unscaled: new(big.Int).Sub(d.rescale(scale), d2.rescale(scale)), scale: scale}
+}
+
+// Mul returns d * d2. The scale of the result is the sum of the two scales, so no precision is lost.
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), d2.int()), scale: d.scale + d2.scale}
+}
+
+// Div returns d / d2 rounded to the given scale. Halfway values are rounded away from zero.
+// Panics if d2 is zero.
+func (d Decimal) Div(d2 Decimal, scale int) Decimal {
+	if d2.IsZero() {
+		panic("decimal division by zero")
+	}
+	return roundRat(new(big.Rat).Quo(d.Rat(), d2.Rat()), scale)
+}
+
+// Round returns d rounded to the given scale. Halfway values are rounded away from zero.
+// If scale is larger than the scale of d, zeros are added to the end of the value.
+func (d Decimal) Round(scale int) Decimal {
+	if scale >= d.scale {
+		return newDecimal(d.rescale(scale), scale)
+	}
+	return roundRat(d.Rat(), scale)
+}
+
+// roundRat returns r as a Decimal rounded to the given scale, rounding halfway values away from zero.
+func roundRat(r *big.Rat, scale int) Decimal {
+	n := new(big.Int).Set(r.Num())
+	den := new(big.Int).Set(r.Denom())
+	if scale >= 0 {
+		n.Mul(n, bigPow10(scale))
+	} else {
+		den.Mul(den, bigPow10(-scale))
+	}
+	q, m := new(big.Int).QuoRem(n, den, new(big.Int))
+	if m.Abs(m).Lsh(m, 1).Cmp(den) >= 0 {
+		if n.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return newDecimal(q, scale)
+}
+
+// Cmp compares d and d2 and returns -1 if d < d2, 0 if d == d2, and 1 if d > d2.
+// Values are compared numerically, so 1.5 and 1.50 are equal.
+func (d Decimal) Cmp(d2 Decimal) int {
+	scale := max(d.scale, d2.scale)
+	return d.rescale(scale).Cmp(d2.rescale(scale))
+}
+
+// Equal returns true if d and d2 have the same numeric value.
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// Less returns true if d is less than d2.
+func (d Decimal) Less(d2 Decimal) bool {
+	return d.Cmp(d2) < 0
+}
+
+// Rat returns the value as a big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.int(), bigPow10(d.scale))
+}
+
+// Float64 returns the float64 value nearest to d.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Fits returns true if d can be stored in a database column with the given precision and scale
+// without losing any digits. Trailing zeros after the decimal point do not count against the scale.
+// A precision of zero indicates that the column has no limit.
+func (d Decimal) Fits(precision, scale int) bool {
+	if precision == 0 {
+		return true
+	}
+	i := d.int()
+	s := d.scale
+	if s > scale {
+		q, m := new(big.Int).QuoRem(i, bigPow10(s-scale), new(big.Int))
+		if m.Sign() != 0 {
+			return false
+		}
+		i = q
+		s = scale
+	}
+	r := Decimal{unscaled: i, scale: s}
+	if r.IsZero() {
+		return true
+	}
+	return r.Precision()-s <= precision-scale
+}
+
+// MarshalJSON outputs the value as a JSON string so that no precision is lost by JSON readers
+// that convert numbers to floats.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts either a JSON string or a JSON number.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+	}
+	var err error
+	*d, err = ParseDecimal(s)
+	return err
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(b []byte) error {
+	var err error
+	*d, err = ParseDecimal(string(b))
+	return err
+}
+
+// MarshalBinary encodes the value using its string form, which preserves the scale.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	return d.MarshalText()
+}
+
+func (d *Decimal) UnmarshalBinary(b []byte) error {
+	return d.UnmarshalText(b)
+}
+
+// Value implements the driver.Valuer interface so that a Decimal can be sent to a database as a string.
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
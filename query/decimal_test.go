@@ -0,0 +1,102 @@
+package query
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{"123.45", "123.45", false},
+		{"-0.05", "-0.05", false},
+		{"+7", "7", false},
+		{".5", "0.5", false},
+		{"10.", "10", false},
+		{"1.5e2", "150", false},
+		{"125e-3", "0.125", false},
+		{"", "", true},
+		{".", "", true},
+		{"1.2.3", "", true},
+		{"abc", "", true},
+		{"1e400", "1" + strings.Repeat("0", 400), false},
+		{"1e2000000000", "", true},
+		{"1e-2000000000", "", true},
+		{"1e99999999999999999999", "", true},
+		{"0.1e-400", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := ParseDecimal(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, d.String())
+		})
+	}
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, "-1.05", NewDecimal(-105, 2).String())
+	assert.Equal(t, 324, NewDecimalFromFloat(5e-324).Scale())
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := MustParseDecimal("10.25")
+	b := MustParseDecimal("3.1")
+
+	assert.Equal(t, "13.35", a.Add(b).String())
+	assert.Equal(t, "7.15", a.Sub(b).String())
+	assert.Equal(t, "31.775", a.Mul(b).String())
+	assert.Equal(t, "3.31", a.Div(b, 2).String())
+	assert.Equal(t, "-3.31", a.Neg().Div(b, 2).String())
+	assert.Equal(t, "10.3", a.Round(1).String())
+	assert.Equal(t, "-10.3", a.Neg().Round(1).String())
+	assert.Equal(t, "10.2500", a.Round(4).String())
+	assert.Equal(t, "10.25", a.Neg().Abs().String())
+	assert.Panics(t, func() { a.Div(Decimal{}, 2) })
+
+	assert.True(t, MustParseDecimal("1.50").Equal(MustParseDecimal("1.5")))
+	assert.True(t, b.Less(a))
+	assert.Equal(t, 1, a.Cmp(b))
+	assert.Equal(t, 0, Decimal{}.Cmp(MustParseDecimal("0.00")))
+	assert.InDelta(t, 10.25, a.Float64(), 0.0001)
+}
+
+func TestDecimalFits(t *testing.T) {
+	assert.True(t, MustParseDecimal("1234.56").Fits(6, 2))
+	assert.True(t, MustParseDecimal("1234.5600").Fits(6, 2))
+	assert.False(t, MustParseDecimal("1234.567").Fits(6, 2))
+	assert.False(t, MustParseDecimal("12345.6").Fits(6, 2))
+	assert.True(t, MustParseDecimal("-0.01").Fits(2, 2))
+	assert.True(t, MustParseDecimal("12345678901234567890.123").Fits(0, 0))
+}
+
+func TestDecimalMarshal(t *testing.T) {
+	d := MustParseDecimal("-98765432109876543210.0100")
+
+	b, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `"-98765432109876543210.0100"`, string(b))
+
+	var d2 Decimal
+	assert.NoError(t, json.Unmarshal(b, &d2))
+	assert.Equal(t, d.String(), d2.String())
+	assert.NoError(t, json.Unmarshal([]byte(`12.5`), &d2))
+	assert.Equal(t, "12.5", d2.String())
+	assert.Error(t, json.Unmarshal([]byte(`"1e-2000000000"`), &d2))
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(d))
+	var d3 Decimal
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&d3))
+	assert.Equal(t, d.String(), d3.String())
+}
@@ -0,0 +1,27 @@
+package query
+
+import "fmt"
+
+// EnumSetBits returns the bit flags that store values in an enum set column.
+// The bit 1<<v is set for each value v, and duplicate values are ignored.
+// It panics if a value is out of the range that can be stored, which is from 0 to 62.
+func EnumSetBits[E ~int](values []E) (bits int64) {
+	for _, v := range values {
+		if v < 0 || v > 62 {
+			panic(fmt.Sprintf("enum value %d cannot be stored in an enum set", v))
+		}
+		bits |= 1 << v
+	}
+	return
+}
+
+// EnumSetFromBits returns the values stored in the bit flags of an enum set column in ascending order.
+// It returns nil if no bits are set.
+func EnumSetFromBits[E ~int](bits int64) (values []E) {
+	for v := 0; v <= 62; v++ {
+		if bits&(1<<v) != 0 {
+			values = append(values, E(v))
+		}
+	}
+	return
+}
@@ -0,0 +1,21 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testEnum int
+
+func TestEnumSetBits(t *testing.T) {
+	assert.Equal(t, int64(0), EnumSetBits[testEnum](nil))
+	assert.Equal(t, int64(0b1010), EnumSetBits([]testEnum{3, 1, 3}))
+	assert.Equal(t, int64(1)<<62, EnumSetBits([]testEnum{62}))
+	assert.Panics(t, func() { EnumSetBits([]testEnum{63}) })
+	assert.Panics(t, func() { EnumSetBits([]testEnum{-1}) })
+
+	assert.Nil(t, EnumSetFromBits[testEnum](0))
+	assert.Equal(t, []testEnum{1, 3}, EnumSetFromBits[testEnum](0b1010))
+	assert.Equal(t, []testEnum{0, 62}, EnumSetFromBits[testEnum](1|1<<62))
+}
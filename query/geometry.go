@@ -0,0 +1,343 @@
+package query
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// GeometryType identifies the shape of a Geometry value.
+type GeometryType int
+
+const (
+	GeometryPoint GeometryType = iota
+	GeometryLineString
+	GeometryPolygon
+)
+
+// String returns the GeoJSON name of the geometry type.
+func (t GeometryType) String() string {
+	switch t {
+	case GeometryPoint:
+		return "Point"
+	case GeometryLineString:
+		return "LineString"
+	case GeometryPolygon:
+		return "Polygon"
+	}
+	return ""
+}
+
+// wkbType returns the type code used by the Well Known Binary format.
+func (t GeometryType) wkbType() uint32 {
+	return uint32(t) + 1
+}
+
+// Point is a single coordinate of a Geometry. X is the longitude and Y is the latitude
+// when working with geographic coordinates.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Geometry is a spatial value, and is the Go representation of ColTypeGeometry columns.
+//
+// A Geometry is a point, a line string, or a polygon made of one or more closed rings,
+// the first of which is the exterior and the rest of which are holes.
+// Geometry values marshal to and from GeoJSON, and to and from Well Known Binary (WKB) when
+// using binary marshaling. Coordinates are planar, so distances are in the units of the coordinates.
+//
+// The zero value is a point at the origin.
+type Geometry struct {
+	typ   GeometryType
+	rings [][]Point
+}
+
+// NewPoint returns a point Geometry.
+func NewPoint(x, y float64) Geometry {
+	return Geometry{typ: GeometryPoint, rings: [][]Point{{{x, y}}}}
+}
+
+// NewLineString returns a line string Geometry that connects the given points.
+func NewLineString(points ...Point) Geometry {
+	return Geometry{typ: GeometryLineString, rings: [][]Point{points}}
+}
+
+// NewPolygon returns a polygon Geometry. The first ring is the exterior of the polygon, and
+// any additional rings are holes. Each ring should end with the same point it starts with.
+func NewPolygon(rings ...[]Point) Geometry {
+	return Geometry{typ: GeometryPolygon, rings: rings}
+}
+
+// Type returns the shape of the geometry.
+func (g Geometry) Type() GeometryType {
+	return g.typ
+}
+
+// Point returns the coordinate of a point Geometry, or the first point of any other type.
+func (g Geometry) Point() Point {
+	if len(g.rings) == 0 || len(g.rings[0]) == 0 {
+		return Point{}
+	}
+	return g.rings[0][0]
+}
+
+// Points returns the points of a line string Geometry, or the exterior ring of a polygon.
+func (g Geometry) Points() []Point {
+	if len(g.rings) == 0 {
+		return []Point{{}}
+	}
+	return g.rings[0]
+}
+
+// Rings returns the rings of a polygon Geometry.
+func (g Geometry) Rings() [][]Point {
+	return g.rings
+}
+
+// Equal returns true if the two geometries are the same shape with the same coordinates.
+func (g Geometry) Equal(g2 Geometry) bool {
+	if g.typ != g2.typ {
+		return false
+	}
+	if g.typ == GeometryPoint {
+		return g.Point() == g2.Point()
+	}
+	if len(g.rings) != len(g2.rings) {
+		return false
+	}
+	for i, r := range g.rings {
+		if len(r) != len(g2.rings[i]) {
+			return false
+		}
+		for j, p := range r {
+			if p != g2.rings[i][j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// String returns the Well Known Text (WKT) representation of the geometry, as in "POINT(1 2)".
+func (g Geometry) String() string {
+	switch g.typ {
+	case GeometryLineString:
+		return "LINESTRING" + wktRing(g.Points())
+	case GeometryPolygon:
+		s := "POLYGON("
+		for i, r := range g.rings {
+			if i > 0 {
+				s += ","
+			}
+			s += wktRing(r)
+		}
+		return s + ")"
+	default:
+		p := g.Point()
+		return fmt.Sprintf("POINT(%v %v)", p.X, p.Y)
+	}
+}
+
+func wktRing(r []Point) string {
+	s := "("
+	for i, p := range r {
+		if i > 0 {
+			s += ","
+		}
+		s += fmt.Sprintf("%v %v", p.X, p.Y)
+	}
+	return s + ")"
+}
+
+// WKB returns the geometry encoded in little-endian Well Known Binary format.
+func (g Geometry) WKB() []byte {
+	b := []byte{1}
+	b = binary.LittleEndian.AppendUint32(b, g.typ.wkbType())
+	appendPoints := func(b []byte, r []Point) []byte {
+		for _, p := range r {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(p.X))
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(p.Y))
+		}
+		return b
+	}
+	switch g.typ {
+	case GeometryLineString:
+		r := g.Points()
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(r)))
+		b = appendPoints(b, r)
+	case GeometryPolygon:
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(g.rings)))
+		for _, r := range g.rings {
+			b = binary.LittleEndian.AppendUint32(b, uint32(len(r)))
+			b = appendPoints(b, r)
+		}
+	default:
+		b = appendPoints(b, []Point{g.Point()})
+	}
+	return b
+}
+
+// GeometryFromWKB decodes a geometry from Well Known Binary format.
+// Extended WKB, as produced by PostGIS, is also accepted, in which case the SRID is ignored.
+func GeometryFromWKB(b []byte) (g Geometry, err error) {
+	r := wkbReader{b: b}
+	if len(b) < 5 {
+		return g, fmt.Errorf("WKB value is too short")
+	}
+	if b[0] == 0 {
+		r.order = binary.BigEndian
+	} else {
+		r.order = binary.LittleEndian
+	}
+	r.pos = 1
+	t := r.uint32()
+	if t&0x20000000 != 0 {
+		r.uint32() // skip the EWKB SRID
+	}
+	if t&0xC0000000 != 0 {
+		return g, fmt.Errorf("WKB values with Z or M coordinates are not supported")
+	}
+	switch t & 0xff {
+	case 1:
+		g = Geometry{typ: GeometryPoint, rings: [][]Point{r.points(1)}}
+	case 2:
+		g = Geometry{typ: GeometryLineString, rings: [][]Point{r.points(int(r.uint32()))}}
+	case 3:
+		n := int(r.uint32())
+		g = Geometry{typ: GeometryPolygon}
+		for i := 0; i < n && r.err == nil; i++ {
+			g.rings = append(g.rings, r.points(int(r.uint32())))
+		}
+	default:
+		return g, fmt.Errorf("unsupported WKB geometry type %d", t&0xff)
+	}
+	return g, r.err
+}
+
+type wkbReader struct {
+	b     []byte
+	pos   int
+	order binary.ByteOrder
+	err   error
+}
+
+func (r *wkbReader) uint32() uint32 {
+	if r.err != nil || r.pos+4 > len(r.b) {
+		r.err = fmt.Errorf("WKB value is too short")
+		return 0
+	}
+	v := r.order.Uint32(r.b[r.pos:])
+	r.pos += 4
+	return v
+}
+
+func (r *wkbReader) points(n int) []Point {
+	if r.err != nil || r.pos+n*16 > len(r.b) {
+		r.err = fmt.Errorf("WKB value is too short")
+		return nil
+	}
+	ps := make([]Point, n)
+	for i := range ps {
+		ps[i].X = math.Float64frombits(r.order.Uint64(r.b[r.pos:]))
+		ps[i].Y = math.Float64frombits(r.order.Uint64(r.b[r.pos+8:]))
+		r.pos += 16
+	}
+	return ps
+}
+
+type geoJson struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// MarshalJSON outputs the geometry as a GeoJSON geometry object.
+func (g Geometry) MarshalJSON() ([]byte, error) {
+	toArray := func(r []Point) [][2]float64 {
+		a := make([][2]float64, len(r))
+		for i, p := range r {
+			a[i] = [2]float64{p.X, p.Y}
+		}
+		return a
+	}
+	var c any
+	switch g.typ {
+	case GeometryLineString:
+		c = toArray(g.Points())
+	case GeometryPolygon:
+		var rings [][][2]float64
+		for _, r := range g.rings {
+			rings = append(rings, toArray(r))
+		}
+		c = rings
+	default:
+		p := g.Point()
+		c = [2]float64{p.X, p.Y}
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(geoJson{Type: g.typ.String(), Coordinates: b})
+}
+
+// UnmarshalJSON reads a GeoJSON Point, LineString or Polygon geometry object.
+func (g *Geometry) UnmarshalJSON(b []byte) error {
+	var gj geoJson
+	if err := json.Unmarshal(b, &gj); err != nil {
+		return err
+	}
+	toPoints := func(a [][2]float64) []Point {
+		r := make([]Point, len(a))
+		for i, c := range a {
+			r[i] = Point{c[0], c[1]}
+		}
+		return r
+	}
+	switch gj.Type {
+	case "Point":
+		var c [2]float64
+		if err := json.Unmarshal(gj.Coordinates, &c); err != nil {
+			return err
+		}
+		*g = NewPoint(c[0], c[1])
+	case "LineString":
+		var c [][2]float64
+		if err := json.Unmarshal(gj.Coordinates, &c); err != nil {
+			return err
+		}
+		*g = NewLineString(toPoints(c)...)
+	case "Polygon":
+		var c [][][2]float64
+		if err := json.Unmarshal(gj.Coordinates, &c); err != nil {
+			return err
+		}
+		var rings [][]Point
+		for _, r := range c {
+			rings = append(rings, toPoints(r))
+		}
+		*g = NewPolygon(rings...)
+	default:
+		return fmt.Errorf("unsupported GeoJSON geometry type %q", gj.Type)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the geometry in Well Known Binary format.
+func (g Geometry) MarshalBinary() ([]byte, error) {
+	return g.WKB(), nil
+}
+
+func (g *Geometry) UnmarshalBinary(b []byte) error {
+	var err error
+	*g, err = GeometryFromWKB(b)
+	return err
+}
+
+// Value implements the driver.Valuer interface and sends the geometry as Well Known Binary.
+// Database drivers that need a different format will convert the value before it is sent.
+func (g Geometry) Value() (driver.Value, error) {
+	return g.WKB(), nil
+}
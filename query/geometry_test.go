@@ -0,0 +1,62 @@
+package query
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeometryWKB(t *testing.T) {
+	tests := []Geometry{
+		NewPoint(1.5, -2),
+		NewLineString(Point{0, 0}, Point{1, 1}, Point{2, 0}),
+		NewPolygon([]Point{{0, 0}, {4, 0}, {4, 4}, {0, 0}}, []Point{{1, 1}, {2, 1}, {2, 2}, {1, 1}}),
+	}
+	for _, g := range tests {
+		t.Run(g.Type().String(), func(t *testing.T) {
+			g2, err := GeometryFromWKB(g.WKB())
+			assert.NoError(t, err)
+			assert.True(t, g.Equal(g2))
+		})
+	}
+
+	// POINT(1 2) as big-endian WKB
+	b, _ := hex.DecodeString("00000000013ff00000000000004000000000000000")
+	g, err := GeometryFromWKB(b)
+	assert.NoError(t, err)
+	assert.Equal(t, Point{1, 2}, g.Point())
+
+	// POINT(1 2) as PostGIS extended WKB with an SRID of 4326
+	b, _ = hex.DecodeString("0101000020E6100000000000000000F03F0000000000000040")
+	g, err = GeometryFromWKB(b)
+	assert.NoError(t, err)
+	assert.Equal(t, Point{1, 2}, g.Point())
+
+	_, err = GeometryFromWKB([]byte{1, 2, 0, 0, 0, 5})
+	assert.Error(t, err)
+}
+
+func TestGeometryJSON(t *testing.T) {
+	g := NewPolygon([]Point{{0, 0}, {1, 0}, {1, 1}, {0, 0}})
+	b, err := json.Marshal(g)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`, string(b))
+
+	var g2 Geometry
+	assert.NoError(t, json.Unmarshal(b, &g2))
+	assert.True(t, g.Equal(g2))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"type":"Point","coordinates":[3,4]}`), &g2))
+	assert.Equal(t, "POINT(3 4)", g2.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"type":"MultiPoint","coordinates":[[3,4]]}`), &g2))
+}
+
+func TestGeometryZero(t *testing.T) {
+	var g Geometry
+	assert.Equal(t, GeometryPoint, g.Type())
+	assert.True(t, g.Equal(NewPoint(0, 0)))
+	assert.Equal(t, "LINESTRING(0 0,1 1)", NewLineString(Point{0, 0}, Point{1, 1}).String())
+}
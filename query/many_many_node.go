@@ -31,6 +31,7 @@ type ManyManyNode struct {
 	RefPrimaryKey string
 
 	nodeLink
+	relatedLink
 }
 
 func (n *ManyManyNode) AssnTableName() string {
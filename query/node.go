@@ -102,7 +102,8 @@ func NodesMatch(node1, node2 Node) bool {
 	case AliasNodeType:
 		return node1.(AliasNodeI).Alias() == node2.(AliasNodeI).Alias()
 	case ColumnNodeType:
-		return ColumnNodeQueryName(node1) == ColumnNodeQueryName(node2)
+		return ColumnNodeQueryName(node1) == ColumnNodeQueryName(node2) &&
+			node1.(*ColumnNode).IsAssociation == node2.(*ColumnNode).IsAssociation
 	case TableNodeType:
 		return true // already know table names are equal
 	case ReferenceNodeType:
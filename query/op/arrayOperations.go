@@ -0,0 +1,24 @@
+package op
+
+import (
+	"reflect"
+
+	. "github.com/goradd/gro/query"
+)
+
+// ArrayContains returns an operation node that is true if the array column contains value, as in:
+//
+//	op.ArrayContains(node.Post().Tags(), "go")
+func ArrayContains(arrayNode Node, value any) *OperationNode {
+	return NewOperationNode(OpArrayContains, arrayNode, value)
+}
+
+// ArrayOverlaps returns an operation node that is true if the array column contains at least one of the values.
+// values is a slice of values, and must not be empty, since databases do not agree on the result
+// of an empty list. ArrayOverlaps panics if it is.
+func ArrayOverlaps(arrayNode Node, values any) *OperationNode {
+	if v := reflect.ValueOf(values); v.Kind() != reflect.Slice || v.Len() == 0 {
+		panic("the values of ArrayOverlaps must be a slice that is not empty")
+	}
+	return NewOperationNode(OpArrayOverlaps, arrayNode, values)
+}
@@ -0,0 +1,51 @@
+package op
+
+import . "github.com/goradd/gro/query"
+
+// CaseBuilder builds a CASE expression. Create one with Case.
+type CaseBuilder struct {
+	operands []any
+}
+
+// Case starts a conditional expression that returns the value of the first condition that is true.
+// Add conditions with When, and finish the expression with Else or End. For example:
+//
+//	op.Case().
+//		When(op.LessThan(node.Project().Spent(), 1000), "small").
+//		When(op.LessThan(node.Project().Spent(), 10000), "medium").
+//		Else("large")
+func Case() *CaseBuilder {
+	return &CaseBuilder{}
+}
+
+// When adds a condition, and the value that will be returned if the condition is true.
+func (b *CaseBuilder) When(condition any, value any) *CaseBuilder {
+	b.operands = append(b.operands, condition, value)
+	return b
+}
+
+// Else finishes the expression, returning value if none of the conditions are true.
+func (b *CaseBuilder) Else(value any) *OperationNode {
+	if len(b.operands) == 0 {
+		panic("a case expression requires at least one When")
+	}
+	return NewOperationNode(OpCase, append(b.operands, value)...)
+}
+
+// End finishes the expression. A NULL will be returned if none of the conditions are true.
+func (b *CaseBuilder) End() *OperationNode {
+	if len(b.operands) == 0 {
+		panic("a case expression requires at least one When")
+	}
+	return NewOperationNode(OpCase, b.operands...)
+}
+
+// Coalesce returns the first of its arguments that is not NULL.
+func Coalesce(args ...any) *OperationNode {
+	return NewFunctionNode("COALESCE", args...)
+}
+
+// NullIf returns NULL if arg1 equals arg2, and arg1 otherwise.
+func NullIf(arg1, arg2 any) *OperationNode {
+	return NewFunctionNode("NULLIF", arg1, arg2)
+}
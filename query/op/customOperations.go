@@ -13,6 +13,8 @@ func EndsWith(arg1 any, arg2 string) *OperationNode {
 // Contains returns on operation node that will return true for the following situations:
 //   - A text type field contains the given string value
 //   - An enum array node contains the given value
+//
+// Use HasAny or HasAll to query an enum set column.
 func Contains(arg1, arg2 any) *OperationNode {
 	return NewOperationNode(OpContains, arg1, arg2)
 }
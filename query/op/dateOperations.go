@@ -0,0 +1,65 @@
+package op
+
+import . "github.com/goradd/gro/query"
+
+// DateTruncYear returns the start of the year of the given datetime.
+// Use this and the other truncation functions to group datetimes into periods in a report.
+func DateTruncYear(arg any) *OperationNode {
+	return NewOperationNode(OpDateTruncYear, arg)
+}
+
+// DateTruncMonth returns the start of the month of the given datetime.
+func DateTruncMonth(arg any) *OperationNode {
+	return NewOperationNode(OpDateTruncMonth, arg)
+}
+
+// DateTruncWeek returns the start of the week of the given datetime. Weeks start on Monday.
+func DateTruncWeek(arg any) *OperationNode {
+	return NewOperationNode(OpDateTruncWeek, arg)
+}
+
+// DateTruncDay returns the start of the day of the given datetime.
+func DateTruncDay(arg any) *OperationNode {
+	return NewOperationNode(OpDateTruncDay, arg)
+}
+
+// Year returns the year of the given datetime.
+func Year(arg any) *OperationNode {
+	return NewOperationNode(OpDateYear, arg)
+}
+
+// Month returns the month of the given datetime, from 1 to 12.
+func Month(arg any) *OperationNode {
+	return NewOperationNode(OpDateMonth, arg)
+}
+
+// Day returns the day of the month of the given datetime, from 1 to 31.
+func Day(arg any) *OperationNode {
+	return NewOperationNode(OpDateDay, arg)
+}
+
+// DayOfWeek returns the day of the week of the given datetime, from 0 (Sunday) to 6 (Saturday).
+func DayOfWeek(arg any) *OperationNode {
+	return NewOperationNode(OpDateDayOfWeek, arg)
+}
+
+// Hour returns the hour of the given datetime, from 0 to 23.
+func Hour(arg any) *OperationNode {
+	return NewOperationNode(OpDateHour, arg)
+}
+
+// DateDiffSeconds returns the number of seconds from arg2 to arg1, as in arg1 - arg2.
+func DateDiffSeconds(arg1, arg2 any) *OperationNode {
+	return NewOperationNode(OpDateDiffSeconds, arg1, arg2)
+}
+
+// Now returns the current time in UTC, as determined by the database.
+func Now() *OperationNode {
+	return NewOperationNode(OpNow)
+}
+
+// DateAdd returns the datetime arg1 with the duration arg2 added to it.
+// arg2 is a ColTypeDuration column or a time.Duration value, which may be negative.
+func DateAdd(arg1, arg2 any) *OperationNode {
+	return NewOperationNode(OpDateAdd, arg1, arg2)
+}
@@ -0,0 +1,20 @@
+package op
+
+import . "github.com/goradd/gro/query"
+
+// HasAny returns an operation node that is true if the enum set column contains at least one of the values
+// in set, as in:
+//
+//	op.HasAny(node.Person().Types(), goradd.NewPersonTypeSet(goradd.PersonTypeManager, goradd.PersonTypeInactive))
+//
+// set is usually one of the generated enum set types. An empty set matches nothing.
+func HasAny[E ~int](setNode Node, set interface{ Values() []E }) *OperationNode {
+	return NotEqual(BitAnd(setNode, EnumSetBits(set.Values())), 0)
+}
+
+// HasAll returns an operation node that is true if the enum set column contains all the values in set.
+// An empty set matches everything.
+func HasAll[E ~int](setNode Node, set interface{ Values() []E }) *OperationNode {
+	bits := EnumSetBits(set.Values())
+	return Equal(BitAnd(setNode, bits), bits)
+}
@@ -0,0 +1,22 @@
+package op
+
+import . "github.com/goradd/gro/query"
+
+// JsonExtract returns an operation node that extracts the value found at path from a JSON column.
+// path uses JSON path syntax, as in "$.a.b" or "$.list[0]".
+// The result is returned as text, so it can be compared to string values, or used in an OrderBy statement
+// to sort by a value inside a JSON document.
+func JsonExtract(node Node, path string) *OperationNode {
+	return NewOperationNode(OpJsonExtract, node, path)
+}
+
+// JsonContains returns an operation node that is true if the JSON column contains the
+// JSON document given in doc. For example, a column containing {"a":1,"b":2} contains {"a":1}.
+func JsonContains(node Node, doc string) *OperationNode {
+	return NewOperationNode(OpJsonContains, node, doc)
+}
+
+// JsonHasKey returns an operation node that is true if the JSON column has a value at the given path.
+func JsonHasKey(node Node, path string) *OperationNode {
+	return NewOperationNode(OpJsonHasKey, node, path)
+}
@@ -0,0 +1,22 @@
+package op
+
+import . "github.com/goradd/gro/query"
+
+// Match returns an operation node that is true if the text in node matches the search terms.
+// node must be a column node of a column that has a full-text index. See schema.IndexLevelFullText.
+//
+// How terms are interpreted depends on the database. MySQL uses a natural language search,
+// Postgres treats the terms as plain words that must all be present, and SQLite uses the FTS5 query syntax.
+func Match(node *ColumnNode, terms string) *OperationNode {
+	return NewOperationNode(OpMatch, node, terms)
+}
+
+// MatchScore returns an operation node that calculates how relevant the text in node is to the search terms.
+// Higher values are more relevant. Use it in an OrderBy statement to sort search results, as in:
+//
+//	OrderBy(op.MatchScore(node.Project().Description(), terms).Descending())
+//
+// or give it an alias with Calculation to return the score.
+func MatchScore(node *ColumnNode, terms string) *OperationNode {
+	return NewOperationNode(OpMatchScore, node, terms)
+}
@@ -0,0 +1,27 @@
+package op
+
+import . "github.com/goradd/gro/query"
+
+// Within returns an operation node that is true if geometry a is completely inside geometry b.
+// Either argument can be a node of a geometry column or a query.Geometry value, as in:
+//
+//	op.Within(node.Customer().Location(), zone)
+func Within(a, b interface{}) *OperationNode {
+	return NewOperationNode(OpWithin, a, b)
+}
+
+// Intersects returns an operation node that is true if geometry a and geometry b share any point.
+func Intersects(a, b interface{}) *OperationNode {
+	return NewOperationNode(OpIntersects, a, b)
+}
+
+// Distance returns an operation node that calculates the planar distance between geometry a and geometry b,
+// in the units of their coordinates.
+func Distance(a, b interface{}) *OperationNode {
+	return NewOperationNode(OpDistance, a, b)
+}
+
+// DistanceLess returns an operation node that is true if geometry a is less than distance away from geometry b.
+func DistanceLess(a, b interface{}, distance float64) *OperationNode {
+	return LessThan(Distance(a, b), distance)
+}
@@ -58,6 +58,10 @@ const (
 	// The function name is followed by the operators in parenthesis
 	OpFunc Operator = "func"
 
+	// Conditional operator
+	// The operands are pairs of conditions and values, optionally followed by an else value
+	OpCase Operator = "CASE"
+
 	// SQL functions that act like operators in that the operator is put in between the operands
 	OpLike  Operator = "LIKE" // This is very SQL specific and may not be supported in NoSql
 	OpIn    Operator = "IN"
@@ -72,6 +76,38 @@ const (
 	OpEndsWith       Operator = "EndsWith"
 	OpContains       Operator = "Contains"
 	OpDateAddSeconds Operator = "AddSeconds" // Adds the given number of seconds to a datetime
+
+	// Date and time operators. Weeks start on Monday, and days of the week are numbered from 0 (Sunday) to 6 (Saturday).
+	OpDateTruncYear   Operator = "DateTruncYear"   // Truncates a datetime to the start of its year
+	OpDateTruncMonth  Operator = "DateTruncMonth"  // Truncates a datetime to the start of its month
+	OpDateTruncWeek   Operator = "DateTruncWeek"   // Truncates a datetime to the start of its week
+	OpDateTruncDay    Operator = "DateTruncDay"    // Truncates a datetime to the start of its day
+	OpDateYear        Operator = "DateYear"        // The year of a datetime
+	OpDateMonth       Operator = "DateMonth"       // The month of a datetime, from 1 to 12
+	OpDateDay         Operator = "DateDay"         // The day of the month of a datetime, from 1 to 31
+	OpDateDayOfWeek   Operator = "DateDayOfWeek"   // The day of the week of a datetime, from 0 to 6
+	OpDateHour        Operator = "DateHour"        // The hour of a datetime, from 0 to 23
+	OpDateDiffSeconds Operator = "DateDiffSeconds" // The number of seconds from the second datetime to the first
+	OpNow             Operator = "Now"             // The current UTC time
+	OpDateAdd         Operator = "DateAdd"         // Adds a duration to a datetime
+
+	// JSON operators. The path operands use the $.a.b[0] JSON path syntax.
+	OpJsonExtract  Operator = "JsonExtract"  // Returns the text value found at the path in a JSON column
+	OpJsonContains Operator = "JsonContains" // True if the JSON column contains the given JSON document
+	OpJsonHasKey   Operator = "JsonHasKey"   // True if a value exists at the path in a JSON column
+
+	// Full-text search operators. These require a schema.IndexLevelFullText index on the column.
+	OpMatch      Operator = "Match"      // True if the column matches the search terms
+	OpMatchScore Operator = "MatchScore" // The relevance of the column to the search terms. Higher is more relevant.
+
+	// Array operators. These operate on array columns, like schema.ColTypeStringArray.
+	OpArrayContains Operator = "ArrayContains" // True if the array column contains the value
+	OpArrayOverlaps Operator = "ArrayOverlaps" // True if the array column contains any of the values
+
+	// Spatial operators. These operate on schema.ColTypeGeometry columns and query.Geometry values.
+	OpWithin     Operator = "Within"     // True if the first geometry is completely inside the second
+	OpIntersects Operator = "Intersects" // True if the two geometries share any point
+	OpDistance   Operator = "Distance"   // The planar distance between two geometries
 )
 
 // String returns a string representation of the Operator type. For convenience, this also corresponds to the SQL
@@ -83,11 +119,12 @@ func (o Operator) String() string {
 // An OperationNode is a general purpose structure that specifies an operation on a node or group of nodes.
 // The operation could be arithmetic, boolean, or a function.
 type OperationNode struct {
-	op           Operator
-	operands     []Node
-	functionName string // for function operations specific to the db driver
-	distinct     bool   // some aggregate queries, particularly count, allow this inside the function
-	isAggregate  bool
+	op             Operator
+	operands       []Node
+	functionName   string // for function operations specific to the db driver
+	distinct       bool   // some aggregate queries, particularly count, allow this inside the function
+	isAggregate    bool
+	sortDescending bool
 }
 
 // NewOperationNode returns a new operation node.
@@ -160,6 +197,23 @@ func (n *OperationNode) assignOperands(operands ...interface{}) {
 	}
 }
 
+// Ascending sets the operation to sort ascending when used in an OrderBy statement.
+func (n *OperationNode) Ascending() Sorter {
+	n.sortDescending = false
+	return n
+}
+
+// Descending sets the operation to sort descending when used in an OrderBy statement.
+func (n *OperationNode) Descending() Sorter {
+	n.sortDescending = true
+	return n
+}
+
+// IsDescending returns true if the node is sorted in descending order.
+func (n *OperationNode) IsDescending() bool {
+	return n.sortDescending
+}
+
 // Distinct sets the operation to return distinct results
 func (n *OperationNode) Distinct() *OperationNode {
 	n.distinct = true
@@ -206,6 +260,9 @@ func (n *OperationNode) GobEncode() (data []byte, err error) {
 	if err = e.Encode(n.distinct); err != nil {
 		panic(err)
 	}
+	if err = e.Encode(n.sortDescending); err != nil {
+		panic(err)
+	}
 	data = buf.Bytes()
 	return
 }
@@ -225,6 +282,9 @@ func (n *OperationNode) GobDecode(data []byte) (err error) {
 	if err = dec.Decode(&n.distinct); err != nil {
 		panic(err)
 	}
+	if err = dec.Decode(&n.sortDescending); err != nil {
+		panic(err)
+	}
 	return
 }
 
@@ -10,3 +10,13 @@ func TestOperationNodeInterfaces(t *testing.T) {
 
 	assert.Implements(t, (*OperationNodeI)(nil), n)
 }
+
+func TestOperationNodeSort(t *testing.T) {
+	n := NewOperationNode(OpJsonExtract, NewValueNode(`{"a":1}`), "$.a")
+	assert.Implements(t, (*Sorter)(nil), n)
+	assert.False(t, n.IsDescending())
+	n.Descending()
+	assert.True(t, NodeIsDescending(n))
+	n.Ascending()
+	assert.False(t, NodeIsDescending(n))
+}
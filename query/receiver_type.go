@@ -24,6 +24,11 @@ const (
 	ColTypeAutoPrimaryKey
 	ColTypeUUID
 	ColTypeULID
+	ColTypeDecimal
+	ColTypeGeometry
+	ColTypeDuration
+	ColTypeStringArray
+	ColTypeIntArray
 )
 
 // String returns the constant type name as a string
@@ -53,6 +58,16 @@ func (g ReceiverType) String() string {
 		return "ColTypeUUID"
 	case ColTypeULID:
 		return "ColTypeULID"
+	case ColTypeDecimal:
+		return "ColTypeDecimal"
+	case ColTypeGeometry:
+		return "ColTypeGeometry"
+	case ColTypeDuration:
+		return "ColTypeDuration"
+	case ColTypeStringArray:
+		return "ColTypeStringArray"
+	case ColTypeIntArray:
+		return "ColTypeIntArray"
 	}
 	return ""
 }
@@ -85,6 +100,16 @@ func (g ReceiverType) DefaultValue() any {
 		return NewUUID()
 	case ColTypeULID:
 		return NewULID()
+	case ColTypeDecimal:
+		return Decimal{}
+	case ColTypeGeometry:
+		return Geometry{}
+	case ColTypeDuration:
+		return time.Duration(0)
+	case ColTypeStringArray:
+		return []string(nil)
+	case ColTypeIntArray:
+		return []int(nil)
 	}
 	return nil
 }
@@ -99,6 +124,10 @@ func (g ReceiverType) GoType() string {
 		return "query.UUID"
 	} else if g == ColTypeULID {
 		return "query.ULID"
+	} else if g == ColTypeDecimal {
+		return "query.Decimal"
+	} else if g == ColTypeGeometry {
+		return "query.Geometry"
 	}
 	t := g.DefaultValue()
 	if t != nil {
@@ -117,6 +146,12 @@ func (g ReceiverType) DefaultValueString() string {
 		return "query.UUID{}"
 	case ColTypeULID:
 		return "query.ULID{}"
+	case ColTypeDecimal:
+		return "query.Decimal{}"
+	case ColTypeGeometry:
+		return "query.Geometry{}"
+	case ColTypeDuration:
+		return "time.Duration(0)"
 	case ColTypeTime:
 		return "time.Time{}"
 	default:
@@ -163,6 +198,16 @@ func ReceiverTypeFromSchema(columnType schema.ColumnType, maxLength uint64) Rece
 		return ColTypeUUID
 	case schema.ColTypeULID:
 		return ColTypeULID
+	case schema.ColTypeGeometry:
+		return ColTypeGeometry
+	case schema.ColTypeDuration:
+		return ColTypeDuration
+	case schema.ColTypeStringArray:
+		return ColTypeStringArray
+	case schema.ColTypeIntArray, schema.ColTypeEnumArray:
+		return ColTypeIntArray
+	case schema.ColTypeEnumSet:
+		return ColTypeInteger64
 	}
 	return ColTypeUnknown
 }
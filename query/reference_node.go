@@ -7,6 +7,7 @@ import (
 
 type ReferenceNodeI interface {
 	ColumnNames() (string, string)
+	TypeCondition() (string, string)
 	equal(n Node) bool
 	TableNodeI
 	linker
@@ -20,6 +21,11 @@ type ReferenceNode struct {
 	PrimaryKey string
 	// The field that can be used in Get() calls to get the corresponding value from the table.
 	Field string
+	// TypeColumn is the query name of the type column of a polymorphic reference in this table.
+	// Empty if the reference is not polymorphic.
+	TypeColumn string
+	// TypeValue is the value that TypeColumn must have for the foreign key to point to the referenced table.
+	TypeValue string
 	nodeLink
 }
 
@@ -29,10 +35,18 @@ func (n *ReferenceNode) ColumnNames() (string, string) {
 	return n.ForeignKey, n.PrimaryKey
 }
 
+// TypeCondition returns the type column in this table and the value it must have for the join
+// of a polymorphic reference to match. The column is empty if the reference is not polymorphic.
+func (n *ReferenceNode) TypeCondition() (string, string) {
+	return n.TypeColumn, n.TypeValue
+}
+
 func (n *ReferenceNode) equal(n2 Node) bool {
 	if r, ok := n2.(ReferenceNodeI); ok {
 		c1, c2 := r.ColumnNames()
-		return c1 == n.ForeignKey && c2 == n.PrimaryKey
+		t1, t2 := r.TypeCondition()
+		return c1 == n.ForeignKey && c2 == n.PrimaryKey &&
+			t1 == n.TypeColumn && t2 == n.TypeValue
 	}
 	return false
 }
@@ -51,6 +65,12 @@ func (n *ReferenceNode) GobEncode() (data []byte, err error) {
 	if err = e.Encode(n.Field); err != nil {
 		panic(err)
 	}
+	if err = e.Encode(n.TypeColumn); err != nil {
+		panic(err)
+	}
+	if err = e.Encode(n.TypeValue); err != nil {
+		panic(err)
+	}
 	if err = e.Encode(&n.nodeLink.parentNode); err != nil {
 		panic(err)
 	}
@@ -70,6 +90,12 @@ func (n *ReferenceNode) GobDecode(data []byte) (err error) {
 	if err = dec.Decode(&n.Field); err != nil {
 		panic(err)
 	}
+	if err = dec.Decode(&n.TypeColumn); err != nil {
+		panic(err)
+	}
+	if err = dec.Decode(&n.TypeValue); err != nil {
+		panic(err)
+	}
 	if err = dec.Decode(&n.nodeLink.parentNode); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,65 @@
+package query
+
+// RelatedOptions restrict the objects of a reverse or many-many relationship that are loaded when the
+// relationship is selected. See Related.
+type RelatedOptions struct {
+	// Condition filters the related objects. It can only refer to columns of the related table.
+	Condition Node
+	// OrderBys sorts the related objects of each parent object. They can only refer to columns of the related table.
+	OrderBys []Sorter
+	// Limit is the maximum number of related objects that are loaded for each parent object.
+	// Zero will load all the related objects that match Condition.
+	Limit int
+}
+
+// The relatedOptioner interface is implemented by the nodes of relationships that accept RelatedOptions.
+type relatedOptioner interface {
+	relatedOptions_() *RelatedOptions
+	setRelatedOptions_(*RelatedOptions)
+}
+
+// The relatedLink is a mixin for the reverse and many-many nodes that holds the options given to Related.
+type relatedLink struct {
+	options *RelatedOptions
+}
+
+func (n *relatedLink) relatedOptions_() *RelatedOptions {
+	return n.options
+}
+
+func (n *relatedLink) setRelatedOptions_(o *RelatedOptions) {
+	n.options = o
+}
+
+// Related sets the options that restrict the related objects loaded when the reverse or many-many relationship n
+// is selected, and returns n so that it can be passed to Select. For example, to select the five most recently
+// started projects of each person:
+//
+//	Select(query.Related(node.Person().Projects(), query.RelatedOptions{
+//		Condition: op.Equal(node.Person().Projects().Status(), goradd.ProjectStatusOpen),
+//		OrderBys:  []query.Sorter{node.Person().Projects().StartDate().Descending()},
+//		Limit:     5,
+//	}))
+//
+// The condition and limit apply to the related objects of each parent object separately, and do not affect
+// which parent objects are returned. When Limit is set, the related objects are joined using a lateral join
+// on databases that support it, and a window function on the others.
+func Related(n Node, options RelatedOptions) Node {
+	r, ok := n.(relatedOptioner)
+	if !ok || !NodeIsArray(n) {
+		panic("only a reverse or many-many relationship node can have related options")
+	}
+	if options.Limit < 0 {
+		panic("the limit of related objects cannot be negative")
+	}
+	r.setRelatedOptions_(&options)
+	return n
+}
+
+// NodeRelatedOptions returns the options given to Related for the relationship node n, or nil if there are none.
+func NodeRelatedOptions(n Node) *RelatedOptions {
+	if r, ok := n.(relatedOptioner); ok {
+		return r.relatedOptions_()
+	}
+	return nil
+}
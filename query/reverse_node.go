@@ -8,6 +8,7 @@ import (
 // ReverseNodeI is the interface to objects that have embedded ReverseNode objects.
 type ReverseNodeI interface {
 	ColumnNames() (string, string)
+	TypeCondition() (string, string)
 	equal(n Node) bool
 	IsArray() bool
 	TableNodeI
@@ -28,7 +29,13 @@ type ReverseNode struct {
 	// IsUnique is true if there is a unique relationship between this node and its parent,
 	// which would create a one-to-one relationship rather than a one-to-many relationship.
 	IsUnique bool
+	// TypeColumn is the query name of the type column of a polymorphic reference in the child table.
+	// Empty if the reference is not polymorphic.
+	TypeColumn string
+	// TypeValue is the value that TypeColumn must have for the foreign key to point to the parent table.
+	TypeValue string
 	nodeLink
+	relatedLink
 }
 
 func (n *ReverseNode) ColumnNames() (string, string) {
@@ -41,10 +48,18 @@ func (n *ReverseNode) IsArray() bool {
 	return !n.IsUnique
 }
 
+// TypeCondition returns the type column in the child table and the value it must have for the join
+// of a polymorphic reference to match. The column is empty if the reference is not polymorphic.
+func (n *ReverseNode) TypeCondition() (string, string) {
+	return n.TypeColumn, n.TypeValue
+}
+
 func (n *ReverseNode) equal(n2 Node) bool {
 	if r, ok := n2.(ReverseNodeI); ok {
 		c1, c2 := r.ColumnNames()
-		return c1 == n.ForeignKey && c2 == n.PrimaryKey
+		t1, t2 := r.TypeCondition()
+		return c1 == n.ForeignKey && c2 == n.PrimaryKey &&
+			t1 == n.TypeColumn && t2 == n.TypeValue
 	}
 	return false
 }
@@ -65,6 +80,12 @@ func (n *ReverseNode) GobEncode() (data []byte, err error) {
 	if err = e.Encode(n.IsUnique); err != nil {
 		panic(err)
 	}
+	if err = e.Encode(n.TypeColumn); err != nil {
+		panic(err)
+	}
+	if err = e.Encode(n.TypeValue); err != nil {
+		panic(err)
+	}
 	if err = e.Encode(&n.nodeLink.parentNode); err != nil {
 		panic(err)
 	}
@@ -87,6 +108,12 @@ func (n *ReverseNode) GobDecode(data []byte) (err error) {
 	if err = dec.Decode(&n.IsUnique); err != nil {
 		panic(err)
 	}
+	if err = dec.Decode(&n.TypeColumn); err != nil {
+		panic(err)
+	}
+	if err = dec.Decode(&n.TypeValue); err != nil {
+		panic(err)
+	}
 	if err = dec.Decode(&n.nodeLink.parentNode); err != nil {
 		panic(err)
 	}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"bytes"
+	"database/sql/driver"
 	"encoding/gob"
 	"reflect"
 	"time"
@@ -33,13 +34,21 @@ func NewValueNode(i interface{}) Node {
 	case float64:
 	case float32:
 	case time.Time:
+	case time.Duration:
+		// the database driver will convert this to its own format
 
 	case AutoPrimaryKey:
 		n.value = v.Val()
+	case Decimal:
+		n.value = v.String()
+	case Geometry:
+		// the database driver will convert this to its own format
 	case []byte:
 		n.value = string(v[:])
 	case nil:
 		panic("You cannot use nil as an operator. If you are testing for a NULL, use the IsNull function.")
+	case driver.Valuer:
+		// the type knows how it is stored, like an enum that is stored by key
 	default:
 		// Use reflection to do various conversions
 		typ := reflect.TypeOf(v)
@@ -3,6 +3,7 @@ package schema
 import (
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	strings2 "github.com/goradd/strings"
@@ -42,6 +43,12 @@ type AssociationReference struct {
 
 	// LabelPlural is the plural of Label
 	LabelPlural string `json:"labelPlural,omitempty"`
+
+	// OrderColumn is the name of an int column that will be created in the association table to hold the position
+	// of each referenced object in the list of objects of the other table.
+	// If specified, setting the objects will save their positions in the order given,
+	// and loading them will return them in that order. Only one of the references can have an OrderColumn.
+	OrderColumn string `json:"order_column,omitempty"`
 }
 
 func (r *AssociationReference) QualifiedTableName() string {
@@ -68,6 +75,12 @@ type AssociationTable struct {
 	// Ref2 references the second table in the many-many relationship
 	Ref2 AssociationReference `json:"ref2"`
 
+	// Columns are additional columns in the association table that hold information about each link,
+	// like the role of a team member in a project, or the date they joined it.
+	// Code generation will create a link type for each side of the relationship that gives access to these values.
+	// Columns must be of type string, int, float, bool, time or enum, and cannot be generated or part of an index.
+	Columns []*Column `json:"columns,omitempty"`
+
 	// Comment is a place to put a comment in the JSON schema file.
 	// If the database driver supports it, it may be put in the database.
 	Comment string `json:"comment,omitempty"`
@@ -96,7 +109,53 @@ func (t *AssociationTable) infer(db *Database) error {
 		slog.Error("Column names are the same")
 		return fmt.Errorf("column names cannot be the same. Specify a column name for each association reference")
 	}
+	for _, c := range t.Columns {
+		if err := t.inferColumn(db, c); err != nil {
+			return err
+		}
+	}
+	if t.Ref1.OrderColumn != "" && t.Ref2.OrderColumn != "" {
+		return fmt.Errorf("only one reference in association table %s can have an order column", t.Table)
+	}
+	if oc := t.OrderColumn(); oc != "" {
+		if oc == t.Ref1.Column || oc == t.Ref2.Column || slices.ContainsFunc(t.Columns, func(c *Column) bool { return c.Name == oc }) {
+			return fmt.Errorf("order column %s in association table %s has the same name as another column", oc, t.Table)
+		}
+	}
+
+	return nil
+}
+
+// OrderColumn returns the name of the column that holds the positions of the linked objects, or an empty string
+// if the association is not ordered.
+func (t *AssociationTable) OrderColumn() string {
+	if t.Ref1.OrderColumn != "" {
+		return t.Ref1.OrderColumn
+	}
+	return t.Ref2.OrderColumn
+}
 
+func (t *AssociationTable) inferColumn(db *Database, c *Column) error {
+	if err := c.infer(db, &Table{Name: t.Table}); err != nil {
+		return err
+	}
+	if c.Name == t.Ref1.Column || c.Name == t.Ref2.Column {
+		return fmt.Errorf("column %s in association table %s has the same name as a reference column", c.Name, t.Table)
+	}
+	switch c.Type {
+	case ColTypeString, ColTypeInt, ColTypeFloat, ColTypeBool, ColTypeTime, ColTypeEnum:
+	default:
+		return fmt.Errorf("column %s in association table %s must be of type string, int, float, bool, time or enum", c.Name, t.Table)
+	}
+	if c.SubType.IsNumeric() || c.SubType == ColSubTypeTimestamp || c.SubType == ColSubTypeLock {
+		return fmt.Errorf("column %s in association table %s cannot have a sub type of %s", c.Name, t.Table, c.SubType.jsonRep())
+	}
+	if c.IsGenerated || c.IndexLevel != IndexLevelNone {
+		return fmt.Errorf("column %s in association table %s cannot be generated or indexed", c.Name, t.Table)
+	}
+	if c.DefaultValue == ColumnDefaultNow || c.DefaultValue == ColumnDefaultUpdate {
+		return fmt.Errorf("column %s in association table %s cannot have a default value of %v", c.Name, t.Table, c.DefaultValue)
+	}
 	return nil
 }
 
@@ -129,7 +188,9 @@ func (t *AssociationTable) inferRef(db *Database, ref *AssociationReference) err
 func (t *AssociationTable) fillDefaults(db *Database) {
 	t.fillRefDefaults(db, &t.Ref1)
 	t.fillRefDefaults(db, &t.Ref2)
-
+	for _, c := range t.Columns {
+		c.fillDefaults()
+	}
 }
 
 func (t *AssociationTable) fillRefDefaults(db *Database, ref *AssociationReference) {
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssociationTableColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		col     *Column
+		wantErr bool
+	}{
+		{"string", &Column{Name: "role", Type: ColTypeString, Size: 50, IsNullable: true}, false},
+		{"int default", &Column{Name: "hours", Type: ColTypeInt, DefaultValue: 40}, false},
+		{"ref name", &Column{Name: "person_id", Type: ColTypeString}, true},
+		{"bad type", &Column{Name: "data", Type: ColTypeBytes}, true},
+		{"lock", &Column{Name: "version", Type: ColTypeInt, SubType: ColSubTypeLock}, true},
+		{"indexed", &Column{Name: "role", Type: ColTypeString, IndexLevel: IndexLevelIndexed}, true},
+		{"now", &Column{Name: "joined", Type: ColTypeTime, DefaultValue: ColumnDefaultNow}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &Database{
+				Tables: []*Table{
+					{Name: "person", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}},
+					{Name: "project", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}},
+				},
+				AssociationTables: []*AssociationTable{
+					{
+						Table:   "person_project_assn",
+						Ref1:    AssociationReference{Table: "person"},
+						Ref2:    AssociationReference{Table: "project"},
+						Columns: []*Column{tt.col},
+					},
+				},
+			}
+			err := db.Clean()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAssociationTableOrderColumn(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref1    string
+		ref2    string
+		wantErr bool
+	}{
+		{"ref1", "position", "", false},
+		{"ref2", "", "position", false},
+		{"both", "position", "position2", true},
+		{"ref column", "person_id", "", true},
+		{"payload column", "role", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := &AssociationTable{
+				Table:   "person_project_assn",
+				Ref1:    AssociationReference{Table: "person", OrderColumn: tt.ref1},
+				Ref2:    AssociationReference{Table: "project", OrderColumn: tt.ref2},
+				Columns: []*Column{{Name: "role", Type: ColTypeString, Size: 50}},
+			}
+			db := &Database{
+				Tables: []*Table{
+					{Name: "person", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}},
+					{Name: "project", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}},
+				},
+				AssociationTables: []*AssociationTable{at},
+			}
+			err := db.Clean()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "position", at.OrderColumn())
+			}
+		})
+	}
+}
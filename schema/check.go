@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Check is a check constraint on a table. The database will refuse to insert or update a record
+// in the table if the expression of the check is false.
+//
+// Violations are reported by Save as a db.CheckConstraintError.
+type Check struct {
+	// Name is the name of the constraint in the database.
+	// If not given, one will be created from the name of the table.
+	// Should be a snake_case word.
+	Name string `json:"name,omitempty"`
+	// Expression is a boolean SQL expression using column names that is understood by all the databases
+	// the schema will be used with. For example, "price >= 0 AND price < 1000".
+	Expression string `json:"expression,omitempty"`
+	// Sql contains expressions that replace Expression for particular databases.
+	// The key is a db.DriverType constant. For example:
+	//  {"postgres":"name ~ '^[a-z]+$'","mysql":"name REGEXP '^[a-z]+$'"}
+	Sql map[string]string `json:"sql,omitempty"`
+}
+
+func (c *Check) infer(t *Table, i int) error {
+	if c.Name == "" {
+		c.Name = t.Name + "_check" + strconv.Itoa(i+1)
+	}
+	if c.Expression == "" && len(c.Sql) == 0 {
+		return fmt.Errorf("check %s in table %s does not have an expression", c.Name, t.QualifiedName())
+	}
+	return nil
+}
+
+// ExpressionFor returns the expression of the check to use for the given db.DriverType, or
+// an empty string if the check does not apply to that database.
+func (c *Check) ExpressionFor(driverType string) string {
+	if s, ok := c.Sql[driverType]; ok {
+		return s
+	}
+	return c.Expression
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"fmt"
+	"time"
 
 	strings2 "github.com/goradd/strings"
 	"github.com/kenshaw/snaker"
@@ -51,6 +52,7 @@ type Column struct {
 	// Time columns can use the string "now" to set the value to the current time when the object
 	// is first saved, and "update" to also set the value to the current time every time the object
 	// is modified and saved.
+	// Duration columns use a Go duration string, as in "1h30m".
 	DefaultValue interface{} `json:"default_value,omitempty"`
 
 	// IsNullable is true if the column can be given a NULL value.
@@ -77,13 +79,28 @@ type Column struct {
 	//  {"mysql":{"type":"decimal(5,2)"},"sqllite":{"type":"string"}}
 	// This would indicate that in Mysql, the column is defined as DECIMAL(5,2), but in Sqllite, as a string.
 	// The information recognized is specific to the database driver.
+	// A "generated" entry holds the expression of a generated column for that database. See IsGenerated.
 	DatabaseDefinition map[string]map[string]interface{} `json:"database_def,omitempty"`
 
 	// Comment is a place to put a comment in the JSON description file. If the database driver supports it, it may be put in the database..
 	Comment string `json:"comment,omitempty"`
 
-	// EnumTable is the enum table if the Type is ColTypeEnum.
+	// EnumTable is the enum table if the Type is ColTypeEnum, ColTypeEnumArray or ColTypeEnumSet.
 	EnumTable string `json:"enum_table,omitempty"`
+
+	// IsGenerated is true if the value of the column is computed by the database from other columns in the row.
+	// The expression is given for each database in DatabaseDefinition under the "generated" key, and will be
+	// created as GENERATED ALWAYS AS (expression) STORED. For example:
+	//  {"postgres":{"generated":"first_name || ' ' || last_name"},"mysql":{"generated":"concat(first_name, ' ', last_name)"}}
+	// Generated columns are read-only in the ORM, and are reloaded from the database after the object is saved.
+	IsGenerated bool `json:"generated,omitempty"`
+}
+
+// GeneratedExpression returns the expression for a generated column that was given in
+// the DatabaseDefinition for the driver type, or an empty string if one was not given.
+func (c *Column) GeneratedExpression(driverType string) string {
+	s, _ := c.DatabaseDefinition[driverType]["generated"].(string)
+	return s
 }
 
 // infer creates some required values if not specified and does some validity checks.
@@ -93,7 +110,7 @@ func (c *Column) infer(db *Database, table *Table) error {
 			slog.String("table", table.Name))
 		return fmt.Errorf("missing column name in table %s", table.Name)
 	}
-	if c.Type == ColTypeEnum && c.EnumTable == "" {
+	if (c.Type == ColTypeEnum || c.Type == ColTypeEnumArray || c.Type == ColTypeEnumSet) && c.EnumTable == "" {
 		// Infer the table from the name of the column
 		for _, e := range db.EnumTables {
 			if e.Name == c.Name ||
@@ -113,6 +130,38 @@ func (c *Column) infer(db *Database, table *Table) error {
 	} else if c.Type == ColTypeAutoPrimaryKey {
 		c.IndexLevel = IndexLevelPrimaryKey
 	}
+	if c.Type == ColTypeEnumArray {
+		if e := db.FindEnumTable(c.EnumTable); e != nil && e.Storage != EnumStorageInteger {
+			return fmt.Errorf("enum array column %s in table %s refers to enum table %s, which does not use integer storage", c.Name, table.Name, e.Name)
+		}
+	}
+	if c.SubType.IsNumeric() && c.Type != ColTypeString {
+		slog.Error("Numeric sub types require a string column",
+			slog.String("table", table.Name),
+			slog.String("column", c.Name))
+		return fmt.Errorf("column %s in table %s has a numeric sub type but is not a string column", c.Name, table.Name)
+	}
+	if c.Type.IsArray() && c.DefaultValue != nil {
+		return fmt.Errorf("array column %s in table %s cannot have a default value", c.Name, table.Name)
+	}
+	if c.Type == ColTypeEnumSet && c.DefaultValue != nil {
+		return fmt.Errorf("enum set column %s in table %s cannot have a default value", c.Name, table.Name)
+	}
+	if c.IsGenerated {
+		if c.DefaultValue != nil {
+			return fmt.Errorf("generated column %s in table %s cannot have a default value", c.Name, table.Name)
+		}
+		if c.Type == ColTypeAutoPrimaryKey || c.IndexLevel == IndexLevelPrimaryKey {
+			return fmt.Errorf("generated column %s in table %s cannot be a primary key", c.Name, table.Name)
+		}
+	}
+	if c.Type == ColTypeDuration && c.DefaultValue != nil {
+		if s, ok := c.DefaultValue.(string); !ok {
+			return fmt.Errorf("the default value of duration column %s in table %s must be a duration string", c.Name, table.Name)
+		} else if _, err := time.ParseDuration(s); err != nil {
+			return fmt.Errorf("the default value of duration column %s in table %s is not valid: %w", c.Name, table.Name, err)
+		}
+	}
 	return nil
 }
 
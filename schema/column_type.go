@@ -125,8 +125,8 @@ import (
 //
 // Enum columns contain values of enumerated types that are described by Enum tables in the schema.
 // ColTypeEnum is an integer value in the database.
-// There is no ColTypeEnumArray because it is difficult to model cross-platform, and is better modeled
-// as a series of ColTypeBool values.
+// Use ColTypeEnumArray to store a list of enum values in one column, or ColTypeEnumSet to store a set of
+// enum values as bit flags.
 //
 // # ColTypeUUID
 //
@@ -140,6 +140,37 @@ import (
 // ULID columns contain a ULID, which is similar to a v7 UUID, but is expressed as a base32 string rather
 // than a hex string. Use ColSubTypeRandom to turn this into an RULID, which is still expressed as a base32
 // string, but is similar to a v4 UUID in that it is completely random.
+//
+// # ColTypeGeometry
+//
+// Geometry columns contain a spatial value, and are represented in Go as a query.Geometry.
+// Use ColSubTypePoint, ColSubTypeLineString or ColSubTypePolygon to restrict the column to a particular
+// shape, or ColSubTypeNone to allow any of them.
+// Postgres requires the PostGIS extension and uses its geometry type. MySQL uses its spatial types.
+// SQLite stores the value as a Well Known Binary (WKB) blob, and does not support spatial operations.
+//
+// # ColTypeDuration
+//
+// Duration columns contain a length of time, and are represented in Go as a time.Duration.
+// Postgres stores the value as an interval. MySQL and SQLite store it as a 64-bit integer number of
+// microseconds, so any precision below a microsecond is lost.
+// A default value is specified as a Go duration string, as in "1h30m".
+//
+// # ColTypeStringArray, ColTypeIntArray and ColTypeEnumArray
+//
+// Array columns contain a list of values, and are represented in Go as a []string, a []int, or a slice of
+// the enum type given by EnumTable. Postgres uses its native text[] and int[] types, and MySQL and SQLite
+// store the list as JSON encoded text. Enum arrays are stored as arrays of integers, and the database does
+// not enforce that the values are in the enum table.
+// Use op.ArrayContains and op.ArrayOverlaps to query these columns.
+//
+// # ColTypeEnumSet
+//
+// Enum set columns contain a set of the values of the enum table given by EnumTable, and are represented
+// in Go as a slice of the enum type in ascending order without duplicates. The set is stored as a 64-bit
+// integer of bit flags, in which the bit 1<<value is set for each value in the set, so the values of the
+// enum table must be from 0 to MaxEnumSetValue.
+// Use op.HasAny and op.HasAll to query these columns.
 type ColumnType int
 
 const (
@@ -155,8 +186,19 @@ const (
 	ColTypeEnum
 	ColTypeUUID
 	ColTypeULID
+	ColTypeGeometry
+	ColTypeDuration
+	ColTypeStringArray
+	ColTypeIntArray
+	ColTypeEnumArray
+	ColTypeEnumSet
 )
 
+// IsArray returns true if the column contains a list of values.
+func (ct ColumnType) IsArray() bool {
+	return ct == ColTypeStringArray || ct == ColTypeIntArray || ct == ColTypeEnumArray
+}
+
 // GroTimestampColumnName is the convention for the name of a ColSubTypeTimestamp column
 // that will automatically be updated with the UnixMicro time upon saving of the record.
 const GroTimestampColumnName = "gro_timestamp"
@@ -190,6 +232,18 @@ func (ct ColumnType) String() string {
 		return "ColTypeUUID"
 	case ColTypeULID:
 		return "ColTypeULID"
+	case ColTypeGeometry:
+		return "ColTypeGeometry"
+	case ColTypeDuration:
+		return "ColTypeDuration"
+	case ColTypeStringArray:
+		return "ColTypeStringArray"
+	case ColTypeIntArray:
+		return "ColTypeIntArray"
+	case ColTypeEnumArray:
+		return "ColTypeEnumArray"
+	case ColTypeEnumSet:
+		return "ColTypeEnumSet"
 	default:
 		return "ColTypeUnknown"
 	}
@@ -224,6 +278,18 @@ func (ct ColumnType) jsonRep() string {
 		return "uuid"
 	case ColTypeULID:
 		return "ulid"
+	case ColTypeGeometry:
+		return "geometry"
+	case ColTypeDuration:
+		return "duration"
+	case ColTypeStringArray:
+		return "string_array"
+	case ColTypeIntArray:
+		return "int_array"
+	case ColTypeEnumArray:
+		return "enum_array"
+	case ColTypeEnumSet:
+		return "enum_set"
 	default:
 		return "unknown"
 	}
@@ -262,6 +328,18 @@ func (ct *ColumnType) UnmarshalJSON(data []byte) error {
 		*ct = ColTypeUUID
 	case "ulid":
 		*ct = ColTypeULID
+	case "geometry":
+		*ct = ColTypeGeometry
+	case "duration":
+		*ct = ColTypeDuration
+	case "string_array":
+		*ct = ColTypeStringArray
+	case "int_array":
+		*ct = ColTypeIntArray
+	case "enum_array":
+		*ct = ColTypeEnumArray
+	case "enum_set":
+		*ct = ColTypeEnumSet
 	default:
 		return fmt.Errorf(`unknown column type "%s"`, ctStr)
 	}
@@ -304,6 +382,16 @@ const (
 	ColSubTypeNumeric
 	// ColSubTypeRandom initializes UUID or ULID values to random values.
 	ColSubTypeRandom
+	// ColSubTypeDecimal is stored in the database the same way as ColSubTypeNumeric, but is represented
+	// in Go as a query.Decimal rather than a string. Generated setters will panic if a value does not
+	// fit within the precision and scale of the column.
+	ColSubTypeDecimal
+	// ColSubTypePoint restricts a geometry column to points.
+	ColSubTypePoint
+	// ColSubTypeLineString restricts a geometry column to line strings.
+	ColSubTypeLineString
+	// ColSubTypePolygon restricts a geometry column to polygons.
+	ColSubTypePolygon
 )
 
 // String returns the string representation of a ColumnType.
@@ -323,11 +411,25 @@ func (ct ColumnSubType) String() string {
 		return "ColSubTypeNumeric"
 	case ColSubTypeRandom:
 		return "ColSubTypeRandom"
+	case ColSubTypeDecimal:
+		return "ColSubTypeDecimal"
+	case ColSubTypePoint:
+		return "ColSubTypePoint"
+	case ColSubTypeLineString:
+		return "ColSubTypeLineString"
+	case ColSubTypePolygon:
+		return "ColSubTypePolygon"
 	default:
 		return "ColSubTypeNone"
 	}
 }
 
+// IsNumeric returns true if the subtype describes an exact decimal number, whether it is represented
+// in Go as a string or a query.Decimal.
+func (cst ColumnSubType) IsNumeric() bool {
+	return cst == ColSubTypeNumeric || cst == ColSubTypeDecimal
+}
+
 // MarshalJSON customizes how ColumnType is serialized to JSON.
 func (cst ColumnSubType) MarshalJSON() ([]byte, error) {
 	// Return the string representation of the ReceiverType
@@ -350,6 +452,14 @@ func (cst ColumnSubType) jsonRep() string {
 		return "numeric"
 	case ColSubTypeRandom:
 		return "random"
+	case ColSubTypeDecimal:
+		return "decimal"
+	case ColSubTypePoint:
+		return "point"
+	case ColSubTypeLineString:
+		return "line_string"
+	case ColSubTypePolygon:
+		return "polygon"
 	default:
 		return "none"
 	}
@@ -376,6 +486,14 @@ func (cst *ColumnSubType) UnmarshalJSON(data []byte) error {
 		*cst = ColSubTypeNumeric
 	case "random":
 		*cst = ColSubTypeRandom
+	case "decimal":
+		*cst = ColSubTypeDecimal
+	case "point":
+		*cst = ColSubTypePoint
+	case "line_string":
+		*cst = ColSubTypeLineString
+	case "polygon":
+		*cst = ColSubTypePolygon
 	default:
 		*cst = ColSubTypeNone
 	}
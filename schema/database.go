@@ -1,8 +1,10 @@
 package schema
 
 import (
+	"fmt"
 	"log/slog"
 	"slices"
+	"strings"
 
 	"github.com/goradd/anyutil"
 	"github.com/goradd/maps"
@@ -62,6 +64,16 @@ type Database struct {
 
 	// AssociationTables form many-to-many relationships between tables in the database.
 	AssociationTables []*AssociationTable `json:"association_tables"`
+
+	// Views are read-only queries that can be accessed like tables.
+	Views []*View `json:"views,omitempty"`
+
+	// Include lists other schema files whose tables, enum tables, association tables and views
+	// are added to this database when it is read. This lets a large schema be split across multiple files.
+	// Paths are relative to the directory of the file that includes them.
+	// Included files can be in any format understood by ReadFile, and can include other files.
+	// Other settings in an included file are ignored.
+	Include []string `json:"include,omitempty"`
 }
 
 // FillDefaults will fill all the undeclared values in the database structure with default values
@@ -95,6 +107,10 @@ func (db *Database) FillDefaults() {
 	for _, t := range db.AssociationTables {
 		t.fillDefaults(db)
 	}
+
+	for _, v := range db.Views {
+		v.fillDefaults(db)
+	}
 }
 
 // infer fills in certain key inferred values. The goal is to infer the minimal set of values
@@ -102,6 +118,11 @@ func (db *Database) FillDefaults() {
 // export their schemas.
 // It also does some validity checks.
 func (db *Database) infer() error {
+	// Sort puts the tables of a circle of references at the end without complaint, so the circle is reported here.
+	if diags := db.lintCircularReferences(); len(diags) > 0 {
+		return fmt.Errorf("%s: %s", diags[0].Path, diags[0].Message)
+	}
+
 	for _, t := range db.Tables {
 		if err := t.Clean(db); err != nil {
 			return err
@@ -120,6 +141,26 @@ func (db *Database) infer() error {
 		}
 	}
 
+	// The enum values are not known until the enum tables are inferred
+	for _, t := range db.Tables {
+		for _, c := range t.Columns {
+			if c.Type != ColTypeEnumSet {
+				continue
+			}
+			if e := db.FindEnumTable(c.EnumTable); e != nil {
+				if err := e.checkSetValues(); err != nil {
+					return fmt.Errorf("enum set column %s in table %s: %w", c.Name, t.Name, err)
+				}
+			}
+		}
+	}
+
+	for _, v := range db.Views {
+		if err := v.infer(db); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -134,6 +175,17 @@ func (db *Database) FindTable(name string) *Table {
 	return nil
 }
 
+// FindView finds the view by name. Returns nil if not found.
+// name should be schema.view if the view has a schema specified.
+func (db *Database) FindView(name string) *View {
+	for _, v := range db.Views {
+		if v.QualifiedName() == name {
+			return v
+		}
+	}
+	return nil
+}
+
 // FindEnumTable finds the enum table by name. Returns nil if not found.
 // name should be schema.table if the table has a schema specified.
 func (db *Database) FindEnumTable(name string) *EnumTable {
@@ -146,6 +198,8 @@ func (db *Database) FindEnumTable(name string) *EnumTable {
 }
 
 // Clean modifies the structure to prepare it for creating a schema in a database.
+// An error is returned if the structure is not valid, including if the tables have a circle of references
+// that are not nullable, since records could not be inserted into those tables.
 func (db *Database) Clean() error {
 	db.Sort() // required before infer so references work
 	if err := db.infer(); err != nil {
@@ -156,6 +210,7 @@ func (db *Database) Clean() error {
 
 // Sort will Sort the Tables, EnumTables and AssociationTables into a predictable order that also
 // orders the tables so that earlier tables do not reference later tables.
+// Tables that are part of circular references are put at the end in alphabetical order.
 func (db *Database) Sort() {
 	var unusedTables maps.SliceSet[*Table]
 
@@ -169,13 +224,20 @@ func (db *Database) Sort() {
 	nexttable:
 		for t := range unusedTables.All() {
 			for _, ref := range t.References {
-				// skip this table if it has references to a table we have not yet seen
-				if !slices.ContainsFunc(db.Tables, func(t2 *Table) bool { return t2.Name == ref.Table }) &&
-					!slices.ContainsFunc(newTables, func(t2 *Table) bool { return t2.Name == ref.Table }) &&
-					t.Name != ref.Table {
-					continue nexttable
+				for _, refTable := range ref.ReferencedTables() {
+					// skip this table if it has references to a table we have not yet seen
+					if !slices.ContainsFunc(db.Tables, func(t2 *Table) bool { return t2.Name == refTable }) &&
+						!slices.ContainsFunc(newTables, func(t2 *Table) bool { return t2.Name == refTable }) &&
+						t.Name != refTable {
+						continue nexttable
+					}
 				}
 			}
+			if t.Extends != "" &&
+				!slices.ContainsFunc(db.Tables, func(t2 *Table) bool { return t2.Name == t.Extends }) &&
+				!slices.ContainsFunc(newTables, func(t2 *Table) bool { return t2.Name == t.Extends }) {
+				continue nexttable
+			}
 			// This has no forward references we care about
 			newTables = append(newTables, t)
 		}
@@ -195,8 +257,10 @@ func (db *Database) Sort() {
 			break
 		}
 		if len(newTables) == 0 {
-			// circular references are what is left
-			panic("database has circular primary key references")
+			// Circular references are what is left. Databases that enforce foreign keys will need one of the
+			// references in each circle to be nullable. Clean and Lint report a circle of required references.
+			db.Tables = append(db.Tables, unusedTables.Values()...)
+			break
 		}
 	}
 
@@ -216,5 +280,38 @@ func (db *Database) Sort() {
 		}
 	})
 
+	db.sortViews()
+
 	return
 }
+
+// sortViews puts the views in alphabetical order, except that views come after the views they depend on.
+func (db *Database) sortViews() {
+	views := slices.Clone(db.Views)
+	slices.SortFunc(views, func(a, b *View) int {
+		return strings.Compare(a.QualifiedName(), b.QualifiedName())
+	})
+	db.Views = nil
+	for len(views) > 0 {
+		var rest []*View
+		for _, v := range views {
+			ready := true
+			for _, d := range v.DependsOn {
+				if d != v.QualifiedName() && !slices.ContainsFunc(db.Views, func(v2 *View) bool { return v2.QualifiedName() == d }) {
+					ready = false
+				}
+			}
+			if ready {
+				db.Views = append(db.Views, v)
+			} else {
+				rest = append(rest, v)
+			}
+		}
+		if len(rest) == len(views) {
+			// circular dependencies, which the database will refuse to create
+			db.Views = append(db.Views, rest...)
+			break
+		}
+		views = rest
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EnumStorage specifies how the columns that refer to an EnumTable store their values in the database.
+//
+// EnumStorageInteger is the default, and stores the integer value of the enum, with a foreign key
+// to the value column of the enum table.
+//
+// EnumStorageKey stores the key of the enum as a string, with a foreign key to the key column of the enum table.
+//
+// EnumStorageNative stores the value using the database's native enum type. Postgres will create a named type
+// using CREATE TYPE ... AS ENUM, and MySQL will use an ENUM(...) column. The values of the native type are the
+// keys of the enum. Databases that do not have a native enum type will store the key, as in EnumStorageKey.
+//
+// Enum arrays can only refer to enum tables that use EnumStorageInteger.
+type EnumStorage int
+
+const (
+	EnumStorageInteger EnumStorage = iota
+	EnumStorageKey
+	EnumStorageNative
+)
+
+func (s EnumStorage) String() string {
+	switch s {
+	case EnumStorageInteger:
+		return "Integer"
+	case EnumStorageKey:
+		return "Key"
+	case EnumStorageNative:
+		return "Native"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalJSON implements custom JSON serialization for EnumStorage
+func (s EnumStorage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.jsonRep())
+}
+
+func (s EnumStorage) jsonRep() string {
+	switch s {
+	case EnumStorageInteger:
+		return "integer"
+	case EnumStorageKey:
+		return "key"
+	case EnumStorageNative:
+		return "native"
+	default:
+		return "unknown"
+	}
+}
+
+// UnmarshalJSON implements custom JSON deserialization for EnumStorage
+func (s *EnumStorage) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	switch str {
+	case "integer", "":
+		*s = EnumStorageInteger
+	case "key":
+		*s = EnumStorageKey
+	case "native":
+		*s = EnumStorageNative
+	default:
+		return fmt.Errorf("invalid EnumStorage: %s", str)
+	}
+
+	return nil
+}
@@ -17,6 +17,10 @@ const LabelKey = "label"
 const NameKey = "name"
 const KeyKey = "key"
 
+// MaxEnumSetValue is the largest enum value that can be stored in a ColTypeEnumSet column.
+// The highest bit of the 64-bit integer is not used so that the stored value is never negative.
+const MaxEnumSetValue = 62
+
 type EnumField struct {
 	// Identifier is the name used in Go code to access the data.
 	Identifier string `json:"identifier,omitempty"`
@@ -32,6 +36,7 @@ type EnumField struct {
 // The resulting Go code will be a named integer type with constants for each value.
 // An EnumTable is processed with its data at compile time and cannot be modified by the application.
 // The values are stored in the database, but are not accessed by database queries.
+// For a set of values that can change while the application is running, use a Table with Lookup set instead.
 type EnumTable struct {
 	// Name is the name of the table in the database.
 	// By convention, the name should have the Database.EnumTableSuffix value as a suffix.
@@ -80,6 +85,11 @@ type EnumTable struct {
 	// Comment is a place to put a comment in the JSON description file.
 	// If the database driver supports it, it may be put in the database.
 	Comment string `json:"comment,omitempty"`
+
+	// Storage is how the columns that refer to the enum table store their values.
+	// Set to "key" to store the key of each value as a string, or "native" to use the database's native enum type.
+	// The default is to store the integer value.
+	Storage EnumStorage `json:"storage,omitempty"`
 }
 
 // QualifiedTableName returns the name of the table in the database, including its schema if applicable.
@@ -91,6 +101,56 @@ func (t *EnumTable) QualifiedTableName() string {
 	}
 }
 
+// NativeTypeName returns the name of the type created in databases that support named enum types
+// when Storage is EnumStorageNative.
+func (t *EnumTable) NativeTypeName() string {
+	return t.Name + "_type"
+}
+
+// Keys returns the keys of the values in the order they were defined.
+func (t *EnumTable) Keys() (keys []string) {
+	for _, vMap := range t.Values {
+		keys = append(keys, valueKey(vMap))
+	}
+	return
+}
+
+// KeyOf returns the key of the item with the given integer value, or an empty string if not found.
+func (t *EnumTable) KeyOf(value int) string {
+	for _, vMap := range t.Values {
+		if vMap[ValueKey] == value {
+			return valueKey(vMap)
+		}
+	}
+	return ""
+}
+
+// valueKey returns the key of the value described by vMap, which is generated from the name if not given.
+func valueKey(vMap map[string]any) string {
+	if k, ok := vMap[KeyKey].(string); ok {
+		return k
+	}
+	return strings2.CamelToSnake(vMap[NameKey].(string))
+}
+
+// MaxKeyLength returns the length of the longest key.
+func (t *EnumTable) MaxKeyLength() (size uint64) {
+	for _, k := range t.Keys() {
+		size = max(size, uint64(len(k)))
+	}
+	return
+}
+
+// checkSetValues returns an error if a value of the enum table cannot be stored in a ColTypeEnumSet column.
+func (t *EnumTable) checkSetValues() error {
+	for _, vMap := range t.Values {
+		if v := vMap[ValueKey].(int); v < 0 || v > MaxEnumSetValue {
+			return fmt.Errorf("the value %d of %s in enum table %s is not from 0 to %d", v, vMap[NameKey], t.Name, MaxEnumSetValue)
+		}
+	}
+	return nil
+}
+
 func (t *EnumTable) infer(db *Database) error {
 	if t.Name == "" {
 		return fmt.Errorf("enum table must have a name")
@@ -220,9 +280,7 @@ func (t *EnumTable) fillDefaults(suffix string) {
 		if _, ok := vMap[LabelKey]; !ok {
 			vMap[LabelKey] = strings2.Title(vMap[NameKey].(string))
 		}
-		if _, ok := vMap[KeyKey]; !ok {
-			vMap[KeyKey] = strings2.CamelToSnake(vMap[NameKey].(string))
-		}
+		vMap[KeyKey] = valueKey(vMap)
 	}
 }
 
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumTableStorage(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage string
+		colType ColumnType
+		wantErr bool
+	}{
+		{"integer", `"integer"`, ColTypeEnum, false},
+		{"key", `"key"`, ColTypeEnum, false},
+		{"native", `"native"`, ColTypeEnum, false},
+		{"integer array", `"integer"`, ColTypeEnumArray, false},
+		{"key array", `"key"`, ColTypeEnumArray, true},
+		{"native array", `"native"`, ColTypeEnumArray, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			et := &EnumTable{
+				Name: "status_enum",
+				Values: []map[string]any{
+					{NameKey: "Open"},
+					{NameKey: "InProgress"},
+					{NameKey: "Closed", KeyKey: "done"},
+				},
+			}
+			require.NoError(t, json.Unmarshal([]byte(tt.storage), &et.Storage))
+			db := &Database{
+				EnumTableSuffix: "_enum",
+				Tables: []*Table{{
+					Name: "task",
+					Columns: []*Column{
+						{Name: "id", Type: ColTypeAutoPrimaryKey},
+						{Name: "status", Type: tt.colType},
+					},
+				}},
+				EnumTables: []*EnumTable{et},
+			}
+			err := db.Clean()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"open", "in_progress", "done"}, et.Keys())
+			assert.Equal(t, "done", et.KeyOf(3))
+			assert.Equal(t, "", et.KeyOf(4))
+			assert.Equal(t, uint64(11), et.MaxKeyLength())
+
+			b, err := json.Marshal(et.Storage)
+			require.NoError(t, err)
+			assert.Equal(t, tt.storage, string(b))
+		})
+	}
+}
+
+func TestEnumTableSetValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int
+		wantErr bool
+	}{
+		{"zero", 0, false},
+		{"max", MaxEnumSetValue, false},
+		{"too big", MaxEnumSetValue + 1, true},
+		{"negative", -1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &Database{
+				EnumTableSuffix: "_enum",
+				Tables: []*Table{{
+					Name: "task",
+					Columns: []*Column{
+						{Name: "id", Type: ColTypeAutoPrimaryKey},
+						{Name: "flag", Type: ColTypeEnumSet},
+					},
+				}},
+				EnumTables: []*EnumTable{{
+					Name: "flag_enum",
+					Values: []map[string]any{
+						{NameKey: "First"},
+						{NameKey: "Other", ValueKey: tt.value},
+					},
+				}},
+			}
+			err := db.Clean()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
+// WriteJsonFile writes the schema to outFile in JSON format.
 func WriteJsonFile(schema *Database, outFile string) error {
 	// Serialize the struct to JSON with indentation for readability
 	jsonData, err := json.MarshalIndent(schema, "", "  ")
@@ -29,7 +35,61 @@ func WriteJsonFile(schema *Database, outFile string) error {
 	return nil
 }
 
+// WriteFile writes the schema to outFile. The format is determined by the extension of the file name,
+// as described in ReadFile.
+func WriteFile(schema *Database, outFile string) error {
+	if !isYamlFile(outFile) {
+		return WriteJsonFile(schema, outFile)
+	}
+	jsonData, err := json.Marshal(schema)
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON: %w", err)
+	}
+	// Decoding into a node, rather than a map, preserves the order of the keys.
+	var n yaml.Node
+	if err = yaml.Unmarshal(jsonData, &n); err != nil {
+		return fmt.Errorf("error converting to YAML: %w", err)
+	}
+	clearYamlStyle(&n)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err = enc.Encode(&n); err != nil {
+		return fmt.Errorf("error marshaling YAML: %w", err)
+	}
+	if err = os.WriteFile(outFile, buf.Bytes(), 0666); err != nil {
+		return fmt.Errorf("error writing to file %s: %w", outFile, err)
+	}
+	return nil
+}
+
+// ReadJsonFile reads a schema file.
+//
+// Deprecated: Use ReadFile, which reads the same files.
 func ReadJsonFile(infile string) (schema *Database, err error) {
+	return ReadFile(infile)
+}
+
+// ReadFile reads a schema file. The format is determined by the extension of the file name:
+//   - Files ending in .yaml or .yml are YAML, using the same keys as the JSON format.
+//   - Other files are JSON. The JSON may contain // and /* */ comments, and trailing commas after the
+//     last item of an object or array.
+//
+// The files listed in Database.Include are read and their tables are added to the returned schema.
+func ReadFile(infile string) (schema *Database, err error) {
+	return readFile(infile, nil)
+}
+
+func readFile(infile string, includedBy []string) (schema *Database, err error) {
+	path, err := filepath.Abs(infile)
+	if err != nil {
+		return
+	}
+	if slices.Contains(includedBy, path) {
+		return nil, fmt.Errorf("schema file %s includes itself", infile)
+	}
+
 	// Read the file's content
 	var data []byte
 	data, err = os.ReadFile(infile)
@@ -37,12 +97,45 @@ func ReadJsonFile(infile string) (schema *Database, err error) {
 		return
 	}
 
+	if isYamlFile(infile) {
+		if data, err = yamlToJson(data); err != nil {
+			return nil, fmt.Errorf("error reading schema file %s: %w", infile, err)
+		}
+	} else {
+		data = stripJsonComments(data)
+	}
+
+	if schema, err = decodeSchema(data); err != nil {
+		return nil, fmt.Errorf("error reading schema file %s: %w", infile, err)
+	}
+
+	for _, inc := range schema.Include {
+		if !filepath.IsAbs(inc) {
+			inc = filepath.Join(filepath.Dir(infile), inc)
+		}
+		var s2 *Database
+		if s2, err = readFile(inc, append(includedBy, path)); err != nil {
+			return nil, err
+		}
+		schema.Tables = append(schema.Tables, s2.Tables...)
+		schema.EnumTables = append(schema.EnumTables, s2.EnumTables...)
+		schema.AssociationTables = append(schema.AssociationTables, s2.AssociationTables...)
+		schema.Views = append(schema.Views, s2.Views...)
+	}
+	schema.Include = nil // the includes are now part of the schema
+	return
+}
+
+func decodeSchema(data []byte) (schema *Database, err error) {
 	decoder := json.NewDecoder(bytes.NewReader(data))
 	decoder.UseNumber()
 
 	if err = decoder.Decode(&schema); err != nil {
 		return
 	}
+	if schema == nil {
+		return nil, fmt.Errorf("schema is empty")
+	}
 
 	// Fix up interfaces
 	for _, t := range schema.Tables {
@@ -50,6 +143,16 @@ func ReadJsonFile(infile string) (schema *Database, err error) {
 			c.DefaultValue = fixVal(c.DefaultValue, c.Type, c.Size)
 		}
 	}
+	for _, v := range schema.Views {
+		for _, c := range v.Columns {
+			c.DefaultValue = fixVal(c.DefaultValue, c.Type, c.Size)
+		}
+	}
+	for _, t := range schema.AssociationTables {
+		for _, c := range t.Columns {
+			c.DefaultValue = fixVal(c.DefaultValue, c.Type, c.Size)
+		}
+	}
 	for _, t := range schema.EnumTables {
 		for rowidx, row := range t.Values {
 			for idx, f := range row {
@@ -61,6 +164,86 @@ func ReadJsonFile(infile string) (schema *Database, err error) {
 	return
 }
 
+func isYamlFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// yamlToJson converts YAML data to JSON so that it can be decoded using the json tags of the schema.
+func yamlToJson(data []byte) ([]byte, error) {
+	var v any
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// clearYamlStyle removes the JSON flow style from nodes that were decoded from JSON
+// so that they will be encoded in block style.
+func clearYamlStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		clearYamlStyle(c)
+	}
+}
+
+// stripJsonComments removes comments and trailing commas from JSON data.
+// Newlines are preserved so that the line numbers of errors are still correct.
+func stripJsonComments(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	var inString bool
+	lastComma := -1 // position in out of a comma that might be a trailing comma
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if inString {
+			out = append(out, c)
+			if c == '\\' && i+1 < len(data) {
+				i++
+				out = append(out, data[i])
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+		switch {
+		case c == '"':
+			inString = true
+			lastComma = -1
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			if i < len(data) {
+				out = append(out, '\n')
+			}
+			continue
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			i += 2
+			for i < len(data) && !(data[i] == '*' && i+1 < len(data) && data[i+1] == '/') {
+				if data[i] == '\n' {
+					out = append(out, '\n')
+				}
+				i++
+			}
+			i++ // skip the closing slash
+			continue
+		case c == ',':
+			lastComma = len(out)
+		case c == '}' || c == ']':
+			if lastComma >= 0 {
+				out[lastComma] = ' '
+			}
+			lastComma = -1
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		default:
+			lastComma = -1
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
 func fixVal(i interface{}, t ColumnType, size uint64) interface{} {
 	if n, ok := i.(json.Number); ok {
 		switch t {
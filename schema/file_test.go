@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFile(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.json", `{
+  // the main file
+  "key": "test",
+  "tables": [
+    {
+      "name": "person",
+      "columns": [
+        {"name": "id", "type": "auto_primary_key"},
+        {"name": "name", "type": "string", "size": 50, /* inline */ "default_value": "a // not a comment"},
+      ],
+    },
+  ],
+  "include": ["more/project.yaml"]
+}`)
+	writeTestFile(t, dir, "more/project.yaml", `
+include:
+  - enums.json
+tables:
+  - name: project
+    columns:
+      - name: id
+        type: auto_primary_key
+      - name: num
+        type: int
+        size: 32
+        default_value: 5
+`)
+	writeTestFile(t, dir, "more/enums.json", `{"enum_tables": [{"name": "status_enum", "values": [{"value": 1, "name": "Open"}]}]}`)
+
+	s, err := ReadFile(filepath.Join(dir, "main.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "test", s.Key)
+	require.Len(t, s.Tables, 2)
+	assert.Equal(t, "a // not a comment", s.Tables[0].Columns[1].DefaultValue)
+	assert.Equal(t, "project", s.Tables[1].Name)
+	assert.Equal(t, 5, s.Tables[1].Columns[1].DefaultValue)
+	require.Len(t, s.EnumTables, 1)
+	assert.Equal(t, "status_enum", s.EnumTables[0].Name)
+	assert.Nil(t, s.Include)
+
+	// round trip through yaml
+	out := filepath.Join(dir, "out.yml")
+	require.NoError(t, WriteFile(s, out))
+	s2, err := ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, s, s2)
+}
+
+func TestReadFileIncludeCycle(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "a.json", `{"key": "a", "include": ["b.json"]}`)
+	writeTestFile(t, dir, "b.json", `{"include": ["a.json"]}`)
+	_, err := ReadFile(filepath.Join(dir, "a.json"))
+	assert.ErrorContains(t, err, "includes itself")
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0777))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0666))
+}
@@ -1,10 +1,14 @@
 package schema
 
 import (
+	"fmt"
 	"log/slog"
+	"regexp"
 	"strings"
 )
 
+var functionNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 // Index declares that a Get or Load function should be created on the given columns in the table
 // and gives direction to the database to create an index on the columns.
 //
@@ -41,12 +45,49 @@ type Index struct {
 	// Should be CamelCase.
 	// A default will be generated from the column names if none is provided.
 	Identifier string `json:"identifier,omitempty"`
+	// Function is the name of a sql function that is applied to each column of the index to create an expression index.
+	// For example, "lower" on a string column with an IndexLevel of IndexLevelUnique creates a case-insensitive unique index.
+	// The generated Load, Has and Count functions apply the same function to the column and to the values given to them.
+	// MariaDB does not support expression indexes, and will skip the index.
+	Function string `json:"function,omitempty"`
+	// Where is a sql condition that creates a partial index, so that only the records that satisfy the condition
+	// are indexed. For example, "deleted_at IS NULL".
+	// Since more than one record can have the same values in a unique partial index, the generated accessors
+	// will be the same as those of a non-unique index.
+	// MySQL does not support partial indexes, and will skip the index.
+	Where string `json:"where,omitempty"`
+	// WhereSql contains conditions that replace Where for particular databases.
+	// The key is a db.DriverType constant.
+	WhereSql map[string]string `json:"where_sql,omitempty"`
 }
 
-func (i *Index) infer(t *Table) {
+// WhereFor returns the condition of a partial index for the given db.DriverType, or
+// an empty string if the index is not partial in that database.
+func (i *Index) WhereFor(driverType string) string {
+	if s, ok := i.WhereSql[driverType]; ok {
+		return s
+	}
+	return i.Where
+}
+
+// IsPartial returns true if the index is a partial index in any database.
+func (i *Index) IsPartial() bool {
+	return i.Where != "" || len(i.WhereSql) > 0
+}
+
+func (i *Index) infer(t *Table) error {
 	if i.Name == "" {
 		i.Name = t.Name + "_" + strings.Join(i.Columns, "_") + "_idx"
 	}
+	if i.Function != "" || i.IsPartial() {
+		if i.IndexLevel != IndexLevelUnique && i.IndexLevel != IndexLevelIndexed {
+			return fmt.Errorf("index %s in table %s must be a unique or indexed index to have a function or where condition", i.Name, t.QualifiedName())
+		}
+	}
+	if i.Function != "" && !functionNameRegex.MatchString(i.Function) {
+		return fmt.Errorf("index %s in table %s has an invalid function name %q", i.Name, t.QualifiedName(), i.Function)
+	}
+	return nil
 }
 
 func (i *Index) fillDefaults(t *Table) {
@@ -26,6 +26,12 @@ import (
 // IndexLevelPrimaryKey indicates that this is a private key.
 // Only one column or one index can be specified with this index level, in a table.
 // Use an Index to specify a composite primary key.
+//
+// IndexLevelFullText creates a full-text search index on a string column, which can then be searched
+// using op.Match and ranked using op.MatchScore. No LoadByXXX function is generated for these indexes.
+// MySQL uses a FULLTEXT index, Postgres uses a GIN index on a tsvector of the column, and SQLite
+// uses an FTS5 shadow table that is kept synchronized with triggers.
+// A full-text index can only be placed on a single column.
 type IndexLevel int
 
 const (
@@ -33,6 +39,7 @@ const (
 	IndexLevelIndexed
 	IndexLevelUnique
 	IndexLevelPrimaryKey
+	IndexLevelFullText
 )
 
 func (il IndexLevel) String() string {
@@ -45,6 +52,8 @@ func (il IndexLevel) String() string {
 		return "Unique"
 	case IndexLevelPrimaryKey:
 		return "Primary"
+	case IndexLevelFullText:
+		return "FullText"
 	default:
 		return "Unknown"
 	}
@@ -65,6 +74,8 @@ func (il IndexLevel) jsonRep() string {
 		return "unique"
 	case IndexLevelPrimaryKey:
 		return "primary"
+	case IndexLevelFullText:
+		return "full_text"
 	default:
 		return "unknown"
 	}
@@ -86,6 +97,8 @@ func (il *IndexLevel) UnmarshalJSON(data []byte) error {
 		*il = IndexLevelUnique
 	case "primary":
 		*il = IndexLevelPrimaryKey
+	case "full_text":
+		*il = IndexLevelFullText
 	default:
 		return fmt.Errorf("invalid IndexLevel: %s", levelStr)
 	}
@@ -0,0 +1,599 @@
+package schema
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	strings2 "github.com/goradd/strings"
+	"github.com/kenshaw/snaker"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultReferenceSuffix is the suffix that Lint expects at the end of the names of reference columns
+// if LintOptions.ReferenceSuffix is not set. It matches the default reference_suffix database setting.
+const DefaultReferenceSuffix = "_id"
+
+// Severity is the seriousness of a problem found by Lint.
+type Severity string
+
+const (
+	// SeverityError means the schema cannot be used to generate code or create a database.
+	SeverityError Severity = "error"
+	// SeverityWarning means the schema will work, but is likely to cause problems.
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic describes a problem found in a schema by Lint.
+type Diagnostic struct {
+	// File is the schema file that the problem is in. It will be empty if the schema was not read from a file.
+	File string `json:"file,omitempty"`
+	// Line is the 1-based line in File of the item that has the problem, or zero if not known.
+	Line int `json:"line,omitempty"`
+	// Column is the 1-based column in File of the item that has the problem, or zero if not known.
+	Column int `json:"column,omitempty"`
+	// Severity is the seriousness of the problem.
+	Severity Severity `json:"severity"`
+	// Path identifies the item in the schema, like "tables/person/references/0".
+	Path string `json:"path"`
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// String returns the diagnostic in the file:line:column: severity: message format understood by most editors.
+func (d Diagnostic) String() string {
+	var loc string
+	if d.File != "" {
+		loc = d.File + ":"
+		if d.Line > 0 {
+			loc += strconv.Itoa(d.Line) + ":"
+			if d.Column > 0 {
+				loc += strconv.Itoa(d.Column) + ":"
+			}
+		}
+		loc += " "
+	} else if d.Path != "" {
+		loc = d.Path + ": "
+	}
+	return loc + string(d.Severity) + ": " + d.Message
+}
+
+// LintOptions are the settings used by Lint.
+type LintOptions struct {
+	// ReferenceSuffix is the suffix that the names of reference columns should end with.
+	// Defaults to DefaultReferenceSuffix.
+	ReferenceSuffix string
+}
+
+// LintFile reads the schema file and its included files and returns all the problems found in the schema,
+// with the location of each problem filled in. See Database.Lint.
+//
+// An error is returned if the files cannot be read.
+func LintFile(infile string, options LintOptions) ([]Diagnostic, error) {
+	db, err := ReadFile(infile)
+	if err != nil {
+		return nil, err
+	}
+	diags := db.Lint(options)
+
+	locations := make(map[string]location)
+	readLocations(infile, locations)
+	for i := range diags {
+		diags[i].setLocation(infile, locations)
+	}
+	return diags, nil
+}
+
+// Lint checks the schema for problems and returns all that it finds.
+// Unlike Clean, it does not stop at the first error.
+//
+// Besides the errors that Clean would report, like circles of references that are not nullable, it finds:
+//   - Tables, enum tables, association tables and views that have the same name.
+//   - Identifiers that are not valid in Go or that are reserved.
+//   - Identifiers that collide in the same generated object, including those of reverse references.
+//   - Enum columns that are not indexed. Reference columns and the columns of association tables are not
+//     checked, since an index is always created for them.
+//   - Reference columns that do not follow the naming convention of options.ReferenceSuffix,
+//     and other columns that look like reference columns.
+//
+// Lint cleans and fills in the defaults of db as part of its checks, so db should not be used afterward.
+func (db *Database) Lint(options LintOptions) (diags []Diagnostic) {
+	if options.ReferenceSuffix == "" {
+		options.ReferenceSuffix = DefaultReferenceSuffix
+	}
+	diags = append(diags, db.lintDuplicateNames()...)
+	diags = append(diags, db.lintCircularReferences()...)
+	db.Sort()
+	valid, inferDiags := db.lintInfer()
+	diags = append(diags, inferDiags...)
+
+	diags = append(diags, valid.lintIdentifiers()...)
+	diags = append(diags, valid.lintCollisions()...)
+	diags = append(diags, valid.lintIndexes()...)
+	diags = append(diags, valid.lintReferenceNames(options.ReferenceSuffix)...)
+	return
+}
+
+func (db *Database) lintDuplicateNames() (diags []Diagnostic) {
+	found := make(map[string]string)
+	check := func(name, path string) {
+		if prev, ok := found[name]; ok {
+			diags = append(diags, Diagnostic{
+				Severity: SeverityError,
+				Path:     path,
+				Message:  fmt.Sprintf("the name %q is already used by %s", name, prev),
+			})
+		} else {
+			found[name] = path
+		}
+	}
+	for _, t := range db.Tables {
+		check(t.QualifiedName(), tablePath(t))
+	}
+	for _, t := range db.EnumTables {
+		check(t.QualifiedTableName(), "enum_tables/"+t.QualifiedTableName())
+	}
+	for _, t := range db.AssociationTables {
+		check(t.QualifiedTableName(), "association_tables/"+t.QualifiedTableName())
+	}
+	for _, v := range db.Views {
+		check(v.QualifiedName(), "views/"+v.QualifiedName())
+	}
+	return
+}
+
+// lintCircularReferences finds circles of references that are not nullable.
+// The first record of any table in such a circle cannot be saved, since it would need to refer to a record that
+// cannot exist yet.
+func (db *Database) lintCircularReferences() (diags []Diagnostic) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	type step struct {
+		table  *Table
+		refIdx int
+	}
+	state := make(map[*Table]int)
+	var stack []step
+
+	var visit func(t *Table)
+	visit = func(t *Table) {
+		state[t] = visiting
+		for i, r := range t.References {
+			if r.IsNullable || r.IsPolymorphic() {
+				continue // polymorphic references do not have foreign key constraints
+			}
+			t2 := db.FindTable(r.Table)
+			if t2 == nil {
+				continue // reported when inferring
+			}
+			stack = append(stack, step{t, i})
+			switch state[t2] {
+			case unvisited:
+				visit(t2)
+			case visiting:
+				start := slices.IndexFunc(stack, func(s step) bool { return s.table == t2 })
+				var names []string
+				for _, s := range stack[start:] {
+					names = append(names, s.table.QualifiedName())
+				}
+				names = append(names, t2.QualifiedName())
+				diags = append(diags, Diagnostic{
+					Severity: SeverityError,
+					Path:     referencePath(t, i),
+					Message: fmt.Sprintf("circular reference %s: at least one of the references must be nullable",
+						strings.Join(names, " -> ")),
+				})
+			}
+			stack = stack[:len(stack)-1]
+		}
+		state[t] = visited
+	}
+
+	for _, t := range db.Tables {
+		if state[t] == unvisited {
+			visit(t)
+		}
+	}
+	return
+}
+
+// lintInfer does the same processing as infer, but continues past errors.
+// It returns a database containing the items that were inferred without errors and that only refer
+// to other such items, so that defaults can be filled in.
+func (db *Database) lintInfer() (valid *Database, diags []Diagnostic) {
+	valid = &Database{
+		Key:             db.Key,
+		EnumTableSuffix: db.EnumTableSuffix,
+		AssnTableSuffix: db.AssnTableSuffix,
+	}
+	if valid.EnumTableSuffix == "" {
+		valid.EnumTableSuffix = "_enum"
+	}
+	if valid.AssnTableSuffix == "" {
+		valid.AssnTableSuffix = "_assn"
+	}
+	addError := func(path string, err error) {
+		diags = append(diags, Diagnostic{Severity: SeverityError, Path: path, Message: err.Error()})
+	}
+
+	for _, t := range db.Tables {
+		if err := t.Clean(db); err != nil {
+			addError(tablePath(t), err)
+		} else {
+			valid.Tables = append(valid.Tables, t)
+		}
+	}
+	// Remove the tables that refer to tables with errors, since their defaults cannot be determined.
+	for {
+		n := len(valid.Tables)
+		valid.Tables = slices.DeleteFunc(valid.Tables, func(t *Table) bool {
+			return !valid.refersToValidTables(t.References)
+		})
+		if len(valid.Tables) == n {
+			break
+		}
+	}
+
+	for _, t := range db.AssociationTables {
+		if err := t.infer(db); err != nil {
+			addError("association_tables/"+t.QualifiedTableName(), err)
+		} else if valid.FindTable(t.Ref1.Table) != nil && valid.FindTable(t.Ref2.Table) != nil {
+			valid.AssociationTables = append(valid.AssociationTables, t)
+		}
+	}
+
+	for _, t := range db.EnumTables {
+		if err := t.infer(db); err != nil {
+			addError("enum_tables/"+t.QualifiedTableName(), err)
+		} else {
+			valid.EnumTables = append(valid.EnumTables, t)
+		}
+	}
+
+	for _, v := range db.Views {
+		if err := v.infer(db); err != nil {
+			addError("views/"+v.QualifiedName(), err)
+		} else if valid.refersToValidTables(v.References) {
+			valid.Views = append(valid.Views, v)
+		}
+	}
+
+	// Same as FillDefaults, but without changing the package
+	for _, t := range valid.Tables {
+		t.fillDefaults(valid)
+	}
+	for _, t := range valid.EnumTables {
+		t.fillDefaults(valid.EnumTableSuffix)
+	}
+	for _, t := range valid.AssociationTables {
+		t.fillDefaults(valid)
+	}
+	for _, v := range valid.Views {
+		v.fillDefaults(valid)
+	}
+	return
+}
+
+func (db *Database) refersToValidTables(refs []*Reference) bool {
+	for _, r := range refs {
+		for _, name := range r.ReferencedTables() {
+			if db.FindTable(name) == nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// lintIdentifiers finds identifiers that will not compile or that conflict with generated code.
+func (db *Database) lintIdentifiers() (diags []Diagnostic) {
+	check := func(path, kind, identifier string) {
+		var msg string
+		if !token.IsIdentifier(identifier) {
+			msg = fmt.Sprintf("%s %q is not a valid Go identifier", kind, identifier)
+		} else if isReservedIdentifier(identifier) {
+			msg = fmt.Sprintf("%s %q is reserved in Go or in the generated code", kind, identifier)
+		} else {
+			return
+		}
+		diags = append(diags, Diagnostic{Severity: SeverityError, Path: path, Message: msg})
+	}
+	checkTable := func(t *Table, path string) {
+		check(path, "identifier", t.Identifier)
+		check(path, "plural identifier", t.IdentifierPlural)
+		for _, c := range t.Columns {
+			check(columnPath(path, c), "column identifier", c.Identifier)
+		}
+		for i, r := range t.References {
+			p := path + "/references/" + strconv.Itoa(i)
+			check(p, "column identifier", r.ColumnIdentifier)
+			check(p, "object identifier", r.ObjectIdentifier)
+			if !t.IsView() {
+				check(p, "reverse identifier", r.ReverseIdentifier)
+				check(p, "plural reverse identifier", r.ReverseIdentifierPlural)
+			}
+		}
+		for i, idx := range t.Indexes {
+			if idx.Identifier != "" {
+				check(path+"/indexes/"+strconv.Itoa(i), "index identifier", idx.Identifier)
+			}
+		}
+	}
+
+	for _, t := range db.Tables {
+		checkTable(t, tablePath(t))
+	}
+	for _, v := range db.Views {
+		checkTable(v.Table(), "views/"+v.QualifiedName())
+	}
+	for _, t := range db.EnumTables {
+		path := "enum_tables/" + t.QualifiedTableName()
+		check(path, "identifier", t.Identifier)
+		check(path, "plural identifier", t.IdentifierPlural)
+		for _, k := range t.FieldKeys() {
+			if k != LabelKey {
+				check(path, "field identifier", t.Fields[k].Identifier)
+			}
+		}
+	}
+	for _, t := range db.AssociationTables {
+		path := "association_tables/" + t.QualifiedTableName()
+		for _, r := range []*AssociationReference{&t.Ref1, &t.Ref2} {
+			check(path, "identifier", r.Identifier)
+			check(path, "plural identifier", r.IdentifierPlural)
+		}
+	}
+	return
+}
+
+// isReservedIdentifier returns true if the identifier, or the lower case version of it that the code generator
+// uses for variables, is a Go keyword or one of the reservedWords.
+func isReservedIdentifier(identifier string) bool {
+	if _, ok := reservedWords[strings2.CamelToSnake(identifier)]; ok {
+		return true
+	}
+	return token.IsKeyword(strings.ToLower(identifier[:1]) + identifier[1:])
+}
+
+// lintCollisions finds identifiers that would create the same accessor in a generated object.
+func (db *Database) lintCollisions() (diags []Diagnostic) {
+	type owner struct {
+		desc string
+		path string
+	}
+	names := make(map[*Table]map[string]owner)
+	add := func(t *Table, identifier, desc, path string) {
+		if names[t] == nil {
+			names[t] = make(map[string]owner)
+		}
+		if prev, ok := names[t][identifier]; ok {
+			if prev.path == path {
+				return // the same item uses the identifier twice, like a reference to a table of the same name
+			}
+			diags = append(diags, Diagnostic{
+				Severity: SeverityError,
+				Path:     path,
+				Message: fmt.Sprintf("identifier %q of %s collides with %s in %s",
+					identifier, desc, prev.desc, t.Identifier),
+			})
+		} else {
+			names[t][identifier] = owner{desc, path}
+		}
+	}
+
+	tables := slices.Clone(db.Tables)
+	for _, v := range db.Views {
+		tables = append(tables, v.Table())
+	}
+	for _, t := range tables {
+		path := tablePath(t)
+		if t.IsView() {
+			path = "views/" + t.QualifiedName()
+		}
+		for _, c := range t.Columns {
+			add(t, c.Identifier, fmt.Sprintf("column %q", c.Name), columnPath(path, c))
+		}
+		for i, r := range t.References {
+			p := path + "/references/" + strconv.Itoa(i)
+			add(t, r.ColumnIdentifier, fmt.Sprintf("reference column %q", r.Column), p)
+			add(t, r.ObjectIdentifier, fmt.Sprintf("reference %q", r.Column), p)
+			if r.IsPolymorphic() {
+				add(t, snaker.SnakeToCamelIdentifier(r.TypeColumn), fmt.Sprintf("reference type column %q", r.TypeColumn), p)
+			}
+		}
+	}
+	for _, t := range db.Tables {
+		for i, r := range t.References {
+			identifier := r.ReverseIdentifierPlural
+			if r.IndexLevel == IndexLevelUnique || r.IndexLevel == IndexLevelPrimaryKey {
+				identifier = r.ReverseIdentifier
+			}
+			for _, name := range r.ReferencedTables() {
+				add(db.FindTable(name), identifier,
+					fmt.Sprintf("reverse reference from %s.%s", t.QualifiedName(), r.Column),
+					referencePath(t, i))
+			}
+		}
+	}
+	for _, a := range db.AssociationTables {
+		path := "association_tables/" + a.QualifiedTableName()
+		desc := fmt.Sprintf("association %s", a.QualifiedTableName())
+		add(db.FindTable(a.Ref1.Table), a.Ref2.IdentifierPlural, desc, path)
+		add(db.FindTable(a.Ref2.Table), a.Ref1.IdentifierPlural, desc, path)
+	}
+	return
+}
+
+// lintIndexes finds enum columns that are not indexed. Enum columns are frequently used to select records,
+// and without an index the database will need to scan the whole table.
+//
+// Only enum columns are checked. Reference columns are indexed according to their IndexLevel, which defaults
+// to IndexLevelIndexed, and each column of an association table gets its own index, so neither can be missing
+// an index.
+func (db *Database) lintIndexes() (diags []Diagnostic) {
+	for _, t := range db.Tables {
+		for _, c := range t.Columns {
+			if c.Type != ColTypeEnum || t.hasLeadingIndex(c.Name) {
+				continue
+			}
+			diags = append(diags, Diagnostic{
+				Severity: SeverityWarning,
+				Path:     columnPath(tablePath(t), c),
+				Message: fmt.Sprintf("enum column %q refers to enum table %s but is not indexed",
+					c.Name, c.EnumTable),
+			})
+		}
+	}
+	return
+}
+
+// hasLeadingIndex returns true if an index that can be used to search on the column starts with the column.
+func (t *Table) hasLeadingIndex(col string) bool {
+	return slices.ContainsFunc(t.Indexes, func(i *Index) bool {
+		return len(i.Columns) > 0 && i.Columns[0] == col &&
+			i.Function == "" && !i.IsPartial() && i.IndexLevel != IndexLevelFullText
+	})
+}
+
+// lintReferenceNames finds reference columns that do not end with the suffix,
+// and other columns that do.
+func (db *Database) lintReferenceNames(suffix string) (diags []Diagnostic) {
+	for _, t := range db.Tables {
+		for i, r := range t.References {
+			if r == t.BaseReference() {
+				continue // named after the primary key of the base table
+			}
+			if !strings.HasSuffix(r.Column, suffix) {
+				diags = append(diags, Diagnostic{
+					Severity: SeverityWarning,
+					Path:     referencePath(t, i),
+					Message:  fmt.Sprintf("reference column %q does not end with the reference suffix %q", r.Column, suffix),
+				})
+			}
+		}
+		for _, c := range t.Columns {
+			if strings.HasSuffix(c.Name, suffix) && c.IndexLevel != IndexLevelPrimaryKey && c.Type != ColTypeAutoPrimaryKey {
+				diags = append(diags, Diagnostic{
+					Severity: SeverityWarning,
+					Path:     columnPath(tablePath(t), c),
+					Message:  fmt.Sprintf("column %q ends with the reference suffix %q but is not a reference", c.Name, suffix),
+				})
+			}
+		}
+	}
+	return
+}
+
+func tablePath(t *Table) string {
+	return "tables/" + t.QualifiedName()
+}
+
+func columnPath(tablePath string, c *Column) string {
+	return tablePath + "/columns/" + c.Name
+}
+
+func referencePath(t *Table, i int) string {
+	return tablePath(t) + "/references/" + strconv.Itoa(i)
+}
+
+// location is a position in a schema file.
+type location struct {
+	file   string
+	line   int
+	column int
+}
+
+// setLocation sets the file position of the diagnostic to the position of the closest item in its path.
+func (d *Diagnostic) setLocation(rootFile string, locations map[string]location) {
+	p := d.Path
+	for {
+		if loc, ok := locations[p]; ok {
+			d.File, d.Line, d.Column = loc.file, loc.line, loc.column
+			return
+		}
+		i := strings.LastIndexByte(p, '/')
+		if i < 0 {
+			break
+		}
+		p = p[:i]
+	}
+	d.File = rootFile
+}
+
+// readLocations records the positions of the items in the schema file and the files it includes.
+// Files that cannot be parsed are skipped, since ReadFile will have already reported them.
+// JSON files are parsed as YAML, which is a superset of JSON.
+func readLocations(infile string, locations map[string]location) {
+	data, err := os.ReadFile(infile)
+	if err != nil {
+		return
+	}
+	if !isYamlFile(infile) {
+		data = stripJsonComments(data) // preserves line numbers
+	}
+	var doc yaml.Node
+	if err = yaml.Unmarshal(data, &doc); err != nil || len(doc.Content) == 0 {
+		return
+	}
+	root := doc.Content[0]
+
+	set := func(path string, n *yaml.Node) {
+		if _, ok := locations[path]; !ok {
+			locations[path] = location{infile, n.Line, n.Column}
+		}
+	}
+	qualifiedName := func(n *yaml.Node) string {
+		name := yamlMapValue(n, "name").Value
+		if s := yamlMapValue(n, "schema").Value; s != "" {
+			return s + "." + name
+		}
+		return name
+	}
+	for _, section := range []string{"tables", "views"} {
+		for _, t := range yamlMapValue(root, section).Content {
+			path := section + "/" + qualifiedName(t)
+			set(path, t)
+			for _, c := range yamlMapValue(t, "columns").Content {
+				set(path+"/columns/"+yamlMapValue(c, "name").Value, c)
+			}
+			for i, r := range yamlMapValue(t, "references").Content {
+				set(path+"/references/"+strconv.Itoa(i), r)
+			}
+			for i, idx := range yamlMapValue(t, "indexes").Content {
+				set(path+"/indexes/"+strconv.Itoa(i), idx)
+			}
+		}
+	}
+	for _, section := range []string{"enum_tables", "association_tables"} {
+		for _, t := range yamlMapValue(root, section).Content {
+			set(section+"/"+qualifiedName(t), t)
+		}
+	}
+
+	for _, inc := range yamlMapValue(root, "include").Content {
+		p := inc.Value
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(filepath.Dir(infile), p)
+		}
+		readLocations(p, locations)
+	}
+}
+
+// yamlMapValue returns the value of key in the mapping node n, or an empty node if not found.
+func yamlMapValue(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == key {
+				return n.Content[i+1]
+			}
+		}
+	}
+	return &yaml.Node{}
+}
@@ -0,0 +1,130 @@
+package schema
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintFile(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.json", `{
+  "key": "test",
+  "tables": [
+    {
+      "name": "person",
+      "columns": [
+        {"name": "id", "type": "auto_primary_key"},
+        {"name": "type", "type": "string", "size": 10}
+      ],
+      "references": [
+        {"table": "team", "column": "team_id"}
+      ]
+    },
+    {
+      "name": "team",
+      "columns": [
+        {"name": "id", "type": "auto_primary_key"},
+        {"name": "owner_id", "type": "int"}
+      ],
+      "references": [
+        {"table": "person", "column": "leader"}
+      ]
+    },
+    {
+      "name": "task",
+      "columns": [
+        {"name": "id", "type": "auto_primary_key"},
+        {"name": "status", "type": "enum"}
+      ]
+    }
+  ],
+  "include": ["more.yaml"]
+}`)
+	writeTestFile(t, dir, "more.yaml", `
+tables:
+  - name: project
+    columns:
+      - name: id
+        type: auto_primary_key
+      - name: state
+        type: enum
+        enum_table: state_enum
+    references:
+      - table: person
+        column: manager_id
+        nullable: true
+        reverse_identifier: Project
+      - table: person
+        column: member_id
+        nullable: true
+        reverse_identifier: Project
+enum_tables:
+  - name: state_enum
+    values:
+      - name: Open
+`)
+
+	main := filepath.Join(dir, "main.json")
+	more := filepath.Join(dir, "more.yaml")
+	diags, err := LintFile(main, LintOptions{})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []Diagnostic{
+		{File: main, Line: 21, Column: 9, Severity: SeverityError, Path: "tables/team/references/0",
+			Message: "circular reference person -> team -> person: at least one of the references must be nullable"},
+		{File: main, Line: 24, Column: 5, Severity: SeverityError, Path: "tables/task",
+			Message: "enum table was not specified and could not be inferred from table task, column status"},
+		{File: main, Line: 8, Column: 9, Severity: SeverityError, Path: "tables/person/columns/type",
+			Message: `column identifier "Type" is reserved in Go or in the generated code`},
+		{File: main, Line: 21, Column: 9, Severity: SeverityWarning, Path: "tables/team/references/0",
+			Message: `reference column "leader" does not end with the reference suffix "_id"`},
+		{File: main, Line: 18, Column: 9, Severity: SeverityWarning, Path: "tables/team/columns/owner_id",
+			Message: `column "owner_id" ends with the reference suffix "_id" but is not a reference`},
+		{File: more, Line: 15, Column: 9, Severity: SeverityError, Path: "tables/project/references/1",
+			Message: `identifier "Projects" of reverse reference from project.member_id collides with reverse reference from project.manager_id in Person`},
+		{File: more, Line: 7, Column: 9, Severity: SeverityWarning, Path: "tables/project/columns/state",
+			Message: `enum column "state" refers to enum table state_enum but is not indexed`},
+	}, diags)
+
+	for _, d := range diags {
+		if d.Path == "tables/task" {
+			assert.Equal(t, main+":24:5: error: enum table was not specified and could not be inferred from table task, column status", d.String())
+		}
+	}
+}
+
+func TestSortCircularReferences(t *testing.T) {
+	db := &Database{
+		Tables: []*Table{
+			{Name: "b", References: []*Reference{{Table: "a", IsNullable: true}}},
+			{Name: "a", References: []*Reference{{Table: "b"}}},
+			{Name: "c"},
+		},
+	}
+	db.Sort()
+	require.Len(t, db.Tables, 3)
+	assert.Equal(t, "c", db.Tables[0].Name)
+	assert.Equal(t, "a", db.Tables[1].Name)
+	assert.Equal(t, "b", db.Tables[2].Name)
+}
+
+func TestCleanCircularReferences(t *testing.T) {
+	db := &Database{
+		Tables: []*Table{
+			{Name: "b", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}},
+				References: []*Reference{{Table: "a", Column: "a_id"}}},
+			{Name: "a", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}},
+				References: []*Reference{{Table: "b", Column: "b_id"}}},
+		},
+	}
+	err := db.Clean()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "circular reference a -> b -> a")
+
+	// A nullable reference breaks the circle
+	db.Tables[0].References[0].IsNullable = true
+	assert.NoError(t, db.Clean())
+}
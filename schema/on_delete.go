@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// OnDeleteAction specifies what happens to the objects that refer to an object through a Reference
+// when the referenced object is deleted.
+//
+// OnDeleteDefault is the default, and is the same as OnDeleteSetNull if the reference is nullable,
+// and OnDeleteCascade if it is not. No action is specified in the foreign key definition of the database,
+// and the generated Delete function does the work.
+//
+// OnDeleteCascade deletes the referring objects.
+//
+// OnDeleteSetNull sets the reference of the referring objects to NULL. The reference must be nullable.
+//
+// OnDeleteRestrict prevents the delete if any objects refer to the object. The generated Delete
+// function will return a db.ReferenceRestrictedError that lists the referring objects.
+//
+// OnDeleteNoAction leaves the referring objects alone, and lets the database decide what to do.
+// Databases that enforce foreign keys will return an error if any objects refer to the deleted object,
+// but may defer the check to the end of the transaction.
+//
+// Actions other than OnDeleteDefault are also added to the foreign key definition of the database,
+// so that deletes done outside the ORM behave the same way.
+type OnDeleteAction int
+
+const (
+	OnDeleteDefault OnDeleteAction = iota
+	OnDeleteCascade
+	OnDeleteSetNull
+	OnDeleteRestrict
+	OnDeleteNoAction
+)
+
+func (a OnDeleteAction) String() string {
+	switch a {
+	case OnDeleteDefault:
+		return "Default"
+	case OnDeleteCascade:
+		return "Cascade"
+	case OnDeleteSetNull:
+		return "SetNull"
+	case OnDeleteRestrict:
+		return "Restrict"
+	case OnDeleteNoAction:
+		return "NoAction"
+	default:
+		return "Unknown"
+	}
+}
+
+// Sql returns the action as used in the ON DELETE clause of a foreign key definition,
+// or an empty string for OnDeleteDefault.
+func (a OnDeleteAction) Sql() string {
+	switch a {
+	case OnDeleteCascade:
+		return "CASCADE"
+	case OnDeleteSetNull:
+		return "SET NULL"
+	case OnDeleteRestrict:
+		return "RESTRICT"
+	case OnDeleteNoAction:
+		return "NO ACTION"
+	default:
+		return ""
+	}
+}
+
+// MarshalJSON implements custom JSON serialization for OnDeleteAction
+func (a OnDeleteAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.jsonRep())
+}
+
+func (a OnDeleteAction) jsonRep() string {
+	switch a {
+	case OnDeleteDefault:
+		return "default"
+	case OnDeleteCascade:
+		return "cascade"
+	case OnDeleteSetNull:
+		return "set_null"
+	case OnDeleteRestrict:
+		return "restrict"
+	case OnDeleteNoAction:
+		return "no_action"
+	default:
+		return "unknown"
+	}
+}
+
+// UnmarshalJSON implements custom JSON deserialization for OnDeleteAction
+func (a *OnDeleteAction) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	switch s {
+	case "default", "":
+		*a = OnDeleteDefault
+	case "cascade":
+		*a = OnDeleteCascade
+	case "set_null":
+		*a = OnDeleteSetNull
+	case "restrict":
+		*a = OnDeleteRestrict
+	case "no_action":
+		*a = OnDeleteNoAction
+	default:
+		return fmt.Errorf("invalid OnDeleteAction: %s", s)
+	}
+
+	return nil
+}
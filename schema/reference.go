@@ -18,12 +18,22 @@ import (
 // create a column in the other table, or may be a kind of virtual column that only gets populated during
 // a query using an index.
 //
+// A polymorphic reference, created by setting Tables instead of Table, can point to an object in one
+// of several tables. It is stored in two columns: TypeColumn holds the name of the table of the referenced object,
+// and Column holds its primary key. Since a database cannot enforce such a reference with a foreign key,
+// no constraint is created, and the ORM maintains the reference.
+//
 // References to tables with composite keys are not currently supported.
 type Reference struct {
 	// Table is the name of the table being referenced.
 	// If Table is the same as the column's table, it creates a parent-child relationship.
 	// Should match a Table.Name value in another table.
-	Table string `json:"table"`
+	Table string `json:"table,omitempty"`
+
+	// Tables are the names of the tables that a polymorphic reference can point to.
+	// Specify either Table or Tables, but not both.
+	// All the tables must have a single primary key column of the same type.
+	Tables []string `json:"tables,omitempty"`
 
 	// Schema is the schema that Table belongs to. If empty, will use the default schema.
 	Schema string `json:"schema,omitempty"`
@@ -33,6 +43,10 @@ type Reference struct {
 	// It will default to a name based on Table and the name of the primary key column in Table.
 	Column string `json:"column,omitempty"`
 
+	// TypeColumn is the name of the local column of a polymorphic reference that holds the name of the table
+	// of the referenced object. It will default to Column with the primary key suffix replaced by "_type".
+	TypeColumn string `json:"type_column,omitempty"`
+
 	// ColumnIdentifier is the name that Go will use to identify the column
 	ColumnIdentifier string `json:"column_identifier,omitempty"`
 
@@ -84,9 +98,55 @@ type Reference struct {
 	// The plural description of Table objects as referred to by the referenced table.
 	// If not specified, the ReverseLabel will be pluralized.
 	ReverseLabelPlural string `json:"reverse_label_plural,omitempty"`
+
+	// OrderColumn is the name of an int column in this table that holds the position of each object
+	// in the list of reverse relationship objects of the referenced table.
+	// If specified, setting the reverse relationship objects will save their positions in the order given,
+	// and loading them will return them in that order. Cannot be used with a unique reference.
+	OrderColumn string `json:"order_column,omitempty"`
+
+	// OnDelete specifies what happens to the objects in this table when the object they refer to is deleted.
+	// If not specified, the objects will have the reference set to NULL if it is nullable, and will
+	// be deleted if it is not. See OnDeleteAction.
+	OnDelete OnDeleteAction `json:"on_delete,omitempty"`
+}
+
+// DeleteAction returns the action that will be taken on the objects in the table of the reference when
+// the referenced object is deleted. Unlike OnDelete, it is never OnDeleteDefault.
+func (r *Reference) DeleteAction() OnDeleteAction {
+	if r.OnDelete != OnDeleteDefault {
+		return r.OnDelete
+	}
+	if r.IsNullable {
+		return OnDeleteSetNull
+	}
+	return OnDeleteCascade
+}
+
+// PolymorphicTypeSize is the size of the type column of a polymorphic reference,
+// which holds the name of the table of the referenced object.
+const PolymorphicTypeSize = 100
+
+// IsPolymorphic returns true if the reference can point to objects in more than one table.
+func (r *Reference) IsPolymorphic() bool {
+	return len(r.Tables) > 0
+}
+
+// ReferencedTables returns the names of the tables the reference can point to.
+func (r *Reference) ReferencedTables() []string {
+	if r.IsPolymorphic() {
+		return r.Tables
+	}
+	return []string{r.Table}
 }
 
 func (r *Reference) infer(db *Database, table *Table) error {
+	if r.OnDelete == OnDeleteSetNull && !r.IsNullable {
+		return fmt.Errorf("reference %s in table %s must be nullable to set it to null on delete", r.Column, table.Name)
+	}
+	if r.IsPolymorphic() {
+		return r.inferPolymorphic(db, table)
+	}
 
 	// Double check some of the values
 	if r.Table == "" {
@@ -124,6 +184,61 @@ func (r *Reference) infer(db *Database, table *Table) error {
 	if r.IndexLevel == IndexLevelNone {
 		r.IndexLevel = IndexLevelIndexed
 	}
+
+	if r.OrderColumn != "" {
+		if r.IndexLevel == IndexLevelUnique || r.IndexLevel == IndexLevelPrimaryKey {
+			return fmt.Errorf("order column %s cannot be used with the unique reference %s in table %s", r.OrderColumn, r.Column, table.Name)
+		}
+		col := table.FindColumn(r.OrderColumn)
+		if col == nil || col.Type != ColTypeInt || col.IsGenerated {
+			return fmt.Errorf("order column %s of reference %s must be an int column in table %s", r.OrderColumn, r.Column, table.Name)
+		}
+	}
+	return nil
+}
+
+func (r *Reference) inferPolymorphic(db *Database, table *Table) error {
+	if r.Table != "" {
+		return fmt.Errorf("reference %s in table %s cannot have both a table and tables", r.Column, table.Name)
+	}
+	if len(r.Tables) < 2 {
+		return fmt.Errorf("polymorphic reference %s in table %s must have at least two tables", r.Column, table.Name)
+	}
+	if r.Column == "" {
+		return fmt.Errorf("a column must be specified for the polymorphic reference in table %s", table.Name)
+	}
+	var firstPk *Column
+	for _, name := range r.Tables {
+		t := db.FindTable(name)
+		if t == nil {
+			return fmt.Errorf("table %s was not found", name)
+		}
+		cols := t.PrimaryKeyColumns()
+		if len(cols) != 1 {
+			return fmt.Errorf("referenced table %s does not have a single primary key", name)
+		}
+		pk := t.FindColumn(cols[0])
+		if pk == nil {
+			return fmt.Errorf("primary key column %s not found", cols[0])
+		}
+		if firstPk == nil {
+			firstPk = pk
+			if r.TypeColumn == "" {
+				r.TypeColumn = strings.TrimSuffix(r.Column, "_"+pk.Name) + "_type"
+			}
+		} else if pk.Type != firstPk.Type {
+			return fmt.Errorf("the primary keys of the tables of polymorphic reference %s in table %s must have the same type", r.Column, table.Name)
+		}
+	}
+	if r.TypeColumn == r.Column || table.FindColumn(r.TypeColumn) != nil || table.FindColumn(r.Column) != nil {
+		return fmt.Errorf("the columns of polymorphic reference %s collide with other columns in table %s", r.Column, table.Name)
+	}
+	if r.IndexLevel != IndexLevelNone && r.IndexLevel != IndexLevelIndexed {
+		return fmt.Errorf("polymorphic reference %s in table %s cannot be unique", r.Column, table.Name)
+	}
+	if r.OrderColumn != "" {
+		return fmt.Errorf("polymorphic reference %s in table %s cannot have an order column", r.Column, table.Name)
+	}
 	return nil
 }
 
@@ -137,11 +252,13 @@ func (r *Reference) fillDefaults(db *Database, table *Table) {
 		r.ColumnLabel = strings2.Title(r.ColumnIdentifier)
 	}
 	if r.ObjectIdentifier == "" {
-		t := db.FindTable(r.Table)
+		t := db.FindTable(r.ReferencedTables()[0])
 		pks := t.PrimaryKeyColumns()
 		if len(pks) == 1 {
 			objName := strings.TrimSuffix(r.Column, "_"+pks[0])
 			r.ObjectIdentifier = snaker.SnakeToCamelIdentifier(objName)
+		} else if r.IsPolymorphic() {
+			r.ObjectIdentifier = snaker.SnakeToCamelIdentifier(r.Column)
 		} else {
 			r.ObjectIdentifier = snaker.SnakeToCamelIdentifier(r.Table)
 		}
@@ -151,7 +268,7 @@ func (r *Reference) fillDefaults(db *Database, table *Table) {
 	}
 
 	if r.ReverseIdentifier == "" {
-		if r.ObjectIdentifier == snaker.SnakeToCamelIdentifier(r.Table) {
+		if r.IsPolymorphic() || r.ObjectIdentifier == snaker.SnakeToCamelIdentifier(r.Table) {
 			r.ReverseIdentifier = table.Identifier
 		} else {
 			r.ReverseIdentifier = r.ObjectIdentifier + table.Identifier
@@ -195,3 +312,29 @@ func (r *Reference) ReferenceColumns(db *Database, table *Table) (*Column, *Colu
 
 	return &fk, pk
 }
+
+// PolymorphicColumns generates the type column and the column holding the primary key of the referenced object
+// of a polymorphic reference. Like the foreign key column returned by ReferenceColumns, the primary key column
+// will have the type of the primary keys of the referenced tables, which may be an auto primary key type.
+func (r *Reference) PolymorphicColumns(db *Database) (typeCol *Column, fkCol *Column) {
+	t := db.FindTable(r.Tables[0])
+	pk := t.FindColumn(t.PrimaryKeyColumns()[0])
+
+	fk := *pk
+	fk.Name = r.Column
+	fk.IndexLevel = IndexLevelNone // indexing is handled by the reference
+	fk.Identifier = r.ColumnIdentifier
+	fk.Label = r.ColumnLabel
+	fk.Comment = ""
+	fk.EnumTable = ""
+	fk.IsNullable = r.IsNullable
+
+	typeCol = &Column{
+		Name:       r.TypeColumn,
+		Identifier: snaker.SnakeToCamelIdentifier(r.TypeColumn),
+		Type:       ColTypeString,
+		Size:       PolymorphicTypeSize,
+		IsNullable: r.IsNullable,
+	}
+	return typeCol, &fk
+}
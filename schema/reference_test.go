@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolymorphicReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref     *Reference
+		wantErr bool
+	}{
+		{"valid", &Reference{Column: "commentable_id", Tables: []string{"post", "photo"}}, false},
+		{"one table", &Reference{Column: "commentable_id", Tables: []string{"post"}}, true},
+		{"table and tables", &Reference{Table: "post", Column: "commentable_id", Tables: []string{"post", "photo"}}, true},
+		{"no column", &Reference{Tables: []string{"post", "photo"}}, true},
+		{"missing table", &Reference{Column: "commentable_id", Tables: []string{"post", "video"}}, true},
+		{"pk types", &Reference{Column: "commentable_id", Tables: []string{"post", "tag"}}, true},
+		{"column collision", &Reference{Column: "commentable_id", Tables: []string{"post", "photo"}, TypeColumn: "body"}, true},
+		{"unique", &Reference{Column: "commentable_id", Tables: []string{"post", "photo"}, IndexLevel: IndexLevelUnique}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment := &Table{
+				Name: "comment",
+				Columns: []*Column{
+					{Name: "id", Type: ColTypeAutoPrimaryKey},
+					{Name: "body", Type: ColTypeString, Size: 200},
+				},
+				References: []*Reference{tt.ref},
+			}
+			db := &Database{
+				Tables: []*Table{
+					comment,
+					{Name: "post", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}},
+					{Name: "photo", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}},
+					{Name: "tag", Columns: []*Column{{Name: "id", Type: ColTypeString, Size: 20, IndexLevel: IndexLevelPrimaryKey}}},
+				},
+			}
+			err := db.Clean()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.ref.IsPolymorphic())
+			assert.Equal(t, "commentable_type", tt.ref.TypeColumn)
+			require.NotEmpty(t, comment.Indexes)
+			assert.Equal(t, []string{"commentable_type", "commentable_id"}, comment.Indexes[len(comment.Indexes)-1].Columns)
+			db.FillDefaults()
+			assert.Equal(t, "Commentable", tt.ref.ObjectIdentifier)
+			assert.Equal(t, "Comments", tt.ref.ReverseIdentifierPlural)
+		})
+	}
+}
+
+func TestReferenceOnDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		json       string
+		wantErr    bool
+		wantAction OnDeleteAction
+	}{
+		{"default", `{"table": "person"}`, false, OnDeleteCascade},
+		{"default nullable", `{"table": "person", "nullable": true}`, false, OnDeleteSetNull},
+		{"restrict", `{"table": "person", "on_delete": "restrict"}`, false, OnDeleteRestrict},
+		{"cascade nullable", `{"table": "person", "nullable": true, "on_delete": "cascade"}`, false, OnDeleteCascade},
+		{"no action", `{"table": "person", "on_delete": "no_action"}`, false, OnDeleteNoAction},
+		{"set null", `{"table": "person", "on_delete": "set_null"}`, true, OnDeleteSetNull},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := new(Reference)
+			require.NoError(t, json.Unmarshal([]byte(tt.json), ref))
+			db := &Database{
+				Tables: []*Table{
+					{Name: "person", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}},
+					{Name: "project", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}, References: []*Reference{ref}},
+				},
+			}
+			err := db.Clean()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAction, ref.DeleteAction())
+		})
+	}
+	assert.Error(t, json.Unmarshal([]byte(`"delete"`), new(OnDeleteAction)))
+}
@@ -52,6 +52,9 @@ type Table struct {
 	// implement this, you will need to manually generate or assign the primary key columns.
 	Indexes []*Index `json:"indexes,omitempty"`
 
+	// Checks are check constraints that the database will enforce on each record in the table.
+	Checks []*Check `json:"checks,omitempty"`
+
 	// Identifier is the corresponding Go object name.
 	// It must obey Go identifier labeling rules.
 	// Should be CamelCase.
@@ -75,6 +78,30 @@ type Table struct {
 	// Comment is a place to put a comment in the json description file.
 	// If the database driver supports it, it may be put in the database.
 	Comment string `json:"comment,omitempty"`
+
+	// Extends is the name of a base table that this table is a subtype of.
+	// A subtype table shares the primary key of its base table, and holds the additional columns
+	// of the subtype. Do not declare a primary key in a subtype table, since it will be created as
+	// a reference to the primary key of the base table. The generated subtype object embeds the base object,
+	// and loads and saves both records together.
+	// The base table must have a single primary key, and cannot itself extend another table.
+	Extends string `json:"extends,omitempty"`
+
+	// Lookup indicates that the table holds a small set of records, like categories, that the application
+	// refers to often but that change rarely. In addition to the usual code, a registry is generated
+	// that holds all the records in memory once loaded, and finds them by primary key or by any single-column
+	// unique index without going to the database. The registry is reloaded whenever a change to the table
+	// is broadcast, so records can be added by operations staff without changing the code.
+	// Use an EnumTable instead if the values are fixed at compile time.
+	// A lookup table must have a single primary key, and cannot extend another table.
+	Lookup bool `json:"lookup,omitempty"`
+
+	isView bool
+}
+
+// IsView returns true if the table is the description of a View.
+func (t *Table) IsView() bool {
+	return t.isView
 }
 
 // QualifiedName returns the name to use to refer to the table
@@ -107,6 +134,12 @@ func (t *Table) Clean(db *Database) error {
 		}
 	}
 
+	if t.Extends != "" {
+		if err := t.inferBase(db); err != nil {
+			return err
+		}
+	}
+
 	if len(t.Columns) == 0 && len(t.References) == 0 {
 		return fmt.Errorf("table %q does not have any columns or references", t.QualifiedName())
 	}
@@ -124,6 +157,16 @@ func (t *Table) Clean(db *Database) error {
 		if err := ref.infer(db, t); err != nil {
 			return err
 		}
+		if ref.IsPolymorphic() {
+			// The type column comes first, so that the index can also find all the objects referring to a table.
+			ref.IndexLevel = IndexLevelIndexed
+			t.Indexes = append(t.Indexes,
+				&Index{
+					IndexLevel: IndexLevelIndexed,
+					Columns:    []string{ref.TypeColumn, ref.Column},
+				})
+			continue
+		}
 		if ref.IndexLevel == IndexLevelNone {
 			if !t.columnIsIndexed(ref.Column) {
 				ref.IndexLevel = IndexLevelIndexed
@@ -140,7 +183,17 @@ func (t *Table) Clean(db *Database) error {
 
 	var hasPk bool
 	for _, i := range t.Indexes {
-		i.infer(t)
+		if err := i.infer(t); err != nil {
+			return err
+		}
+		if i.IndexLevel == IndexLevelFullText {
+			if len(i.Columns) != 1 {
+				return fmt.Errorf("full-text index %s in table %s must have exactly one column", i.Name, t.QualifiedName())
+			}
+			if c := t.FindColumn(i.Columns[0]); c == nil || c.Type != ColTypeString {
+				return fmt.Errorf("full-text index %s in table %s must be on a string column", i.Name, t.QualifiedName())
+			}
+		}
 		if i.IndexLevel == IndexLevelPrimaryKey &&
 			len(i.Columns) > 0 {
 
@@ -150,6 +203,67 @@ func (t *Table) Clean(db *Database) error {
 	if !hasPk {
 		return fmt.Errorf("table %s has no primary key", t.QualifiedName())
 	}
+	if t.Lookup {
+		if t.Extends != "" {
+			return fmt.Errorf("lookup table %s cannot extend another table", t.QualifiedName())
+		}
+		if len(t.PrimaryKeyColumns()) != 1 {
+			return fmt.Errorf("lookup table %s must have a single primary key", t.QualifiedName())
+		}
+	}
+	for i, c := range t.Checks {
+		if err := c.infer(t, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inferBase checks the base table of a subtype table and adds the reference to it that holds the primary key.
+func (t *Table) inferBase(db *Database) error {
+	base := db.FindTable(t.Extends)
+	if base == nil {
+		return fmt.Errorf("base table %s of table %s was not found", t.Extends, t.QualifiedName())
+	}
+	if base == t {
+		return fmt.Errorf("table %s cannot extend itself", t.QualifiedName())
+	}
+	if base.Extends != "" {
+		return fmt.Errorf("table %s cannot extend table %s, since that table extends another table", t.QualifiedName(), t.Extends)
+	}
+	pks := base.PrimaryKeyColumns()
+	if len(pks) != 1 {
+		return fmt.Errorf("base table %s of table %s must have a single primary key", t.Extends, t.QualifiedName())
+	}
+	if t.BaseReference() != nil {
+		return nil // already cleaned
+	}
+	if t.PrimaryKeyColumns() != nil {
+		return fmt.Errorf("table %s extends table %s and cannot declare its own primary key", t.QualifiedName(), t.Extends)
+	}
+	if t.FindColumn(pks[0]) != nil {
+		return fmt.Errorf("column %s in table %s collides with the primary key of base table %s", pks[0], t.QualifiedName(), t.Extends)
+	}
+	t.References = append(t.References, &Reference{
+		Table:      t.Extends,
+		Column:     pks[0],
+		IndexLevel: IndexLevelPrimaryKey,
+		OnDelete:   OnDeleteCascade,
+	})
+	return nil
+}
+
+// BaseReference returns the reference that links a subtype table to its base table through the primary key,
+// or nil if the table does not extend another table or has not been cleaned yet.
+func (t *Table) BaseReference() *Reference {
+	if t.Extends == "" {
+		return nil
+	}
+	for _, ref := range t.References {
+		if ref.Table == t.Extends && ref.IndexLevel == IndexLevelPrimaryKey {
+			return ref
+		}
+	}
 	return nil
 }
 
@@ -169,6 +283,13 @@ func (t *Table) fillDefaults(db *Database) {
 	for _, c := range t.Columns {
 		c.fillDefaults()
 	}
+	if ref := t.BaseReference(); ref != nil && ref.ObjectIdentifier == "" {
+		// The subtype object embeds the base object, so it is named after the base table
+		ref.ObjectIdentifier = db.FindTable(t.Extends).Identifier
+		if ref.ObjectIdentifier == "" {
+			ref.ObjectIdentifier = snaker.SnakeToCamelIdentifier(t.Extends)
+		}
+	}
 	for _, r := range t.References {
 		r.fillDefaults(db, t)
 	}
@@ -180,13 +301,19 @@ func (t *Table) fillDefaults(db *Database) {
 
 // PrimaryKeyColumns returns the names of the primary key columns of the table, or nil if not found.
 // Note that these names may refer to reference columns.
-// This only works after Clean has been called.
+// Before Clean has been called, this will only find a primary key that is declared by an Index or a Column.
 func (t *Table) PrimaryKeyColumns() []string {
 	for _, i := range t.Indexes {
 		if i.IndexLevel == IndexLevelPrimaryKey {
 			return i.Columns
 		}
 	}
+	// The table has not been cleaned yet, which happens when tables refer to each other in a circle.
+	for _, c := range t.Columns {
+		if c.IndexLevel == IndexLevelPrimaryKey || c.Type == ColTypeAutoPrimaryKey {
+			return []string{c.Name}
+		}
+	}
 	return nil
 }
 
@@ -220,6 +347,9 @@ func (t *Table) columnIdentifier(n string) string {
 		if r.Column == n {
 			return r.ColumnIdentifier
 		}
+		if r.IsPolymorphic() && r.TypeColumn == n {
+			return snaker.SnakeToCamelIdentifier(r.TypeColumn)
+		}
 	}
 	return ""
 }
@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableExtends(t *testing.T) {
+	tests := []struct {
+		name    string
+		extends string
+		columns []*Column
+		wantErr bool
+	}{
+		{"valid", "person", []*Column{{Name: "salary", Type: ColTypeInt}}, false},
+		{"missing base", "party", []*Column{{Name: "salary", Type: ColTypeInt}}, true},
+		{"base extends", "customer", []*Column{{Name: "salary", Type: ColTypeInt}}, true},
+		{"composite base", "two_key", []*Column{{Name: "salary", Type: ColTypeInt}}, true},
+		{"own pk", "person", []*Column{{Name: "code", Type: ColTypeString, Size: 10, IndexLevel: IndexLevelPrimaryKey}}, true},
+		{"pk collision", "person", []*Column{{Name: "id", Type: ColTypeInt}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			employee := &Table{
+				Name:    "employee",
+				Extends: tt.extends,
+				Columns: tt.columns,
+			}
+			db := &Database{
+				Tables: []*Table{
+					employee,
+					{Name: "person", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}},
+					{Name: "customer", Extends: "person", Columns: []*Column{{Name: "rating", Type: ColTypeInt}}},
+					{
+						Name: "two_key",
+						Columns: []*Column{
+							{Name: "a", Type: ColTypeInt},
+							{Name: "b", Type: ColTypeInt},
+						},
+						Indexes: []*Index{{Columns: []string{"a", "b"}, IndexLevel: IndexLevelPrimaryKey}},
+					},
+				},
+			}
+			err := db.Clean()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "person", db.Tables[0].Name, "base tables sort before their subtypes")
+			ref := employee.BaseReference()
+			require.NotNil(t, ref)
+			assert.Equal(t, "id", ref.Column)
+			assert.Equal(t, OnDeleteCascade, ref.OnDelete)
+			assert.Equal(t, []string{"id"}, employee.PrimaryKeyColumns())
+
+			// Cleaning again does not add another reference
+			require.NoError(t, employee.Clean(db))
+			assert.Len(t, employee.References, 1)
+
+			db.FillDefaults()
+			assert.Equal(t, "Person", ref.ObjectIdentifier)
+			assert.Equal(t, "Employee", ref.ReverseIdentifier)
+		})
+	}
+}
+
+func TestTableLookup(t *testing.T) {
+	tests := []struct {
+		name    string
+		table   *Table
+		wantErr bool
+	}{
+		{"valid", &Table{Name: "category", Lookup: true, Columns: []*Column{
+			{Name: "id", Type: ColTypeAutoPrimaryKey},
+			{Name: "code", Type: ColTypeString, Size: 20, IndexLevel: IndexLevelUnique},
+		}}, false},
+		{"composite key", &Table{Name: "category", Lookup: true,
+			Columns: []*Column{
+				{Name: "a", Type: ColTypeInt},
+				{Name: "b", Type: ColTypeInt},
+			},
+			Indexes: []*Index{{Columns: []string{"a", "b"}, IndexLevel: IndexLevelPrimaryKey}},
+		}, true},
+		{"extends", &Table{Name: "category", Lookup: true, Extends: "person", Columns: []*Column{
+			{Name: "code", Type: ColTypeString, Size: 20},
+		}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &Database{
+				Tables: []*Table{
+					tt.table,
+					{Name: "person", Columns: []*Column{{Name: "id", Type: ColTypeAutoPrimaryKey}}},
+				},
+			}
+			err := db.Clean()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// View describes a database view.
+//
+// The ORM treats a view as a read-only table. Code generation will create an object, node and query builder
+// for the view so that it can be queried like a table, but the object will not have setters or Save and Delete methods.
+//
+// The ORM needs a way to uniquely identify each row, so one of the columns must be given an IndexLevel
+// of IndexLevelPrimaryKey. No index is created in the database.
+type View struct {
+	// Name is the name of the view as used in the database.
+	// Should be lower_snake_case.
+	Name string `json:"name"`
+
+	// For databases that support schemas, this is the name of the schema of the view.
+	// Leave blank for the default schema.
+	Schema string `json:"schema,omitempty"`
+
+	// Sql is the SELECT statement that defines the view. The key is a db.DriverType constant. For example:
+	//  {"postgres":"SELECT id, name FROM project WHERE status_enum = 1"}
+	// The view will not be created in databases that do not have an entry.
+	Sql map[string]string `json:"sql"`
+
+	// DependsOn lists the names of other views that this view selects from, so that they are created before this view.
+	DependsOn []string `json:"depends_on,omitempty"`
+
+	// Columns describes the columns that the view returns.
+	// The Name of each column must match the name of a column returned by Sql.
+	Columns []*Column `json:"columns"`
+
+	// References describes columns of the view that refer to records in tables.
+	// These will create accessors from the view to the table, but not in the reverse direction.
+	References []*Reference `json:"references,omitempty"`
+
+	// ReadTimeout is used to wrap read transactions with a timeout on their contexts.
+	// Leaving it as zero will use the Database.ReadTimeout value.
+	// Use a duration format understood by time.ParseDuration.
+	ReadTimeout string `json:"read_timeout,omitempty"`
+
+	// Identifier is the corresponding Go object name.
+	// If empty, will be based on Name.
+	Identifier string `json:"identifier,omitempty"`
+
+	// IdentifierPlural is the plural form of Identifier.
+	// If blank, will be base on Identifier.
+	IdentifierPlural string `json:"identifier_plural,omitempty"`
+
+	// Label is the name of the object when describing it to humans.
+	// If left blank, will be base on Identifier.
+	Label string `json:"label,omitempty"`
+
+	// LabelPlural is the plural form of the Label.
+	// If left blank, will be based on Label.
+	LabelPlural string `json:"label_plural,omitempty"`
+
+	// Comment is a place to put a comment in the json description file.
+	Comment string `json:"comment,omitempty"`
+
+	table *Table
+}
+
+// QualifiedName returns the name to use to refer to the view
+// in the database, including the schema if one is provided.
+func (v *View) QualifiedName() string {
+	if v.Schema == "" {
+		return v.Name
+	} else {
+		return v.Schema + "." + v.Name
+	}
+}
+
+// SqlFor returns the SELECT statement that defines the view for the given db.DriverType,
+// or an empty string if the view is not defined for that database.
+func (v *View) SqlFor(driverType string) string {
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(v.Sql[driverType]), ";"))
+}
+
+// Table returns the description of the view as a table that is used to generate the ORM.
+// Only valid after the database has been cleaned.
+func (v *View) Table() *Table {
+	return v.table
+}
+
+func (v *View) infer(db *Database) error {
+	if len(v.Sql) == 0 {
+		return fmt.Errorf("view %s does not have any sql", v.QualifiedName())
+	}
+	for _, d := range v.DependsOn {
+		if db.FindView(d) == nil {
+			return fmt.Errorf("view %s depends on view %s, which was not found", v.QualifiedName(), d)
+		}
+	}
+	for _, r := range v.References {
+		if r.IsPolymorphic() {
+			return fmt.Errorf("view %s cannot have the polymorphic reference %s", v.QualifiedName(), r.Column)
+		}
+		if db.FindView(r.Table) != nil {
+			return fmt.Errorf("view %s has a reference to view %s. References must point to tables", v.QualifiedName(), r.Table)
+		}
+	}
+	for _, c := range v.Columns {
+		if c.IsGenerated || c.Type == ColTypeAutoPrimaryKey {
+			return fmt.Errorf("column %s in view %s must not be generated", c.Name, v.QualifiedName())
+		}
+	}
+
+	v.table = &Table{
+		Name:             v.Name,
+		Schema:           v.Schema,
+		ReadTimeout:      v.ReadTimeout,
+		NoTest:           true, // the automated tests need to save records
+		Columns:          v.Columns,
+		References:       v.References,
+		Identifier:       v.Identifier,
+		IdentifierPlural: v.IdentifierPlural,
+		Label:            v.Label,
+		LabelPlural:      v.LabelPlural,
+		Comment:          v.Comment,
+		isView:           true,
+	}
+	if err := v.table.Clean(db); err != nil {
+		return fmt.Errorf("view %s: %w", v.QualifiedName(), err)
+	}
+	return nil
+}
+
+func (v *View) fillDefaults(db *Database) {
+	if v.table != nil {
+		v.table.fillDefaults(db)
+		v.Identifier = v.table.Identifier
+		v.IdentifierPlural = v.table.IdentifierPlural
+		v.Label = v.table.Label
+		v.LabelPlural = v.table.LabelPlural
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/goradd/gro/query"
 	"github.com/goradd/maps"
 	"github.com/goradd/strings"
 	"golang.org/x/exp/constraints"
@@ -22,6 +23,7 @@ func randomString(source string, n int) string {
 // For strings and []byte types, if size is 0, a size of 10 will be used as a reasonable limit.
 // size will indicate the number of bytes or characters generated.
 // times do not generate fractional seconds, since the value might be truncated depending on the sql dialect and data type.
+// durations are whole microseconds, since that is the precision that databases store.
 func RandomValue[T any](size int) T {
 	var v T
 	var i any
@@ -93,6 +95,21 @@ func RandomValue[T any](size int) T {
 		i = rng.Float32()
 	case time.Time:
 		i = time.Unix(int64(rng.Uint32()), 0).UTC()
+	case time.Duration:
+		i = time.Duration(rng.Int63n(1<<40)*int64(rng.Intn(2)*2-1)) * time.Microsecond
+	case []string:
+		// size limits the number of items, which always includes at least one
+		a := make([]string, rng.Intn(min(max(size, 1), 5))+1)
+		for j := range a {
+			a[j] = randomString(strings.AlphaNumeric, 10)
+		}
+		i = a
+	case []int:
+		a := make([]int, rng.Intn(min(max(size, 1), 5))+1)
+		for j := range a {
+			a[j] = int(rng.Int31()) * (rng.Intn(2)*2 - 1)
+		}
+		i = a
 	}
 	return i.(T)
 }
@@ -124,6 +141,11 @@ func RandomEnumArray[T ~int](valueList []T) *maps.OrderedSet[T] {
 	return values
 }
 
+// RandomEnumSlice returns a slice with at least one value, chosen from valueList without repeating.
+func RandomEnumSlice[T ~int](valueList []T) []T {
+	return RandomEnumArray(valueList).Values()
+}
+
 func RandomDecimal(precision int, scale int) string {
 	l := precision - scale
 	pc := rng.Intn(l + 1)
@@ -141,3 +163,28 @@ func RandomDecimal(precision int, scale int) string {
 	s = randomString("+-", 1) + s
 	return s
 }
+
+// RandomGeometry returns a geometry of the given type with random coordinates.
+// Line strings have three points, and polygons are a single closed triangle.
+func RandomGeometry(typ query.GeometryType) query.Geometry {
+	p := func() query.Point {
+		return query.Point{X: float64(rng.Intn(360000)-180000) / 1000, Y: float64(rng.Intn(180000)-90000) / 1000}
+	}
+	switch typ {
+	case query.GeometryLineString:
+		return query.NewLineString(p(), p(), p())
+	case query.GeometryPolygon:
+		p1 := p()
+		p2 := query.Point{X: p1.X + 1, Y: p1.Y}
+		p3 := query.Point{X: p1.X, Y: p1.Y + 1}
+		return query.NewPolygon([]query.Point{p1, p2, p3, p1})
+	default:
+		return query.NewPoint(p().X, p().Y)
+	}
+}
+
+// RandomJSON returns a JSON object with a single random key and string value. It is formatted the way that
+// databases return their JSON values, so that it is returned unchanged after it is saved.
+func RandomJSON() string {
+	return fmt.Sprintf(`{"%s": "%s"}`, randomString(strings.AlphaNumeric, 5), randomString(strings.AlphaNumeric, 10))
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/goradd/gro/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,6 +32,10 @@ func TestRandomValue(t *testing.T) {
 	t2 := RandomValue[time.Time](0)
 	fmt.Println(t2)
 
+	d := RandomValue[time.Duration](0)
+	fmt.Println(d)
+	assert.Zero(t, d%time.Microsecond)
+
 	s := RandomValue[string](0)
 	fmt.Println(s)
 
@@ -144,3 +149,16 @@ func TestRandomDecimal(t *testing.T) {
 		t.Errorf(`Non-float was returned by RandomDecimal(10,5): %s`, err.Error())
 	}
 }
+
+func TestRandomGeometry(t *testing.T) {
+	g := RandomGeometry(query.GeometryPoint)
+	assert.Equal(t, query.GeometryPoint, g.Type())
+
+	g = RandomGeometry(query.GeometryLineString)
+	assert.Equal(t, query.GeometryLineString, g.Type())
+	assert.Len(t, g.Points(), 3)
+
+	g = RandomGeometry(query.GeometryPolygon)
+	assert.Equal(t, query.GeometryPolygon, g.Type())
+	assert.Equal(t, g.Points()[0], g.Points()[3])
+}